@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package alert
+
+import (
+	"context"
+	"time"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/workerpool"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Evaluator - periodically evaluates active alert rules against blob statistics.
+// It opens alert when metric exceeds rule threshold and resolves it when metric returns back.
+// State changes are published to postgres channel, so every API instance pushes them to websocket clients.
+type Evaluator struct {
+	rules       storage.IAlertRule
+	alerts      storage.IAlert
+	notificator storage.Notificator
+
+	interval         time.Duration
+	percentileWindow time.Duration
+	now              func() time.Time
+
+	log zerolog.Logger
+	g   workerpool.Group
+}
+
+func NewEvaluator(
+	rules storage.IAlertRule,
+	alerts storage.IAlert,
+	notificator storage.Notificator,
+	opts ...EvaluatorOption,
+) *Evaluator {
+	e := &Evaluator{
+		rules:            rules,
+		alerts:           alerts,
+		notificator:      notificator,
+		interval:         time.Minute,
+		percentileWindow: time.Hour,
+		now:              time.Now,
+		log:              log.With().Str("module", "alerts").Logger(),
+		g:                workerpool.NewGroup(),
+	}
+
+	for i := range opts {
+		opts[i](e)
+	}
+
+	return e
+}
+
+func (e *Evaluator) Start(ctx context.Context) {
+	e.g.GoCtx(ctx, e.run)
+}
+
+func (e *Evaluator) Close() error {
+	e.g.Wait()
+	return nil
+}
+
+func (e *Evaluator) run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.Evaluate(ctx); err != nil {
+				e.log.Err(err).Msg("evaluating alert rules")
+			}
+		}
+	}
+}
+
+// Evaluate - evaluates all active rules once
+func (e *Evaluator) Evaluate(ctx context.Context) error {
+	rules, err := e.rules.Active(ctx)
+	if err != nil {
+		return errors.Wrap(err, "receive active rules")
+	}
+
+	now := e.now().UTC()
+	for i := range rules {
+		if err := e.evaluateRule(ctx, rules[i], now); err != nil {
+			e.log.Err(err).Uint64("rule_id", rules[i].Id).Msg("evaluating alert rule")
+		}
+	}
+	return nil
+}
+
+func (e *Evaluator) evaluateRule(ctx context.Context, rule storage.AlertRule, now time.Time) error {
+	value, err := e.metric(ctx, rule, now)
+	if err != nil {
+		return errors.Wrapf(err, "compute %s metric", rule.Kind)
+	}
+
+	if value.GreaterThan(rule.Threshold) {
+		alert := storage.Alert{
+			RuleId:      rule.Id,
+			Kind:        rule.Kind,
+			RollupId:    rule.RollupId,
+			NamespaceId: rule.NamespaceId,
+			Value:       value,
+			Threshold:   rule.Threshold,
+			StartedAt:   now,
+		}
+		opened, err := e.alerts.Open(ctx, &alert)
+		if err != nil {
+			return errors.Wrap(err, "open alert")
+		}
+		if opened {
+			return e.notify(ctx, rule, alert)
+		}
+		return nil
+	}
+
+	resolved, err := e.alerts.Resolve(ctx, rule.Id, now)
+	if err != nil {
+		return errors.Wrap(err, "resolve alert")
+	}
+	for i := range resolved {
+		if err := e.notify(ctx, rule, resolved[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Evaluator) metric(ctx context.Context, rule storage.AlertRule, now time.Time) (types.Numeric, error) {
+	switch rule.Kind {
+	case types.AlertRuleKindNoBlob:
+		last, err := e.alerts.LastBlobTime(ctx, rule)
+		if err != nil {
+			return types.NumericZero(), err
+		}
+		// silence is counted since rule creation if there were no blobs after it
+		if last.Before(rule.CreatedAt) {
+			last = rule.CreatedAt
+		}
+		return types.NumericFromInt64(int64(now.Sub(last).Seconds())), nil
+	case types.AlertRuleKindHourlyFee:
+		return e.alerts.HourlyFee(ctx, rule, now.Truncate(time.Hour))
+	case types.AlertRuleKindHourlySize:
+		size, err := e.alerts.HourlySize(ctx, rule, now.Truncate(time.Hour))
+		if err != nil {
+			return types.NumericZero(), err
+		}
+		return types.NumericFromInt64(size), nil
+	case types.AlertRuleKindBlobSizeP99:
+		size, err := e.alerts.BlobSizeP99(ctx, rule, now.Add(-e.percentileWindow))
+		if err != nil {
+			return types.NumericZero(), err
+		}
+		return types.NumericFromFloat64(size), nil
+	default:
+		return types.NumericZero(), errors.Errorf("unknown rule kind: %s", rule.Kind)
+	}
+}
+
+func (e *Evaluator) notify(ctx context.Context, rule storage.AlertRule, alert storage.Alert) error {
+	// api key of rule owner must not be sent to public channel
+	alert.Rule = &storage.AlertRule{
+		Id:   rule.Id,
+		Name: rule.Name,
+	}
+	payload, err := json.MarshalString([]storage.Alert{alert})
+	if err != nil {
+		return errors.Wrap(err, "marshal alert")
+	}
+	return e.notificator.Notify(ctx, storage.ChannelAlert, payload)
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testNow = time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+func newTestEvaluator(t *testing.T) (*Evaluator, *mock.MockIAlertRule, *mock.MockIAlert, *mock.MockNotificator) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	rules := mock.NewMockIAlertRule(ctrl)
+	alerts := mock.NewMockIAlert(ctrl)
+	notificator := mock.NewMockNotificator(ctrl)
+
+	e := NewEvaluator(rules, alerts, notificator)
+	e.now = func() time.Time { return testNow }
+	return e, rules, alerts, notificator
+}
+
+func TestEvaluateOpensAlert(t *testing.T) {
+	e, rules, alerts, notificator := newTestEvaluator(t)
+
+	rule := storage.AlertRule{
+		Id:        1,
+		ApiKey:    "secret_key",
+		Name:      "silence",
+		Kind:      types.AlertRuleKindNoBlob,
+		RollupId:  10,
+		Threshold: types.NumericFromInt64(600),
+		Active:    true,
+		CreatedAt: testNow.Add(-24 * time.Hour),
+	}
+
+	rules.EXPECT().
+		Active(gomock.Any()).
+		Return([]storage.AlertRule{rule}, nil).
+		Times(1)
+
+	alerts.EXPECT().
+		LastBlobTime(gomock.Any(), rule).
+		Return(testNow.Add(-15*time.Minute), nil).
+		Times(1)
+
+	alerts.EXPECT().
+		Open(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, alert *storage.Alert) (bool, error) {
+			require.EqualValues(t, 1, alert.RuleId)
+			require.EqualValues(t, 10, alert.RollupId)
+			require.Equal(t, "900", alert.Value.String())
+			require.Equal(t, testNow, alert.StartedAt)
+			alert.Id = 5
+			return true, nil
+		}).
+		Times(1)
+
+	notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelAlert, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, payload string) error {
+			require.NotContains(t, payload, "secret_key")
+
+			var sent []storage.Alert
+			require.NoError(t, json.UnmarshalString(payload, &sent))
+			require.Len(t, sent, 1)
+			require.EqualValues(t, 5, sent[0].Id)
+			require.NotNil(t, sent[0].Rule)
+			require.Equal(t, "silence", sent[0].Rule.Name)
+			return nil
+		}).
+		Times(1)
+
+	require.NoError(t, e.Evaluate(t.Context()))
+}
+
+func TestEvaluateAlreadyOpened(t *testing.T) {
+	e, rules, alerts, _ := newTestEvaluator(t)
+
+	rule := storage.AlertRule{
+		Id:          2,
+		Kind:        types.AlertRuleKindHourlyFee,
+		NamespaceId: 3,
+		Threshold:   types.NumericFromInt64(1000),
+		Active:      true,
+	}
+
+	rules.EXPECT().
+		Active(gomock.Any()).
+		Return([]storage.AlertRule{rule}, nil).
+		Times(1)
+
+	alerts.EXPECT().
+		HourlyFee(gomock.Any(), rule, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)).
+		Return(types.NumericFromInt64(5000), nil).
+		Times(1)
+
+	alerts.EXPECT().
+		Open(gomock.Any(), gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	require.NoError(t, e.Evaluate(t.Context()))
+}
+
+func TestEvaluateResolvesAlert(t *testing.T) {
+	e, rules, alerts, notificator := newTestEvaluator(t)
+
+	rule := storage.AlertRule{
+		Id:          3,
+		Kind:        types.AlertRuleKindBlobSizeP99,
+		NamespaceId: 3,
+		Threshold:   types.NumericFromInt64(100_000),
+		Active:      true,
+	}
+
+	rules.EXPECT().
+		Active(gomock.Any()).
+		Return([]storage.AlertRule{rule}, nil).
+		Times(1)
+
+	alerts.EXPECT().
+		BlobSizeP99(gomock.Any(), rule, testNow.Add(-time.Hour)).
+		Return(512.5, nil).
+		Times(1)
+
+	resolvedAt := testNow
+	alerts.EXPECT().
+		Resolve(gomock.Any(), uint64(3), testNow).
+		Return([]storage.Alert{
+			{Id: 7, RuleId: 3, ResolvedAt: &resolvedAt},
+		}, nil).
+		Times(1)
+
+	notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelAlert, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, e.Evaluate(t.Context()))
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package alert
+
+import "time"
+
+type EvaluatorOption func(*Evaluator)
+
+func WithInterval(interval time.Duration) EvaluatorOption {
+	return func(e *Evaluator) {
+		if interval > 0 {
+			e.interval = interval
+		}
+	}
+}
+
+func WithPercentileWindow(window time.Duration) EvaluatorOption {
+	return func(e *Evaluator) {
+		if window > 0 {
+			e.percentileWindow = window
+		}
+	}
+}
@@ -69,7 +69,21 @@ func (d *Dispatcher) Start(ctx context.Context) {
 		}
 	}
 
-	if err := d.listener.Subscribe(ctx, storage.ChannelHead, storage.ChannelBlock); err != nil {
+	if err := d.listener.Subscribe(ctx,
+		storage.ChannelHead,
+		storage.ChannelBlock,
+		storage.ChannelTx,
+		storage.ChannelMessage,
+		storage.ChannelBlob,
+		storage.ChannelJail,
+		storage.ChannelProposal,
+		storage.ChannelAlert,
+		storage.ChannelMempool,
+		storage.ChannelRollback,
+		storage.ChannelLabel,
+		storage.ChannelAddress,
+		storage.ChannelApiKey,
+	); err != nil {
 		log.Err(err).Msg("subscribe on postgres notifications")
 		return
 	}
@@ -112,6 +126,28 @@ func (d *Dispatcher) handleNotification(ctx context.Context, notification pgconn
 		return d.handleState(ctx, notification.Payload)
 	case storage.ChannelBlock:
 		return d.handleBlock(ctx, notification.Payload)
+	case storage.ChannelTx:
+		return d.handleTxs(notification.Payload)
+	case storage.ChannelMessage:
+		return d.handleMessages(notification.Payload)
+	case storage.ChannelBlob:
+		return d.handleBlobs(notification.Payload)
+	case storage.ChannelJail:
+		return d.handleJails(notification.Payload)
+	case storage.ChannelProposal:
+		return d.handleProposals(notification.Payload)
+	case storage.ChannelAlert:
+		return d.handleAlerts(notification.Payload)
+	case storage.ChannelMempool:
+		return d.handleMempool(notification.Payload)
+	case storage.ChannelRollback:
+		return d.handleRollback(notification.Payload)
+	case storage.ChannelLabel:
+		return d.handleLabel(notification.Payload)
+	case storage.ChannelAddress:
+		return d.handleAddresses(notification.Payload)
+	case storage.ChannelApiKey:
+		return d.handleApiKey(notification.Payload)
 	default:
 		return errors.Errorf("unknown channel name: %s", notification.Channel)
 	}
@@ -158,3 +194,169 @@ func (d *Dispatcher) handleState(ctx context.Context, payload string) error {
 	d.mx.RUnlock()
 	return nil
 }
+
+func (d *Dispatcher) handleTxs(payload string) error {
+	var txs []storage.Tx
+	if err := json.Unmarshal([]byte(payload), &txs); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range txs {
+		for j := range d.observers {
+			d.observers[j].notifyTxs(&txs[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleMessages(payload string) error {
+	var msgs []storage.Message
+	if err := json.Unmarshal([]byte(payload), &msgs); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range msgs {
+		for j := range d.observers {
+			d.observers[j].notifyMessages(&msgs[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleBlobs(payload string) error {
+	var blobs []storage.BlobLog
+	if err := json.Unmarshal([]byte(payload), &blobs); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range blobs {
+		for j := range d.observers {
+			d.observers[j].notifyBlobs(&blobs[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleJails(payload string) error {
+	var jails []storage.Jail
+	if err := json.Unmarshal([]byte(payload), &jails); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range jails {
+		for j := range d.observers {
+			d.observers[j].notifyJails(&jails[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleProposals(payload string) error {
+	var proposals []storage.Proposal
+	if err := json.Unmarshal([]byte(payload), &proposals); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range proposals {
+		for j := range d.observers {
+			d.observers[j].notifyProposals(&proposals[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleAlerts(payload string) error {
+	var alerts []storage.Alert
+	if err := json.Unmarshal([]byte(payload), &alerts); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range alerts {
+		for j := range d.observers {
+			d.observers[j].notifyAlerts(&alerts[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleMempool(payload string) error {
+	var txs []storage.MempoolTx
+	if err := json.Unmarshal([]byte(payload), &txs); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range txs {
+		for j := range d.observers {
+			d.observers[j].notifyMempool(&txs[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleRollback(payload string) error {
+	rollback := new(storage.Rollback)
+	if err := json.Unmarshal([]byte(payload), rollback); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range d.observers {
+		d.observers[i].notifyRollback(rollback)
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleLabel(payload string) error {
+	label := new(storage.AddressLabel)
+	if err := json.Unmarshal([]byte(payload), label); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range d.observers {
+		d.observers[i].notifyLabel(label)
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+func (d *Dispatcher) handleAddresses(payload string) error {
+	var addresses []storage.Address
+	if err := json.Unmarshal([]byte(payload), &addresses); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range addresses {
+		for j := range d.observers {
+			d.observers[j].notifyAddresses(&addresses[i])
+		}
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
+// handleApiKey - payload is identity of the key which was changed, rotated or revoked
+func (d *Dispatcher) handleApiKey(payload string) error {
+	d.mx.RLock()
+	for i := range d.observers {
+		d.observers[i].notifyApiKey(payload)
+	}
+	d.mx.RUnlock()
+	return nil
+}
@@ -4,16 +4,47 @@
 package bus
 
 import (
+	"sync/atomic"
+
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/workerpool"
+	"github.com/rs/zerolog/log"
 )
 
+// droppedLogInterval - dropped notifications are logged once per the interval to not flood the log with a slow subscriber
+const droppedLogInterval = 1000
+
 type Observer struct {
-	blocks chan *storage.Block
-	state  chan *storage.State
+	blocks    chan *storage.Block
+	state     chan *storage.State
+	txs       chan *storage.Tx
+	messages  chan *storage.Message
+	blobs     chan *storage.BlobLog
+	jails     chan *storage.Jail
+	proposals chan *storage.Proposal
+	alerts    chan *storage.Alert
+	mempool   chan *storage.MempoolTx
+	rollbacks chan *storage.Rollback
+	labels    chan *storage.AddressLabel
+	addresses chan *storage.Address
+	apiKeys   chan string
 
-	listenBlocks bool
-	listenHead   bool
+	listenBlocks    bool
+	listenHead      bool
+	listenTxs       bool
+	listenMessages  bool
+	listenBlobs     bool
+	listenJails     bool
+	listenProposals bool
+	listenAlerts    bool
+	listenMempool   bool
+	listenRollbacks bool
+	listenLabels    bool
+	listenAddresses bool
+	listenApiKeys   bool
+
+	// dropped - count of notifications which were dropped because the subscriber didn't read its channel
+	dropped *atomic.Uint64
 
 	g workerpool.Group
 }
@@ -24,9 +55,21 @@ func NewObserver(channels ...string) *Observer {
 	}
 
 	observer := &Observer{
-		blocks: make(chan *storage.Block, 1024),
-		state:  make(chan *storage.State, 1024),
-		g:      workerpool.NewGroup(),
+		blocks:    make(chan *storage.Block, 1024),
+		state:     make(chan *storage.State, 1024),
+		txs:       make(chan *storage.Tx, 1024),
+		messages:  make(chan *storage.Message, 1024),
+		blobs:     make(chan *storage.BlobLog, 1024),
+		jails:     make(chan *storage.Jail, 1024),
+		proposals: make(chan *storage.Proposal, 1024),
+		alerts:    make(chan *storage.Alert, 1024),
+		mempool:   make(chan *storage.MempoolTx, 1024),
+		rollbacks: make(chan *storage.Rollback, 1024),
+		labels:    make(chan *storage.AddressLabel, 1024),
+		addresses: make(chan *storage.Address, 1024),
+		apiKeys:   make(chan string, 1024),
+		dropped:   new(atomic.Uint64),
+		g:         workerpool.NewGroup(),
 	}
 
 	for i := range channels {
@@ -35,6 +78,28 @@ func NewObserver(channels ...string) *Observer {
 			observer.listenBlocks = true
 		case storage.ChannelHead:
 			observer.listenHead = true
+		case storage.ChannelTx:
+			observer.listenTxs = true
+		case storage.ChannelMessage:
+			observer.listenMessages = true
+		case storage.ChannelBlob:
+			observer.listenBlobs = true
+		case storage.ChannelJail:
+			observer.listenJails = true
+		case storage.ChannelProposal:
+			observer.listenProposals = true
+		case storage.ChannelAlert:
+			observer.listenAlerts = true
+		case storage.ChannelMempool:
+			observer.listenMempool = true
+		case storage.ChannelRollback:
+			observer.listenRollbacks = true
+		case storage.ChannelLabel:
+			observer.listenLabels = true
+		case storage.ChannelAddress:
+			observer.listenAddresses = true
+		case storage.ChannelApiKey:
+			observer.listenApiKeys = true
 		}
 	}
 
@@ -45,6 +110,17 @@ func (observer Observer) Close() error {
 	observer.g.Wait()
 	close(observer.blocks)
 	close(observer.state)
+	close(observer.txs)
+	close(observer.messages)
+	close(observer.blobs)
+	close(observer.jails)
+	close(observer.proposals)
+	close(observer.alerts)
+	close(observer.mempool)
+	close(observer.rollbacks)
+	close(observer.labels)
+	close(observer.addresses)
+	close(observer.apiKeys)
 	return nil
 }
 
@@ -60,6 +136,93 @@ func (observer Observer) notifyState(state *storage.State) {
 	}
 }
 
+func (observer Observer) notifyTxs(tx *storage.Tx) {
+	if observer.listenTxs {
+		trySend(observer, observer.txs, tx, storage.ChannelTx)
+	}
+}
+
+func (observer Observer) notifyMessages(msg *storage.Message) {
+	if observer.listenMessages {
+		trySend(observer, observer.messages, msg, storage.ChannelMessage)
+	}
+}
+
+func (observer Observer) notifyBlobs(blob *storage.BlobLog) {
+	if observer.listenBlobs {
+		observer.blobs <- blob
+	}
+}
+
+func (observer Observer) notifyJails(jail *storage.Jail) {
+	if observer.listenJails {
+		observer.jails <- jail
+	}
+}
+
+func (observer Observer) notifyProposals(proposal *storage.Proposal) {
+	if observer.listenProposals {
+		observer.proposals <- proposal
+	}
+}
+
+func (observer Observer) notifyAlerts(alert *storage.Alert) {
+	if observer.listenAlerts {
+		observer.alerts <- alert
+	}
+}
+
+func (observer Observer) notifyMempool(tx *storage.MempoolTx) {
+	if observer.listenMempool {
+		observer.mempool <- tx
+	}
+}
+
+func (observer Observer) notifyRollback(rollback *storage.Rollback) {
+	if observer.listenRollbacks {
+		observer.rollbacks <- rollback
+	}
+}
+
+func (observer Observer) notifyLabel(label *storage.AddressLabel) {
+	if observer.listenLabels {
+		observer.labels <- label
+	}
+}
+
+func (observer Observer) notifyAddresses(address *storage.Address) {
+	if observer.listenAddresses {
+		trySend(observer, observer.addresses, address, storage.ChannelAddress)
+	}
+}
+
+func (observer Observer) notifyApiKey(key string) {
+	if observer.listenApiKeys {
+		trySend(observer, observer.apiKeys, key, storage.ChannelApiKey)
+	}
+}
+
+// trySend - pushes notification without blocking the dispatcher. Transactions, messages and addresses are sent one by one
+// for every block, so a slow subscriber would fill the buffer and stall the database listener with all other observers.
+// Notification is dropped and counted if the buffer is full.
+func trySend[T any](observer Observer, ch chan T, value T, channel string) {
+	select {
+	case ch <- value:
+	default:
+		if count := observer.dropped.Add(1); count%droppedLogInterval == 1 {
+			log.Warn().
+				Str("channel", channel).
+				Uint64("dropped", count).
+				Msg("observer channel is full, notification was dropped")
+		}
+	}
+}
+
+// Dropped - returns count of notifications which were dropped because the subscriber didn't read its channel
+func (observer Observer) Dropped() uint64 {
+	return observer.dropped.Load()
+}
+
 func (observer Observer) Blocks() <-chan *storage.Block {
 	return observer.blocks
 }
@@ -67,3 +230,47 @@ func (observer Observer) Blocks() <-chan *storage.Block {
 func (observer Observer) Head() <-chan *storage.State {
 	return observer.state
 }
+
+func (observer Observer) Txs() <-chan *storage.Tx {
+	return observer.txs
+}
+
+func (observer Observer) Messages() <-chan *storage.Message {
+	return observer.messages
+}
+
+func (observer Observer) Blobs() <-chan *storage.BlobLog {
+	return observer.blobs
+}
+
+func (observer Observer) Jails() <-chan *storage.Jail {
+	return observer.jails
+}
+
+func (observer Observer) Proposals() <-chan *storage.Proposal {
+	return observer.proposals
+}
+
+func (observer Observer) Alerts() <-chan *storage.Alert {
+	return observer.alerts
+}
+
+func (observer Observer) Mempool() <-chan *storage.MempoolTx {
+	return observer.mempool
+}
+
+func (observer Observer) Rollbacks() <-chan *storage.Rollback {
+	return observer.rollbacks
+}
+
+func (observer Observer) Labels() <-chan *storage.AddressLabel {
+	return observer.labels
+}
+
+func (observer Observer) Addresses() <-chan *storage.Address {
+	return observer.addresses
+}
+
+func (observer Observer) ApiKeys() <-chan string {
+	return observer.apiKeys
+}
@@ -13,7 +13,20 @@ type ICache interface {
 	io.Closer
 
 	Get(ctx context.Context, key string) (string, bool)
-	Set(ctx context.Context, key string, data string, f ExpirationFunc) error
+	// Set - stores data by the key. Entry is attached to tags and will be removed on invalidation of any of them.
+	Set(ctx context.Context, key string, data string, f ExpirationFunc, tags ...string) error
+	// Invalidate - removes all entries attached to the tags
+	Invalidate(ctx context.Context, tags ...string) error
+}
+
+// ICounter - atomic counters which are shared between API instances
+type ICounter interface {
+	// Increment - increments the counter by the key and returns its new value. Counter expires in ttl after creation.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// IncrementFields - increments fields of the hash by the key and returns their new values in the same order. Hash expires in ttl after creation.
+	IncrementFields(ctx context.Context, key string, by int64, ttl time.Duration, fields ...string) ([]int64, error)
+	// Fields - returns all fields of the hash by the key. Empty map is returned if the hash doesn't exist.
+	Fields(ctx context.Context, key string) (map[string]int64, error)
 }
 
 type ExpirationFunc func() time.Duration
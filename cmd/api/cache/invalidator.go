@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/bus"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/workerpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultInvalidationInterval = time.Second
+
+// Invalidator - removes cached responses which are outdated by new blocks, rollbacks and label changes.
+// Tags of addresses which balances were updated and namespaces involved in new blocks are collected and invalidated in batches on new head or by timer.
+type Invalidator struct {
+	cache    ICache
+	observer *bus.Observer
+	interval time.Duration
+	tags     map[string]struct{}
+	log      zerolog.Logger
+	g        workerpool.Group
+}
+
+func NewInvalidator(cache ICache, observer *bus.Observer) *Invalidator {
+	return &Invalidator{
+		cache:    cache,
+		observer: observer,
+		interval: defaultInvalidationInterval,
+		tags:     make(map[string]struct{}),
+		log:      log.With().Str("module", "cache_invalidator").Logger(),
+		g:        workerpool.NewGroup(),
+	}
+}
+
+func (inv *Invalidator) Start(ctx context.Context) {
+	inv.g.GoCtx(ctx, inv.listen)
+}
+
+func (inv *Invalidator) Close() error {
+	inv.g.Wait()
+	return nil
+}
+
+func (inv *Invalidator) listen(ctx context.Context) {
+	ticker := time.NewTicker(inv.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-inv.observer.Head():
+			if !ok {
+				return
+			}
+			inv.flush(ctx)
+		case address, ok := <-inv.observer.Addresses():
+			if !ok {
+				return
+			}
+			inv.processAddress(address)
+		case blob, ok := <-inv.observer.Blobs():
+			if !ok {
+				return
+			}
+			inv.processBlob(blob)
+		case rollback, ok := <-inv.observer.Rollbacks():
+			if !ok {
+				return
+			}
+			inv.log.Info().
+				Uint64("from", uint64(rollback.FromHeight)).
+				Uint64("to", uint64(rollback.ToHeight)).
+				Msg("invalidate cache after rollback")
+			inv.tags[TagRollback] = struct{}{}
+			inv.flush(ctx)
+		case _, ok := <-inv.observer.Labels():
+			if !ok {
+				return
+			}
+			inv.tags[TagLabel] = struct{}{}
+			inv.flush(ctx)
+		case <-ticker.C:
+			inv.flush(ctx)
+		}
+	}
+}
+
+func (inv *Invalidator) processAddress(address *storage.Address) {
+	if address.Address != "" {
+		inv.tags[AddressTag(address.Address)] = struct{}{}
+	}
+}
+
+func (inv *Invalidator) processBlob(blob *storage.BlobLog) {
+	if blob.Namespace != nil {
+		inv.tags[NamespaceTag(hex.EncodeToString(blob.Namespace.NamespaceID))] = struct{}{}
+	}
+	if blob.Signer != nil && blob.Signer.Address != "" {
+		inv.tags[AddressTag(blob.Signer.Address)] = struct{}{}
+	}
+}
+
+// flush - invalidates collected tags. Tags are kept on failure and will be invalidated on the next flush.
+func (inv *Invalidator) flush(ctx context.Context) {
+	if len(inv.tags) == 0 {
+		return
+	}
+
+	tags := make([]string, 0, len(inv.tags))
+	for tag := range inv.tags {
+		tags = append(tags, tag)
+	}
+	if err := inv.cache.Invalidate(ctx, tags...); err != nil {
+		inv.log.Err(err).Int("tags_count", len(tags)).Msg("cache invalidation")
+		return
+	}
+	clear(inv.tags)
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidator_flush(t *testing.T) {
+	cache := newMemoryCache()
+	ctx := t.Context()
+
+	namespaceId, err := hex.DecodeString("000000000000000000000000000000000000000008e5f679bf7116cb")
+	require.NoError(t, err)
+
+	namespaceTag := NamespaceTag(hex.EncodeToString(namespaceId))
+	require.NoError(t, cache.Set(ctx, "address:1", "data", nil, AddressTag("celestia1sender"), TagRollback))
+	require.NoError(t, cache.Set(ctx, "address:2", "data", nil, AddressTag("celestia1other"), TagRollback))
+	require.NoError(t, cache.Set(ctx, "namespace:1", "data", nil, namespaceTag, TagRollback))
+	require.NoError(t, cache.Set(ctx, "block:1", "data", nil, TagRollback, TagLabel))
+
+	inv := NewInvalidator(cache, nil)
+	inv.processAddress(&storage.Address{Address: "celestia1sender"})
+	inv.processBlob(&storage.BlobLog{
+		Namespace: &storage.Namespace{NamespaceID: namespaceId},
+		Signer:    &storage.Address{Address: "celestia1sender"},
+	})
+	require.Len(t, inv.tags, 2)
+
+	inv.flush(ctx)
+	require.Empty(t, inv.tags)
+	require.NotContains(t, cache.entries, "address:1")
+	require.NotContains(t, cache.entries, "namespace:1")
+	require.Contains(t, cache.entries, "address:2")
+	require.Contains(t, cache.entries, "block:1")
+
+	inv.tags[TagRollback] = struct{}{}
+	inv.flush(ctx)
+	require.Empty(t, cache.entries)
+}
@@ -17,6 +17,7 @@ type CacheMiddleware struct {
 	cache          ICache
 	skipper        middleware.Skipper
 	expirationFunc ExpirationFunc
+	tags           TagsFunc
 }
 
 func Middleware(cache ICache, skipper middleware.Skipper, expirationFunc ExpirationFunc) echo.MiddlewareFunc {
@@ -28,6 +29,16 @@ func Middleware(cache ICache, skipper middleware.Skipper, expirationFunc Expirat
 	return mdlwr.Handler
 }
 
+// TaggedMiddleware - caches responses attached to tags. Cached response is removed when any of its tags is invalidated.
+func TaggedMiddleware(cache ICache, tags TagsFunc, expirationFunc ExpirationFunc) echo.MiddlewareFunc {
+	mdlwr := CacheMiddleware{
+		cache:          cache,
+		expirationFunc: expirationFunc,
+		tags:           tags,
+	}
+	return mdlwr.Handler
+}
+
 func (m *CacheMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		if m.cache == nil {
@@ -53,11 +64,15 @@ func (m *CacheMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 		if err := next(c); err != nil {
 			return err
 		}
-		return m.cacheResult(c.Request().Context(), key, recorder)
+		var tags []string
+		if m.tags != nil {
+			tags = m.tags(c)
+		}
+		return m.cacheResult(c.Request().Context(), key, recorder, tags)
 	}
 }
 
-func (m *CacheMiddleware) cacheResult(ctx context.Context, key string, r *ResponseRecorder) error {
+func (m *CacheMiddleware) cacheResult(ctx context.Context, key string, r *ResponseRecorder, tags []string) error {
 	result := r.Result()
 	if !m.isStatusCacheable(result) {
 		return nil
@@ -68,7 +83,7 @@ func (m *CacheMiddleware) cacheResult(ctx context.Context, key string, r *Respon
 		return errors.Wrap(err, "unable to read recorded response")
 	}
 
-	return m.cache.Set(ctx, key, data, m.expirationFunc)
+	return m.cache.Set(ctx, key, data, m.expirationFunc, tags...)
 }
 
 func (m *CacheMiddleware) isStatusCacheable(e *CacheEntry) bool {
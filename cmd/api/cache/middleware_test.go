@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryCache - in-memory implementation of ICache for tests
+type memoryCache struct {
+	mx      sync.Mutex
+	entries map[string]string
+	tags    map[string]map[string]struct{}
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{
+		entries: make(map[string]string),
+		tags:    make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (string, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	data, ok := c.entries[key]
+	return data, ok
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, data string, _ ExpirationFunc, tags ...string) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.entries[key] = data
+	for i := range tags {
+		if _, ok := c.tags[tags[i]]; !ok {
+			c.tags[tags[i]] = make(map[string]struct{})
+		}
+		c.tags[tags[i]][key] = struct{}{}
+	}
+	return nil
+}
+
+func (c *memoryCache) Invalidate(_ context.Context, tags ...string) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	for i := range tags {
+		for key := range c.tags[tags[i]] {
+			delete(c.entries, key)
+		}
+		delete(c.tags, tags[i])
+	}
+	return nil
+}
+
+func (c *memoryCache) Close() error {
+	return nil
+}
+
+func TestTaggedMiddleware(t *testing.T) {
+	cache := newMemoryCache()
+	e := echo.New()
+
+	var calls int
+	handler := TaggedMiddleware(cache, ParamTags("hash", AddressTag, TagRollback), nil)(func(c echo.Context) error {
+		calls++
+		return c.String(http.StatusOK, "response")
+	})
+
+	request := func() string {
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/address/celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/address/:hash")
+		c.SetParamNames("hash")
+		c.SetParamValues("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60")
+		require.NoError(t, handler(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	require.Equal(t, "response", request())
+	require.Equal(t, "response", request())
+	require.Equal(t, 1, calls, "second response should be returned from cache")
+	require.Contains(t, cache.tags, AddressTag("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"))
+	require.Contains(t, cache.tags, TagRollback)
+
+	require.NoError(t, cache.Invalidate(t.Context(), AddressTag("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60")))
+	require.Equal(t, "response", request())
+	require.Equal(t, 2, calls, "response should be recomputed after invalidation")
+
+	require.NoError(t, cache.Invalidate(t.Context(), AddressTag("celestia1other")))
+	require.Equal(t, "response", request())
+	require.Equal(t, 2, calls, "invalidation of other tag should not affect the response")
+}
+
+func TestTaggedMiddleware_NotCacheable(t *testing.T) {
+	cache := newMemoryCache()
+	e := echo.New()
+
+	handler := TaggedMiddleware(cache, Tags(TagRollback), nil)(func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/block/100", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler(e.NewContext(req, rec)))
+	require.Empty(t, cache.entries)
+	require.Empty(t, cache.tags)
+}
+
+func TestNamespaceTag(t *testing.T) {
+	require.Equal(t, "namespace:000000000000000000000000000000000000000008e5f679bf7116cb", NamespaceTag("000000000000000000000000000000000000000008E5F679BF7116CB"))
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// TagRollback - responses which are changed only if chain is rolled back. Such responses can be cached for a long time.
+	TagRollback = "rollback"
+	// TagLabel - responses which contain address labels
+	TagLabel = "label"
+)
+
+// AddressTag - tag of responses which are changed when the address is involved in a new block
+func AddressTag(address string) string {
+	return "address:" + address
+}
+
+// NamespaceTag - tag of responses which are changed when a blob is pushed to the namespace. Namespace id is hexadecimal.
+func NamespaceTag(id string) string {
+	return "namespace:" + strings.ToLower(id)
+}
+
+// TagsFunc - returns tags of the cached response
+type TagsFunc func(c echo.Context) []string
+
+// Tags - returns the same tags for all responses
+func Tags(tags ...string) TagsFunc {
+	return func(c echo.Context) []string {
+		return tags
+	}
+}
+
+// ParamTags - returns tag built from path parameter and static tags
+func ParamTags(param string, tag func(string) string, tags ...string) TagsFunc {
+	return func(c echo.Context) []string {
+		result := make([]string, 0, len(tags)+1)
+		if value := c.Param(param); value != "" {
+			result = append(result, tag(value))
+		}
+		return append(result, tags...)
+	}
+}
@@ -11,7 +11,10 @@ import (
 	valkey "github.com/valkey-io/valkey-go"
 )
 
-var _ ICache = (*ValKey)(nil)
+var (
+	_ ICache   = (*ValKey)(nil)
+	_ ICounter = (*ValKey)(nil)
+)
 
 type ValKey struct {
 	client     valkey.Client
@@ -41,16 +44,115 @@ func (c *ValKey) Get(ctx context.Context, key string) (data string, found bool)
 	return val, err == nil
 }
 
-func (c *ValKey) Set(ctx context.Context, key string, data string, expirationFunc ExpirationFunc) error {
+func (c *ValKey) Set(ctx context.Context, key string, data string, expirationFunc ExpirationFunc, tags ...string) error {
 	expiredAt := c.ttlSeconds
 	if expirationFunc != nil {
 		expiredAt = int64(expirationFunc().Seconds())
 	}
 
-	return c.client.Do(
-		ctx,
-		c.client.B().Set().Key(key).Value(data).ExSeconds(expiredAt).Build(),
-	).Error()
+	if len(tags) == 0 {
+		return c.client.Do(
+			ctx,
+			c.client.B().Set().Key(key).Value(data).ExSeconds(expiredAt).Build(),
+		).Error()
+	}
+
+	// tag is a set of keys attached to it. It lives as long as the longest living entry.
+	cmds := make(valkey.Commands, 0, len(tags)*3+1)
+	cmds = append(cmds, c.client.B().Set().Key(key).Value(data).ExSeconds(expiredAt).Build())
+	for i := range tags {
+		tagKey := tagKey(tags[i])
+		cmds = append(cmds,
+			c.client.B().Sadd().Key(tagKey).Member(key).Build(),
+			c.client.B().Expire().Key(tagKey).Seconds(expiredAt).Nx().Build(),
+			c.client.B().Expire().Key(tagKey).Seconds(expiredAt).Gt().Build(),
+		)
+	}
+	for _, resp := range c.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ValKey) Invalidate(ctx context.Context, tags ...string) error {
+	for i := range tags {
+		tagKey := tagKey(tags[i])
+		keys, err := c.client.Do(ctx, c.client.B().Smembers().Key(tagKey).Build()).AsStrSlice()
+		if err != nil {
+			return errors.Wrapf(err, "receive keys of tag %s", tags[i])
+		}
+
+		// members are removed one by one instead of the whole tag because entries can be attached to the tag concurrently
+		for start := 0; start < len(keys); start += invalidationBatchSize {
+			batch := keys[start:min(start+invalidationBatchSize, len(keys))]
+			for _, resp := range c.client.DoMulti(ctx,
+				c.client.B().Del().Key(batch...).Build(),
+				c.client.B().Srem().Key(tagKey).Member(batch...).Build(),
+			) {
+				if err := resp.Error(); err != nil {
+					return errors.Wrapf(err, "invalidate tag %s", tags[i])
+				}
+			}
+		}
+	}
+	return nil
+}
+
+const invalidationBatchSize = 1000
+
+func tagKey(tag string) string {
+	return "tag:" + tag
+}
+
+func (c *ValKey) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	resps := c.client.DoMulti(ctx,
+		c.client.B().Incr().Key(key).Build(),
+		c.client.B().Expire().Key(key).Seconds(int64(ttl.Seconds())).Nx().Build(),
+	)
+	value, err := resps[0].AsInt64()
+	if err != nil {
+		return 0, errors.Wrapf(err, "increment %s", key)
+	}
+	if err := resps[1].Error(); err != nil {
+		return 0, errors.Wrapf(err, "set expiration of %s", key)
+	}
+	return value, nil
+}
+
+func (c *ValKey) IncrementFields(ctx context.Context, key string, by int64, ttl time.Duration, fields ...string) ([]int64, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	cmds := make(valkey.Commands, 0, len(fields)+1)
+	for i := range fields {
+		cmds = append(cmds, c.client.B().Hincrby().Key(key).Field(fields[i]).Increment(by).Build())
+	}
+	cmds = append(cmds, c.client.B().Expire().Key(key).Seconds(int64(ttl.Seconds())).Nx().Build())
+
+	resps := c.client.DoMulti(ctx, cmds...)
+	values := make([]int64, len(fields))
+	for i := range fields {
+		value, err := resps[i].AsInt64()
+		if err != nil {
+			return nil, errors.Wrapf(err, "increment field %s of %s", fields[i], key)
+		}
+		values[i] = value
+	}
+	if err := resps[len(fields)].Error(); err != nil {
+		return nil, errors.Wrapf(err, "set expiration of %s", key)
+	}
+	return values, nil
+}
+
+func (c *ValKey) Fields(ctx context.Context, key string) (map[string]int64, error) {
+	values, err := c.client.Do(ctx, c.client.B().Hgetall().Key(key).Build()).AsIntMap()
+	if err != nil {
+		return nil, errors.Wrapf(err, "receive fields of %s", key)
+	}
+	return values, nil
 }
 
 func (c *ValKey) Close() error {
@@ -20,16 +20,26 @@ type Config struct {
 }
 
 type ApiConfig struct {
-	Bind                  string  `validate:"required,hostname_port" yaml:"bind"`
-	RateLimit             float64 `validate:"omitempty,min=0"        yaml:"rate_limit"`
-	Prometheus            bool    `validate:"omitempty"              yaml:"prometheus"`
-	RequestTimeout        int     `validate:"omitempty,min=1"        yaml:"request_timeout"`
-	BlobReceiver          string  `validate:"required"               yaml:"blob_receiver"`
-	SentryDsn             string  `validate:"omitempty"              yaml:"sentry_dsn"`
-	Websocket             bool    `validate:"omitempty"              yaml:"websocket"`
-	Cache                 string  `validate:"omitempty,url"          yaml:"cache"`
-	DefaultCacheTTL       int     `validate:"omitempty,min=1"        yaml:"default_cache_ttl"`
-	HyperlaneNodeUrl      string  `validate:"omitempty,url"          yaml:"hyperlane_node"`
-	WebscoketClientsPerIp int     `validate:"omitempty,min=1"        yaml:"websocket_clients_per_ip"`
-	TrustedProxies        string  `validate:"omitempty"              yaml:"trusted_proxies"`
+	Bind                  string                `validate:"required,hostname_port" yaml:"bind"`
+	RateLimit             float64               `validate:"omitempty,min=0"        yaml:"rate_limit"`
+	Prometheus            bool                  `validate:"omitempty"              yaml:"prometheus"`
+	RequestTimeout        int                   `validate:"omitempty,min=1"        yaml:"request_timeout"`
+	BlobReceiver          string                `validate:"required"               yaml:"blob_receiver"`
+	SentryDsn             string                `validate:"omitempty"              yaml:"sentry_dsn"`
+	Websocket             bool                  `validate:"omitempty"              yaml:"websocket"`
+	Cache                 string                `validate:"omitempty,url"          yaml:"cache"`
+	DefaultCacheTTL       int                   `validate:"omitempty,min=1"        yaml:"default_cache_ttl"`
+	HyperlaneNodeUrl      string                `validate:"omitempty,url"          yaml:"hyperlane_node"`
+	WebscoketClientsPerIp int                   `validate:"omitempty,min=1"        yaml:"websocket_clients_per_ip"`
+	TrustedProxies        string                `validate:"omitempty"              yaml:"trusted_proxies"`
+	Webhooks              bool                  `validate:"omitempty"              yaml:"webhooks"`
+	Alerts                bool                  `validate:"omitempty"              yaml:"alerts"`
+	GraphqlMaxCost        int64                 `validate:"omitempty,min=1"        yaml:"graphql_max_cost"`
+	Tiers                 map[string]TierConfig `validate:"omitempty,dive"         yaml:"tiers"`
+}
+
+// TierConfig - limits of api keys tier in public API. Zero value means unlimited.
+type TierConfig struct {
+	RateLimit  int64 `validate:"omitempty,min=0" yaml:"rate_limit"`
+	DailyQuota int64 `validate:"omitempty,min=0" yaml:"daily_quota"`
 }
@@ -6,6 +6,7 @@ package gas
 import (
 	"iter"
 	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
@@ -14,6 +15,24 @@ type GasPrice struct {
 	Slow   string
 	Median string
 	Fast   string
+
+	// average time to inclusion in milliseconds of recently included mempool transactions grouped by gas price tier. Zero if there is no data for the tier.
+	SlowInclusionTime   int64
+	MedianInclusionTime int64
+	FastInclusionTime   int64
+}
+
+// inclusion - gas price and time to inclusion of transaction observed in mempool
+type inclusion struct {
+	GasPrice decimal.Decimal
+	Time     int64
+}
+
+// pendingTx - transaction which is waiting for inclusion in mempool
+type pendingTx struct {
+	GasPrice  decimal.Decimal
+	Size      int64
+	FirstSeen time.Time
 }
 
 type info struct {
@@ -25,6 +44,8 @@ type info struct {
 	Fee            decimal.Decimal
 	GasUsedRatio   decimal.Decimal
 	BlockOccupancy float64
+	BlockTime      uint64
+	MinGasPrice    decimal.Decimal
 }
 
 type queue struct {
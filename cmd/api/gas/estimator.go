@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package gas
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/currency"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultInclusionProbability - probability of inclusion which is used if it's not set in the estimation target
+	DefaultInclusionProbability = .9
+	// MaxTargetBlocks - the largest number of blocks which can be requested. It's limited by the blocks window of the tracker.
+	MaxTargetBlocks = blockCount
+
+	// fullnessHalfLife - count of blocks after which weight of the block in the estimation is halved.
+	// It makes the estimator follow square fullness trends: a row of recent full blocks outweighs a long calm period.
+	fullnessHalfLife = 20.
+)
+
+// priceTick - the smallest gas price step which is reported by the tracker
+var priceTick = decimal.New(1, -6)
+
+// EstimationTarget - time to inclusion which is requested by the user. Blocks has priority over Time.
+type EstimationTarget struct {
+	Blocks      int
+	Time        time.Duration
+	Probability float64
+}
+
+// Estimation - the lowest gas price which is expected to be included within the target with requested probability
+type Estimation struct {
+	WithinBlocks      int
+	TargetProbability float64
+	GasPrice          string
+	// Probability - estimated probability of inclusion within the target for the gas price. It's lower than target one if the target is unreachable.
+	Probability float64
+	// ExpectedTime - expected time of target blocks production in milliseconds
+	ExpectedTime int64
+	// SquareFullness - weighted average block occupancy where recent blocks weigh more
+	SquareFullness float64
+	// PendingAhead - total size in bytes of pending mempool transactions which offered the same or higher gas price
+	PendingAhead int64
+}
+
+type blockSample struct {
+	weight      float64
+	full        bool
+	minGasPrice decimal.Decimal
+}
+
+// Estimate - returns the lowest gas price which is included within the target with requested probability.
+//
+// Probability of inclusion in a single block is a weighted share of recent blocks which would accept the price:
+// block accepts any price if it's not full, otherwise price should not be lower than the cheapest transaction in the block.
+// Pending mempool transactions with the same or higher price are included first, so blocks required to clear them are excluded from the target.
+func (tracker *Tracker) Estimate(target EstimationTarget) Estimation {
+	if target.Probability <= 0 || target.Probability >= 1 {
+		target.Probability = DefaultInclusionProbability
+	}
+
+	samples, fullness, blockTime := tracker.blockSamples()
+
+	blocks := target.Blocks
+	if blocks <= 0 {
+		blocks = 1
+		if target.Time > 0 && blockTime > 0 {
+			blocks = int(float64(target.Time.Milliseconds()) / blockTime)
+		}
+	}
+	blocks = min(max(blocks, 1), MaxTargetBlocks)
+
+	tracker.mx.RLock()
+	pending := make([]pendingTx, 0, len(tracker.pending))
+	for _, tx := range tracker.pending {
+		pending = append(pending, tx)
+	}
+	tracker.mx.RUnlock()
+
+	var (
+		price       decimal.Decimal
+		probability float64
+		ahead       int64
+	)
+	for _, candidate := range priceCandidates(samples, pending) {
+		price = candidate
+		ahead = pendingAhead(pending, candidate)
+		probability = inclusionProbability(samples, blocks, ahead, candidate)
+		if probability >= target.Probability {
+			break
+		}
+	}
+
+	return Estimation{
+		WithinBlocks:      blocks,
+		TargetProbability: target.Probability,
+		GasPrice:          currency.StringTia(price),
+		Probability:       probability,
+		ExpectedTime:      int64(blockTime * float64(blocks)),
+		SquareFullness:    fullness,
+		PendingAhead:      ahead,
+	}
+}
+
+// blockSamples - returns samples of blocks in the window from newest to oldest, weighted average block occupancy and average block time in milliseconds
+func (tracker *Tracker) blockSamples() ([]blockSample, float64, float64) {
+	var (
+		samples     = make([]blockSample, 0, blockCount)
+		weightSum   float64
+		fullnessSum float64
+		blockTime   uint64
+	)
+	for item := range tracker.q.All() {
+		weight := math.Pow(.5, float64(len(samples))/fullnessHalfLife)
+		samples = append(samples, blockSample{
+			weight:      weight,
+			full:        item.BlockOccupancy >= emptyBlockPercent,
+			minGasPrice: item.MinGasPrice,
+		})
+		weightSum += weight
+		fullnessSum += weight * item.BlockOccupancy
+		blockTime += item.BlockTime
+	}
+	if len(samples) == 0 {
+		return samples, 0, 0
+	}
+	return samples, fullnessSum / weightSum, float64(blockTime) / float64(len(samples))
+}
+
+// priceCandidates - returns sorted gas prices at which probability of inclusion may change: the cheapest prices accepted by full blocks and prices outbidding pending transactions
+func priceCandidates(samples []blockSample, pending []pendingTx) []decimal.Decimal {
+	candidates := []decimal.Decimal{minGasPrice}
+	for i := range samples {
+		if samples[i].full && samples[i].minGasPrice.GreaterThan(minGasPrice) {
+			candidates = append(candidates, samples[i].minGasPrice)
+		}
+	}
+	for i := range pending {
+		if price := pending[i].GasPrice.Add(priceTick); price.GreaterThan(minGasPrice) {
+			candidates = append(candidates, price)
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b decimal.Decimal) int {
+		return a.Cmp(b)
+	})
+	return slices.CompactFunc(candidates, func(a, b decimal.Decimal) bool {
+		return a.Equal(b)
+	})
+}
+
+// pendingAhead - returns total size of pending transactions which will be included before the transaction with the price
+func pendingAhead(pending []pendingTx, price decimal.Decimal) int64 {
+	var size int64
+	for i := range pending {
+		if pending[i].GasPrice.GreaterThanOrEqual(price) {
+			size += pending[i].Size
+		}
+	}
+	return size
+}
+
+// inclusionProbability - returns probability that transaction with the price will be included within the count of blocks
+func inclusionProbability(samples []blockSample, blocks int, ahead int64, price decimal.Decimal) float64 {
+	blocks -= int(ahead / maxBlockSize)
+	if blocks <= 0 {
+		return 0
+	}
+	if len(samples) == 0 {
+		return 1
+	}
+
+	var accepted, total float64
+	for i := range samples {
+		total += samples[i].weight
+		if !samples[i].full || price.GreaterThanOrEqual(samples[i].minGasPrice) {
+			accepted += samples[i].weight
+		}
+	}
+	return 1 - math.Pow(1-accepted/total, float64(blocks))
+}
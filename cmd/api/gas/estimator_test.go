@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package gas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func pushBlocks(tracker *Tracker, count int, occupancy float64, minPrice string) {
+	for range count {
+		tracker.q.Push(info{
+			BlockOccupancy: occupancy,
+			MinGasPrice:    decimal.RequireFromString(minPrice),
+			BlockTime:      6000,
+		})
+	}
+}
+
+func TestTracker_Estimate(t *testing.T) {
+	t.Run("no data", func(t *testing.T) {
+		tracker := NewTracker(nil, nil, nil, nil)
+
+		estimation := tracker.Estimate(EstimationTarget{Blocks: 3})
+		require.Equal(t, 3, estimation.WithinBlocks)
+		require.Equal(t, "0.004000", estimation.GasPrice)
+		require.InDelta(t, DefaultInclusionProbability, estimation.TargetProbability, 1e-9)
+		require.InDelta(t, 1, estimation.Probability, 1e-9)
+		require.EqualValues(t, 0, estimation.ExpectedTime)
+	})
+
+	t.Run("full blocks", func(t *testing.T) {
+		tracker := NewTracker(nil, nil, nil, nil)
+		pushBlocks(tracker, 10, .95, "0.02")
+
+		estimation := tracker.Estimate(EstimationTarget{Blocks: 3})
+		require.Equal(t, "0.020000", estimation.GasPrice)
+		require.InDelta(t, 1, estimation.Probability, 1e-9)
+		require.InDelta(t, .95, estimation.SquareFullness, 1e-9)
+		require.EqualValues(t, 18000, estimation.ExpectedTime)
+	})
+
+	t.Run("half of blocks are full", func(t *testing.T) {
+		tracker := NewTracker(nil, nil, nil, nil)
+		for range 5 {
+			pushBlocks(tracker, 1, .95, "0.02")
+			pushBlocks(tracker, 1, .1, "0.004")
+		}
+
+		fast := tracker.Estimate(EstimationTarget{Blocks: 1})
+		require.Equal(t, "0.020000", fast.GasPrice)
+
+		slow := tracker.Estimate(EstimationTarget{Blocks: 5})
+		require.Equal(t, "0.004000", slow.GasPrice)
+		require.Greater(t, slow.Probability, DefaultInclusionProbability)
+
+		strict := tracker.Estimate(EstimationTarget{Blocks: 5, Probability: .999})
+		require.Equal(t, "0.020000", strict.GasPrice)
+	})
+
+	t.Run("recent blocks weigh more", func(t *testing.T) {
+		tracker := NewTracker(nil, nil, nil, nil)
+		pushBlocks(tracker, 80, .1, "0.004")
+		pushBlocks(tracker, 20, .95, "0.02")
+
+		estimation := tracker.Estimate(EstimationTarget{Blocks: 2})
+		require.Equal(t, "0.020000", estimation.GasPrice)
+		require.Greater(t, estimation.SquareFullness, .5)
+	})
+
+	t.Run("time target", func(t *testing.T) {
+		tracker := NewTracker(nil, nil, nil, nil)
+		pushBlocks(tracker, 10, .1, "0.004")
+
+		estimation := tracker.Estimate(EstimationTarget{Time: 30 * time.Second})
+		require.Equal(t, 5, estimation.WithinBlocks)
+		require.EqualValues(t, 30000, estimation.ExpectedTime)
+
+		estimation = tracker.Estimate(EstimationTarget{Time: time.Second})
+		require.Equal(t, 1, estimation.WithinBlocks)
+	})
+
+	t.Run("mempool pressure", func(t *testing.T) {
+		tracker := NewTracker(nil, nil, nil, nil)
+		for i := range 3 {
+			tracker.processMempoolTx(&storage.MempoolTx{
+				Hash:      []byte{byte(i)},
+				Status:    storageTypes.MempoolTxStatusPending,
+				GasPrice:  storageTypes.NewNumeric(decimal.RequireFromString("0.01")),
+				Size:      maxBlockSize,
+				FirstSeen: time.Now(),
+			})
+		}
+
+		estimation := tracker.Estimate(EstimationTarget{Blocks: 2})
+		require.Equal(t, "0.010001", estimation.GasPrice)
+		require.EqualValues(t, 0, estimation.PendingAhead)
+
+		estimation = tracker.Estimate(EstimationTarget{Blocks: 5})
+		require.Equal(t, "0.004000", estimation.GasPrice)
+		require.EqualValues(t, 3*maxBlockSize, estimation.PendingAhead)
+
+		// included transaction doesn't compete anymore
+		tracker.processMempoolTx(&storage.MempoolTx{
+			Hash:     []byte{0},
+			Status:   storageTypes.MempoolTxStatusIncluded,
+			GasPrice: storageTypes.NewNumeric(decimal.RequireFromString("0.01")),
+		})
+		require.Len(t, tracker.pending, 2)
+
+		estimation = tracker.Estimate(EstimationTarget{Blocks: 3})
+		require.Equal(t, "0.004000", estimation.GasPrice)
+		require.EqualValues(t, 2*maxBlockSize, estimation.PendingAhead)
+	})
+}
+
+func TestTracker_prunePending(t *testing.T) {
+	tracker := NewTracker(nil, nil, nil, nil)
+	now := time.Now()
+
+	tracker.processMempoolTx(&storage.MempoolTx{
+		Hash:      []byte{0x01},
+		Status:    storageTypes.MempoolTxStatusPending,
+		FirstSeen: now.Add(-2 * pendingTTL),
+	})
+	tracker.processMempoolTx(&storage.MempoolTx{
+		Hash:      []byte{0x02},
+		Status:    storageTypes.MempoolTxStatusPending,
+		FirstSeen: now,
+	})
+
+	tracker.prunePending(now)
+	require.Len(t, tracker.pending, 1)
+	require.Contains(t, tracker.pending, string([]byte{0x02}))
+}
@@ -16,4 +16,5 @@ type ITracker interface {
 	Init(ctx context.Context) error
 	SubscribeOnCompute(handler ComputeHandler)
 	State() GasPrice
+	Estimate(target EstimationTarget) Estimation
 }
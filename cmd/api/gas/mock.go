@@ -81,6 +81,44 @@ func (c *MockITrackerCloseCall) DoAndReturn(f func() error) *MockITrackerCloseCa
 	return c
 }
 
+// Estimate mocks base method.
+func (m *MockITracker) Estimate(target EstimationTarget) Estimation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Estimate", target)
+	ret0, _ := ret[0].(Estimation)
+	return ret0
+}
+
+// Estimate indicates an expected call of Estimate.
+func (mr *MockITrackerMockRecorder) Estimate(target any) *MockITrackerEstimateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Estimate", reflect.TypeOf((*MockITracker)(nil).Estimate), target)
+	return &MockITrackerEstimateCall{Call: call}
+}
+
+// MockITrackerEstimateCall wrap *gomock.Call
+type MockITrackerEstimateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITrackerEstimateCall) Return(arg0 Estimation) *MockITrackerEstimateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITrackerEstimateCall) Do(f func(EstimationTarget) Estimation) *MockITrackerEstimateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITrackerEstimateCall) DoAndReturn(f func(EstimationTarget) Estimation) *MockITrackerEstimateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Init mocks base method.
 func (m *MockITracker) Init(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package gas
+
+import "github.com/celenium-io/celestia-indexer/internal/storage"
+
+type TrackerOption func(*Tracker)
+
+// WithMempool - restores time to inclusion of recently included mempool transactions during initialization
+func WithMempool(mempool storage.IMempoolTx) TrackerOption {
+	return func(t *Tracker) {
+		t.mempool = mempool
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/bus"
 	"github.com/celenium-io/celestia-indexer/internal/currency"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celestiaorg/celestia-app/v9/pkg/appconsts"
 	coreTypes "github.com/cometbft/cometbft/types"
 	"github.com/dipdup-io/workerpool"
@@ -23,6 +25,8 @@ import (
 const (
 	blockCount        = 100
 	emptyBlockPercent = .90
+	inclusionCount    = 500
+	pendingTTL        = time.Hour
 )
 
 var (
@@ -36,6 +40,7 @@ type Tracker struct {
 	state    storage.IState
 	stats    storage.IBlockStats
 	tx       storage.ITx
+	mempool  storage.IMempoolTx
 	observer *bus.Observer
 	log      zerolog.Logger
 	mx       *sync.RWMutex
@@ -43,6 +48,11 @@ type Tracker struct {
 	q        *queue
 	g        workerpool.Group
 
+	// inclusions - last included mempool transactions which are used to compute time to inclusion per gas price tier
+	inclusions []inclusion
+	// pending - transactions which are waiting in mempool by hash. They are competing for block space with new transactions.
+	pending map[string]pendingTx
+
 	computeHandler ComputeHandler
 }
 
@@ -51,8 +61,9 @@ func NewTracker(
 	stats storage.IBlockStats,
 	tx storage.ITx,
 	observer *bus.Observer,
+	opts ...TrackerOption,
 ) *Tracker {
-	return &Tracker{
+	tracker := &Tracker{
 		state:    state,
 		stats:    stats,
 		tx:       tx,
@@ -63,10 +74,18 @@ func NewTracker(
 			Median: "0",
 			Fast:   "0",
 		},
-		log: log.With().Str("module", "gas_tracker").Logger(),
-		q:   newQueue(blockCount),
-		g:   workerpool.NewGroup(),
+		log:        log.With().Str("module", "gas_tracker").Logger(),
+		q:          newQueue(blockCount),
+		g:          workerpool.NewGroup(),
+		inclusions: make([]inclusion, 0, inclusionCount),
+		pending:    make(map[string]pendingTx),
+	}
+
+	for i := range opts {
+		opts[i](tracker)
 	}
+
+	return tracker
 }
 
 func (tracker *Tracker) SubscribeOnCompute(handler ComputeHandler) {
@@ -97,15 +116,21 @@ func (tracker *Tracker) listen(ctx context.Context) {
 				continue
 			}
 
+			tracker.prunePending(time.Now())
 			tracker.computeMetrics()
 
 			if tracker.computeHandler != nil {
 				tracker.g.GoCtx(ctx, func(ctx context.Context) {
-					if err := tracker.computeHandler(ctx, tracker.gasState); err != nil {
+					if err := tracker.computeHandler(ctx, tracker.State()); err != nil {
 						log.Err(err).Msg("error in compute handler of gas tracker")
 					}
 				})
 			}
+		case tx, ok := <-tracker.observer.Mempool():
+			if !ok {
+				return
+			}
+			tracker.processMempoolTx(tx)
 		}
 	}
 }
@@ -129,10 +154,69 @@ func (tracker *Tracker) Init(ctx context.Context) error {
 		}
 	}
 
+	if tracker.mempool != nil {
+		included, err := tracker.mempool.LastIncluded(ctx, inclusionCount)
+		if err != nil {
+			return err
+		}
+		for i := len(included) - 1; i >= 0; i-- {
+			tracker.processMempoolTx(&included[i])
+		}
+
+		pending, err := tracker.mempool.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range pending {
+			tracker.processMempoolTx(&pending[i])
+		}
+	}
+
 	tracker.computeMetrics()
 	return nil
 }
 
+func (tracker *Tracker) processMempoolTx(tx *storage.MempoolTx) {
+	if tx == nil {
+		return
+	}
+
+	tracker.mx.Lock()
+	defer tracker.mx.Unlock()
+
+	switch tx.Status {
+	case storageTypes.MempoolTxStatusPending:
+		tracker.pending[string(tx.Hash)] = pendingTx{
+			GasPrice:  tx.GasPrice.Decimal,
+			Size:      tx.Size,
+			FirstSeen: tx.FirstSeen,
+		}
+	case storageTypes.MempoolTxStatusIncluded:
+		delete(tracker.pending, string(tx.Hash))
+		if len(tracker.inclusions) == inclusionCount {
+			tracker.inclusions = tracker.inclusions[1:]
+		}
+		tracker.inclusions = append(tracker.inclusions, inclusion{
+			GasPrice: tx.GasPrice.Decimal,
+			Time:     tx.InclusionTime,
+		})
+	case storageTypes.MempoolTxStatusDropped:
+		delete(tracker.pending, string(tx.Hash))
+	}
+}
+
+// prunePending - removes pending transactions which notification about finish was missed
+func (tracker *Tracker) prunePending(now time.Time) {
+	tracker.mx.Lock()
+	defer tracker.mx.Unlock()
+
+	for hash, tx := range tracker.pending {
+		if now.Sub(tx.FirstSeen) > pendingTTL {
+			delete(tracker.pending, hash)
+		}
+	}
+}
+
 func (tracker *Tracker) processBlock(ctx context.Context, blockStat storage.BlockStats) error {
 	data := info{
 		Height:         uint64(blockStat.Height),
@@ -143,6 +227,8 @@ func (tracker *Tracker) processBlock(ctx context.Context, blockStat storage.Bloc
 		GasUsedRatio:   decimal.New(0, 1),
 		Percentiles:    make([]decimal.Decimal, 0),
 		BlockOccupancy: float64(blockStat.BytesInBlock) / float64(maxBlockSize),
+		BlockTime:      blockStat.BlockTime,
+		MinGasPrice:    decimal.New(0, 1),
 	}
 
 	for range percentiles {
@@ -165,6 +251,9 @@ func (tracker *Tracker) processBlock(ctx context.Context, blockStat storage.Bloc
 	sort.Sort(storage.ByGasPrice(txs))
 
 	tracker.compute(txs, blockStat.GasLimit, &data)
+	if len(txs) > 0 {
+		data.MinGasPrice = txs[0].GasPrice.Copy().Decimal
+	}
 
 	if data.BlockOccupancy < emptyBlockPercent {
 		// If block occupancy is less than empty block threshold set all percentiles to slow.
@@ -235,11 +324,44 @@ func (tracker *Tracker) computeMetrics() {
 		fast = minGasPrice.Copy()
 	}
 
+	inclusionTimes := tracker.computeInclusionTimes(median, fast)
+
 	tracker.mx.Lock()
 	{
 		tracker.gasState.Slow = currency.StringTia(slow)
 		tracker.gasState.Median = currency.StringTia(median)
 		tracker.gasState.Fast = currency.StringTia(fast)
+		tracker.gasState.SlowInclusionTime = inclusionTimes[0]
+		tracker.gasState.MedianInclusionTime = inclusionTimes[1]
+		tracker.gasState.FastInclusionTime = inclusionTimes[2]
 	}
 	tracker.mx.Unlock()
 }
+
+// computeInclusionTimes - returns average time to inclusion for slow, median and fast tiers.
+// Transaction belongs to the highest tier which price it offered.
+func (tracker *Tracker) computeInclusionTimes(median, fast decimal.Decimal) [3]int64 {
+	var (
+		sum   [3]int64
+		count [3]int64
+	)
+	for i := range tracker.inclusions {
+		tier := 0
+		switch {
+		case tracker.inclusions[i].GasPrice.GreaterThanOrEqual(fast):
+			tier = 2
+		case tracker.inclusions[i].GasPrice.GreaterThanOrEqual(median):
+			tier = 1
+		}
+		sum[tier] += tracker.inclusions[i].Time
+		count[tier]++
+	}
+
+	var result [3]int64
+	for i := range result {
+		if count[i] > 0 {
+			result[i] = sum[i] / count[i]
+		}
+	}
+	return result
+}
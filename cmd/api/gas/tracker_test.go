@@ -77,6 +77,52 @@ func TestTracker_computeMetrics(t *testing.T) {
 	})
 }
 
+func TestTracker_inclusionTimes(t *testing.T) {
+	tracker := NewTracker(nil, nil, nil, nil)
+
+	tracker.q.Push(info{
+		Height: 1,
+		Percentiles: []decimal.Decimal{
+			decimal.RequireFromString("2"),
+			decimal.RequireFromString("3"),
+			decimal.RequireFromString("4"),
+		},
+	})
+
+	for _, tx := range []storage.MempoolTx{
+		{Status: storageTypes.MempoolTxStatusIncluded, GasPrice: storageTypes.NewNumeric(decimal.RequireFromString("5")), InclusionTime: 6000},
+		{Status: storageTypes.MempoolTxStatusIncluded, GasPrice: storageTypes.NewNumeric(decimal.RequireFromString("4")), InclusionTime: 4000},
+		{Status: storageTypes.MempoolTxStatusIncluded, GasPrice: storageTypes.NewNumeric(decimal.RequireFromString("3.5")), InclusionTime: 10000},
+		{Status: storageTypes.MempoolTxStatusIncluded, GasPrice: storageTypes.NewNumeric(decimal.RequireFromString("1")), InclusionTime: 30000},
+		{Status: storageTypes.MempoolTxStatusDropped, GasPrice: storageTypes.NewNumeric(decimal.RequireFromString("1")), InclusionTime: 0},
+	} {
+		tracker.processMempoolTx(&tx)
+	}
+	require.Len(t, tracker.inclusions, 4)
+
+	tracker.computeMetrics()
+	state := tracker.State()
+	require.Equal(t, "4.000000", state.Fast)
+	require.EqualValues(t, 30000, state.SlowInclusionTime)
+	require.EqualValues(t, 10000, state.MedianInclusionTime)
+	require.EqualValues(t, 5000, state.FastInclusionTime)
+
+	for range inclusionCount {
+		tracker.processMempoolTx(&storage.MempoolTx{
+			Status:        storageTypes.MempoolTxStatusIncluded,
+			GasPrice:      storageTypes.NewNumeric(decimal.RequireFromString("5")),
+			InclusionTime: 2000,
+		})
+	}
+	require.Len(t, tracker.inclusions, inclusionCount)
+
+	tracker.computeMetrics()
+	state = tracker.State()
+	require.EqualValues(t, 0, state.SlowInclusionTime)
+	require.EqualValues(t, 0, state.MedianInclusionTime)
+	require.EqualValues(t, 2000, state.FastInclusionTime)
+}
+
 func TestTracker_processBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -194,6 +240,7 @@ func TestTracker_processBlock(t *testing.T) {
 		require.EqualValues(t, "1", item.Percentiles[0].StringFixed(0))
 		require.EqualValues(t, "2", item.Percentiles[1].StringFixed(0))
 		require.EqualValues(t, "3", item.Percentiles[2].StringFixed(0))
+		require.EqualValues(t, "1", item.MinGasPrice.StringFixed(0))
 	})
 
 	t.Run("empty block with 3 transaction", func(t *testing.T) {
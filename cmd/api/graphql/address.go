@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+type addressResolver struct {
+	root    *resolver
+	address storage.Address
+}
+
+func newAddressResolver(root *resolver, address storage.Address) *addressResolver {
+	return &addressResolver{
+		root:    root,
+		address: address,
+	}
+}
+
+func (a *addressResolver) Id() Uint64 {
+	return Uint64(a.address.Id)
+}
+
+func (a *addressResolver) Hash() string {
+	return a.address.Address
+}
+
+func (a *addressResolver) Name() string {
+	return a.address.Name
+}
+
+func (a *addressResolver) FirstHeight() Uint64 {
+	return Uint64(a.address.Height)
+}
+
+func (a *addressResolver) LastHeight() Uint64 {
+	return Uint64(a.address.LastHeight)
+}
+
+// Balance - returns balance in default currency. Address is requested once more if it was received without balance.
+func (a *addressResolver) Balance(ctx context.Context) (*balanceResolver, error) {
+	if a.address.DefaultBalance != nil {
+		return &balanceResolver{balance: *a.address.DefaultBalance}, nil
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	address, err := a.root.addresses.ByHash(ctx, a.address.Hash)
+	if err != nil {
+		if a.root.addresses.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if address.DefaultBalance == nil {
+		return nil, nil
+	}
+	return &balanceResolver{balance: *address.DefaultBalance}, nil
+}
+
+func (a *addressResolver) Txs(ctx context.Context, args connectionArgs) (*connection[*txResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := a.root.txs.ByAddress(ctx, a.address.Id, storage.TxFilter{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Sort:   sdk.SortOrderDesc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*txResolver, len(txs))
+	for i := range txs {
+		nodes[i] = newTxResolver(a.root, txs[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (a *addressResolver) Blobs(ctx context.Context, args connectionArgs) (*connection[*blobLogResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := a.root.blobLogs.BySigner(ctx, a.address.Id, storage.BlobLogFilters{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Sort:   sdk.SortOrderDesc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBlobLogConnection(a.root, p, blobs), nil
+}
+
+// addressById - resolves address of nested entities
+func addressById(ctx context.Context, root *resolver, id uint64) (*addressResolver, error) {
+	if id == 0 {
+		return nil, nil
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	address, err := root.addresses.GetByID(ctx, id)
+	if err != nil {
+		if root.addresses.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newAddressResolver(root, *address), nil
+}
+
+type balanceResolver struct {
+	balance storage.Balance
+}
+
+func (b *balanceResolver) Currency() string {
+	return b.balance.Currency
+}
+
+func (b *balanceResolver) Spendable() string {
+	return b.balance.Spendable.String()
+}
+
+func (b *balanceResolver) Delegated() string {
+	return b.balance.Delegated.String()
+}
+
+func (b *balanceResolver) Unbonding() string {
+	return b.balance.Unbonding.String()
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+type blobLogResolver struct {
+	root *resolver
+	blob storage.BlobLog
+}
+
+func newBlobLogResolver(root *resolver, blob storage.BlobLog) *blobLogResolver {
+	return &blobLogResolver{
+		root: root,
+		blob: blob,
+	}
+}
+
+func newBlobLogConnection(root *resolver, p page, blobs []storage.BlobLog) *connection[*blobLogResolver] {
+	nodes := make([]*blobLogResolver, len(blobs))
+	for i := range blobs {
+		nodes[i] = newBlobLogResolver(root, blobs[i])
+	}
+	return newConnection(p, nodes)
+}
+
+func (b *blobLogResolver) Id() Uint64 {
+	return Uint64(b.blob.Id)
+}
+
+func (b *blobLogResolver) Height() Uint64 {
+	return Uint64(b.blob.Height)
+}
+
+func (b *blobLogResolver) Time() gql.Time {
+	return gql.Time{Time: b.blob.Time}
+}
+
+func (b *blobLogResolver) Commitment() string {
+	return b.blob.Commitment
+}
+
+func (b *blobLogResolver) Size() Uint64 {
+	return Uint64(b.blob.Size)
+}
+
+func (b *blobLogResolver) ShareVersion() int32 {
+	return int32(b.blob.ShareVersion)
+}
+
+func (b *blobLogResolver) ContentType() string {
+	return b.blob.ContentType
+}
+
+func (b *blobLogResolver) Fee() string {
+	return b.blob.Fee.String()
+}
+
+// Namespace - uses namespace joined by storage if it's present
+func (b *blobLogResolver) Namespace(ctx context.Context) (*namespaceResolver, error) {
+	if b.blob.Namespace != nil && b.blob.Namespace.Id != 0 {
+		return newNamespaceResolver(b.root, *b.blob.Namespace), nil
+	}
+	return namespaceById(ctx, b.root, b.blob.NamespaceId)
+}
+
+func (b *blobLogResolver) Signer(ctx context.Context) (*addressResolver, error) {
+	return addressById(ctx, b.root, b.blob.SignerId)
+}
+
+// Tx - uses transaction joined by storage if it's present
+func (b *blobLogResolver) Tx(ctx context.Context) (*txResolver, error) {
+	if b.blob.Tx != nil && b.blob.Tx.Id != 0 {
+		return newTxResolver(b.root, *b.blob.Tx), nil
+	}
+	return txById(ctx, b.root, b.blob.TxId)
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+type blockResolver struct {
+	root  *resolver
+	block storage.Block
+}
+
+func newBlockResolver(root *resolver, block storage.Block) *blockResolver {
+	return &blockResolver{
+		root:  root,
+		block: block,
+	}
+}
+
+func (b *blockResolver) Id() Uint64 {
+	return Uint64(b.block.Id)
+}
+
+func (b *blockResolver) Height() Uint64 {
+	return Uint64(b.block.Height)
+}
+
+func (b *blockResolver) Time() gql.Time {
+	return gql.Time{Time: b.block.Time}
+}
+
+func (b *blockResolver) Hash() string {
+	return b.block.Hash.String()
+}
+
+func (b *blockResolver) ParentHash() string {
+	return b.block.ParentHash.String()
+}
+
+func (b *blockResolver) AppHash() string {
+	return b.block.AppHash.String()
+}
+
+func (b *blockResolver) DataHash() string {
+	return b.block.DataHash.String()
+}
+
+func (b *blockResolver) VersionApp() Uint64 {
+	return Uint64(b.block.VersionApp)
+}
+
+func (b *blockResolver) MessageTypes() []string {
+	return msgTypeNames(b.block.MessageTypes)
+}
+
+// Stats - returns nil if block was received without stats
+func (b *blockResolver) Stats() *blockStatsResolver {
+	if b.block.Stats.Height == 0 {
+		return nil
+	}
+	return &blockStatsResolver{stats: b.block.Stats}
+}
+
+func (b *blockResolver) Proposer(ctx context.Context) (*validatorResolver, error) {
+	if b.block.ProposerId == 0 {
+		return nil, nil
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	validator, err := b.root.validators.GetByID(ctx, b.block.ProposerId)
+	if err != nil {
+		if b.root.validators.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newValidatorResolver(b.root, *validator), nil
+}
+
+func (b *blockResolver) Txs(ctx context.Context, args connectionArgs) (*connection[*txResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	height := uint64(b.block.Height)
+	txs, err := b.root.txs.Filter(ctx, storage.TxFilter{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Sort:   sdk.SortOrderAsc,
+		Height: &height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*txResolver, len(txs))
+	for i := range txs {
+		nodes[i] = newTxResolver(b.root, txs[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (b *blockResolver) Events(ctx context.Context, args connectionArgs) (*connection[*eventResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	events, err := b.root.events.ByBlock(ctx, b.block.Height, storage.EventFilter{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Time:   b.block.Time.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*eventResolver, len(events))
+	for i := range events {
+		nodes[i] = newEventResolver(b.root, events[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (b *blockResolver) Blobs(ctx context.Context, args connectionArgs) (*connection[*blobLogResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := b.root.blobLogs.ByHeight(ctx, b.block.Height, storage.BlobLogFilters{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Sort:   sdk.SortOrderAsc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBlobLogConnection(b.root, p, blobs), nil
+}
+
+type blockStatsResolver struct {
+	stats storage.BlockStats
+}
+
+func (s *blockStatsResolver) TxCount() Uint64 {
+	return Uint64(s.stats.TxCount)
+}
+
+func (s *blockStatsResolver) EventsCount() Uint64 {
+	return Uint64(s.stats.EventsCount)
+}
+
+func (s *blockStatsResolver) BlobsSize() Uint64 {
+	return Uint64(s.stats.BlobsSize)
+}
+
+func (s *blockStatsResolver) BlobsCount() int32 {
+	return int32(s.stats.BlobsCount)
+}
+
+func (s *blockStatsResolver) BlockTime() Uint64 {
+	return Uint64(s.stats.BlockTime)
+}
+
+func (s *blockStatsResolver) GasLimit() Uint64 {
+	return Uint64(s.stats.GasLimit)
+}
+
+func (s *blockStatsResolver) GasUsed() Uint64 {
+	return Uint64(s.stats.GasUsed)
+}
+
+func (s *blockStatsResolver) Fee() string {
+	return s.stats.Fee.String()
+}
+
+func (s *blockStatsResolver) Rewards() string {
+	return s.stats.Rewards.String()
+}
+
+func (s *blockStatsResolver) Commissions() string {
+	return s.stats.Commissions.String()
+}
+
+func (s *blockStatsResolver) SupplyChange() string {
+	return s.stats.SupplyChange.String()
+}
+
+func (s *blockStatsResolver) InflationRate() string {
+	return s.stats.InflationRate.String()
+}
+
+func (s *blockStatsResolver) BytesInBlock() Uint64 {
+	return Uint64(s.stats.BytesInBlock)
+}
+
+func (s *blockStatsResolver) SquareSize() Uint64 {
+	return Uint64(s.stats.SquareSize)
+}
+
+func msgTypeNames(mask types.MsgTypeBits) []string {
+	msgTypes := mask.Names()
+	names := make([]string, len(msgTypes))
+	for i := range msgTypes {
+		names[i] = msgTypes[i].String()
+	}
+	return names
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+var ErrCostLimitExceeded = errors.New("query cost limit exceeded")
+
+type budgetKey struct{}
+
+// budget - query cost left for the request. Resolvers are executed in parallel so it is decreased atomically.
+type budget struct {
+	left atomic.Int64
+}
+
+func withBudget(ctx context.Context, limit int64) context.Context {
+	b := new(budget)
+	b.left.Store(limit)
+	return context.WithValue(ctx, budgetKey{}, b)
+}
+
+// spend - charges cost from request budget. Requests without budget are not limited.
+func spend(ctx context.Context, cost int) error {
+	b, ok := ctx.Value(budgetKey{}).(*budget)
+	if !ok {
+		return nil
+	}
+	if b.left.Add(-int64(cost)) < 0 {
+		return ErrCostLimitExceeded
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// Handler - serves GraphQL queries over indexed entities
+type Handler struct {
+	schema  *gql.Schema
+	maxCost int64
+
+	maxDepth       int
+	maxQueryLength int
+	maxParallelism int
+}
+
+// NewHandler - parses schema and binds it to the resolvers backed by storage
+func NewHandler(
+	blocks storage.IBlock,
+	txs storage.ITx,
+	messages storage.IMessage,
+	events storage.IEvent,
+	namespaces storage.INamespace,
+	blobLogs storage.IBlobLog,
+	addresses storage.IAddress,
+	validators storage.IValidator,
+	rollups storage.IRollup,
+	opts ...HandlerOption,
+) (*Handler, error) {
+	h := &Handler{
+		maxCost:        1000,
+		maxDepth:       12,
+		maxQueryLength: 8192,
+		maxParallelism: 10,
+	}
+	for i := range opts {
+		opts[i](h)
+	}
+
+	root := &resolver{
+		blocks:     blocks,
+		txs:        txs,
+		messages:   messages,
+		events:     events,
+		namespaces: namespaces,
+		blobLogs:   blobLogs,
+		addresses:  addresses,
+		validators: validators,
+		rollups:    rollups,
+	}
+
+	s, err := gql.ParseSchema(schema, root,
+		gql.MaxDepth(h.maxDepth),
+		gql.MaxQueryLength(h.maxQueryLength),
+		gql.MaxParallelism(h.maxParallelism),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse graphql schema")
+	}
+	h.schema = s
+	return h, nil
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Serve - executes GraphQL query. Every resolved entity and every requested list item
+// is charged from the query cost budget. When the budget is exhausted remaining fields
+// are resolved with error.
+func (h *Handler) Serve(c echo.Context) error {
+	var req request
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"message": err.Error(),
+		})
+	}
+	if req.Query == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"message": "empty query",
+		})
+	}
+
+	ctx := withBudget(c.Request().Context(), h.maxCost)
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type testStorages struct {
+	blocks     *mock.MockIBlock
+	txs        *mock.MockITx
+	validators *mock.MockIValidator
+}
+
+func newTestHandler(t *testing.T, opts ...HandlerOption) (*Handler, testStorages) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	s := testStorages{
+		blocks:     mock.NewMockIBlock(ctrl),
+		txs:        mock.NewMockITx(ctrl),
+		validators: mock.NewMockIValidator(ctrl),
+	}
+
+	h, err := NewHandler(
+		s.blocks,
+		s.txs,
+		mock.NewMockIMessage(ctrl),
+		mock.NewMockIEvent(ctrl),
+		mock.NewMockINamespace(ctrl),
+		mock.NewMockIBlobLog(ctrl),
+		mock.NewMockIAddress(ctrl),
+		s.validators,
+		mock.NewMockIRollup(ctrl),
+		opts...,
+	)
+	require.NoError(t, err)
+	return h, s
+}
+
+type testResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func execute(t *testing.T, h *Handler, query string) (int, testResponse) {
+	body, err := json.Marshal(request{Query: query})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	require.NoError(t, h.Serve(c))
+
+	var response testResponse
+	if rec.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	}
+	return rec.Code, response
+}
+
+func TestServeNestedQuery(t *testing.T) {
+	h, s := newTestHandler(t)
+
+	s.blocks.EXPECT().
+		ByHeightWithStats(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.Block{
+			Id:         1,
+			Height:     100,
+			ProposerId: 3,
+			Stats: storage.BlockStats{
+				Height:  100,
+				TxCount: 1,
+			},
+		}, nil).
+		Times(1)
+
+	s.validators.EXPECT().
+		GetByID(gomock.Any(), uint64(3)).
+		Return(&storage.Validator{Id: 3, Moniker: "validator"}, nil).
+		Times(1)
+
+	s.txs.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, fltrs storage.TxFilter) ([]storage.Tx, error) {
+			require.NotNil(t, fltrs.Height)
+			require.EqualValues(t, 100, *fltrs.Height)
+			require.EqualValues(t, 3, fltrs.Limit)
+			return []storage.Tx{{Id: 10}, {Id: 11}}, nil
+		}).
+		Times(1)
+
+	code, response := execute(t, h, `{
+		block(height: 100) {
+			height
+			stats { txCount }
+			proposer { moniker }
+			txs(first: 2) { edges { node { id } } pageInfo { hasNextPage } }
+		}
+	}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, response.Errors)
+
+	block, ok := response.Data["block"].(map[string]any)
+	require.True(t, ok)
+	require.EqualValues(t, 100, block["height"])
+	require.EqualValues(t, 1, block["stats"].(map[string]any)["txCount"])
+	require.Equal(t, "validator", block["proposer"].(map[string]any)["moniker"])
+
+	txs := block["txs"].(map[string]any)
+	require.Len(t, txs["edges"], 2)
+	require.Equal(t, false, txs["pageInfo"].(map[string]any)["hasNextPage"])
+}
+
+func TestServeBlocksPagination(t *testing.T) {
+	h, s := newTestHandler(t)
+
+	s.blocks.EXPECT().
+		ListWithStatsAfter(gomock.Any(), uint64(8), 3).
+		Return([]*storage.Block{{Id: 7}, {Id: 6}, {Id: 5}}, nil).
+		Times(1)
+
+	code, response := execute(t, h, `{
+		blocks(first: 2, after: "`+encodeCursor(8)+`") {
+			edges { cursor node { id } }
+			pageInfo { endCursor hasNextPage }
+		}
+	}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, response.Errors)
+
+	blocks := response.Data["blocks"].(map[string]any)
+	edges := blocks["edges"].([]any)
+	require.Len(t, edges, 2)
+	require.Equal(t, encodeCursor(7), edges[0].(map[string]any)["cursor"])
+	require.Equal(t, encodeCursor(6), edges[1].(map[string]any)["cursor"])
+
+	pageInfo := blocks["pageInfo"].(map[string]any)
+	require.Equal(t, true, pageInfo["hasNextPage"])
+	require.Equal(t, encodeCursor(6), pageInfo["endCursor"])
+}
+
+func TestServeInvalidPagination(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	code, response := execute(t, h, `{ blocks(first: 100) { edges { cursor } } }`)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, response.Errors, 1)
+	require.Contains(t, response.Errors[0].Message, "first should be between")
+
+	for _, cursor := range []string{
+		"invalid",
+		base64.StdEncoding.EncodeToString([]byte("offset:4")),
+		base64.StdEncoding.EncodeToString([]byte("id:0")),
+		base64.StdEncoding.EncodeToString([]byte("id:-1")),
+	} {
+		code, response = execute(t, h, `{ blocks(after: "`+cursor+`") { edges { cursor } } }`)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, response.Errors, 1)
+		require.Equal(t, errInvalidCursor.Error(), response.Errors[0].Message)
+	}
+}
+
+func TestServeCostLimit(t *testing.T) {
+	h, s := newTestHandler(t, WithMaxCost(5))
+
+	s.blocks.EXPECT().
+		ListWithStatsAfter(gomock.Any(), uint64(0), 5).
+		Return([]*storage.Block{
+			{Id: 1, ProposerId: 1},
+			{Id: 2, ProposerId: 1},
+		}, nil).
+		Times(1)
+
+	// only one proposer fits the budget left after the list is charged
+	s.validators.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Validator{Id: 1}, nil).
+		Times(1)
+
+	code, response := execute(t, h, `{ blocks(first: 4) { edges { node { proposer { id } } } } }`)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, response.Errors, 1)
+	require.Equal(t, ErrCostLimitExceeded.Error(), response.Errors[0].Message)
+
+	code, response = execute(t, h, `{ blocks(first: 6) { edges { cursor } } }`)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, response.Errors, 1)
+	require.Equal(t, ErrCostLimitExceeded.Error(), response.Errors[0].Message)
+}
+
+func TestServeEmptyQuery(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	code, _ := execute(t, h, "")
+	require.Equal(t, http.StatusBadRequest, code)
+}
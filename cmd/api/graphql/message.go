@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+type messageResolver struct {
+	root *resolver
+	msg  storage.Message
+}
+
+func newMessageResolver(root *resolver, msg storage.Message) *messageResolver {
+	return &messageResolver{
+		root: root,
+		msg:  msg,
+	}
+}
+
+func (m *messageResolver) Id() Uint64 {
+	return Uint64(m.msg.Id)
+}
+
+func (m *messageResolver) Height() Uint64 {
+	return Uint64(m.msg.Height)
+}
+
+func (m *messageResolver) Time() gql.Time {
+	return gql.Time{Time: m.msg.Time}
+}
+
+func (m *messageResolver) Position() int32 {
+	return int32(m.msg.Position)
+}
+
+func (m *messageResolver) Type() string {
+	return m.msg.Type.String()
+}
+
+func (m *messageResolver) Size() int32 {
+	return int32(m.msg.Size)
+}
+
+func (m *messageResolver) Data() (string, error) {
+	return marshalData(m.msg.Data)
+}
+
+func (m *messageResolver) Tx(ctx context.Context) (*txResolver, error) {
+	return txById(ctx, m.root, m.msg.TxId)
+}
+
+type eventResolver struct {
+	root  *resolver
+	event storage.Event
+}
+
+func newEventResolver(root *resolver, event storage.Event) *eventResolver {
+	return &eventResolver{
+		root:  root,
+		event: event,
+	}
+}
+
+func (e *eventResolver) Id() Uint64 {
+	return Uint64(e.event.Id)
+}
+
+func (e *eventResolver) Height() Uint64 {
+	return Uint64(e.event.Height)
+}
+
+func (e *eventResolver) Time() gql.Time {
+	return gql.Time{Time: e.event.Time}
+}
+
+func (e *eventResolver) Position() int32 {
+	return int32(e.event.Position)
+}
+
+func (e *eventResolver) Type() string {
+	return e.event.Type.String()
+}
+
+func (e *eventResolver) Data() (string, error) {
+	return marshalData(e.event.Data)
+}
+
+func (e *eventResolver) Tx(ctx context.Context) (*txResolver, error) {
+	if e.event.TxId == nil {
+		return nil, nil
+	}
+	return txById(ctx, e.root, *e.event.TxId)
+}
+
+// marshalData - encodes free-form entity data to JSON string since GraphQL has no map type
+func marshalData[T ~map[string]V, V any](data T) (string, error) {
+	if len(data) == 0 {
+		return "{}", nil
+	}
+	return json.MarshalString(data)
+}
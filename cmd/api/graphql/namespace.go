@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+type namespaceResolver struct {
+	root *resolver
+	ns   storage.Namespace
+}
+
+func newNamespaceResolver(root *resolver, ns storage.Namespace) *namespaceResolver {
+	return &namespaceResolver{
+		root: root,
+		ns:   ns,
+	}
+}
+
+func (n *namespaceResolver) Id() Uint64 {
+	return Uint64(n.ns.Id)
+}
+
+func (n *namespaceResolver) NamespaceId() string {
+	return hex.EncodeToString(n.ns.NamespaceID)
+}
+
+func (n *namespaceResolver) Version() int32 {
+	return int32(n.ns.Version)
+}
+
+func (n *namespaceResolver) Hash() string {
+	return n.ns.Hash()
+}
+
+func (n *namespaceResolver) Size() Uint64 {
+	return Uint64(n.ns.Size)
+}
+
+func (n *namespaceResolver) BlobsCount() Uint64 {
+	return Uint64(n.ns.BlobsCount)
+}
+
+func (n *namespaceResolver) PfbCount() Uint64 {
+	return Uint64(n.ns.PfbCount)
+}
+
+func (n *namespaceResolver) Reserved() bool {
+	return n.ns.Reserved
+}
+
+func (n *namespaceResolver) FirstHeight() Uint64 {
+	return Uint64(n.ns.FirstHeight)
+}
+
+func (n *namespaceResolver) LastHeight() Uint64 {
+	return Uint64(n.ns.LastHeight)
+}
+
+func (n *namespaceResolver) LastMessageTime() gql.Time {
+	return gql.Time{Time: n.ns.LastMessageTime}
+}
+
+func (n *namespaceResolver) Blobs(ctx context.Context, args connectionArgs) (*connection[*blobLogResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := n.root.blobLogs.ByNamespace(ctx, n.ns.Id, storage.BlobLogFilters{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Sort:   sdk.SortOrderDesc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBlobLogConnection(n.root, p, blobs), nil
+}
+
+func (n *namespaceResolver) Rollups(ctx context.Context, args connectionArgs) (*connection[*rollupResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	rollups, err := n.root.rollups.RollupsByNamespaceAfter(ctx, n.ns.Id, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*rollupResolver, 0, len(rollups))
+	for i := range rollups {
+		if rollups[i].Id == 0 {
+			continue
+		}
+		// rollups are returned by namespace without stats, so they are requested once more
+		if err := spend(ctx, 1); err != nil {
+			return nil, err
+		}
+		rollup, err := n.root.rollups.ById(ctx, rollups[i].Id)
+		if err != nil {
+			if n.root.rollups.IsNoRows(err) {
+				continue
+			}
+			return nil, err
+		}
+		nodes = append(nodes, newRollupResolver(n.root, rollup))
+	}
+	return newConnection(p, nodes), nil
+}
+
+// namespaceById - resolves namespace of nested entities
+func namespaceById(ctx context.Context, root *resolver, id uint64) (*namespaceResolver, error) {
+	if id == 0 {
+		return nil, nil
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	ns, err := root.namespaces.GetByID(ctx, id)
+	if err != nil {
+		if root.namespaces.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newNamespaceResolver(root, *ns), nil
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+type HandlerOption func(*Handler)
+
+func WithMaxCost(cost int64) HandlerOption {
+	return func(h *Handler) {
+		if cost > 0 {
+			h.maxCost = cost
+		}
+	}
+}
+
+func WithMaxDepth(depth int) HandlerOption {
+	return func(h *Handler) {
+		if depth > 0 {
+			h.maxDepth = depth
+		}
+	}
+}
+
+func WithMaxQueryLength(length int) HandlerOption {
+	return func(h *Handler) {
+		if length > 0 {
+			h.maxQueryLength = length
+		}
+	}
+}
+
+func WithMaxParallelism(parallelism int) HandlerOption {
+	return func(h *Handler) {
+		if parallelism > 0 {
+			h.maxParallelism = parallelism
+		}
+	}
+}
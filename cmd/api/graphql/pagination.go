@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPageSize = 10
+	// maxPageSize - one extra item is requested to detect next page and storage limits queries by 100 rows
+	maxPageSize  = 50
+	cursorPrefix = "id:"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type connectionArgs struct {
+	First *int32
+	After *string
+}
+
+type page struct {
+	limit  int
+	cursor uint64
+}
+
+// node - item of connection. Its identity is used as a cursor.
+type node interface {
+	Id() Uint64
+}
+
+// newPage - validates pagination arguments and charges requested page size from the query budget
+func newPage(ctx context.Context, args connectionArgs) (page, error) {
+	p := page{
+		limit: defaultPageSize,
+	}
+	if args.First != nil {
+		if *args.First < 1 || *args.First > maxPageSize {
+			return p, errors.Errorf("first should be between 1 and %d", maxPageSize)
+		}
+		p.limit = int(*args.First)
+	}
+	if args.After != nil && *args.After != "" {
+		cursor, err := decodeCursor(*args.After)
+		if err != nil {
+			return p, err
+		}
+		p.cursor = cursor
+	}
+	if err := spend(ctx, p.limit); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// fetch - count of rows which should be requested from storage
+func (p page) fetch() int {
+	return p.limit + 1
+}
+
+func encodeCursor(id uint64) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.FormatUint(id, 10)))
+}
+
+// decodeCursor - returns identity of the last received item. Cursors of other formats are rejected.
+func decodeCursor(cursor string) (uint64, error) {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	value, ok := strings.CutPrefix(string(data), cursorPrefix)
+	if !ok {
+		return 0, errInvalidCursor
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || id == 0 {
+		return 0, errInvalidCursor
+	}
+	return id, nil
+}
+
+type pageInfo struct {
+	endCursor   *string
+	hasNextPage bool
+}
+
+func (p *pageInfo) EndCursor() *string {
+	return p.endCursor
+}
+
+func (p *pageInfo) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+type edge[T any] struct {
+	cursor string
+	node   T
+}
+
+func (e *edge[T]) Cursor() string {
+	return e.cursor
+}
+
+func (e *edge[T]) Node() T {
+	return e.node
+}
+
+type connection[T any] struct {
+	edges []*edge[T]
+	info  *pageInfo
+}
+
+// newConnection - builds page from nodes received with page.fetch limit. Cursor of every edge is the identity of its node.
+func newConnection[T node](p page, nodes []T) *connection[T] {
+	info := &pageInfo{
+		hasNextPage: len(nodes) > p.limit,
+	}
+	if info.hasNextPage {
+		nodes = nodes[:p.limit]
+	}
+
+	edges := make([]*edge[T], len(nodes))
+	for i := range nodes {
+		edges[i] = &edge[T]{
+			cursor: encodeCursor(uint64(nodes[i].Id())),
+			node:   nodes[i],
+		}
+	}
+	if len(edges) > 0 {
+		info.endCursor = &edges[len(edges)-1].cursor
+	}
+
+	return &connection[T]{
+		edges: edges,
+		info:  info,
+	}
+}
+
+func (c *connection[T]) Edges() []*edge[T] {
+	return c.edges
+}
+
+func (c *connection[T]) PageInfo() *pageInfo {
+	return c.info
+}
+
+// emptyConnection - returns connection without items
+func emptyConnection[T any]() *connection[T] {
+	return &connection[T]{
+		edges: make([]*edge[T], 0),
+		info:  new(pageInfo),
+	}
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// resolver - root query resolver
+type resolver struct {
+	blocks     storage.IBlock
+	txs        storage.ITx
+	messages   storage.IMessage
+	events     storage.IEvent
+	namespaces storage.INamespace
+	blobLogs   storage.IBlobLog
+	addresses  storage.IAddress
+	validators storage.IValidator
+	rollups    storage.IRollup
+}
+
+// noRows - converts storage not found error to null value
+func noRows[T any](table interface{ IsNoRows(error) bool }, value *T, err error) (*T, error) {
+	if err != nil {
+		if table.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return value, nil
+}
+
+func (r *resolver) Block(ctx context.Context, args struct{ Height Uint64 }) (*blockResolver, error) {
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	block, err := r.blocks.ByHeightWithStats(ctx, pkgTypes.Level(args.Height))
+	return noRows(r.blocks, newBlockResolver(r, block), err)
+}
+
+func (r *resolver) Blocks(ctx context.Context, args connectionArgs) (*connection[*blockResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := r.blocks.ListWithStatsAfter(ctx, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*blockResolver, len(blocks))
+	for i := range blocks {
+		nodes[i] = newBlockResolver(r, *blocks[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (r *resolver) Tx(ctx context.Context, args struct{ Hash string }) (*txResolver, error) {
+	hash, err := hex.DecodeString(args.Hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid transaction hash")
+	}
+	if len(hash) != 32 {
+		return nil, errors.Errorf("invalid transaction hash length: %d", len(hash))
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	tx, err := r.txs.ByHash(ctx, hash)
+	return noRows(r.txs, newTxResolver(r, tx), err)
+}
+
+func (r *resolver) Address(ctx context.Context, args struct{ Hash string }) (*addressResolver, error) {
+	_, hash, err := pkgTypes.Address(args.Hash).Decode()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address")
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	address, err := r.addresses.ByHash(ctx, hash)
+	return noRows(r.addresses, newAddressResolver(r, address), err)
+}
+
+func (r *resolver) Addresses(ctx context.Context, args connectionArgs) (*connection[*addressResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := r.addresses.ListWithBalanceAfter(ctx, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*addressResolver, len(addresses))
+	for i := range addresses {
+		nodes[i] = newAddressResolver(r, addresses[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (r *resolver) Namespace(ctx context.Context, args struct {
+	Id      string
+	Version *int32
+}) (*namespaceResolver, error) {
+	namespaceId, err := hex.DecodeString(args.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid namespace id")
+	}
+	var version byte
+	if args.Version != nil {
+		if *args.Version < 0 || *args.Version > 255 {
+			return nil, errors.Errorf("invalid namespace version: %d", *args.Version)
+		}
+		version = byte(*args.Version)
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	ns, err := r.namespaces.ByNamespaceIdAndVersion(ctx, namespaceId, version)
+	return noRows(r.namespaces, newNamespaceResolver(r, ns), err)
+}
+
+func (r *resolver) Namespaces(ctx context.Context, args connectionArgs) (*connection[*namespaceResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	namespaces, err := r.namespaces.ListAfter(ctx, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*namespaceResolver, len(namespaces))
+	for i := range namespaces {
+		nodes[i] = newNamespaceResolver(r, namespaces[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (r *resolver) Validator(ctx context.Context, args struct{ Address string }) (*validatorResolver, error) {
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	validator, err := r.validators.ByAddress(ctx, args.Address)
+	return noRows(r.validators, newValidatorResolver(r, validator), err)
+}
+
+func (r *resolver) Validators(ctx context.Context, args connectionArgs) (*connection[*validatorResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := r.validators.ListByPowerAfter(ctx, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*validatorResolver, len(validators))
+	for i := range validators {
+		nodes[i] = newValidatorResolver(r, validators[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (r *resolver) Rollup(ctx context.Context, args struct{ Slug string }) (*rollupResolver, error) {
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	rollup, err := r.rollups.BySlug(ctx, args.Slug)
+	return noRows(r.rollups, newRollupResolver(r, rollup), err)
+}
+
+func (r *resolver) Rollups(ctx context.Context, args connectionArgs) (*connection[*rollupResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	rollups, err := r.rollups.LeaderboardAfter(ctx, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*rollupResolver, len(rollups))
+	for i := range rollups {
+		nodes[i] = newRollupResolver(r, rollups[i])
+	}
+	return newConnection(p, nodes), nil
+}
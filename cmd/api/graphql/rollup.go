@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+type rollupResolver struct {
+	root   *resolver
+	rollup storage.RollupWithStats
+}
+
+func newRollupResolver(root *resolver, rollup storage.RollupWithStats) *rollupResolver {
+	return &rollupResolver{
+		root:   root,
+		rollup: rollup,
+	}
+}
+
+func (r *rollupResolver) Id() Uint64 {
+	return Uint64(r.rollup.Id)
+}
+
+func (r *rollupResolver) Name() string {
+	return r.rollup.Name
+}
+
+func (r *rollupResolver) Slug() string {
+	return r.rollup.Slug
+}
+
+func (r *rollupResolver) Description() string {
+	return r.rollup.Description
+}
+
+func (r *rollupResolver) Website() string {
+	return r.rollup.Website
+}
+
+func (r *rollupResolver) Github() string {
+	return r.rollup.GitHub
+}
+
+func (r *rollupResolver) Twitter() string {
+	return r.rollup.Twitter
+}
+
+func (r *rollupResolver) Logo() string {
+	return r.rollup.Logo
+}
+
+func (r *rollupResolver) Type() string {
+	return r.rollup.Type.String()
+}
+
+func (r *rollupResolver) Category() string {
+	return r.rollup.Category.String()
+}
+
+func (r *rollupResolver) Tags() []string {
+	if r.rollup.Tags == nil {
+		return []string{}
+	}
+	return r.rollup.Tags
+}
+
+func (r *rollupResolver) Verified() bool {
+	return r.rollup.Verified
+}
+
+func (r *rollupResolver) Size() Uint64 {
+	return Uint64(r.rollup.Size)
+}
+
+func (r *rollupResolver) BlobsCount() Uint64 {
+	return Uint64(r.rollup.BlobsCount)
+}
+
+func (r *rollupResolver) Fee() string {
+	return r.rollup.Fee.String()
+}
+
+func (r *rollupResolver) FirstActionTime() gql.Time {
+	return gql.Time{Time: r.rollup.FirstActionTime}
+}
+
+func (r *rollupResolver) LastActionTime() gql.Time {
+	return gql.Time{Time: r.rollup.LastActionTime}
+}
+
+func (r *rollupResolver) Namespaces(ctx context.Context, args connectionArgs) (*connection[*namespaceResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := r.root.rollups.NamespacesAfter(ctx, r.rollup.Id, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return emptyConnection[*namespaceResolver](), nil
+	}
+
+	namespaces, err := r.root.namespaces.GetByIds(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[uint64]storage.Namespace, len(namespaces))
+	for i := range namespaces {
+		byId[namespaces[i].Id] = namespaces[i]
+	}
+
+	// keep order of identities returned by rollup storage
+	nodes := make([]*namespaceResolver, 0, len(ids))
+	for _, id := range ids {
+		if ns, ok := byId[id]; ok {
+			nodes = append(nodes, newNamespaceResolver(r.root, ns))
+		}
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (r *rollupResolver) Blobs(ctx context.Context, args connectionArgs) (*connection[*blobLogResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	providers, err := r.root.rollups.Providers(ctx, r.rollup.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(providers) == 0 {
+		return emptyConnection[*blobLogResolver](), nil
+	}
+
+	blobs, err := r.root.blobLogs.ByProviders(ctx, providers, storage.BlobLogFilters{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Sort:   sdk.SortOrderDesc,
+		From:   r.rollup.FirstActionTime,
+		To:     r.rollup.LastActionTime.Add(time.Hour),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBlobLogConnection(r.root, p, blobs), nil
+}
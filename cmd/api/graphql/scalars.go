@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Uint64 - custom scalar for identities, heights and sizes which do not fit into GraphQL Int
+type Uint64 uint64
+
+func (Uint64) ImplementsGraphQLType(name string) bool {
+	return name == "Uint64"
+}
+
+func (u *Uint64) UnmarshalGraphQL(input any) error {
+	switch value := input.(type) {
+	case int32:
+		if value < 0 {
+			return errors.Errorf("negative Uint64 value: %d", value)
+		}
+		*u = Uint64(value)
+	case float64:
+		if value < 0 || value != float64(uint64(value)) {
+			return errors.Errorf("invalid Uint64 value: %v", value)
+		}
+		*u = Uint64(value)
+	case string:
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid Uint64 value")
+		}
+		*u = Uint64(parsed)
+	default:
+		return errors.Errorf("invalid Uint64 type: %T", input)
+	}
+	return nil
+}
+
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(u), 10), nil
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+type txResolver struct {
+	root *resolver
+	tx   storage.Tx
+}
+
+func newTxResolver(root *resolver, tx storage.Tx) *txResolver {
+	return &txResolver{
+		root: root,
+		tx:   tx,
+	}
+}
+
+func (t *txResolver) Id() Uint64 {
+	return Uint64(t.tx.Id)
+}
+
+func (t *txResolver) Height() Uint64 {
+	return Uint64(t.tx.Height)
+}
+
+func (t *txResolver) Time() gql.Time {
+	return gql.Time{Time: t.tx.Time}
+}
+
+func (t *txResolver) Position() int32 {
+	return int32(t.tx.Position)
+}
+
+func (t *txResolver) Hash() string {
+	return hex.EncodeToString(t.tx.Hash)
+}
+
+func (t *txResolver) Status() string {
+	return t.tx.Status.String()
+}
+
+func (t *txResolver) Fee() string {
+	return t.tx.Fee.String()
+}
+
+func (t *txResolver) GasWanted() Uint64 {
+	return Uint64(t.tx.GasWanted)
+}
+
+func (t *txResolver) GasUsed() Uint64 {
+	return Uint64(t.tx.GasUsed)
+}
+
+func (t *txResolver) TimeoutHeight() Uint64 {
+	return Uint64(t.tx.TimeoutHeight)
+}
+
+func (t *txResolver) EventsCount() int32 {
+	return int32(t.tx.EventsCount)
+}
+
+func (t *txResolver) MessagesCount() int32 {
+	return int32(t.tx.MessagesCount)
+}
+
+func (t *txResolver) Memo() string {
+	return t.tx.Memo
+}
+
+func (t *txResolver) Codespace() string {
+	return t.tx.Codespace
+}
+
+func (t *txResolver) Error() string {
+	return t.tx.Error
+}
+
+func (t *txResolver) MessageTypes() []string {
+	return msgTypeNames(t.tx.MessageTypes)
+}
+
+func (t *txResolver) Block(ctx context.Context) (*blockResolver, error) {
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	block, err := t.root.blocks.ByHeightWithStats(ctx, t.tx.Height)
+	return noRows(t.root.blocks, newBlockResolver(t.root, block), err)
+}
+
+func (t *txResolver) Messages(ctx context.Context, args connectionArgs) (*connection[*messageResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := t.root.messages.ByTxIdAfter(ctx, t.tx.Id, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*messageResolver, len(messages))
+	for i := range messages {
+		nodes[i] = newMessageResolver(t.root, messages[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (t *txResolver) Events(ctx context.Context, args connectionArgs) (*connection[*eventResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	events, err := t.root.events.ByTxId(ctx, t.tx.Id, storage.EventFilter{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Time:   t.tx.Time.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*eventResolver, len(events))
+	for i := range events {
+		nodes[i] = newEventResolver(t.root, events[i])
+	}
+	return newConnection(p, nodes), nil
+}
+
+func (t *txResolver) Blobs(ctx context.Context, args connectionArgs) (*connection[*blobLogResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := t.root.blobLogs.ByTxId(ctx, t.tx.Id, storage.BlobLogFilters{
+		Limit:  p.fetch(),
+		Cursor: p.cursor,
+		Sort:   sdk.SortOrderAsc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBlobLogConnection(t.root, p, blobs), nil
+}
+
+// txById - resolves parent transaction of nested entities
+func txById(ctx context.Context, root *resolver, id uint64) (*txResolver, error) {
+	if id == 0 {
+		return nil, nil
+	}
+	if err := spend(ctx, 1); err != nil {
+		return nil, err
+	}
+	tx, err := root.txs.GetByID(ctx, id)
+	if err != nil {
+		if root.txs.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newTxResolver(root, *tx), nil
+}
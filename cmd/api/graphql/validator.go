@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+type validatorResolver struct {
+	root      *resolver
+	validator storage.Validator
+}
+
+func newValidatorResolver(root *resolver, validator storage.Validator) *validatorResolver {
+	return &validatorResolver{
+		root:      root,
+		validator: validator,
+	}
+}
+
+func (v *validatorResolver) Id() Uint64 {
+	return Uint64(v.validator.Id)
+}
+
+func (v *validatorResolver) Address() string {
+	return v.validator.Address
+}
+
+func (v *validatorResolver) ConsAddress() string {
+	return v.validator.ConsAddress
+}
+
+func (v *validatorResolver) Delegator() string {
+	return v.validator.Delegator
+}
+
+func (v *validatorResolver) Moniker() string {
+	return v.validator.Moniker
+}
+
+func (v *validatorResolver) Website() string {
+	return v.validator.Website
+}
+
+func (v *validatorResolver) Identity() string {
+	return v.validator.Identity
+}
+
+func (v *validatorResolver) Contacts() string {
+	return v.validator.Contacts
+}
+
+func (v *validatorResolver) Details() string {
+	return v.validator.Details
+}
+
+func (v *validatorResolver) Rate() string {
+	return v.validator.Rate.String()
+}
+
+func (v *validatorResolver) MaxRate() string {
+	return v.validator.MaxRate.String()
+}
+
+func (v *validatorResolver) Stake() string {
+	return v.validator.Stake.String()
+}
+
+func (v *validatorResolver) Rewards() string {
+	return v.validator.Rewards.String()
+}
+
+func (v *validatorResolver) Commissions() string {
+	return v.validator.Commissions.String()
+}
+
+func (v *validatorResolver) Jailed() bool {
+	return v.validator.Jailed != nil && *v.validator.Jailed
+}
+
+func (v *validatorResolver) CreationTime() gql.Time {
+	return gql.Time{Time: v.validator.CreationTime}
+}
+
+func (v *validatorResolver) Blocks(ctx context.Context, args connectionArgs) (*connection[*blockResolver], error) {
+	p, err := newPage(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := v.root.blocks.ByProposerAfter(ctx, v.validator.Id, p.cursor, p.fetch())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*blockResolver, len(blocks))
+	for i := range blocks {
+		nodes[i] = newBlockResolver(v.root, blocks[i])
+	}
+	return newConnection(p, nodes), nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/currency"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
@@ -31,6 +32,8 @@ type AddressHandler struct {
 	grants        storage.IGrant
 	celestial     celestials.ICelestial
 	votes         storage.IVote
+	stakingLogs   storage.IStakingLog
+	validators    storage.IValidator
 	state         storage.IState
 	indexerName   string
 }
@@ -48,6 +51,8 @@ func NewAddressHandler(
 	grants storage.IGrant,
 	celestial celestials.ICelestial,
 	votes storage.IVote,
+	stakingLogs storage.IStakingLog,
+	validators storage.IValidator,
 	state storage.IState,
 	indexerName string,
 ) *AddressHandler {
@@ -64,6 +69,8 @@ func NewAddressHandler(
 		grants:        grants,
 		celestial:     celestial,
 		votes:         votes,
+		stakingLogs:   stakingLogs,
+		validators:    validators,
 		state:         state,
 		indexerName:   indexerName,
 	}
@@ -911,13 +918,190 @@ func (handler *AddressHandler) Votes(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+// EffectiveVotes godoc
+//
+//	@Summary		Get list of effective votes for address
+//	@Description	Returns a paginated list of effective votes of the address delegations: its own vote or the vote inherited from the validator if the address didn't vote. Votes in finished proposals are recorded at the end of voting, votes in active proposals are computed from the current delegations.
+//	@Tags			address
+//	@ID				address-effective-votes
+//	@Param			hash	path	string	true	"Hash"							minlength(47)	maxlength(128)
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.EffectiveVote
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/effective_votes [get]
+func (handler *AddressHandler) EffectiveVotes(c echo.Context) error {
+	req, err := bindAndValidate[getAddressPageable](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByAddress(c.Request().Context(), req.Hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	votes, err := handler.votes.EffectiveVotesByAddress(
+		c.Request().Context(),
+		addressId,
+		req.Limit,
+		req.Offset,
+	)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.EffectiveVote, len(votes))
+	for i := range votes {
+		response[i] = responses.NewEffectiveVote(votes[i])
+	}
+	return returnArray(c, response)
+}
+
+type addressRewardsRequest struct {
+	Hash      string `param:"hash"      validate:"required,address"`
+	Limit     int    `query:"limit"     validate:"omitempty,min=1,max=100"`
+	Offset    int    `query:"offset"    validate:"omitempty,min=0"`
+	Sort      string `query:"sort"      validate:"omitempty,oneof=asc desc"`
+	From      int64  `query:"from"      validate:"omitempty,min=1"`
+	To        int64  `query:"to"        validate:"omitempty,min=1"`
+	Validator string `query:"validator" validate:"omitempty,address"`
+}
+
+func (req *addressRewardsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Rewards godoc
+//
+//	@Summary		Get rewards ledger of address
+//	@Description	Returns a paginated rewards ledger of the address per validator built from staking rewards withdrawals (explicit or automatic on delegation change) and delegation changes. Every entry contains running delegated amount and withdrawn rewards of the (address, validator) pair after the entry.
+//	@Tags			address
+//	@ID				address-rewards
+//	@Param			hash		path	string	true	"Hash"							minlength(47)	maxlength(128)
+//	@Param			limit		query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						minimum(1)
+//	@Param			sort		query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Param			validator	query	string	false	"Validator address"				minlength(54)	maxlength(54)
+//	@Produce		json
+//	@Success		200	{array}		responses.RewardsLedgerItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/rewards [get]
+func (handler *AddressHandler) Rewards(c echo.Context) error {
+	req, err := bindAndValidate[addressRewardsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	addressId, err := handler.address.IdByAddress(c.Request().Context(), req.Hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	seriesRequest := storage.NewSeriesRequest(req.From, req.To)
+	fltrs := storage.RewardsLedgerFilters{
+		AddressId: &addressId,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		Sort:      pgSort(req.Sort),
+		From:      seriesRequest.From,
+		To:        seriesRequest.To,
+	}
+	if req.Validator != "" {
+		validator, err := handler.validators.ByAddress(c.Request().Context(), req.Validator)
+		if err != nil {
+			return handleError(c, err, handler.validators)
+		}
+		fltrs.ValidatorId = &validator.Id
+	}
+
+	ledger, err := handler.stakingLogs.RewardsLedger(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.stakingLogs)
+	}
+
+	response := make([]responses.RewardsLedgerItem, len(ledger))
+	for i := range ledger {
+		response[i] = responses.NewRewardsLedgerItem(ledger[i])
+	}
+	return returnArray(c, response)
+}
+
+type addressRewardsSeriesRequest struct {
+	Hash      string `example:"celestia1glfkehhpvl55amdew2fnm6wxt7egy560mxdrj7" param:"hash"      swaggertype:"string"  validate:"required,address"`
+	Timeframe string `example:"day"                                             param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day week month"`
+	From      int64  `example:"1692892095"                                      query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64  `example:"1692892095"                                      query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// RewardsSeries godoc
+//
+//	@Summary		Get rewards time series of address
+//	@Description	Returns a time series of staking rewards accrued and withdrawn by the address. Accrued rewards are estimated per bucket by the share of the address in the validator stake at the end of the bucket. Commission fields are always zero for address.
+//	@Tags			address
+//	@ID				address-rewards-series
+//	@Param			hash		path	string	true	"Hash"							minlength(47)	maxlength(128)
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, week, month)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.RewardsSeriesItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/rewards/{timeframe} [get]
+func (handler *AddressHandler) RewardsSeries(c echo.Context) error {
+	req, err := bindAndValidate[addressRewardsSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByAddress(c.Request().Context(), req.Hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	series, err := handler.stakingLogs.RewardsSeries(c.Request().Context(), storage.RewardsSeriesFilters{
+		AddressId:     &addressId,
+		Timeframe:     storage.Timeframe(req.Timeframe),
+		SeriesRequest: storage.NewSeriesRequest(req.From, req.To),
+	})
+	if err != nil {
+		return handleError(c, err, handler.stakingLogs)
+	}
+
+	response := make([]responses.RewardsSeriesItem, len(series))
+	for i := range series {
+		response[i] = responses.NewRewardsSeriesItem(series[i])
+	}
+	return returnArray(c, response)
+}
+
+type getAddressBalances struct {
+	Hash   string `param:"hash"   validate:"required,address"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Height uint64 `query:"height" validate:"omitempty,min=1"`
+}
+
 // @Summary		Get list of balances for address
-// @Description	Returns a paginated list of all token balances held by the given address, including native and IBC tokens. Results are ordered by currency name.
+// @Description	Returns a paginated list of all token balances held by the given address, including native and IBC tokens. Results are ordered by currency name. If height is passed, balances at the end of the block with this height are returned. Heights before the start of balance history are rejected.
 // @Tags			address
 // @ID				address-balances
 // @Param			hash	path	string	true	"Hash"							minlength(47)	maxlength(128)
 // @Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
 // @Param			offset	query	integer	false	"Offset"						minimum(1)
+// @Param			height	query	integer	false	"Block height"					minimum(1)
 // @Produce		json
 // @Success		200	{array}		responses.Balance
 // @Failure		400	{object}	Error
@@ -925,7 +1109,7 @@ func (handler *AddressHandler) Votes(c echo.Context) error {
 // @Failure		500	{object}	Error
 // @Router			/address/{hash}/balances [get]
 func (h *AddressHandler) Balances(c echo.Context) error {
-	req, err := bindAndValidate[getAddressPageable](c)
+	req, err := bindAndValidate[getAddressBalances](c)
 	if err != nil {
 		return badRequestError(c, err)
 	}
@@ -935,7 +1119,22 @@ func (h *AddressHandler) Balances(c echo.Context) error {
 		return handleError(c, err, h.address)
 	}
 
-	balances, err := h.address.Balances(c.Request().Context(), addressId, req.Limit, req.Offset)
+	if req.Height > 0 {
+		state, err := h.state.ByName(c.Request().Context(), h.indexerName)
+		if err != nil {
+			return handleError(c, err, h.state)
+		}
+		if types.Level(req.Height) < state.BalanceHistoryHeight {
+			return badRequestError(c, errors.Errorf("balance history is available since height %d", state.BalanceHistoryHeight))
+		}
+	}
+
+	var balances []storage.Balance
+	if req.Height > 0 {
+		balances, err = h.address.BalancesAt(c.Request().Context(), addressId, types.Level(req.Height), req.Limit, req.Offset)
+	} else {
+		balances, err = h.address.Balances(c.Request().Context(), addressId, req.Limit, req.Offset)
+	}
 	if err != nil {
 		return handleError(c, err, h.address)
 	}
@@ -945,3 +1144,64 @@ func (h *AddressHandler) Balances(c echo.Context) error {
 	}
 	return returnArray(c, response)
 }
+
+type addressBalanceHistoryRequest struct {
+	Hash      string `example:"celestia1glfkehhpvl55amdew2fnm6wxt7egy560mxdrj7" param:"hash"      swaggertype:"string"  validate:"required,address"`
+	Timeframe string `example:"day"                                             param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day week month"`
+	Currency  string `example:"utia"                                            query:"currency"  swaggertype:"string"  validate:"omitempty"`
+	From      int64  `example:"1692892095"                                      query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64  `example:"1692892095"                                      query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (req *addressBalanceHistoryRequest) SetDefault() {
+	if req.Currency == "" {
+		req.Currency = currency.DefaultCurrency
+	}
+}
+
+// BalanceHistory godoc
+//
+//	@Summary		Get address balance history
+//	@Description	Returns a time series of address balance in the given currency. Every item contains balance at the end of the time bucket. Series starts from the beginning of balance history.
+//	@Tags			address
+//	@ID				address-balance-history
+//	@Param			hash		path	string	true	"Hash"							minlength(47)	maxlength(128)
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, week, month)
+//	@Param			currency	query	string	false	"Currency. Default: utia"
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.BalanceHistoryItem
+//	@Failure		400	{object}	Error
+//	@Failure		404	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/balances/history/{timeframe} [get]
+func (handler *AddressHandler) BalanceHistory(c echo.Context) error {
+	req, err := bindAndValidate[addressBalanceHistoryRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	addressId, err := handler.address.IdByAddress(c.Request().Context(), req.Hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	series, err := handler.address.BalanceSeries(
+		c.Request().Context(),
+		addressId,
+		req.Currency,
+		storage.Timeframe(req.Timeframe),
+		storage.NewSeriesRequest(req.From, req.To),
+	)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.BalanceHistoryItem, len(series))
+	for i := range series {
+		response[i] = responses.NewBalanceHistoryItem(series[i])
+	}
+	return returnArray(c, response)
+}
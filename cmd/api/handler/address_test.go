@@ -20,6 +20,7 @@ import (
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	celestials "github.com/celenium-io/celestial-module/pkg/storage"
 	celestialMock "github.com/celenium-io/celestial-module/pkg/storage/mock"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -55,6 +56,8 @@ type AddressTestSuite struct {
 	grants        *mock.MockIGrant
 	celestials    *celestialMock.MockICelestial
 	votes         *mock.MockIVote
+	stakingLogs   *mock.MockIStakingLog
+	validators    *mock.MockIValidator
 	state         *mock.MockIState
 	echo          *echo.Echo
 	handler       *AddressHandler
@@ -78,9 +81,11 @@ func (s *AddressTestSuite) SetupSuite() {
 	s.grants = mock.NewMockIGrant(s.ctrl)
 	s.celestials = celestialMock.NewMockICelestial(s.ctrl)
 	s.votes = mock.NewMockIVote(s.ctrl)
+	s.stakingLogs = mock.NewMockIStakingLog(s.ctrl)
+	s.validators = mock.NewMockIValidator(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
 	s.blocks = mock.NewMockIBlock(s.ctrl)
-	s.handler = NewAddressHandler(s.address, s.blocks, s.txs, s.blobLogs, s.messages, s.delegations, s.undelegations, s.redelegations, s.vestings, s.grants, s.celestials, s.votes, s.state, testIndexerName)
+	s.handler = NewAddressHandler(s.address, s.blocks, s.txs, s.blobLogs, s.messages, s.delegations, s.undelegations, s.redelegations, s.vestings, s.grants, s.celestials, s.votes, s.stakingLogs, s.validators, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -880,6 +885,58 @@ func (s *AddressTestSuite) TestVotes() {
 	s.Require().EqualValues("image", votes[0].Voter.Celestials.ImageUrl)
 }
 
+func (s *AddressTestSuite) TestEffectiveVotes() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/effective_votes")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByAddress(gomock.Any(), testAddress).
+		Return(123, nil)
+
+	s.votes.EXPECT().
+		EffectiveVotesByAddress(gomock.Any(), uint64(123), 10, 0).
+		Return([]storage.EffectiveVote{
+			{
+				ProposalId:  2,
+				AddressId:   123,
+				ValidatorId: 1,
+				Option:      types.VoteOptionAbstain,
+				Weight:      types.NumericFromInt64(1),
+				VotingPower: types.NumericFromInt64(100),
+				Inherited:   true,
+				Validator:   &testValidator,
+				Proposal: &storage.Proposal{
+					Id:     2,
+					Status: types.ProposalStatusActive,
+					Title:  "title",
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.EffectiveVotes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.EffectiveVote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(2, votes[0].ProposalId)
+	s.Require().EqualValues(types.VoteOptionAbstain, votes[0].Option)
+	s.Require().EqualValues(types.NumericFromInt64(100), votes[0].VotingPower)
+	s.Require().True(votes[0].Inherited)
+	s.Require().NotNil(votes[0].Validator)
+	s.Require().NotNil(votes[0].Proposal)
+	s.Require().Equal("title", votes[0].Proposal.Title)
+}
+
 func (s *AddressTestSuite) TestBalances() {
 	q := make(url.Values)
 	q.Set("limit", "10")
@@ -929,3 +986,246 @@ func (s *AddressTestSuite) TestBalances() {
 	s.Require().Equal("3", balances[1].Delegated)
 	s.Require().Equal("4", balances[1].Unbonding)
 }
+
+func (s *AddressTestSuite) TestBalancesAtHeight() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("height", "100")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balances")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByAddress(gomock.Any(), testAddress).
+		Return(1, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{BalanceHistoryHeight: 50}, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		BalancesAt(gomock.Any(), uint64(1), pkgTypes.Level(100), 10, 0).
+		Return([]storage.Balance{
+			{
+				Currency:  "utia",
+				Spendable: types.NumericFromInt64(50),
+				Delegated: types.NumericFromInt64(10),
+				Unbonding: types.NumericZero(),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Balances(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var balances []responses.Balance
+	err := json.NewDecoder(rec.Body).Decode(&balances)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+	s.Require().Equal("utia", balances[0].Currency)
+	s.Require().Equal("50", balances[0].Spendable)
+	s.Require().Equal("10", balances[0].Delegated)
+	s.Require().Equal("0", balances[0].Unbonding)
+}
+
+func (s *AddressTestSuite) TestBalancesBeforeHistoryStart() {
+	q := make(url.Values)
+	q.Set("height", "49")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balances")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByAddress(gomock.Any(), testAddress).
+		Return(1, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{BalanceHistoryHeight: 50}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Balances(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *AddressTestSuite) TestBalanceHistory() {
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeWeek,
+		storage.TimeframeMonth,
+	} {
+		q := make(url.Values)
+		q.Set("from", "1692892095")
+
+		req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/address/:hash/balances/history/:timeframe")
+		c.SetParamNames("hash", "timeframe")
+		c.SetParamValues(testAddress, string(tf))
+
+		s.address.EXPECT().
+			IdByAddress(gomock.Any(), testAddress).
+			Return(1, nil).
+			Times(1)
+
+		s.address.EXPECT().
+			BalanceSeries(gomock.Any(), uint64(1), "utia", tf, gomock.Any()).
+			Return([]storage.BalanceSeriesItem{
+				{
+					Time:      testTime,
+					Spendable: types.NumericFromInt64(100),
+					Delegated: types.NumericFromInt64(1),
+					Unbonding: types.NumericFromInt64(2),
+				},
+			}, nil).
+			Times(1)
+
+		s.Require().NoError(s.handler.BalanceHistory(c))
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var items []responses.BalanceHistoryItem
+		err := json.NewDecoder(rec.Body).Decode(&items)
+		s.Require().NoError(err)
+		s.Require().Len(items, 1)
+		s.Require().Equal(testTime, items[0].Time)
+		s.Require().Equal("100", items[0].Spendable)
+		s.Require().Equal("1", items[0].Delegated)
+		s.Require().Equal("2", items[0].Unbonding)
+	}
+}
+
+func (s *AddressTestSuite) TestRewards() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("validator", "celestiavaloper1qycj0ymu9fqvwgyw4xz93p3n4a83jjk7sm2wzh")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/rewards")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByAddress(gomock.Any(), testAddress).
+		Return(1, nil).
+		Times(1)
+
+	s.validators.EXPECT().
+		ByAddress(gomock.Any(), "celestiavaloper1qycj0ymu9fqvwgyw4xz93p3n4a83jjk7sm2wzh").
+		Return(testValidator, nil).
+		Times(1)
+
+	s.stakingLogs.EXPECT().
+		RewardsLedger(gomock.Any(), storage.RewardsLedgerFilters{
+			AddressId:   testsuite.Ptr(uint64(1)),
+			ValidatorId: testsuite.Ptr(uint64(1)),
+			Limit:       10,
+			Sort:        sdk.SortOrderDesc,
+		}).
+		Return([]storage.RewardsLedgerItem{
+			{
+				Time:        testTime,
+				Height:      100,
+				AddressId:   1,
+				ValidatorId: 1,
+				Type:        types.StakingLogTypeRewards,
+				Change:      types.NumericFromInt64(-150),
+				Delegated:   types.NumericFromInt64(10000),
+				Withdrawn:   types.NumericFromInt64(250),
+				Address: &storage.Address{
+					Address: testAddress,
+				},
+				Validator: &testValidator,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Rewards(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []responses.RewardsLedgerItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().EqualValues(100, items[0].Height)
+	s.Require().Equal("rewards", items[0].Type)
+	s.Require().Equal("-150", items[0].Change)
+	s.Require().Equal("10000", items[0].Delegated)
+	s.Require().Equal("250", items[0].Withdrawn)
+	s.Require().NotNil(items[0].Delegator)
+	s.Require().Equal(testAddress, items[0].Delegator.Hash)
+	s.Require().NotNil(items[0].Validator)
+	s.Require().Equal("moniker", items[0].Validator.Moniker)
+}
+
+func (s *AddressTestSuite) TestRewardsSeries() {
+	q := make(url.Values)
+	q.Set("from", "1692892095")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/rewards/:timeframe")
+	c.SetParamNames("hash", "timeframe")
+	c.SetParamValues(testAddress, "day")
+
+	s.address.EXPECT().
+		IdByAddress(gomock.Any(), testAddress).
+		Return(1, nil).
+		Times(1)
+
+	s.stakingLogs.EXPECT().
+		RewardsSeries(gomock.Any(), storage.RewardsSeriesFilters{
+			AddressId:     testsuite.Ptr(uint64(1)),
+			Timeframe:     storage.TimeframeDay,
+			SeriesRequest: storage.NewSeriesRequest(1692892095, 0),
+		}).
+		Return([]storage.RewardsSeriesItem{
+			{
+				Bucket:               testTime,
+				Rewards:              types.NumericZero(),
+				Withdrawn:            types.NumericFromInt64(250),
+				Commissions:          types.NumericZero(),
+				CommissionsWithdrawn: types.NumericZero(),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.RewardsSeries(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []responses.RewardsSeriesItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Equal(testTime, items[0].Time)
+	s.Require().Equal("250", items[0].Withdrawn)
+	s.Require().Equal("0", items[0].Rewards)
+}
+
+func (s *AddressTestSuite) TestBalanceHistoryInvalidTimeframe() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balances/history/:timeframe")
+	c.SetParamNames("hash", "timeframe")
+	c.SetParamValues(testAddress, "year")
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
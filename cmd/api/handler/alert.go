@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+)
+
+type AlertHandler struct {
+	alerts storage.IAlert
+}
+
+func NewAlertHandler(alerts storage.IAlert) *AlertHandler {
+	return &AlertHandler{
+		alerts: alerts,
+	}
+}
+
+type alertsRequest struct {
+	Limit       int    `example:"10" query:"limit"        swaggertype:"integer" validate:"omitempty,min=1,max=100"`
+	Offset      int    `example:"0"  query:"offset"       swaggertype:"integer" validate:"omitempty,min=0"`
+	RollupId    uint64 `example:"1"  query:"rollup_id"    swaggertype:"integer" validate:"omitempty,min=1"`
+	NamespaceId uint64 `example:"2"  query:"namespace_id" swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// List godoc
+//
+//	@Summary		List active alerts
+//	@Description	Returns alerts which are currently triggered by rollup and namespace alert rules: blob silence, hourly fee, hourly blob size and blob size p99. Alert stays in the list until the rule metric returns below threshold.
+//	@Tags			alert
+//	@ID				list-alert
+//	@Param			rollup_id		query	integer	false	"Rollup internal id"			minimum(1)
+//	@Param			namespace_id	query	integer	false	"Namespace internal id"			minimum(1)
+//	@Param			limit			query	integer	false	"Count of requested entities"	minimum(1)	maximum(100)
+//	@Param			offset			query	integer	false	"Offset"						minimum(0)
+//	@Produce		json
+//	@Success		200	{array}		responses.Alert
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/alert [get]
+func (handler *AlertHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[alertsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	alerts, err := handler.alerts.ActiveList(c.Request().Context(), storage.AlertFilters{
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		RollupId:    req.RollupId,
+		NamespaceId: req.NamespaceId,
+	})
+	if err != nil {
+		return handleError(c, err, handler.alerts)
+	}
+
+	response := make([]responses.Alert, len(alerts))
+	for i := range alerts {
+		response[i] = responses.NewAlert(alerts[i])
+	}
+	return returnArray(c, response)
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testAlert = storage.Alert{
+	Id:        1,
+	RuleId:    2,
+	Kind:      storageTypes.AlertRuleKindHourlyFee,
+	RollupId:  3,
+	Value:     storageTypes.NumericFromInt64(2000),
+	Threshold: storageTypes.NumericFromInt64(1000),
+	StartedAt: time.Now().UTC(),
+	Rule: &storage.AlertRule{
+		Id:     2,
+		ApiKey: "secret",
+		Name:   "fee spike",
+	},
+}
+
+// AlertTestSuite -
+type AlertTestSuite struct {
+	suite.Suite
+	alerts  *mock.MockIAlert
+	echo    *echo.Echo
+	handler *AlertHandler
+	ctrl    *gomock.Controller
+}
+
+// SetupSuite -
+func (s *AlertTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.alerts = mock.NewMockIAlert(s.ctrl)
+	s.handler = NewAlertHandler(s.alerts)
+}
+
+// TearDownSuite -
+func (s *AlertTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(s.T().Context()))
+}
+
+func TestSuiteAlert_Run(t *testing.T) {
+	suite.Run(t, new(AlertTestSuite))
+}
+
+func (s *AlertTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("rollup_id", "3")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/alert")
+
+	s.alerts.EXPECT().
+		ActiveList(gomock.Any(), storage.AlertFilters{
+			Limit:    10,
+			RollupId: 3,
+		}).
+		Return([]storage.Alert{testAlert}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().NotContains(rec.Body.String(), "secret")
+
+	var alerts []responses.Alert
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&alerts))
+	s.Require().Len(alerts, 1)
+	s.Require().EqualValues(1, alerts[0].Id)
+	s.Require().EqualValues(2, alerts[0].RuleId)
+	s.Require().Equal("fee spike", alerts[0].RuleName)
+	s.Require().Equal("hourly_fee", alerts[0].Kind)
+	s.Require().Equal("2000", alerts[0].Value)
+	s.Require().True(alerts[0].Active)
+}
+
+func (s *AlertTestSuite) TestListInvalidRequest() {
+	q := make(url.Values)
+	q.Set("limit", "1000")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/alert")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
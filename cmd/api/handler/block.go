@@ -4,6 +4,7 @@
 package handler
 
 import (
+	"encoding/base64"
 	"net/http"
 	"time"
 
@@ -25,6 +26,7 @@ type BlockHandler struct {
 	events      storage.IEvent
 	namespace   storage.INamespace
 	blobLogs    storage.IBlobLog
+	shareRanges storage.IShareRange
 	message     storage.IMessage
 	state       storage.IState
 	node        node.Api
@@ -38,6 +40,7 @@ func NewBlockHandler(
 	namespace storage.INamespace,
 	message storage.IMessage,
 	blobLogs storage.IBlobLog,
+	shareRanges storage.IShareRange,
 	state storage.IState,
 	node node.Api,
 	indexerName string,
@@ -48,6 +51,7 @@ func NewBlockHandler(
 		events:      events,
 		namespace:   namespace,
 		blobLogs:    blobLogs,
+		shareRanges: shareRanges,
 		message:     message,
 		state:       state,
 		node:        node,
@@ -465,3 +469,77 @@ func (handler *BlockHandler) BlockODS(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, ods)
 }
+
+type getBlockSharesRequest struct {
+	Height    types.Level `param:"height"    validate:"min=0"`
+	Namespace string      `query:"namespace" validate:"omitempty,base64,namespace"`
+	Limit     int         `query:"limit"     validate:"omitempty,min=1,max=1000"`
+	Offset    int         `query:"offset"    validate:"omitempty,min=0"`
+}
+
+func (req *getBlockSharesRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+}
+
+// BlockShares godoc
+//
+//	@Summary		Layout of block's original data square
+//	@Description	Returns contiguous ranges of shares in the original data square of the block: compact shares of transactions and pay for blobs, blobs with their pay for blob transaction, namespace, reserved and tail padding.
+//	@Description	Share indexes are in row-major order, `end` is exclusive. `from` and `to` are row and column of the first and the last share of the range.
+//	@Tags			block
+//	@ID				block-shares
+//	@Param			height		path	integer	true	"Block height"					minimum(1)
+//	@Param			namespace	query	string	false	"Base64-encoded namespace (version and namespace id)"
+//	@Param			limit		query	integer	false	"Count of requested entities"	minimum(1)	maximum(1000)
+//	@Param			offset		query	integer	false	"Offset"						minimum(0)
+//	@Produce		json
+//	@Success		200	{array}		responses.ShareRange
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/block/{height}/shares [get]
+func (handler *BlockHandler) BlockShares(c echo.Context) error {
+	req, err := bindAndValidate[getBlockSharesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	ctx := c.Request().Context()
+	blockStats, err := handler.blockStats.ByHeight(ctx, req.Height)
+	if err != nil {
+		return handleError(c, err, handler.block)
+	}
+
+	if blockStats.TxCount == 0 {
+		if req.Namespace != "" || req.Offset > 0 {
+			return returnArray(c, []responses.ShareRange{})
+		}
+		return returnArray(c, []responses.ShareRange{
+			responses.EmptySquareShareRange(),
+		})
+	}
+
+	fltrs := storage.ShareRangeFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+	if req.Namespace != "" {
+		fltrs.Namespace, err = base64.StdEncoding.DecodeString(req.Namespace)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+	}
+
+	ranges, err := handler.shareRanges.ByHeight(ctx, req.Height, fltrs)
+	if err != nil {
+		return handleError(c, err, handler.shareRanges)
+	}
+
+	response := make([]responses.ShareRange, len(ranges))
+	for i := range ranges {
+		response[i] = responses.NewShareRange(ranges[i], blockStats.SquareSize)
+	}
+	return returnArray(c, response)
+}
@@ -69,17 +69,18 @@ var (
 // BlockTestSuite -
 type BlockTestSuite struct {
 	suite.Suite
-	blocks     *mock.MockIBlock
-	blockStats *mock.MockIBlockStats
-	events     *mock.MockIEvent
-	message    *mock.MockIMessage
-	namespace  *mock.MockINamespace
-	blobLogs   *mock.MockIBlobLog
-	state      *mock.MockIState
-	node       *nodeMock.MockApi
-	echo       *echo.Echo
-	handler    *BlockHandler
-	ctrl       *gomock.Controller
+	blocks      *mock.MockIBlock
+	blockStats  *mock.MockIBlockStats
+	events      *mock.MockIEvent
+	message     *mock.MockIMessage
+	namespace   *mock.MockINamespace
+	blobLogs    *mock.MockIBlobLog
+	shareRanges *mock.MockIShareRange
+	state       *mock.MockIState
+	node        *nodeMock.MockApi
+	echo        *echo.Echo
+	handler     *BlockHandler
+	ctrl        *gomock.Controller
 }
 
 // SetupSuite -
@@ -92,10 +93,11 @@ func (s *BlockTestSuite) SetupSuite() {
 	s.events = mock.NewMockIEvent(s.ctrl)
 	s.namespace = mock.NewMockINamespace(s.ctrl)
 	s.blobLogs = mock.NewMockIBlobLog(s.ctrl)
+	s.shareRanges = mock.NewMockIShareRange(s.ctrl)
 	s.message = mock.NewMockIMessage(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
 	s.node = nodeMock.NewMockApi(s.ctrl)
-	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.events, s.namespace, s.message, s.blobLogs, s.state, s.node, testIndexerName)
+	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.events, s.namespace, s.message, s.blobLogs, s.shareRanges, s.state, s.node, testIndexerName)
 }
 
 // TearDownSuite -
@@ -593,3 +595,106 @@ func (s *BlockTestSuite) TestEmptyBlockODS() {
 	s.Require().EqualValues(1, ods.Width)
 	s.Require().Len(ods.Items, 1)
 }
+
+func (s *BlockTestSuite) TestBlockShares() {
+	q := make(url.Values)
+	q.Set("namespace", "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height/shares")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	s.blockStats.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.BlockStats{
+			TxCount:    2,
+			Height:     100,
+			SquareSize: 4,
+		}, nil).
+		Times(1)
+
+	s.shareRanges.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), storage.ShareRangeFilters{
+			Limit:     100,
+			Namespace: make([]byte, 29),
+		}).
+		Return([]storage.ShareRange{
+			{
+				Height:    100,
+				Time:      testTime,
+				Kind:      types.ShareRangeKindBlob,
+				Namespace: make([]byte, 29),
+				Start:     2,
+				End:       7,
+				TxId:      1,
+				BlobIndex: 0,
+				Tx: &storage.Tx{
+					Hash: []byte{0x01, 0x02},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BlockShares(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var ranges []responses.ShareRange
+	err := json.NewDecoder(rec.Body).Decode(&ranges)
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 1)
+
+	item := ranges[0]
+	s.Require().Equal("blob", item.Kind)
+	s.Require().Equal("AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=", item.Namespace)
+	s.Require().EqualValues(2, item.Start)
+	s.Require().EqualValues(7, item.End)
+	s.Require().EqualValues(5, item.Size)
+	s.Require().Equal([]int64{0, 2}, item.From)
+	s.Require().Equal([]int64{1, 2}, item.To)
+	s.Require().Equal("0102", item.TxHash)
+	s.Require().NotNil(item.BlobIndex)
+	s.Require().EqualValues(0, *item.BlobIndex)
+}
+
+func (s *BlockTestSuite) TestEmptyBlockShares() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height/shares")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	s.blockStats.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.BlockStats{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BlockShares(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var ranges []responses.ShareRange
+	err := json.NewDecoder(rec.Body).Decode(&ranges)
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 1)
+	s.Require().Equal("tail_padding", ranges[0].Kind)
+	s.Require().EqualValues(1, ranges[0].Size)
+	s.Require().Nil(ranges[0].BlobIndex)
+}
+
+func (s *BlockTestSuite) TestBlockSharesInvalidNamespace() {
+	q := make(url.Values)
+	q.Set("namespace", "invalid")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height/shares")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	s.Require().NoError(s.handler.BlockShares(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
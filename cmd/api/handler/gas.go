@@ -6,6 +6,7 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/gas"
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
@@ -115,22 +116,59 @@ func (handler GasHandler) EstimateForPfb(c echo.Context) error {
 	}, gasPerBlobByte, txSizeCost))
 }
 
+type estimatePriceRequest struct {
+	WithinBlocks  int     `query:"within_blocks"  validate:"omitempty,min=1,max=100"`
+	WithinSeconds int64   `query:"within_seconds" validate:"omitempty,min=1,max=3600"`
+	Probability   float64 `query:"probability"    validate:"omitempty,gt=0,lt=1"`
+}
+
 // EstimatePrice godoc
 //
 //	@Summary		Get estimated gas price
-//	@Description	Returns estimated gas prices (slow, median, fast) derived from recent transaction history. Useful for setting the gas price when submitting new transactions.
+//	@Description	Returns estimated gas prices (slow, median, fast) derived from recent transaction history. Useful for setting the gas price when submitting new transactions. If mempool observation is enabled in the indexer, average time to inclusion in milliseconds of recent transactions offered each tier's gas price is returned as well. If `within_blocks` or `within_seconds` is set, the lowest gas price which is expected to be included within the target with requested probability is returned in `estimation` field. Probability of inclusion is modeled from recent blocks: not full blocks accept any price, full blocks accept prices not lower than their cheapest transaction. Recent blocks weigh more, so square fullness trends are taken into account. Pending mempool transactions with the same or higher gas price are included first, so blocks required to clear them are excluded from the target.
 //	@Tags			gas
 //	@ID				gas-price
+//	@Param			within_blocks	query	integer	false	"Target count of blocks for inclusion"						minimum(1)	maximum(100)
+//	@Param			within_seconds	query	integer	false	"Target time for inclusion in seconds. Ignored if within_blocks is set"	minimum(1)	maximum(3600)
+//	@Param			probability		query	number	false	"Requested probability of inclusion. Default: 0.9"			minimum(0)	maximum(1)
 //	@Produce		json
 //	@Success		200	{object}	responses.GasPrice
+//	@Failure		400	{object}	Error
 //	@Router			/gas/price [get]
 func (handler GasHandler) EstimatePrice(c echo.Context) error {
+	req, err := bindAndValidate[estimatePriceRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
 	data := handler.tracker.State()
-	return c.JSON(http.StatusOK, responses.GasPrice{
-		Slow:   data.Slow,
-		Median: data.Median,
-		Fast:   data.Fast,
-	})
+	response := responses.GasPrice{
+		Slow:                data.Slow,
+		Median:              data.Median,
+		Fast:                data.Fast,
+		SlowInclusionTime:   data.SlowInclusionTime,
+		MedianInclusionTime: data.MedianInclusionTime,
+		FastInclusionTime:   data.FastInclusionTime,
+	}
+
+	if req.WithinBlocks > 0 || req.WithinSeconds > 0 {
+		estimation := handler.tracker.Estimate(gas.EstimationTarget{
+			Blocks:      req.WithinBlocks,
+			Time:        time.Duration(req.WithinSeconds) * time.Second,
+			Probability: req.Probability,
+		})
+		response.Estimation = &responses.GasPriceEstimation{
+			WithinBlocks:      estimation.WithinBlocks,
+			TargetProbability: estimation.TargetProbability,
+			GasPrice:          estimation.GasPrice,
+			Probability:       estimation.Probability,
+			ExpectedTime:      estimation.ExpectedTime,
+			SquareFullness:    estimation.SquareFullness,
+			PendingAhead:      estimation.PendingAhead,
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
 }
 
 type estimatePricePriorityRequest struct {
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/gas"
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
@@ -21,9 +22,12 @@ import (
 )
 
 var testGasState = gas.GasPrice{
-	Slow:   "0.02",
-	Median: "0.03",
-	Fast:   "0.04",
+	Slow:                "0.02",
+	Median:              "0.03",
+	Fast:                "0.04",
+	SlowInclusionTime:   30000,
+	MedianInclusionTime: 12000,
+	FastInclusionTime:   6000,
 }
 
 // GasTestSuite -
@@ -178,6 +182,103 @@ func (s *GasTestSuite) TestEstimatePrice() {
 	s.Require().Equal(testGasState.Slow, response.Slow)
 	s.Require().Equal(testGasState.Median, response.Median)
 	s.Require().Equal(testGasState.Fast, response.Fast)
+	s.Require().Equal(testGasState.SlowInclusionTime, response.SlowInclusionTime)
+	s.Require().Equal(testGasState.MedianInclusionTime, response.MedianInclusionTime)
+	s.Require().Equal(testGasState.FastInclusionTime, response.FastInclusionTime)
+}
+
+func (s *GasTestSuite) TestEstimatePriceWithinBlocks() {
+	q := make(url.Values)
+	q.Set("within_blocks", "3")
+	q.Set("probability", "0.95")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/gas/price")
+
+	s.tracker.EXPECT().
+		State().
+		Return(testGasState).
+		Times(1)
+
+	s.tracker.EXPECT().
+		Estimate(gas.EstimationTarget{
+			Blocks:      3,
+			Probability: 0.95,
+		}).
+		Return(gas.Estimation{
+			WithinBlocks:      3,
+			TargetProbability: 0.95,
+			GasPrice:          "0.004200",
+			Probability:       0.97,
+			ExpectedTime:      18000,
+			SquareFullness:    0.5,
+			PendingAhead:      1024,
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.EstimatePrice(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.GasPrice
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal(testGasState.Slow, response.Slow)
+	s.Require().NotNil(response.Estimation)
+	s.Require().Equal(3, response.Estimation.WithinBlocks)
+	s.Require().Equal("0.004200", response.Estimation.GasPrice)
+	s.Require().InDelta(0.97, response.Estimation.Probability, 1e-9)
+	s.Require().EqualValues(18000, response.Estimation.ExpectedTime)
+	s.Require().EqualValues(1024, response.Estimation.PendingAhead)
+}
+
+func (s *GasTestSuite) TestEstimatePriceWithinSeconds() {
+	q := make(url.Values)
+	q.Set("within_seconds", "30")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/gas/price")
+
+	s.tracker.EXPECT().
+		State().
+		Return(testGasState).
+		Times(1)
+
+	s.tracker.EXPECT().
+		Estimate(gas.EstimationTarget{
+			Time: 30 * time.Second,
+		}).
+		Return(gas.Estimation{
+			WithinBlocks:      5,
+			TargetProbability: gas.DefaultInclusionProbability,
+			GasPrice:          "0.004000",
+			Probability:       0.99,
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.EstimatePrice(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.GasPrice
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().NotNil(response.Estimation)
+	s.Require().Equal(5, response.Estimation.WithinBlocks)
+}
+
+func (s *GasTestSuite) TestEstimatePriceInvalidTarget() {
+	for _, query := range []string{"within_blocks=101", "probability=1.5", "within_seconds=-1"} {
+		req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+query, nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/gas/price")
+
+		s.Require().NoError(s.handler.EstimatePrice(c), query)
+		s.Require().Equal(http.StatusBadRequest, rec.Code, query)
+	}
 }
 
 func (s *GasTestSuite) TestEstimatePriceWithPriority() {
@@ -258,16 +258,17 @@ func (handler *HyperlaneHandler) ListTokens(c echo.Context) error {
 }
 
 type listHyperlaneTransferRequest struct {
-	Limit   int         `query:"limit"   validate:"omitempty,min=1,max=100"`
-	Offset  int         `query:"offset"  validate:"omitempty,min=0"`
-	Sort    string      `query:"sort"    validate:"omitempty,oneof=asc desc"`
-	Address string      `query:"address" validate:"omitempty,address"`
-	Relayer string      `query:"relayer" validate:"omitempty,address"`
-	Mailbox string      `query:"mailbox" validate:"omitempty,hexadecimal"`
-	Token   string      `query:"token"   validate:"omitempty,hexadecimal"`
-	Type    StringArray `query:"type"    validate:"omitempty,dive,hl_transfer_type"`
-	Domain  uint64      `query:"domain"  validate:"omitempty,min=1"`
-	Hash    string      `query:"hash"    validate:"omitempty,hexadecimal,len=64"`
+	Limit          int         `query:"limit"           validate:"omitempty,min=1,max=100"`
+	Offset         int         `query:"offset"          validate:"omitempty,min=0"`
+	Sort           string      `query:"sort"            validate:"omitempty,oneof=asc desc"`
+	Address        string      `query:"address"         validate:"omitempty,address"`
+	Relayer        string      `query:"relayer"         validate:"omitempty,address"`
+	Mailbox        string      `query:"mailbox"         validate:"omitempty,hexadecimal"`
+	Token          string      `query:"token"           validate:"omitempty,hexadecimal"`
+	Type           StringArray `query:"type"            validate:"omitempty,dive,hl_transfer_type"`
+	Domain         uint64      `query:"domain"          validate:"omitempty,min=1"`
+	Hash           string      `query:"hash"            validate:"omitempty,hexadecimal,len=64"`
+	DeliveryStatus string      `query:"delivery_status" validate:"omitempty,hl_delivery_status"`
 }
 
 func (req *listHyperlaneTransferRequest) ToFilters(ctx context.Context, address storage.IAddress, mailbox storage.IHLMailbox, tokens storage.IHLToken, tx storage.ITx) (storage.ListHyperlaneTransferFilters, error) {
@@ -282,6 +283,8 @@ func (req *listHyperlaneTransferRequest) ToFilters(ctx context.Context, address
 		Offset: req.Offset,
 		Sort:   pgSort(req.Sort),
 		Domain: req.Domain,
+
+		DeliveryStatus: storageTypes.HLDeliveryStatus(req.DeliveryStatus),
 	}
 
 	if req.Mailbox != "" {
@@ -352,7 +355,7 @@ func (req *listHyperlaneTransferRequest) ToFilters(ctx context.Context, address
 // ListTransfers godoc
 //
 //	@Summary		List hyperlane transfers info
-//	@Description	Returns a paginated list of Hyperlane cross-chain token transfers. Supports filtering by sender/receiver address, relayer, mailbox, token, transfer type (send/receive), domain, and transaction hash.
+//	@Description	Returns a paginated list of Hyperlane cross-chain token transfers. Supports filtering by sender/receiver address, relayer, mailbox, token, transfer type (send/receive), domain, transaction hash and delivery status.
 //	@Tags			hyperlane
 //	@ID				list-hyperlane-transfers
 //	@Param			limit	query	integer	false	"Count of requested entities"				minimum(1)	maximum(100)
@@ -365,6 +368,7 @@ func (req *listHyperlaneTransferRequest) ToFilters(ctx context.Context, address
 //	@Param			type    query	string	false	"Comma-separated string of transfer type"	Enums(send, receive)
 //	@Param			domain	query	integer	false	"Domain of counterparty chain"				minimum(1)
 //	@Param			hash	query	string	false	"Transaction hash in hexadecimal"	minlength(64)	maxlength(64)
+//	@Param			delivery_status	query	string	false	"Message delivery status"	Enums(pending, delivered)
 //	@Produce		json
 //	@Success		200	{array}	responses.HyperlaneTransfer
 //	@Success		204
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/cmd/api/hyperlane"
@@ -21,6 +22,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
 	hl "github.com/celenium-io/celestia-indexer/pkg/node/hyperlane"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -650,6 +652,69 @@ func (s *HyperlaneTestSuite) TestListTransferWithoutChainStore() {
 	s.Require().Nil(response.Counterparty.ChainMetadata)
 }
 
+func (s *HyperlaneTestSuite) TestListTransferByDeliveryStatus() {
+	q := make(url.Values)
+	q.Add("delivery_status", "delivered")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/hyperlane/transfer")
+
+	dispatchedAt := testTime.Add(-time.Minute)
+	transfer := testTransfer
+	transfer.MessageId = testsuite.RandomBytes(32)
+	transfer.DeliveryStatus = types.HLDeliveryStatusDelivered
+	transfer.DispatchedAt = &dispatchedAt
+	transfer.DeliveredAt = &testTime
+	transfer.CounterpartyTxHash = "5e8a7c2b9f3d6a1e4c7b0d9f2a5e8c1b4d7a0e3f6c9b2d5a8e1f4c7b0a3d6e9f"
+
+	s.chainStore.EXPECT().
+		Get(uint64(1)).
+		Return(testChainMetadata, true)
+
+	s.transfer.EXPECT().
+		List(gomock.Any(), storage.ListHyperlaneTransferFilters{
+			Limit:          10,
+			Sort:           sdk.SortOrderDesc,
+			DeliveryStatus: types.HLDeliveryStatusDelivered,
+		}).
+		Return([]storage.HLTransfer{
+			transfer,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.ListTransfers(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.HyperlaneTransfer
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+
+	response := items[0]
+	s.Require().EqualValues(transfer.Id, response.Id)
+	s.Require().EqualValues(hex.EncodeToString(transfer.MessageId), response.MessageId)
+	s.Require().EqualValues("delivered", response.DeliveryStatus)
+	s.Require().NotNil(response.DispatchedAt)
+	s.Require().NotNil(response.DeliveredAt)
+	s.Require().EqualValues(60, response.Latency)
+	s.Require().EqualValues(transfer.CounterpartyTxHash, response.Counterparty.TxHash)
+}
+
+func (s *HyperlaneTestSuite) TestListTransferInvalidDeliveryStatus() {
+	q := make(url.Values)
+	q.Add("delivery_status", "unknown")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/hyperlane/transfer")
+
+	s.Require().NoError(s.handler.ListTransfers(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *HyperlaneTestSuite) TestListDomains() {
 	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -329,15 +329,16 @@ func (handler *IbcHandler) ListChannels(c echo.Context) error {
 }
 
 type getIbcTransfersRequest struct {
-	Limit     int    `query:"limit"      validate:"omitempty,min=1,max=100"`
-	Offset    int    `query:"offset"     validate:"omitempty,min=0"`
-	Sort      string `query:"sort"       validate:"omitempty,oneof=asc desc"`
-	ChannelId string `query:"channel_id" validate:"omitempty"`
-	ChainId   string `query:"chain_id"   validate:"omitempty"`
-	Receiver  string `query:"receiver"   validate:"omitempty,address"`
-	Sender    string `query:"sender"     validate:"omitempty,address"`
-	Address   string `query:"address"    validate:"omitempty,address"`
-	Hash      string `query:"hash"       validate:"omitempty,hexadecimal,len=64"`
+	Limit     int                     `query:"limit"      validate:"omitempty,min=1,max=100"`
+	Offset    int                     `query:"offset"     validate:"omitempty,min=0"`
+	Sort      string                  `query:"sort"       validate:"omitempty,oneof=asc desc"`
+	ChannelId string                  `query:"channel_id" validate:"omitempty"`
+	ChainId   string                  `query:"chain_id"   validate:"omitempty"`
+	Receiver  string                  `query:"receiver"   validate:"omitempty,address"`
+	Sender    string                  `query:"sender"     validate:"omitempty,address"`
+	Address   string                  `query:"address"    validate:"omitempty,address"`
+	Hash      string                  `query:"hash"       validate:"omitempty,hexadecimal,len=64"`
+	Status    types.IbcTransferStatus `query:"status"     validate:"omitempty,ibc_transfer_status"`
 }
 
 func (req *getIbcTransfersRequest) SetDefault() {
@@ -352,7 +353,7 @@ func (req *getIbcTransfersRequest) SetDefault() {
 // ListTransfers godoc
 //
 //	@Summary		Get ibc transfers info
-//	@Description	Returns a paginated list of IBC token transfers. Supports filtering by channel, chain ID, sender, receiver, either-party address, and transaction hash.
+//	@Description	Returns a paginated list of IBC token transfers. Supports filtering by channel, chain ID, sender, receiver, either-party address, transaction hash and packet status.
 //	@Tags			ibc
 //	@ID				get-ibc-transfers
 //	@Param			limit	    	query	integer	false	"Count of requested entities"					minimum(1)	maximum(100)
@@ -364,6 +365,7 @@ func (req *getIbcTransfersRequest) SetDefault() {
 //	@Param			sender			query	string	false	"Sender address"								minlength(47)	maxlength(47)
 //	@Param			address			query	string	false	"Address: receiver or sender"					minlength(47)	maxlength(47)
 //	@Param			hash	        query	string	false	"Transaction hash in hexadecimal"	            minlength(64)	maxlength(64)
+//	@Param			status	        query	string	false	"Transfer status"	                            Enums(pending, received, failed, acknowledged, refunded, timed_out)
 //	@Produce		json
 //	@Success		200	{array}	responses.IbcTransfer
 //	@Success		204
@@ -382,6 +384,7 @@ func (handler *IbcHandler) ListTransfers(c echo.Context) error {
 		Offset:    req.Offset,
 		Sort:      pgSort(req.Sort),
 		ChannelId: req.ChannelId,
+		Status:    req.Status,
 	}
 	if req.Address != "" {
 		id, err := handler.address.IdByAddress(c.Request().Context(), req.Address)
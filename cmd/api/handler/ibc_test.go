@@ -456,6 +456,81 @@ func (s *IbcTestSuite) TestListTransfers() {
 	s.Require().EqualValues("celestia1xyz1488", transfer.Relayer.Addresses[0])
 }
 
+func (s *IbcTestSuite) TestListTransfersByStatus() {
+	q := make(url.Values)
+	q.Set("status", "acknowledged")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/transfer")
+
+	s.relayers.EXPECT().
+		List().
+		Return(relayersMap).
+		Times(1)
+
+	s.transfers.EXPECT().
+		List(gomock.Any(), storage.ListIbcTransferFilters{
+			Limit:  10,
+			Sort:   sdk.SortOrderDesc,
+			Status: types.IbcTransferStatusAcknowledged,
+		}).
+		Return([]storage.IbcTransferWithSigner{
+			{
+				IbcTransfer: storage.IbcTransfer{
+					Id:              1,
+					Time:            testTime,
+					Height:          1000,
+					ChannelId:       "channel-1",
+					ConnectionId:    "connection-1",
+					Amount:          types.NumericFromInt64(101),
+					Denom:           currency.Utia,
+					ReceiverAddress: testsuite.Ptr("osmo1mj37s3mmv78tj0ke3yely7zwmzl5rkh9gx9ma2"),
+					Sender: &storage.Address{
+						Hash:    testHashAddress,
+						Address: testAddress,
+					},
+					Sequence:      123456,
+					Status:        types.IbcTransferStatusAcknowledged,
+					Tx:            &testTx,
+					ResolveTxId:   testsuite.Ptr(uint64(2)),
+					ResolveHeight: 1010,
+					ResolveTx:     &testTx,
+				},
+				SignerId: testsuite.Ptr(uint64(1)),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.ListTransfers(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var transfers []responses.IbcTransfer
+	err := json.NewDecoder(rec.Body).Decode(&transfers)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+
+	transfer := transfers[0]
+	s.Require().EqualValues(1, transfer.Id)
+	s.Require().Equal("acknowledged", transfer.Status)
+	s.Require().EqualValues(1010, transfer.ResolveHeight)
+	s.Require().EqualValues(strings.ToLower(testTxHash), transfer.ResolveTxHash)
+}
+
+func (s *IbcTestSuite) TestListTransfersInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "unknown")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/transfer")
+
+	s.Require().NoError(s.handler.ListTransfers(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *IbcTestSuite) TestListTransfersByChainId() {
 	q := make(url.Values)
 	q.Set("chain_id", "test")
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+)
+
+type MempoolHandler struct {
+	mempool storage.IMempoolTx
+}
+
+func NewMempoolHandler(mempool storage.IMempoolTx) *MempoolHandler {
+	return &MempoolHandler{
+		mempool: mempool,
+	}
+}
+
+type mempoolListRequest struct {
+	Limit   int         `query:"limit"   validate:"omitempty,min=1,max=100"`
+	Offset  int         `query:"offset"  validate:"omitempty,min=0"`
+	Sort    string      `query:"sort"    validate:"omitempty,oneof=asc desc"`
+	Status  StringArray `query:"status"  validate:"omitempty,dive,oneof=pending included dropped"`
+	Address string      `query:"address" validate:"omitempty,address"`
+}
+
+func (p *mempoolListRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// List godoc
+//
+//	@Summary		List mempool transactions
+//	@Description	Returns transactions observed in the node's mempool. Pending transactions are tracked until they are included in a block or dropped. For included transactions time from the first observation to inclusion is returned in milliseconds and in blocks. Finished transactions are kept for a week. Returns an empty list if mempool observation is disabled in the indexer.
+//	@Tags			mempool
+//	@ID				list-mempool
+//	@Param			status	query	string	false	"Comma-separated list of statuses"	Enums(pending, included, dropped)
+//	@Param			address	query	string	false	"Signer address (bech32)"			minlength(47)	maxlength(47)
+//	@Param			limit	query	integer	false	"Count of requested entities"		minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"							minimum(0)
+//	@Param			sort	query	string	false	"Sort order. Default: desc"			Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.MempoolTx
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/mempool [get]
+func (handler *MempoolHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[mempoolListRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	txs, err := handler.mempool.List(c.Request().Context(), storage.MempoolFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+		Status: req.Status,
+		Signer: req.Address,
+	})
+	if err != nil {
+		return handleError(c, err, handler.mempool)
+	}
+
+	response := make([]responses.MempoolTx, len(txs))
+	for i := range txs {
+		response[i] = responses.NewMempoolTx(txs[i])
+	}
+	return returnArray(c, response)
+}
+
+// Get godoc
+//
+//	@Summary		Get mempool transaction by hash
+//	@Description	Returns the transaction observed in the node's mempool: offered gas price, blobs, signers and status. If the transaction is included, height of the block and time to inclusion are returned. Returns 204 if the transaction was not observed.
+//	@Tags			mempool
+//	@ID				get-mempool
+//	@Param			hash	path	string	true	"Transaction hash in hexadecimal"	minlength(64)	maxlength(64)
+//	@Produce		json
+//	@Success		200	{object}	responses.MempoolTx
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/mempool/{hash} [get]
+func (handler *MempoolHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[getTxRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	tx, err := handler.mempool.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.mempool)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewMempoolTx(tx))
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testMempoolTxHash = "652452a670018d629cc116e510ba88c1cabe061336661b1f3d206d248bd558af"
+	testFirstSeen     = time.Date(2023, 7, 4, 3, 10, 50, 0, time.UTC)
+	testFinishedAt    = testFirstSeen.Add(7 * time.Second)
+)
+
+var testMempoolTx = storage.MempoolTx{
+	Id:            1,
+	Hash:          []byte{0x65, 0x24, 0x52, 0xa6, 0x70, 0x01, 0x8d, 0x62, 0x9c, 0xc1, 0x16, 0xe5, 0x10, 0xba, 0x88, 0xc1, 0xca, 0xbe, 0x06, 0x13, 0x36, 0x66, 0x1b, 0x1f, 0x3d, 0x20, 0x6d, 0x24, 0x8b, 0xd5, 0x58, 0xaf},
+	Status:        storageTypes.MempoolTxStatusIncluded,
+	FirstSeen:     testFirstSeen,
+	SeenHeight:    99,
+	GasWanted:     80410,
+	Fee:           storageTypes.NumericFromInt64(80410),
+	GasPrice:      storageTypes.NumericFromInt64(1),
+	Size:          250,
+	BlobsCount:    1,
+	BlobsSize:     1024,
+	Signers:       []string{testAddress},
+	MessageTypes:  []string{"MsgPayForBlobs"},
+	Height:        100,
+	FinishedAt:    &testFinishedAt,
+	InclusionTime: 7000,
+}
+
+// MempoolTestSuite -
+type MempoolTestSuite struct {
+	suite.Suite
+	mempool *mock.MockIMempoolTx
+	echo    *echo.Echo
+	handler *MempoolHandler
+	ctrl    *gomock.Controller
+}
+
+// SetupSuite -
+func (s *MempoolTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.mempool = mock.NewMockIMempoolTx(s.ctrl)
+	s.handler = NewMempoolHandler(s.mempool)
+}
+
+// TearDownSuite -
+func (s *MempoolTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(s.T().Context()))
+}
+
+func TestSuiteMempool_Run(t *testing.T) {
+	suite.Run(t, new(MempoolTestSuite))
+}
+
+func (s *MempoolTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("status", "pending,included")
+	q.Set("address", testAddress)
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/mempool")
+
+	s.mempool.EXPECT().
+		List(gomock.Any(), storage.MempoolFilters{
+			Limit:  10,
+			Sort:   sdk.SortOrderDesc,
+			Status: []string{"pending", "included"},
+			Signer: testAddress,
+		}).
+		Return([]storage.MempoolTx{testMempoolTx}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var txs []responses.MempoolTx
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&txs))
+	s.Require().Len(txs, 1)
+
+	tx := txs[0]
+	s.Require().Equal(testMempoolTxHash, tx.Hash)
+	s.Require().Equal("included", tx.Status)
+	s.Require().Equal("80410", tx.Fee)
+	s.Require().Equal("1", tx.GasPrice)
+	s.Require().EqualValues(100, tx.Height)
+	s.Require().EqualValues(7000, tx.InclusionTime)
+	s.Require().EqualValues(1, tx.InclusionBlocks)
+	s.Require().Equal([]string{testAddress}, tx.Signers)
+	s.Require().Equal([]string{"MsgPayForBlobs"}, tx.MessageTypes)
+}
+
+func (s *MempoolTestSuite) TestListInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "unknown")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/mempool")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MempoolTestSuite) TestGet() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/mempool/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testMempoolTxHash)
+
+	hash, err := hex.DecodeString(testMempoolTxHash)
+	s.Require().NoError(err)
+
+	s.mempool.EXPECT().
+		ByHash(gomock.Any(), hash).
+		Return(testMempoolTx, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tx responses.MempoolTx
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&tx))
+	s.Require().Equal(testMempoolTxHash, tx.Hash)
+	s.Require().NotNil(tx.FinishedAt)
+	s.Require().Equal(testFinishedAt, tx.FinishedAt.UTC())
+}
+
+func (s *MempoolTestSuite) TestGetNotFound() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/mempool/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testMempoolTxHash)
+
+	s.mempool.EXPECT().
+		ByHash(gomock.Any(), gomock.Any()).
+		Return(storage.MempoolTx{}, sql.ErrNoRows).
+		Times(1)
+	s.mempool.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *MempoolTestSuite) TestGetInvalidHash() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/mempool/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues("invalid")
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
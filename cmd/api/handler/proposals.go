@@ -4,6 +4,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/hex"
 	"net/http"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 // ProposalsHandler -
@@ -19,6 +21,7 @@ type ProposalsHandler struct {
 	votes      storage.IVote
 	address    storage.IAddress
 	validators storage.IValidator
+	constants  storage.IConstant
 }
 
 func NewProposalsHandler(
@@ -26,12 +29,14 @@ func NewProposalsHandler(
 	votes storage.IVote,
 	address storage.IAddress,
 	validators storage.IValidator,
+	constants storage.IConstant,
 ) ProposalsHandler {
 	return ProposalsHandler{
 		proposals:  proposals,
 		votes:      votes,
 		address:    address,
 		validators: validators,
+		constants:  constants,
 	}
 }
 
@@ -152,6 +157,63 @@ func (handler *ProposalsHandler) Get(c echo.Context) error {
 	return c.JSON(http.StatusOK, responses.NewProposal(proposal))
 }
 
+// Timeline godoc
+//
+//	@Summary		Get proposal tally timeline
+//	@Description	Returns snapshots of the proposal tally ordered by time: votes count and cumulative yes, no, no with veto and abstain voting power. Snapshots are recorded in blocks with votes for the proposal, once an hour during voting period and when the proposal is finished. Each snapshot contains quorum and threshold lines computed from the gov parameters, so it's possible to chart when the proposal crossed them.
+//	@Tags			proposal
+//	@ID				get-proposal-timeline
+//	@Param			id	path	integer	true	"Internal identity"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.ProposalTimeline
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/proposal/{id}/timeline [get]
+func (handler *ProposalsHandler) Timeline(c echo.Context) error {
+	req, err := bindAndValidate[getById](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	proposal, err := handler.proposals.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	quorum, err := handler.govParameter(c.Request().Context(), proposal.Quorum, "quorum")
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+	threshold, err := handler.govParameter(c.Request().Context(), proposal.Threshold, "threshold")
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+	veto, err := handler.govParameter(c.Request().Context(), proposal.VetoQuorum, "veto_threshold")
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	tallies, err := handler.proposals.Timeline(c.Request().Context(), proposal.Id)
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewProposalTimeline(tallies, quorum, threshold, veto))
+}
+
+// govParameter - returns parameter value fixed in the finished proposal or current value of the gov constant
+func (handler *ProposalsHandler) govParameter(ctx context.Context, value, name string) (types.Numeric, error) {
+	if value == "" {
+		constant, err := handler.constants.Get(ctx, types.ModuleNameGov, name)
+		if err != nil {
+			return types.Numeric{}, errors.Wrapf(err, "receiving %s constant", name)
+		}
+		value = constant.Value
+	}
+	return types.NumericFromString(value)
+}
+
 type listVotesRequest struct {
 	Id        uint64      `param:"id"        validate:"required,min=1"`
 	Limit     int         `query:"limit"     validate:"omitempty,min=1,max=100"`
@@ -237,3 +299,98 @@ func (handler *ProposalsHandler) Votes(c echo.Context) error {
 	}
 	return returnArray(c, response)
 }
+
+type listEffectiveVotesRequest struct {
+	Id        uint64      `param:"id"        validate:"required,min=1"`
+	Limit     int         `query:"limit"     validate:"omitempty,min=1,max=100"`
+	Offset    int         `query:"offset"    validate:"omitempty,min=0"`
+	Option    StringArray `query:"option"    validate:"omitempty,dive,vote_option"`
+	Inherited *bool       `query:"inherited" validate:"omitempty"`
+	Address   string      `query:"address"   validate:"omitempty,address"`
+	Validator string      `query:"validator" validate:"omitempty,address"`
+}
+
+func (p *listEffectiveVotesRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+// EffectiveVotes godoc
+//
+//	@Summary		Get effective votes of the proposal
+//	@Description	Returns a paginated list of effective votes of delegations for the given proposal: the delegator's own vote or the vote inherited from the validator if the delegator didn't vote. Weighted votes are split into a row per option. Effective votes of finished proposals are recorded at the end of voting, for active proposals they are computed from the current delegations. Sorted by voting power.
+//	@Tags			proposal
+//	@ID				proposal-effective-votes
+//	@Param			id	path	integer	true	"Internal identity"	minimum(1)
+//	@Param			limit	    query	integer	false	"Count of requested entities"		minimum(1)	maximum(100)
+//	@Param			offset	    query	integer	false	"Offset"							minimum(1)
+//
+// @Param			option	    query	string	false	"Option"		Enums(yes, no, no_with_veto, abstain)
+// @Param			inherited	query	boolean	false	"Filter by inherited votes"
+// @Param			address		query	string	false	"Delegator address"	minlength(47)	maxlength(47)
+// @Param			validator	query	string	false	"Validator address"	minlength(54)	maxlength(54)
+//
+//	@Produce		json
+//	@Success		200	{array}		responses.EffectiveVote
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//
+// @Router /proposal/{id}/effective_votes [get]
+func (handler *ProposalsHandler) EffectiveVotes(c echo.Context) error {
+	req, err := bindAndValidate[listEffectiveVotesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	ctx := c.Request().Context()
+	proposal, err := handler.proposals.ById(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	options := make([]types.VoteOption, len(req.Option))
+	for i := range req.Option {
+		options[i] = types.VoteOption(req.Option[i])
+	}
+
+	filter := storage.EffectiveVoteFilters{
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		Option:    options,
+		Inherited: req.Inherited,
+	}
+
+	if req.Address != "" {
+		addressId, err := handler.address.IdByAddress(ctx, req.Address)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		filter.AddressId = &addressId
+	}
+	if req.Validator != "" {
+		validator, err := handler.validators.ByAddress(ctx, req.Validator)
+		if err != nil {
+			return handleError(c, err, handler.validators)
+		}
+		filter.ValidatorId = &validator.Id
+	}
+
+	var votes []storage.EffectiveVote
+	if proposal.Finished() {
+		votes, err = handler.votes.EffectiveVotes(ctx, proposal.Id, filter)
+	} else {
+		votes, err = handler.votes.ComputeEffectiveVotes(ctx, proposal.Id, filter)
+	}
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	response := make([]responses.EffectiveVote, len(votes))
+	for i := range votes {
+		response[i] = responses.NewEffectiveVote(votes[i])
+	}
+	return returnArray(c, response)
+}
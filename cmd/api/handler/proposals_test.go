@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
@@ -43,6 +44,7 @@ type ProposalTestSuite struct {
 	votes      *mock.MockIVote
 	address    *mock.MockIAddress
 	validators *mock.MockIValidator
+	constants  *mock.MockIConstant
 	echo       *echo.Echo
 	handler    ProposalsHandler
 	ctrl       *gomock.Controller
@@ -57,11 +59,13 @@ func (s *ProposalTestSuite) SetupSuite() {
 	s.votes = mock.NewMockIVote(s.ctrl)
 	s.address = mock.NewMockIAddress(s.ctrl)
 	s.validators = mock.NewMockIValidator(s.ctrl)
+	s.constants = mock.NewMockIConstant(s.ctrl)
 	s.handler = NewProposalsHandler(
 		s.proposal,
 		s.votes,
 		s.address,
 		s.validators,
+		s.constants,
 	)
 }
 
@@ -133,6 +137,129 @@ func (s *ProposalTestSuite) TestGet() {
 	s.Require().EqualValues(0, proposal.NoWithVeto)
 }
 
+func (s *ProposalTestSuite) TestTimeline() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id/timeline")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.proposal.EXPECT().
+		ById(gomock.Any(), uint64(1)).
+		Return(testProposal, nil).
+		Times(1)
+
+	for name, value := range map[string]string{
+		"quorum":         "0.334",
+		"threshold":      "0.5",
+		"veto_threshold": "0.334",
+	} {
+		s.constants.EXPECT().
+			Get(gomock.Any(), types.ModuleNameGov, name).
+			Return(storage.Constant{
+				Module: types.ModuleNameGov,
+				Name:   name,
+				Value:  value,
+			}, nil).
+			Times(1)
+	}
+
+	s.proposal.EXPECT().
+		Timeline(gomock.Any(), uint64(1)).
+		Return([]storage.ProposalTally{
+			{
+				Height:             100,
+				Time:               testTime,
+				ProposalId:         1,
+				VotesCount:         1,
+				Yes:                1,
+				VotingPower:        types.NumericFromInt64(100),
+				YesVotingPower:     types.NumericFromInt64(100),
+				AbstainVotingPower: types.NumericZero(),
+				TotalVotingPower:   types.NumericFromInt64(1000),
+			}, {
+				Height:             200,
+				Time:               testTime.Add(time.Hour),
+				ProposalId:         1,
+				VotesCount:         3,
+				Yes:                2,
+				Abstain:            1,
+				VotingPower:        types.NumericFromInt64(500),
+				YesVotingPower:     types.NumericFromInt64(300),
+				AbstainVotingPower: types.NumericFromInt64(200),
+				TotalVotingPower:   types.NumericFromInt64(1000),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Timeline(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var timeline responses.ProposalTimeline
+	err := json.NewDecoder(rec.Body).Decode(&timeline)
+	s.Require().NoError(err)
+	s.Require().Equal("0.334", timeline.Quorum)
+	s.Require().Equal("0.5", timeline.Threshold)
+	s.Require().Equal("0.334", timeline.VetoThreshold)
+	s.Require().Len(timeline.Items, 2)
+
+	first := timeline.Items[0]
+	s.Require().EqualValues(100, first.Height)
+	s.Require().EqualValues(1, first.VotesCount)
+	s.Require().Equal("100", first.VotingPower)
+	s.Require().Equal("334", first.QuorumVotingPower)
+	s.Require().Equal("50", first.ThresholdVotingPower)
+	s.Require().False(first.QuorumReached)
+	s.Require().True(first.ThresholdReached)
+
+	second := timeline.Items[1]
+	s.Require().EqualValues(200, second.Height)
+	s.Require().EqualValues(3, second.VotesCount)
+	s.Require().EqualValues(1, second.Abstain)
+	s.Require().Equal("300", second.YesVotingPower)
+	s.Require().Equal("150", second.ThresholdVotingPower)
+	s.Require().True(second.QuorumReached)
+	s.Require().True(second.ThresholdReached)
+}
+
+func (s *ProposalTestSuite) TestTimelineOfFinishedProposal() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id/timeline")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	proposal := testProposal
+	proposal.Id = 2
+	proposal.Status = types.ProposalStatusApplied
+	proposal.Quorum = "0.4"
+	proposal.Threshold = "0.6"
+	proposal.VetoQuorum = "0.3"
+
+	s.proposal.EXPECT().
+		ById(gomock.Any(), uint64(2)).
+		Return(proposal, nil).
+		Times(1)
+
+	s.proposal.EXPECT().
+		Timeline(gomock.Any(), uint64(2)).
+		Return([]storage.ProposalTally{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Timeline(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var timeline responses.ProposalTimeline
+	err := json.NewDecoder(rec.Body).Decode(&timeline)
+	s.Require().NoError(err)
+	s.Require().Equal("0.4", timeline.Quorum)
+	s.Require().Equal("0.6", timeline.Threshold)
+	s.Require().Equal("0.3", timeline.VetoThreshold)
+	s.Require().Empty(timeline.Items)
+}
+
 func (s *ProposalTestSuite) TestVotes() {
 	q := make(url.Values)
 	q.Set("limit", "10")
@@ -348,3 +475,111 @@ func (s *ProposalTestSuite) TestVotesByProposalIdWithValidator() {
 	s.Require().EqualValues("test title", votes[0].Proposal.Title)
 	s.Require().EqualValues("test description", votes[0].Proposal.Description)
 }
+
+func (s *ProposalTestSuite) TestEffectiveVotesOfActiveProposal() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("inherited", "true")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id/effective_votes")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.proposal.EXPECT().
+		ById(gomock.Any(), uint64(1)).
+		Return(testProposal, nil).
+		Times(1)
+
+	s.votes.EXPECT().
+		ComputeEffectiveVotes(gomock.Any(), uint64(1), storage.EffectiveVoteFilters{
+			Limit:     10,
+			Option:    []types.VoteOption{},
+			Inherited: testsuite.Ptr(true),
+		}).
+		Return([]storage.EffectiveVote{
+			{
+				ProposalId:  1,
+				AddressId:   111,
+				ValidatorId: 1,
+				Option:      types.VoteOptionYes,
+				Weight:      types.NumericFromInt64(1),
+				VotingPower: types.NumericFromInt64(1000),
+				Inherited:   true,
+				Address: &storage.Address{
+					Id:      111,
+					Hash:    testHashAddress,
+					Address: testAddress,
+				},
+				Validator: &testValidator,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.EffectiveVotes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.EffectiveVote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(1, votes[0].ProposalId)
+	s.Require().EqualValues(types.VoteOptionYes, votes[0].Option)
+	s.Require().Equal("1000", votes[0].VotingPower.String())
+	s.Require().True(votes[0].Inherited)
+	s.Require().NotNil(votes[0].Delegator)
+	s.Require().Equal(testAddress, votes[0].Delegator.Hash)
+	s.Require().NotNil(votes[0].Validator)
+	s.Require().EqualValues("moniker", votes[0].Validator.Moniker)
+}
+
+func (s *ProposalTestSuite) TestEffectiveVotesOfFinishedProposal() {
+	q := make(url.Values)
+	q.Set("option", "no")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id/effective_votes")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	proposal := testProposal
+	proposal.Id = 2
+	proposal.Status = types.ProposalStatusRejected
+
+	s.proposal.EXPECT().
+		ById(gomock.Any(), uint64(2)).
+		Return(proposal, nil).
+		Times(1)
+
+	s.votes.EXPECT().
+		EffectiveVotes(gomock.Any(), uint64(2), storage.EffectiveVoteFilters{
+			Limit:  10,
+			Option: []types.VoteOption{types.VoteOptionNo},
+		}).
+		Return([]storage.EffectiveVote{
+			{
+				ProposalId:  2,
+				AddressId:   111,
+				ValidatorId: 1,
+				Option:      types.VoteOptionNo,
+				Weight:      types.NumericFromInt64(1),
+				VotingPower: types.NumericFromInt64(500),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.EffectiveVotes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.EffectiveVote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(2, votes[0].ProposalId)
+	s.Require().EqualValues(types.VoteOptionNo, votes[0].Option)
+	s.Require().False(votes[0].Inherited)
+}
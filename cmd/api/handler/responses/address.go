@@ -4,6 +4,8 @@
 package responses
 
 import (
+	"time"
+
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	celestials "github.com/celenium-io/celestial-module/pkg/storage"
@@ -21,7 +23,8 @@ type Address struct {
 	IsForwarding bool           `example:"true"                                            json:"is_forwarding"  swaggertype:"boolean"`
 	Balance      Balance        `json:"balance"`
 
-	Celestials *Celestial `json:"celestials,omitempty"`
+	Celestials *Celestial    `json:"celestials,omitempty"`
+	Label      *AddressLabel `json:"label,omitempty"`
 }
 
 func NewAddress(addr storage.Address) Address {
@@ -37,6 +40,7 @@ func NewAddress(addr storage.Address) Address {
 		address.Balance = NewBalance(*addr.DefaultBalance)
 	}
 	address.AddCelestails(addr.Celestials)
+	address.AddLabel(addr.Label)
 	return address
 }
 
@@ -46,6 +50,12 @@ func (address *Address) AddCelestails(celestials *celestials.Celestial) {
 	}
 }
 
+func (address *Address) AddLabel(label *storage.AddressLabel) {
+	if label != nil {
+		address.Label = NewAddressLabel(*label)
+	}
+}
+
 // Balance info
 //
 //	@Description	Balance of address information
@@ -65,6 +75,22 @@ func NewBalance(balance storage.Balance) Balance {
 	}
 }
 
+type BalanceHistoryItem struct {
+	Time      time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"      swaggertype:"string"`
+	Spendable string    `example:"10000000000"               json:"spendable" swaggertype:"string"`
+	Delegated string    `example:"10000000000"               json:"delegated" swaggertype:"string"`
+	Unbonding string    `example:"10000000000"               json:"unbonding" swaggertype:"string"`
+}
+
+func NewBalanceHistoryItem(item storage.BalanceSeriesItem) BalanceHistoryItem {
+	return BalanceHistoryItem{
+		Time:      item.Time,
+		Spendable: item.Spendable.String(),
+		Delegated: item.Delegated.String(),
+		Unbonding: item.Unbonding.String(),
+	}
+}
+
 // Celestial ID
 //
 //	@Description	Linked celestial id
@@ -84,9 +110,27 @@ func NewCelestial(c *celestials.Celestial) *Celestial {
 	}
 }
 
+// Address label
+//
+//	@Description	Name, category and source of the address label
+type AddressLabel struct {
+	Name     string `example:"Exchange hot wallet"    json:"name"             swaggertype:"string"`
+	Category string `example:"exchange"               json:"category"         swaggertype:"string"`
+	Source   string `example:"https://explorer.io/tx" json:"source,omitempty" swaggertype:"string"`
+}
+
+func NewAddressLabel(label storage.AddressLabel) *AddressLabel {
+	return &AddressLabel{
+		Name:     label.Name,
+		Category: label.Category.String(),
+		Source:   label.Source,
+	}
+}
+
 type ShortAddress struct {
-	Hash       string     `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"hash" swaggertype:"string"`
-	Celestials *Celestial `json:"celestials,omitempty"`
+	Hash       string        `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"hash" swaggertype:"string"`
+	Celestials *Celestial    `json:"celestials,omitempty"`
+	Label      *AddressLabel `json:"label,omitempty"`
 }
 
 func NewShortAddress(address *storage.Address) *ShortAddress {
@@ -99,5 +143,8 @@ func NewShortAddress(address *storage.Address) *ShortAddress {
 	if address.Celestials != nil {
 		result.Celestials = NewCelestial(address.Celestials)
 	}
+	if address.Label != nil {
+		result.Label = NewAddressLabel(*address.Label)
+	}
 	return result
 }
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+type Alert struct {
+	Id          uint64     `example:"321"                       format:"int64"     json:"id"                     swaggertype:"integer"`
+	RuleId      uint64     `example:"12"                        format:"int64"     json:"rule_id"                swaggertype:"integer"`
+	RuleName    string     `example:"no blobs for 10 minutes"   format:"string"    json:"rule_name,omitempty"    swaggertype:"string"`
+	Kind        string     `example:"no_blob"                   format:"string"    json:"kind"                   swaggertype:"string"`
+	RollupId    uint64     `example:"1"                         format:"int64"     json:"rollup_id,omitempty"    swaggertype:"integer"`
+	NamespaceId uint64     `example:"2"                         format:"int64"     json:"namespace_id,omitempty" swaggertype:"integer"`
+	Value       string     `example:"1200"                      format:"string"    json:"value"                  swaggertype:"string"`
+	Threshold   string     `example:"600"                       format:"string"    json:"threshold"              swaggertype:"string"`
+	StartedAt   time.Time  `example:"2025-07-04T03:10:57+00:00" format:"date-time" json:"started_at"             swaggertype:"string"`
+	ResolvedAt  *time.Time `example:"2025-07-04T03:10:57+00:00" format:"date-time" json:"resolved_at,omitempty"  swaggertype:"string"`
+	Active      bool       `example:"true"                      format:"boolean"   json:"active"                 swaggertype:"boolean"`
+}
+
+func NewAlert(alert storage.Alert) Alert {
+	result := Alert{
+		Id:          alert.Id,
+		RuleId:      alert.RuleId,
+		Kind:        alert.Kind.String(),
+		RollupId:    alert.RollupId,
+		NamespaceId: alert.NamespaceId,
+		Value:       alert.Value.String(),
+		Threshold:   alert.Threshold.String(),
+		StartedAt:   alert.StartedAt,
+		ResolvedAt:  alert.ResolvedAt,
+		Active:      alert.ResolvedAt == nil,
+	}
+
+	if alert.Rule != nil {
+		result.RuleName = alert.Rule.Name
+	}
+
+	return result
+}
@@ -50,6 +50,8 @@ type BlobLog struct {
 	Tx           *Tx            `json:"tx,omitempty"`
 	Rollup       *ShortRollup   `json:"rollup,omitempty"`
 	Signer       *ShortAddress  `json:"signer,omitempty"`
+
+	SignerId uint64 `json:"-"`
 }
 
 func NewBlobLog(blob storage.BlobLog) BlobLog {
@@ -63,6 +65,7 @@ func NewBlobLog(blob storage.BlobLog) BlobLog {
 		ContentType:  blob.ContentType,
 		Rollup:       NewShortRollup(blob.Rollup),
 		Signer:       NewShortAddress(blob.Signer),
+		SignerId:     blob.SignerId,
 	}
 
 	if blob.Namespace != nil {
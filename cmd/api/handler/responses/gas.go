@@ -7,4 +7,20 @@ type GasPrice struct {
 	Slow   string `example:"0.1234" format:"string" json:"slow"   swaggertype:"string"`
 	Median string `example:"0.1234" format:"string" json:"median" swaggertype:"string"`
 	Fast   string `example:"0.1234" format:"string" json:"fast"   swaggertype:"string"`
+
+	SlowInclusionTime   int64 `example:"30000" format:"int64" json:"slow_inclusion_time,omitempty"   swaggertype:"integer"`
+	MedianInclusionTime int64 `example:"12000" format:"int64" json:"median_inclusion_time,omitempty" swaggertype:"integer"`
+	FastInclusionTime   int64 `example:"6000"  format:"int64" json:"fast_inclusion_time,omitempty"   swaggertype:"integer"`
+
+	Estimation *GasPriceEstimation `json:"estimation,omitempty"`
+}
+
+type GasPriceEstimation struct {
+	WithinBlocks      int     `example:"3"      format:"int64"  json:"within_blocks"      swaggertype:"integer"`
+	TargetProbability float64 `example:"0.9"    format:"float"  json:"target_probability" swaggertype:"number"`
+	GasPrice          string  `example:"0.0042" format:"string" json:"gas_price"          swaggertype:"string"`
+	Probability       float64 `example:"0.93"   format:"float"  json:"probability"        swaggertype:"number"`
+	ExpectedTime      int64   `example:"18000"  format:"int64"  json:"expected_time"      swaggertype:"integer"`
+	SquareFullness    float64 `example:"0.45"   format:"float"  json:"square_fullness"    swaggertype:"number"`
+	PendingAhead      int64   `example:"123456" format:"int64"  json:"pending_ahead"      swaggertype:"integer"`
 }
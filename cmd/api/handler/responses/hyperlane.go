@@ -103,19 +103,24 @@ func NewHyperlaneToken(token storage.HLToken) HyperlaneToken {
 }
 
 type HyperlaneTransfer struct {
-	Id       uint64         `example:"321"                                                              format:"int64"     json:"id"                 swaggertype:"integer"`
-	Height   pkgTypes.Level `example:"100"                                                              format:"int64"     json:"height"             swaggertype:"integer"`
-	Time     time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"               swaggertype:"string"`
-	TxHash   string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"tx_hash,omitempty"  swaggertype:"string"`
-	Mailbox  string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"mailbox"            swaggertype:"string"`
-	TokenId  string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"token_id"           swaggertype:"string"`
-	Type     string         `example:"collateral"                                                       format:"string"    json:"type"               swaggertype:"string"`
-	Version  byte           `example:"1"                                                                format:"int64"     json:"version"            swaggertype:"integer"`
-	Nonce    uint32         `example:"10"                                                               format:"int64"     json:"nonce"              swaggertype:"integer"`
-	Body     []byte         `example:"AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="                         format:"string"    json:"body,omitempty"     swaggertype:"string"`
-	Metadata []byte         `example:"AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="                         format:"string"    json:"metadata,omitempty" swaggertype:"string"`
-	Amount   string         `example:"123445"                                                           format:"string"    json:"received"           swaggertype:"string"`
-	Denom    string         `example:"utia"                                                             format:"string"    json:"denom"              swaggertype:"string"`
+	Id             uint64         `example:"321"                                                              format:"int64"     json:"id"                        swaggertype:"integer"`
+	Height         pkgTypes.Level `example:"100"                                                              format:"int64"     json:"height"                    swaggertype:"integer"`
+	Time           time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"                      swaggertype:"string"`
+	TxHash         string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"tx_hash,omitempty"         swaggertype:"string"`
+	Mailbox        string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"mailbox"                   swaggertype:"string"`
+	TokenId        string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"token_id"                  swaggertype:"string"`
+	Type           string         `example:"collateral"                                                       format:"string"    json:"type"                      swaggertype:"string"`
+	Version        byte           `example:"1"                                                                format:"int64"     json:"version"                   swaggertype:"integer"`
+	Nonce          uint32         `example:"10"                                                               format:"int64"     json:"nonce"                     swaggertype:"integer"`
+	Body           []byte         `example:"AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="                         format:"string"    json:"body,omitempty"            swaggertype:"string"`
+	Metadata       []byte         `example:"AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="                         format:"string"    json:"metadata,omitempty"        swaggertype:"string"`
+	Amount         string         `example:"123445"                                                           format:"string"    json:"received"                  swaggertype:"string"`
+	Denom          string         `example:"utia"                                                             format:"string"    json:"denom"                     swaggertype:"string"`
+	MessageId      string         `example:"dcdb3f985ecd20c313c58c0f6b2a0d7ea980349134ee4813f6bd53cfe5bf0a1e" format:"binary"    json:"message_id,omitempty"      swaggertype:"string"`
+	DeliveryStatus string         `example:"delivered"                                                        format:"string"    json:"delivery_status,omitempty" swaggertype:"string"`
+	DispatchedAt   *time.Time     `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"dispatched_at,omitempty"   swaggertype:"string"`
+	DeliveredAt    *time.Time     `example:"2023-07-04T03:11:57+00:00"                                        format:"date-time" json:"delivered_at,omitempty"    swaggertype:"string"`
+	Latency        float64        `example:"60.5"                                                             format:"float"     json:"latency,omitempty"         swaggertype:"number"`
 
 	Address      *ShortAddress         `json:"address,omitempty"`
 	Relayer      *ShortAddress         `json:"relayer,omitempty"`
@@ -127,6 +132,7 @@ func NewHyperlaneTransfer(transfer storage.HLTransfer, store hyperlane.IChainSto
 	counterparty := HyperlaneCounterparty{
 		Hash:   transfer.CounterpartyAddress,
 		Domain: transfer.Counterparty,
+		TxHash: transfer.CounterpartyTxHash,
 	}
 
 	if store != nil {
@@ -147,6 +153,16 @@ func NewHyperlaneTransfer(transfer storage.HLTransfer, store hyperlane.IChainSto
 		Address:      NewShortAddress(transfer.Address),
 		Relayer:      NewShortAddress(transfer.Relayer),
 		Counterparty: counterparty,
+		DispatchedAt: transfer.DispatchedAt,
+		DeliveredAt:  transfer.DeliveredAt,
+		Latency:      transfer.Latency().Seconds(),
+	}
+
+	if len(transfer.MessageId) > 0 {
+		result.MessageId = hex.EncodeToString(transfer.MessageId)
+	}
+	if transfer.DeliveryStatus != "" {
+		result.DeliveryStatus = transfer.DeliveryStatus.String()
 	}
 
 	if transfer.Token != nil {
@@ -181,6 +197,7 @@ type GasPayment struct {
 
 type HyperlaneCounterparty struct {
 	Hash          string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"hash"    swaggertype:"string"`
+	TxHash        string         `example:"5e8a7c2b9f3d6a1e4c7b0d9f2a5e8c1b4d7a0e3f6c9b2d5a8e1f4c7b0a3d6e9f" json:"tx_hash,omitempty" swaggertype:"string"`
 	Domain        uint64         `example:"100"                                                              format:"int64" json:"domain,omitempty" swaggertype:"integer"`
 	ChainMetadata *ChainMetadata `json:"chain_metadata,omitempty"`
 }
@@ -327,3 +344,31 @@ type HyperlaneRemoteDomain struct {
 	RemoteDomain  uint64         `example:"100"                   format:"int64" json:"remote_domain" swaggertype:"integer"`
 	ChainMetadata *ChainMetadata `json:"chain_metadata,omitempty"`
 }
+
+type HlDomainLatency struct {
+	Domain        uint64         `example:"123456"    format:"integer" json:"domain_id"   swaggertype:"integer"`
+	Type          string         `example:"send"      format:"string"  json:"type"        swaggertype:"string"`
+	Delivered     uint64         `example:"1234"      format:"integer" json:"delivered"   swaggertype:"integer"`
+	Undelivered   uint64         `example:"12"        format:"integer" json:"undelivered" swaggertype:"integer"`
+	P50           float64        `example:"62.5"      format:"float"   json:"p50"         swaggertype:"number"`
+	P90           float64        `example:"184.1"     format:"float"   json:"p90"         swaggertype:"number"`
+	P99           float64        `example:"905.3"     format:"float"   json:"p99"         swaggertype:"number"`
+	ChainMetadata *ChainMetadata `json:"chain_metadata,omitempty"`
+}
+
+func NewHlDomainLatency(stats storage.DomainLatency, store hyperlane.IChainStore) HlDomainLatency {
+	result := HlDomainLatency{
+		Domain:      stats.Domain,
+		Type:        stats.Type.String(),
+		Delivered:   stats.Delivered,
+		Undelivered: stats.Undelivered,
+		P50:         stats.P50,
+		P90:         stats.P90,
+		P99:         stats.P99,
+	}
+	if store != nil {
+		result.ChainMetadata = NewChainMetadata(stats.Domain, store)
+	}
+
+	return result
+}
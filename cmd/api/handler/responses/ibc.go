@@ -168,20 +168,23 @@ func NewIbcChannel(channel storage.IbcChannel) IbcChannel {
 }
 
 type IbcTransfer struct {
-	Id            uint64         `example:"123456"                                                           format:"integer"   json:"id"                       swaggertype:"integer"`
-	Time          time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"                     swaggertype:"string"`
-	Height        pkgTypes.Level `example:"100"                                                              format:"integer"   json:"height"                   swaggertype:"integer"`
-	ChannelId     string         `example:"channel-1"                                                        format:"string"    json:"channel_id"               swaggertype:"string"`
-	ConnectionId  string         `example:"connection-1"                                                     format:"string"    json:"connection_id"            swaggertype:"string"`
-	Port          string         `example:"transfer"                                                         format:"string"    json:"port"                     swaggertype:"string"`
-	Amount        string         `example:"123445"                                                           format:"string"    json:"amount"                   swaggertype:"string"`
-	Denom         string         `example:"utia"                                                             format:"string"    json:"denom"                    swaggertype:"string"`
-	Memo          string         `example:"memo"                                                             format:"string"    json:"memo,omitempty"           swaggertype:"string"`
-	Timeout       *time.Time     `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"timeout,omitempty"        swaggertype:"string"`
-	TimeoutHeight uint64         `example:"100"                                                              format:"integer"   json:"timeout_height,omitempty" swaggertype:"integer"`
-	TxHash        string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"tx_hash"                  swaggertype:"string"`
-	Sequence      uint64         `example:"123456"                                                           format:"integer"   json:"sequence"                 swaggertype:"integer"`
-	ChainId       string         `example:"osmosis-1"                                                        format:"binary"    json:"chain_id"                 swaggertype:"string"`
+	Id            uint64         `example:"123456"                                                           format:"integer"   json:"id"                        swaggertype:"integer"`
+	Time          time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"                      swaggertype:"string"`
+	Height        pkgTypes.Level `example:"100"                                                              format:"integer"   json:"height"                    swaggertype:"integer"`
+	ChannelId     string         `example:"channel-1"                                                        format:"string"    json:"channel_id"                swaggertype:"string"`
+	ConnectionId  string         `example:"connection-1"                                                     format:"string"    json:"connection_id"             swaggertype:"string"`
+	Port          string         `example:"transfer"                                                         format:"string"    json:"port"                      swaggertype:"string"`
+	Amount        string         `example:"123445"                                                           format:"string"    json:"amount"                    swaggertype:"string"`
+	Denom         string         `example:"utia"                                                             format:"string"    json:"denom"                     swaggertype:"string"`
+	Memo          string         `example:"memo"                                                             format:"string"    json:"memo,omitempty"            swaggertype:"string"`
+	Timeout       *time.Time     `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"timeout,omitempty"         swaggertype:"string"`
+	TimeoutHeight uint64         `example:"100"                                                              format:"integer"   json:"timeout_height,omitempty"  swaggertype:"integer"`
+	TxHash        string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"tx_hash"                   swaggertype:"string"`
+	Sequence      uint64         `example:"123456"                                                           format:"integer"   json:"sequence"                  swaggertype:"integer"`
+	ChainId       string         `example:"osmosis-1"                                                        format:"binary"    json:"chain_id"                  swaggertype:"string"`
+	Status        string         `example:"acknowledged"                                                     format:"string"    json:"status"                    swaggertype:"string"`
+	ResolveTxHash string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"resolve_tx_hash,omitempty" swaggertype:"string"`
+	ResolveHeight pkgTypes.Level `example:"100"                                                              format:"integer"   json:"resolve_height,omitempty"  swaggertype:"integer"`
 
 	Sender   *ShortAddress `json:"sender,omitempty"`
 	Receiver *ShortAddress `json:"receiver,omitempty"`
@@ -202,6 +205,8 @@ func NewIbcTransfer(transfer storage.IbcTransfer) IbcTransfer {
 		Timeout:       transfer.Timeout,
 		TimeoutHeight: transfer.HeightTimeout,
 		Sequence:      transfer.Sequence,
+		Status:        transfer.Status.String(),
+		ResolveHeight: transfer.ResolveHeight,
 		Sender:        NewShortAddress(transfer.Sender),
 		Receiver:      NewShortAddress(transfer.Receiver),
 	}
@@ -222,6 +227,10 @@ func NewIbcTransfer(transfer storage.IbcTransfer) IbcTransfer {
 		response.TxHash = hex.EncodeToString(transfer.Tx.Hash)
 	}
 
+	if transfer.ResolveTx != nil {
+		response.ResolveTxHash = hex.EncodeToString(transfer.ResolveTx.Hash)
+	}
+
 	if transfer.Connection != nil && transfer.Connection.Client != nil {
 		response.ChainId = transfer.Connection.Client.ChainId
 	}
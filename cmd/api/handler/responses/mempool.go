@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+type MempoolTx struct {
+	Hash            string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"hash"                       swaggertype:"string"`
+	Status          string         `example:"pending"                                                          format:"string"    json:"status"                     swaggertype:"string"`
+	FirstSeen       time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"first_seen"                 swaggertype:"string"`
+	SeenHeight      pkgTypes.Level `example:"100"                                                              format:"int64"     json:"seen_height"                swaggertype:"integer"`
+	GasWanted       int64          `example:"9348"                                                             format:"int64"     json:"gas_wanted"                 swaggertype:"integer"`
+	Fee             string         `example:"9348"                                                             format:"int64"     json:"fee"                        swaggertype:"string"`
+	GasPrice        string         `example:"0.004"                                                            format:"string"    json:"gas_price"                  swaggertype:"string"`
+	Size            int64          `example:"1024"                                                             format:"int64"     json:"size"                       swaggertype:"integer"`
+	BlobsCount      int            `example:"1"                                                                format:"int64"     json:"blobs_count"                swaggertype:"integer"`
+	BlobsSize       int64          `example:"12345"                                                            format:"int64"     json:"blobs_size"                 swaggertype:"integer"`
+	Memo            string         `example:"Transfer to private account"                                      format:"string"    json:"memo,omitempty"             swaggertype:"string"`
+	Height          pkgTypes.Level `example:"102"                                                              format:"int64"     json:"height,omitempty"           swaggertype:"integer"`
+	FinishedAt      *time.Time     `example:"2023-07-04T03:11:03+00:00"                                        format:"date-time" json:"finished_at,omitempty"      swaggertype:"string"`
+	InclusionTime   int64          `example:"6000"                                                             format:"int64"     json:"inclusion_time,omitempty"   swaggertype:"integer"`
+	InclusionBlocks int64          `example:"2"                                                                format:"int64"     json:"inclusion_blocks,omitempty" swaggertype:"integer"`
+
+	Signers      []string `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"signers"`
+	MessageTypes []string `example:"MsgPayForBlobs"                                  json:"message_types"`
+}
+
+func NewMempoolTx(tx storage.MempoolTx) MempoolTx {
+	return MempoolTx{
+		Hash:            hex.EncodeToString(tx.Hash),
+		Status:          tx.Status.String(),
+		FirstSeen:       tx.FirstSeen,
+		SeenHeight:      tx.SeenHeight,
+		GasWanted:       tx.GasWanted,
+		Fee:             tx.Fee.String(),
+		GasPrice:        tx.GasPrice.String(),
+		Size:            tx.Size,
+		BlobsCount:      tx.BlobsCount,
+		BlobsSize:       tx.BlobsSize,
+		Memo:            tx.Memo,
+		Height:          tx.Height,
+		FinishedAt:      tx.FinishedAt,
+		InclusionTime:   tx.InclusionTime,
+		InclusionBlocks: tx.InclusionBlocks(),
+		Signers:         tx.Signers,
+		MessageTypes:    tx.MessageTypes,
+	}
+}
@@ -24,6 +24,10 @@ type Message struct {
 	Data map[string]any `json:"data"`
 
 	Tx *Tx `json:"tx,omitempty"`
+
+	Labels map[string]AddressLabel `json:"labels,omitempty"`
+
+	Addresses []string `json:"-"`
 }
 
 func NewMessage(msg storage.Message) Message {
@@ -59,6 +63,18 @@ func NewMessageWithTx(msg storage.MessageWithTx) Message {
 	return message
 }
 
+// AddLabel - attaches label of the address which is linked to the message
+func (m *Message) AddLabel(label storage.MessageLabel) {
+	if m.Labels == nil {
+		m.Labels = make(map[string]AddressLabel)
+	}
+	m.Labels[label.Address] = AddressLabel{
+		Name:     label.Name,
+		Category: label.Category.String(),
+		Source:   label.Source,
+	}
+}
+
 func NewValidatorMessage(msg storage.MsgValidator) Message {
 	response := Message{
 		Id:     msg.MsgId,
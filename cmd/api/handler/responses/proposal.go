@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 )
 
@@ -122,3 +123,72 @@ func NewShortProposal(proposal storage.Proposal) *ShortProposal {
 
 	return result
 }
+
+type ProposalTimeline struct {
+	Quorum        string `example:"0.33"  format:"string" json:"quorum"         swaggertype:"string"`
+	Threshold     string `example:"0.5"   format:"string" json:"threshold"      swaggertype:"string"`
+	VetoThreshold string `example:"0.334" format:"string" json:"veto_threshold" swaggertype:"string"`
+
+	Items []ProposalTallyItem `json:"items"`
+}
+
+type ProposalTallyItem struct {
+	Height pkgTypes.Level `example:"100"                       format:"int64"     json:"height" swaggertype:"integer"`
+	Time   time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"   swaggertype:"string"`
+
+	VotesCount int64 `example:"12354" json:"votes_count"  swaggertype:"integer"`
+	Yes        int64 `example:"1234"  json:"yes"          swaggertype:"integer"`
+	No         int64 `example:"1234"  json:"no"           swaggertype:"integer"`
+	NoWithVeto int64 `example:"1234"  json:"no_with_veto" swaggertype:"integer"`
+	Abstain    int64 `example:"1234"  json:"abstain"      swaggertype:"integer"`
+
+	TotalVotingPower      string `example:"1000000000" format:"string" json:"total_voting_power"        swaggertype:"string"`
+	VotingPower           string `example:"1000000000" format:"string" json:"voting_power"              swaggertype:"string"`
+	YesVotingPower        string `example:"1000000000" format:"string" json:"yes_voting_power"          swaggertype:"string"`
+	NoVotingPower         string `example:"1000000000" format:"string" json:"no_voting_power"           swaggertype:"string"`
+	NoWithVetoVotingPower string `example:"1000000000" format:"string" json:"no_with_veto_voting_power" swaggertype:"string"`
+	AbstainVotingPower    string `example:"1000000000" format:"string" json:"abstain_voting_power"      swaggertype:"string"`
+
+	QuorumVotingPower    string `example:"330000000" format:"string" json:"quorum_voting_power"    swaggertype:"string"`
+	ThresholdVotingPower string `example:"500000000" format:"string" json:"threshold_voting_power" swaggertype:"string"`
+	QuorumReached        bool   `example:"true"                      json:"quorum_reached"         swaggertype:"boolean"`
+	ThresholdReached     bool   `example:"true"                      json:"threshold_reached"      swaggertype:"boolean"`
+}
+
+// NewProposalTimeline - builds proposal timeline. Quorum line is the share of total voting power which has to vote,
+// threshold line is the share of non-abstain voting power which has to vote yes.
+func NewProposalTimeline(tallies []storage.ProposalTally, quorum, threshold, vetoThreshold types.Numeric) ProposalTimeline {
+	result := ProposalTimeline{
+		Quorum:        quorum.String(),
+		Threshold:     threshold.String(),
+		VetoThreshold: vetoThreshold.String(),
+		Items:         make([]ProposalTallyItem, len(tallies)),
+	}
+
+	for i := range tallies {
+		quorumPower := tallies[i].TotalVotingPower.Mul(quorum)
+		thresholdPower := tallies[i].VotingPower.Sub(tallies[i].AbstainVotingPower).Mul(threshold)
+
+		result.Items[i] = ProposalTallyItem{
+			Height:                tallies[i].Height,
+			Time:                  tallies[i].Time,
+			VotesCount:            tallies[i].VotesCount,
+			Yes:                   tallies[i].Yes,
+			No:                    tallies[i].No,
+			NoWithVeto:            tallies[i].NoWithVeto,
+			Abstain:               tallies[i].Abstain,
+			TotalVotingPower:      tallies[i].TotalVotingPower.String(),
+			VotingPower:           tallies[i].VotingPower.String(),
+			YesVotingPower:        tallies[i].YesVotingPower.String(),
+			NoVotingPower:         tallies[i].NoVotingPower.String(),
+			NoWithVetoVotingPower: tallies[i].NoWithVetoVotingPower.String(),
+			AbstainVotingPower:    tallies[i].AbstainVotingPower.String(),
+			QuorumVotingPower:     quorumPower.String(),
+			ThresholdVotingPower:  thresholdPower.String(),
+			QuorumReached:         tallies[i].TotalVotingPower.IsPositive() && tallies[i].VotingPower.GreaterThanOrEqual(quorumPower),
+			ThresholdReached:      thresholdPower.IsPositive() && tallies[i].YesVotingPower.GreaterThan(thresholdPower),
+		}
+	}
+
+	return result
+}
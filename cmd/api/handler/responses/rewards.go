@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+type RewardsLedgerItem struct {
+	Height    pkgTypes.Level `example:"100"                       json:"height"    swaggertype:"integer"`
+	Time      time.Time      `example:"2023-07-04T03:10:57+00:00" json:"time"      swaggertype:"string"`
+	Type      string         `example:"rewards"                   json:"type"      swaggertype:"string"`
+	Change    string         `example:"-1000"                     json:"change"    swaggertype:"string"`
+	Delegated string         `example:"10000000"                  json:"delegated" swaggertype:"string"`
+	Withdrawn string         `example:"1000"                      json:"withdrawn" swaggertype:"string"`
+
+	Delegator *ShortAddress   `json:"delegator,omitempty"`
+	Validator *ShortValidator `json:"validator,omitempty"`
+}
+
+func NewRewardsLedgerItem(item storage.RewardsLedgerItem) RewardsLedgerItem {
+	result := RewardsLedgerItem{
+		Height:    item.Height,
+		Time:      item.Time,
+		Type:      item.Type.String(),
+		Change:    item.Change.String(),
+		Delegated: item.Delegated.String(),
+		Withdrawn: item.Withdrawn.String(),
+		Delegator: NewShortAddress(item.Address),
+	}
+
+	if item.Validator != nil {
+		result.Validator = NewShortValidator(*item.Validator)
+	}
+
+	return result
+}
+
+type RewardsSeriesItem struct {
+	Time                 time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"                  swaggertype:"string"`
+	Rewards              string    `example:"10000000"                  json:"rewards"               swaggertype:"string"`
+	Withdrawn            string    `example:"10000000"                  json:"withdrawn"             swaggertype:"string"`
+	Commissions          string    `example:"10000000"                  json:"commissions"           swaggertype:"string"`
+	CommissionsWithdrawn string    `example:"10000000"                  json:"commissions_withdrawn" swaggertype:"string"`
+}
+
+func NewRewardsSeriesItem(item storage.RewardsSeriesItem) RewardsSeriesItem {
+	return RewardsSeriesItem{
+		Time:                 item.Bucket,
+		Rewards:              item.Rewards.String(),
+		Withdrawn:            item.Withdrawn.String(),
+		Commissions:          item.Commissions.String(),
+		CommissionsWithdrawn: item.CommissionsWithdrawn.String(),
+	}
+}
@@ -4,9 +4,15 @@
 package responses
 
 type SearchItem struct {
-	// Result type which is in the result. Can be 'block', 'address', 'namespace', 'tx', 'validator', 'rollup'
+	// Result type which is in the result. Can be 'block', 'address', 'namespace', 'tx', 'validator', 'rollup', 'proposal', 'ibc_transfer'
 	Type string `json:"type"`
 
-	// Search result. Can be one of folowwing types: Block, Address, Namespace, Tx, Validator, Rollup
+	// Search result. Can be one of folowwing types: Block, Address, Namespace, Tx, Validator, Rollup, Proposal, IbcTransfer
 	Result any `json:"result" swaggertype:"object"`
+
+	// Rank of the full-text search match. Empty for exact matches
+	Rank float64 `json:"rank,omitempty"`
+
+	// Fragments of the matched document with highlighted words wrapped into <b></b>. Empty for exact matches
+	Snippet string `json:"snippet,omitempty"`
 }
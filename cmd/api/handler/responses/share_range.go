@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+)
+
+type ShareRange struct {
+	Kind      string  `example:"blob"                                     json:"kind"                 swaggertype:"string"`
+	Namespace string  `example:"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=" json:"namespace"            swaggertype:"string"`
+	Start     int64   `example:"2"                                        json:"start"                swaggertype:"integer"`
+	End       int64   `example:"5"                                        json:"end"                  swaggertype:"integer"`
+	Size      int64   `example:"3"                                        json:"size"                 swaggertype:"integer"`
+	From      []int64 `json:"from"`
+	To        []int64 `json:"to"`
+
+	TxHash    string `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"    swaggertype:"string"`
+	BlobIndex *int   `example:"0"                                                                json:"blob_index,omitempty" swaggertype:"integer"`
+}
+
+// NewShareRange - creates response from the share range. Width is the size of the original data square.
+func NewShareRange(sr storage.ShareRange, width uint64) ShareRange {
+	response := ShareRange{
+		Kind:      sr.Kind.String(),
+		Namespace: base64.StdEncoding.EncodeToString(sr.Namespace),
+		Start:     sr.Start,
+		End:       sr.End,
+		Size:      sr.Size(),
+		From:      shareCoordinates(sr.Start, width),
+		To:        shareCoordinates(sr.End-1, width),
+	}
+
+	if sr.Kind == types.ShareRangeKindBlob {
+		blobIndex := sr.BlobIndex
+		response.BlobIndex = &blobIndex
+		if sr.Tx != nil {
+			response.TxHash = hex.EncodeToString(sr.Tx.Hash)
+		}
+	}
+	return response
+}
+
+// EmptySquareShareRange - layout of the block without transactions: one share of tail padding
+func EmptySquareShareRange() ShareRange {
+	return ShareRange{
+		Kind:      types.ShareRangeKindTailPadding.String(),
+		Namespace: "//////////////////////////////////////4=",
+		Start:     0,
+		End:       1,
+		Size:      1,
+		From:      []int64{0, 0},
+		To:        []int64{0, 0},
+	}
+}
+
+func shareCoordinates(index int64, width uint64) []int64 {
+	if width == 0 {
+		return []int64{0, index}
+	}
+	w := int64(width)
+	return []int64{index / w, index % w}
+}
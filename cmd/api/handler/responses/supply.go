@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+type Supply struct {
+	Height        pkgTypes.Level `example:"100"                       json:"height"         swaggertype:"integer"`
+	Time          time.Time      `example:"2023-07-04T03:10:57+00:00" json:"time"           swaggertype:"string"`
+	Total         string         `example:"1000000000"                json:"total"          swaggertype:"string"`
+	Circulating   string         `example:"900000000"                 json:"circulating"    swaggertype:"string"`
+	Staked        string         `example:"600000000"                 json:"staked"         swaggertype:"string"`
+	Unbonding     string         `example:"1000000"                   json:"unbonding"      swaggertype:"string"`
+	VestingLocked string         `example:"90000000"                  json:"vesting_locked" swaggertype:"string"`
+	CommunityPool string         `example:"10000000"                  json:"community_pool" swaggertype:"string"`
+}
+
+func NewSupply(supply storage.Supply) Supply {
+	return Supply{
+		Height:        supply.Height,
+		Time:          supply.Time,
+		Total:         supply.Total.String(),
+		Circulating:   supply.Circulating.String(),
+		Staked:        supply.Staked.String(),
+		Unbonding:     supply.Unbonding.String(),
+		VestingLocked: supply.VestingLocked.String(),
+		CommunityPool: supply.CommunityPool.String(),
+	}
+}
+
+type SupplyForecast struct {
+	TotalSupply       string `example:"1000000000" json:"total_supply"       swaggertype:"string"`
+	CirculatingSupply string `example:"900000000"  json:"circulating_supply" swaggertype:"string"`
+	Locked            string `example:"100000000"  json:"locked"             swaggertype:"string"`
+	InflationRate     string `example:"0.05"       json:"inflation_rate"     swaggertype:"string"`
+
+	Series []SupplyForecastItem `json:"series"`
+}
+
+type SupplyForecastItem struct {
+	Time              time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"                         swaggertype:"string"`
+	Unlocked          string    `example:"1000000"                   json:"unlocked"                     swaggertype:"string"`
+	Locked            string    `example:"100000000"                 json:"locked"                       swaggertype:"string"`
+	Minted            string    `example:"1000000"                   json:"minted,omitempty"             swaggertype:"string"`
+	TotalSupply       string    `example:"1000000000"                json:"total_supply,omitempty"       swaggertype:"string"`
+	CirculatingSupply string    `example:"900000000"                 json:"circulating_supply,omitempty" swaggertype:"string"`
+	InflationRate     string    `example:"0.05"                      json:"inflation_rate,omitempty"     swaggertype:"string"`
+	Projected         bool      `example:"true"                      json:"projected"                    swaggertype:"boolean"`
+}
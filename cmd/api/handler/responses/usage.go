@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/quota"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+type Usage struct {
+	Tier       string `example:"pro"     format:"string" json:"tier"        swaggertype:"string"`
+	RateLimit  int64  `example:"100"     format:"int64"  json:"rate_limit"  swaggertype:"integer"`
+	DailyQuota int64  `example:"1000000" format:"int64"  json:"daily_quota" swaggertype:"integer"`
+	UsedToday  int64  `example:"12345"   format:"int64"  json:"used_today"  swaggertype:"integer"`
+	// Remaining - count of requests which are left today. It's omitted if quota is unlimited.
+	Remaining *int64    `example:"987655"                    format:"int64"     json:"remaining,omitempty" swaggertype:"integer"`
+	ResetAt   time.Time `example:"2023-07-05T00:00:00+00:00" format:"date-time" json:"reset_at"            swaggertype:"string"`
+
+	Days []UsageDay `json:"days"`
+}
+
+type UsageDay struct {
+	Date   string       `example:"2023-07-04" format:"date"  json:"date"  swaggertype:"string"`
+	Total  int64        `example:"12345"      format:"int64" json:"total" swaggertype:"integer"`
+	Routes []RouteUsage `json:"routes"`
+}
+
+type RouteUsage struct {
+	Route string `example:"/v1/block/:height" format:"string" json:"route" swaggertype:"string"`
+	Count int64  `example:"1234"              format:"int64"  json:"count" swaggertype:"integer"`
+}
+
+func NewUsage(key storage.ApiKey, limits quota.Tier, usage []quota.DayUsage) Usage {
+	result := Usage{
+		Tier:       key.Tier.String(),
+		RateLimit:  limits.RateLimit,
+		DailyQuota: limits.DailyQuota,
+		Days:       make([]UsageDay, len(usage)),
+	}
+
+	for i := range usage {
+		day := UsageDay{
+			Date:   usage[i].Date.Format(time.DateOnly),
+			Total:  usage[i].Total,
+			Routes: make([]RouteUsage, 0, len(usage[i].Routes)),
+		}
+		for route, count := range usage[i].Routes {
+			day.Routes = append(day.Routes, RouteUsage{
+				Route: route,
+				Count: count,
+			})
+		}
+		slices.SortFunc(day.Routes, func(a, b RouteUsage) int {
+			if c := cmp.Compare(b.Count, a.Count); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Route, b.Route)
+		})
+		result.Days[i] = day
+	}
+
+	if len(usage) > 0 {
+		result.UsedToday = usage[0].Total
+		result.ResetAt = usage[0].Date.Add(24 * time.Hour)
+	}
+	if limits.DailyQuota > 0 {
+		remaining := max(limits.DailyQuota-result.UsedToday, 0)
+		result.Remaining = &remaining
+	}
+	return result
+}
@@ -22,14 +22,14 @@ type Validator struct {
 	Contacts string `example:"security@0xfury.com"            json:"contacts" swaggertype:"string"`
 	Details  string `example:"Some long text about validator" json:"details"  swaggertype:"string"`
 
-	Rate              string `example:"0.03" json:"rate"                swaggertype:"string"`
-	MaxRate           string `example:"0.1"  json:"max_rate"            swaggertype:"string"`
-	MaxChangeRate     string `example:"0.01" json:"max_change_rate"     swaggertype:"string"`
-	MinSelfDelegation string `example:"1"    json:"min_self_delegation" swaggertype:"string"`
-	Stake             string `example:"1"    json:"stake"               swaggertype:"string"`
-	Rewards           string `example:"1"    json:"rewards"             swaggertype:"string"`
-	Commissions       string `example:"1"    json:"commissions"         swaggertype:"string"`
-	VotingPower       string `example:"1"    json:"voting_power"        swaggertype:"string"`
+	Rate              *string `example:"0.03" json:"rate"                swaggertype:"string"`
+	MaxRate           string  `example:"0.1"  json:"max_rate"            swaggertype:"string"`
+	MaxChangeRate     string  `example:"0.01" json:"max_change_rate"     swaggertype:"string"`
+	MinSelfDelegation string  `example:"1"    json:"min_self_delegation" swaggertype:"string"`
+	Stake             string  `example:"1"    json:"stake"               swaggertype:"string"`
+	Rewards           string  `example:"1"    json:"rewards"             swaggertype:"string"`
+	Commissions       string  `example:"1"    json:"commissions"         swaggertype:"string"`
+	VotingPower       string  `example:"1"    json:"voting_power"        swaggertype:"string"`
 
 	Jailed bool `example:"false" json:"jailed" swaggertype:"boolean"`
 
@@ -49,6 +49,11 @@ func NewValidator(val storage.Validator) *Validator {
 	if val.Jailed != nil {
 		jailed = *val.Jailed
 	}
+	var rate *string
+	if !val.RateUnknown {
+		value := val.Rate.String()
+		rate = &value
+	}
 	return &Validator{
 		Id:      val.Id,
 		Version: val.Version,
@@ -64,7 +69,7 @@ func NewValidator(val storage.Validator) *Validator {
 		Identity:          val.Identity,
 		Contacts:          val.Contacts,
 		Details:           val.Details,
-		Rate:              val.Rate.String(),
+		Rate:              rate,
 		MaxRate:           val.MaxRate.String(),
 		MaxChangeRate:     val.MaxChangeRate.String(),
 		MinSelfDelegation: val.MinSelfDelegation.String(),
@@ -78,6 +83,29 @@ func NewValidator(val storage.Validator) *Validator {
 	}
 }
 
+type ValidatorHistoryItem struct {
+	Height      types.Level `example:"100"                       format:"int64"     json:"height"       swaggertype:"integer"`
+	Time        time.Time   `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"         swaggertype:"string"`
+	Stake       string      `example:"1"                                            json:"stake"        swaggertype:"string"`
+	VotingPower string      `example:"1"                                            json:"voting_power" swaggertype:"string"`
+	Rate        *string     `example:"0.03"                                         json:"rate"         swaggertype:"string"`
+}
+
+func NewValidatorHistoryItem(item storage.ValidatorHistoryItem) ValidatorHistoryItem {
+	var rate *string
+	if !item.RateUnknown {
+		value := item.Rate.String()
+		rate = &value
+	}
+	return ValidatorHistoryItem{
+		Height:      item.Height,
+		Time:        item.Time,
+		Stake:       item.Stake.String(),
+		VotingPower: item.VotingPower().String(),
+		Rate:        rate,
+	}
+}
+
 type ShortValidator struct {
 	Id          uint64 `example:"321"                                      json:"id"           swaggertype:"integer"`
 	ConsAddress string `example:"E641C7A2C964833E556AEF934FBF166B712874B6" json:"cons_address" swaggertype:"string"`
@@ -48,3 +48,36 @@ func NewVote(vote storage.Vote) Vote {
 
 	return result
 }
+
+type EffectiveVote struct {
+	ProposalId  uint64           `example:"2"        format:"int64"   json:"proposal_id"  swaggertype:"integer"`
+	Option      types.VoteOption `example:"yes"      format:"string"  json:"option"       swaggertype:"string"`
+	Weight      types.Numeric    `example:"1"        format:"string"  json:"weight"       swaggertype:"string"`
+	VotingPower types.Numeric    `example:"12345678" format:"string"  json:"voting_power" swaggertype:"string"`
+	Inherited   bool             `example:"true"     format:"boolean" json:"inherited"    swaggertype:"boolean"`
+
+	Delegator *ShortAddress   `json:"delegator,omitempty"`
+	Validator *ShortValidator `json:"validator,omitempty"`
+	Proposal  *ShortProposal  `json:"proposal,omitempty"`
+}
+
+func NewEffectiveVote(vote storage.EffectiveVote) EffectiveVote {
+	result := EffectiveVote{
+		ProposalId:  vote.ProposalId,
+		Option:      vote.Option,
+		Weight:      vote.Weight,
+		VotingPower: vote.VotingPower,
+		Inherited:   vote.Inherited,
+		Delegator:   NewShortAddress(vote.Address),
+	}
+
+	if vote.Validator != nil {
+		result.Validator = NewShortValidator(*vote.Validator)
+	}
+
+	if vote.Proposal != nil {
+		result.Proposal = NewShortProposal(*vote.Proposal)
+	}
+
+	return result
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -20,14 +21,17 @@ import (
 )
 
 type SearchHandler struct {
-	search     storage.ISearch
-	address    storage.IAddress
-	block      storage.IBlock
-	tx         storage.ITx
-	namespace  storage.INamespace
-	validator  storage.IValidator
-	rollup     storage.IRollup
-	celestials celestials.ICelestial
+	search       storage.ISearch
+	address      storage.IAddress
+	block        storage.IBlock
+	tx           storage.ITx
+	namespace    storage.INamespace
+	validator    storage.IValidator
+	rollup       storage.IRollup
+	labels       storage.IAddressLabel
+	proposals    storage.IProposal
+	ibcTransfers storage.IIbcTransfer
+	celestials   celestials.ICelestial
 }
 
 func NewSearchHandler(
@@ -38,22 +42,29 @@ func NewSearchHandler(
 	namespace storage.INamespace,
 	validator storage.IValidator,
 	rollup storage.IRollup,
+	labels storage.IAddressLabel,
+	proposals storage.IProposal,
+	ibcTransfers storage.IIbcTransfer,
 	celestials celestials.ICelestial,
 ) SearchHandler {
 	return SearchHandler{
-		search:     search,
-		address:    address,
-		block:      block,
-		tx:         tx,
-		namespace:  namespace,
-		validator:  validator,
-		rollup:     rollup,
-		celestials: celestials,
+		search:       search,
+		address:      address,
+		block:        block,
+		tx:           tx,
+		namespace:    namespace,
+		validator:    validator,
+		rollup:       rollup,
+		labels:       labels,
+		proposals:    proposals,
+		ibcTransfers: ibcTransfers,
+		celestials:   celestials,
 	}
 }
 
 type searchRequest struct {
-	Search string `query:"query" validate:"required"`
+	Search string      `query:"query" validate:"required"`
+	Types  StringArray `query:"types" validate:"omitempty,dive,oneof=proposal rollup validator tx ibc_transfer"`
 }
 
 var (
@@ -68,6 +79,7 @@ var (
 //	@Tags					search
 //	@ID						search
 //	@Param					query	query	string	true	"Search string"
+//	@Param					types	query	string	false	"Comma-separated list of full-text search document types: proposal, rollup, validator, tx, ibc_transfer. If set, only full-text search is performed. Memos are searched only if tx or ibc_transfer is passed"
 //	@Produce				json
 //	@Success				200	{array}	responses.SearchItem
 //	@Success				204
@@ -80,6 +92,14 @@ func (handler SearchHandler) Search(c echo.Context) error {
 		return badRequestError(c, err)
 	}
 
+	if len(req.Types) > 0 {
+		response, err := handler.fullText(c.Request().Context(), req.Search, req.Types, nil)
+		if err != nil {
+			return handleError(c, err, handler.search)
+		}
+		return returnArray(c, response)
+	}
+
 	data := make([]responses.SearchItem, 0)
 
 	if height, err := strconv.ParseInt(req.Search, 10, 64); err == nil {
@@ -92,25 +112,34 @@ func (handler SearchHandler) Search(c echo.Context) error {
 		}
 	}
 
-	var response []responses.SearchItem
+	var (
+		response []responses.SearchItem
+		noRows   NoRows
+	)
 
 	switch {
 	case isAddress(req.Search):
 		response, err = handler.searchAddress(c.Request().Context(), req.Search)
+		noRows = handler.address
 	case isValoperAddress(req.Search):
 		response, err = handler.searchValoperAddress(c.Request().Context(), req.Search)
+		noRows = handler.validator
 	case hashRegexp.MatchString(req.Search):
 		response, err = handler.searchHash(c.Request().Context(), req.Search)
+		noRows = handler.search
 	case namespaceRegexp.MatchString(req.Search):
 		response, err = handler.searchNamespaceById(c.Request().Context(), req.Search)
+		noRows = handler.namespace
 	case isNamespace(req.Search):
 		response, err = handler.searchNamespaceByBase64(c.Request().Context(), req.Search)
+		noRows = handler.namespace
 	default:
 		response, err = handler.searchText(c.Request().Context(), req.Search)
+		noRows = handler.search
 	}
 	if err != nil {
-		if !handler.address.IsNoRows(err) {
-			return handleError(c, err, handler.address)
+		if !noRows.IsNoRows(err) {
+			return handleError(c, err, noRows)
 		}
 	}
 
@@ -239,47 +268,223 @@ func (handler SearchHandler) searchText(ctx context.Context, text string) ([]res
 		return nil, err
 	}
 
-	response := make([]responses.SearchItem, len(result))
+	ids := make(map[string][]uint64)
 	for i := range result {
-		response[i].Type = result[i].Type
-		switch response[i].Type {
+		ids[result[i].Type] = append(ids[result[i].Type], result[i].Id)
+	}
+
+	validatorById := make(map[uint64]storage.Validator)
+	if len(ids["validator"]) > 0 {
+		validators, err := handler.validator.GetByIds(ctx, ids["validator"]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range validators {
+			validatorById[validators[i].Id] = validators[i]
+		}
+	}
+	rollupById := make(map[uint64]storage.Rollup)
+	if len(ids["rollup"]) > 0 {
+		rollups, err := handler.rollup.GetByIds(ctx, ids["rollup"]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rollups {
+			rollupById[rollups[i].Id] = rollups[i]
+		}
+	}
+	namespaceById := make(map[uint64]storage.Namespace)
+	if len(ids["namespace"]) > 0 {
+		namespaces, err := handler.namespace.GetByIds(ctx, ids["namespace"]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range namespaces {
+			namespaceById[namespaces[i].Id] = namespaces[i]
+		}
+	}
+	addressById := make(map[uint64]storage.Address)
+	addressIds := make([]uint64, 0, len(ids["celestial"])+len(ids["label"]))
+	addressIds = append(addressIds, ids["celestial"]...)
+	addressIds = append(addressIds, ids["label"]...)
+	if len(addressIds) > 0 {
+		addresses, err := handler.address.GetByIds(ctx, addressIds...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range addresses {
+			addressById[addresses[i].Id] = addresses[i]
+		}
+	}
+	labelByAddressId := make(map[uint64]storage.AddressLabel)
+	if len(ids["label"]) > 0 {
+		labels, err := handler.labels.ByAddressIds(ctx, ids["label"]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range labels {
+			labelByAddressId[labels[i].AddressId] = labels[i]
+		}
+	}
+
+	// entities removed after search are skipped
+	response := make([]responses.SearchItem, 0, len(result))
+	for i := range result {
+		item := responses.SearchItem{
+			Type: result[i].Type,
+		}
+		switch result[i].Type {
 		case "validator":
-			validator, err := handler.validator.GetByID(ctx, result[i].Id)
-			if err != nil {
-				return nil, err
+			validator, ok := validatorById[result[i].Id]
+			if !ok {
+				continue
 			}
-			response[i].Result = responses.NewValidator(*validator)
+			item.Result = responses.NewValidator(validator)
 		case "rollup":
-			rollup, err := handler.rollup.GetByID(ctx, result[i].Id)
-			if err != nil {
-				return nil, err
+			rollup, ok := rollupById[result[i].Id]
+			if !ok {
+				continue
 			}
-			response[i].Result = responses.NewRollup(rollup)
+			item.Result = responses.NewRollup(&rollup)
 		case "namespace":
-			namespace, err := handler.namespace.GetByID(ctx, result[i].Id)
-			if err != nil {
-				return nil, err
+			namespace, ok := namespaceById[result[i].Id]
+			if !ok {
+				continue
 			}
-			response[i].Result = responses.NewNamespace(*namespace)
+			item.Result = responses.NewNamespace(namespace)
 		case "celestial":
-			address, err := handler.address.GetByID(ctx, result[i].Id)
-			if err != nil {
-				return nil, err
+			address, ok := addressById[result[i].Id]
+			if !ok {
+				continue
 			}
-			addr := responses.NewAddress(*address)
+			addr := responses.NewAddress(address)
 
 			celestial, err := handler.celestials.ById(ctx, result[i].Value)
 			if err != nil {
+				if handler.celestials.IsNoRows(err) {
+					continue
+				}
 				return nil, err
 			}
 			addr.AddCelestails(&celestial)
 
-			response[i].Result = addr
-			response[i].Type = "address"
+			item.Result = addr
+			item.Type = "address"
+		case "label":
+			address, ok := addressById[result[i].Id]
+			if !ok {
+				continue
+			}
+			label, ok := labelByAddressId[result[i].Id]
+			if !ok {
+				continue
+			}
+			addr := responses.NewAddress(address)
+			addr.AddLabel(&label)
+
+			item.Result = addr
+			item.Type = "address"
 		default:
-			return nil, errors.Errorf("unknown search text type: %s", response[i].Type)
+			return nil, errors.Errorf("unknown search text type: %s", result[i].Type)
 		}
+		response = append(response, item)
 	}
 
+	found := make(map[string]struct{}, len(result))
+	for i := range result {
+		found[searchKey(result[i].Type, result[i].Id)] = struct{}{}
+	}
+	fullText, err := handler.fullText(ctx, text, nil, found)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(response, fullText...), nil
+}
+
+// fullText - returns ranked documents matched by full-text search with highlighted snippets. Documents from `skip` are omitted.
+// Memos of transactions and IBC transfers are searched only if their types are passed explicitly.
+func (handler SearchHandler) fullText(ctx context.Context, text string, types []string, skip map[string]struct{}) ([]responses.SearchItem, error) {
+	result, err := handler.search.FullText(ctx, storage.FullTextFilters{
+		Query: text,
+		Types: types,
+		Limit: 10,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string][]uint64)
+	for i := range result {
+		if _, ok := skip[searchKey(result[i].Type, result[i].Id)]; ok {
+			continue
+		}
+		ids[result[i].Type] = append(ids[result[i].Type], result[i].Id)
+	}
+
+	entities := make(map[string]any, len(result))
+	if len(ids[storage.SearchTypeProposal]) > 0 {
+		proposals, err := handler.proposals.ByIds(ctx, ids[storage.SearchTypeProposal]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range proposals {
+			entities[searchKey(storage.SearchTypeProposal, proposals[i].Id)] = responses.NewProposal(proposals[i])
+		}
+	}
+	if len(ids[storage.SearchTypeRollup]) > 0 {
+		rollups, err := handler.rollup.GetByIds(ctx, ids[storage.SearchTypeRollup]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rollups {
+			entities[searchKey(storage.SearchTypeRollup, rollups[i].Id)] = responses.NewRollup(&rollups[i])
+		}
+	}
+	if len(ids[storage.SearchTypeValidator]) > 0 {
+		validators, err := handler.validator.GetByIds(ctx, ids[storage.SearchTypeValidator]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range validators {
+			entities[searchKey(storage.SearchTypeValidator, validators[i].Id)] = responses.NewValidator(validators[i])
+		}
+	}
+	if len(ids[storage.SearchTypeTx]) > 0 {
+		txs, err := handler.tx.GetByIds(ctx, ids[storage.SearchTypeTx]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range txs {
+			entities[searchKey(storage.SearchTypeTx, txs[i].Id)] = responses.NewTx(txs[i])
+		}
+	}
+	if len(ids[storage.SearchTypeIbcTransfer]) > 0 {
+		transfers, err := handler.ibcTransfers.ByIds(ctx, ids[storage.SearchTypeIbcTransfer]...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range transfers {
+			entities[searchKey(storage.SearchTypeIbcTransfer, transfers[i].Id)] = responses.NewIbcTransfer(transfers[i].IbcTransfer)
+		}
+	}
+
+	response := make([]responses.SearchItem, 0, len(result))
+	for i := range result {
+		entity, ok := entities[searchKey(result[i].Type, result[i].Id)]
+		if !ok {
+			continue
+		}
+		response = append(response, responses.SearchItem{
+			Type:    result[i].Type,
+			Rank:    result[i].Rank,
+			Snippet: result[i].Snippet,
+			Result:  entity,
+		})
+	}
 	return response, nil
 }
+
+func searchKey(typ string, id uint64) string {
+	return fmt.Sprintf("%s_%d", typ, id)
+}
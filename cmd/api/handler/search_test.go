@@ -15,6 +15,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	celestials "github.com/celenium-io/celestial-module/pkg/storage"
@@ -35,6 +36,9 @@ type SearchTestSuite struct {
 	validator *mock.MockIValidator
 	search    *mock.MockISearch
 	rollup    *mock.MockIRollup
+	labels    *mock.MockIAddressLabel
+	proposals *mock.MockIProposal
+	ibc       *mock.MockIIbcTransfer
 	celestial *celestialMock.MockICelestial
 
 	echo    *echo.Echo
@@ -54,8 +58,11 @@ func (s *SearchTestSuite) SetupSuite() {
 	s.search = mock.NewMockISearch(s.ctrl)
 	s.validator = mock.NewMockIValidator(s.ctrl)
 	s.rollup = mock.NewMockIRollup(s.ctrl)
+	s.labels = mock.NewMockIAddressLabel(s.ctrl)
+	s.proposals = mock.NewMockIProposal(s.ctrl)
+	s.ibc = mock.NewMockIIbcTransfer(s.ctrl)
 	s.celestial = celestialMock.NewMockICelestial(s.ctrl)
-	s.handler = NewSearchHandler(s.search, s.address, s.block, s.tx, s.namespace, s.validator, s.rollup, s.celestial)
+	s.handler = NewSearchHandler(s.search, s.address, s.block, s.tx, s.namespace, s.validator, s.rollup, s.labels, s.proposals, s.ibc, s.celestial)
 }
 
 // TearDownSuite -
@@ -158,6 +165,11 @@ func (s *SearchTestSuite) TestSearchBlockByHeight() {
 		Return([]storage.SearchResult{}, nil).
 		Times(1)
 
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Search(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -229,6 +241,11 @@ func (s *SearchTestSuite) TestSearchBlockWithInvalidHash() {
 		Return([]storage.SearchResult{}, nil).
 		Times(1)
 
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Search(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 }
@@ -344,12 +361,19 @@ func (s *SearchTestSuite) TestSearchValidator() {
 		}, nil).
 		Times(1)
 
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
 	s.validator.EXPECT().
-		GetByID(gomock.Any(), uint64(1)).
-		Return(&storage.Validator{
-			Moniker: "name 1",
-			Id:      1,
-			Jailed:  testsuite.Ptr(false),
+		GetByIds(gomock.Any(), uint64(1)).
+		Return([]storage.Validator{
+			{
+				Moniker: "name 1",
+				Id:      1,
+				Jailed:  testsuite.Ptr(false),
+			},
 		}, nil).
 		Times(1)
 
@@ -386,11 +410,18 @@ func (s *SearchTestSuite) TestSearchRollup() {
 		}, nil).
 		Times(1)
 
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
 	s.rollup.EXPECT().
-		GetByID(gomock.Any(), uint64(1)).
-		Return(&storage.Rollup{
-			Name: "name 1",
-			Id:   1,
+		GetByIds(gomock.Any(), uint64(1)).
+		Return([]storage.Rollup{
+			{
+				Name: "name 1",
+				Id:   1,
+			},
 		}, nil).
 		Times(1)
 
@@ -427,12 +458,19 @@ func (s *SearchTestSuite) TestSearchTextNamespace() {
 		}, nil).
 		Times(1)
 
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
 	s.namespace.EXPECT().
-		GetByID(gomock.Any(), uint64(1)).
-		Return(&storage.Namespace{
-			NamespaceID: testsuite.MustHexDecode("5f45"),
-			Version:     1,
-			Id:          1,
+		GetByIds(gomock.Any(), uint64(1)).
+		Return([]storage.Namespace{
+			{
+				NamespaceID: testsuite.MustHexDecode("5f45"),
+				Version:     1,
+				Id:          1,
+			},
 		}, nil).
 		Times(1)
 
@@ -469,14 +507,21 @@ func (s *SearchTestSuite) TestSearchCelestial() {
 		}, nil).
 		Times(1)
 
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
 	s.address.EXPECT().
-		GetByID(gomock.Any(), uint64(1)).
-		Return(&storage.Address{
-			Id:         1,
-			Hash:       testHashAddress,
-			Address:    testAddress,
-			Height:     100,
-			LastHeight: 100,
+		GetByIds(gomock.Any(), uint64(1)).
+		Return([]storage.Address{
+			{
+				Id:         1,
+				Hash:       testHashAddress,
+				Address:    testAddress,
+				Height:     100,
+				LastHeight: 100,
+			},
 		}, nil).
 		Times(1)
 
@@ -501,6 +546,204 @@ func (s *SearchTestSuite) TestSearchCelestial() {
 	s.Require().NotNil(response.Result)
 }
 
+func (s *SearchTestSuite) TestSearchLabel() {
+	q := make(url.Values)
+	q.Set("query", "hot wallet")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/search")
+
+	s.search.EXPECT().
+		SearchText(gomock.Any(), "hot wallet").
+		Return([]storage.SearchResult{
+			{
+				Id:    1,
+				Type:  "label",
+				Value: "Binance hot wallet",
+			},
+		}, nil).
+		Times(1)
+
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		GetByIds(gomock.Any(), uint64(1)).
+		Return([]storage.Address{
+			{
+				Id:         1,
+				Hash:       testHashAddress,
+				Address:    testAddress,
+				Height:     100,
+				LastHeight: 100,
+			},
+		}, nil).
+		Times(1)
+
+	s.labels.EXPECT().
+		ByAddressIds(gomock.Any(), uint64(1)).
+		Return([]storage.AddressLabel{
+			{
+				Id:        1,
+				AddressId: 1,
+				Name:      "Binance hot wallet",
+				Category:  storageTypes.AddressLabelCategoryExchange,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Search(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []struct {
+		Type   string            `json:"type"`
+		Result responses.Address `json:"result"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+
+	response := items[0]
+	s.Require().Equal("address", response.Type)
+	s.Require().Equal(testAddress, response.Result.Hash)
+	s.Require().NotNil(response.Result.Label)
+	s.Require().Equal("Binance hot wallet", response.Result.Label.Name)
+	s.Require().Equal("exchange", response.Result.Label.Category)
+}
+
+func (s *SearchTestSuite) TestSearchFullText() {
+	q := make(url.Values)
+	q.Set("query", "blob size")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/search")
+
+	s.search.EXPECT().
+		SearchText(gomock.Any(), "blob size").
+		Return([]storage.SearchResult{
+			{
+				Id:    1,
+				Type:  "rollup",
+				Value: "blob size rollup",
+			},
+		}, nil).
+		Times(1)
+
+	s.rollup.EXPECT().
+		GetByIds(gomock.Any(), uint64(1)).
+		Return([]storage.Rollup{testRollup}, nil).
+		Times(1)
+
+	s.search.EXPECT().
+		FullText(gomock.Any(), storage.FullTextFilters{
+			Query: "blob size",
+			Limit: 10,
+		}).
+		Return([]storage.FullTextResult{
+			{
+				Id:      1,
+				Type:    storage.SearchTypeRollup,
+				Rank:    0.2,
+				Snippet: "<b>blob</b> <b>size</b> rollup",
+			},
+			{
+				Id:      2,
+				Type:    storage.SearchTypeProposal,
+				Rank:    0.1,
+				Snippet: "increase max <b>blob</b> <b>size</b>",
+			},
+		}, nil).
+		Times(1)
+
+	s.proposals.EXPECT().
+		ByIds(gomock.Any(), uint64(2)).
+		Return([]storage.Proposal{
+			{
+				Id:     2,
+				Title:  "Increase max blob size",
+				Status: storageTypes.ProposalStatusApplied,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Search(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.SearchItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().Equal("rollup", items[0].Type)
+	s.Require().Empty(items[0].Snippet)
+
+	s.Require().Equal("proposal", items[1].Type)
+	s.Require().EqualValues(0.1, items[1].Rank)
+	s.Require().Equal("increase max <b>blob</b> <b>size</b>", items[1].Snippet)
+}
+
+func (s *SearchTestSuite) TestSearchFullTextByTypes() {
+	q := make(url.Values)
+	q.Set("query", "airdrop")
+	q.Set("types", "tx,ibc_transfer")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/search")
+
+	s.search.EXPECT().
+		FullText(gomock.Any(), storage.FullTextFilters{
+			Query: "airdrop",
+			Types: []string{"tx", "ibc_transfer"},
+			Limit: 10,
+		}).
+		Return([]storage.FullTextResult{
+			{
+				Id:      1,
+				Type:    storage.SearchTypeTx,
+				Rank:    0.06,
+				Snippet: "<b>airdrop</b> claim",
+			},
+		}, nil).
+		Times(1)
+
+	s.tx.EXPECT().
+		GetByIds(gomock.Any(), uint64(1)).
+		Return([]storage.Tx{testTx}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Search(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.SearchItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Equal("tx", items[0].Type)
+	s.Require().Equal("<b>airdrop</b> claim", items[0].Snippet)
+}
+
+func (s *SearchTestSuite) TestSearchFullTextInvalidType() {
+	q := make(url.Values)
+	q.Set("query", "airdrop")
+	q.Set("types", "block")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/search")
+
+	s.Require().NoError(s.handler.Search(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *SearchTestSuite) TestSearchNoResult() {
 	q := make(url.Values)
 	q.Set("query", "unknown")
@@ -515,6 +758,11 @@ func (s *SearchTestSuite) TestSearchNoResult() {
 		Return([]storage.SearchResult{}, nil).
 		Times(1)
 
+	s.search.EXPECT().
+		FullText(gomock.Any(), gomock.Any()).
+		Return([]storage.FullTextResult{}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Search(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 }
@@ -798,3 +798,42 @@ func (sh StatsHandler) HlByDomain(c echo.Context) error {
 	}
 	return returnArray(c, response)
 }
+
+type hlLatencyRequest struct {
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// HlLatency godoc
+//
+//	@Summary		Get hyperlane message delivery latency by domain
+//	@Description	Returns delivery latency percentiles (p50, p90, p99 in seconds) of Hyperlane messages grouped by counterparty domain and direction, with counts of delivered and still pending messages. By default only the last 30 days are taken into account.
+//	@Tags			stats
+//	@ID				stats-hl-latency
+//	@Param			from	query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.HlDomainLatency
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/stats/hyperlane/latency [get]
+func (sh StatsHandler) HlLatency(c echo.Context) error {
+	req, err := bindAndValidate[hlLatencyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	from := time.Now().UTC().AddDate(0, 0, -30)
+	if req.From > 0 {
+		from = time.Unix(req.From, 0).UTC()
+	}
+
+	stats, err := sh.hyperlane.LatencyByDomain(c.Request().Context(), from)
+	if err != nil {
+		return handleError(c, err, sh.nsRepo)
+	}
+
+	response := make([]responses.HlDomainLatency, len(stats))
+	for i := range stats {
+		response[i] = responses.NewHlDomainLatency(stats[i], sh.chainStore)
+	}
+	return returnArray(c, response)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/cmd/api/hyperlane"
@@ -845,6 +846,67 @@ func (s *StatsTestSuite) TestHlTotalSeries() {
 	}
 }
 
+func (s *StatsTestSuite) TestHlLatency() {
+	q := make(url.Values)
+	q.Set("from", "1692892095")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/hyperlane/latency")
+
+	s.chainStore.EXPECT().
+		Get(uint64(1)).
+		Return(testChainMetadata, true).
+		Times(1)
+
+	s.hyperlane.EXPECT().
+		LatencyByDomain(gomock.Any(), time.Unix(1692892095, 0).UTC()).
+		Return([]storage.DomainLatency{
+			{
+				Domain:      1,
+				Type:        storageTypes.HLTransferTypeSend,
+				Delivered:   100,
+				Undelivered: 2,
+				P50:         61.5,
+				P90:         120,
+				P99:         600.25,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.HlLatency(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response []responses.HlDomainLatency
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Len(response, 1)
+
+	result := response[0]
+	s.Require().EqualValues(1, result.Domain)
+	s.Require().EqualValues("send", result.Type)
+	s.Require().EqualValues(100, result.Delivered)
+	s.Require().EqualValues(2, result.Undelivered)
+	s.Require().EqualValues(61.5, result.P50)
+	s.Require().EqualValues(120, result.P90)
+	s.Require().EqualValues(600.25, result.P99)
+	s.Require().NotNil(result.ChainMetadata)
+	s.Require().EqualValues(testChainMetadata.DisplayName, result.ChainMetadata.Name)
+}
+
+func (s *StatsTestSuite) TestHlLatencyInvalidFrom() {
+	q := make(url.Values)
+	q.Set("from", "-1")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/hyperlane/latency")
+
+	s.Require().NoError(s.handler.HlLatency(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *StatsTestSuite) TestStakingSeries() {
 	for _, name := range []string{
 		storage.SeriesDelegations,
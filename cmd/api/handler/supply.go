@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/currency"
+	"github.com/celenium-io/celestia-indexer/internal/math"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+const maxForecastBuckets = 1000
+
+type SupplyHandler struct {
+	supply      storage.ISupply
+	vestings    storage.IVestingAccount
+	blocks      storage.IBlock
+	state       storage.IState
+	indexerName string
+}
+
+func NewSupplyHandler(
+	supply storage.ISupply,
+	vestings storage.IVestingAccount,
+	blocks storage.IBlock,
+	state storage.IState,
+	indexerName string,
+) *SupplyHandler {
+	return &SupplyHandler{
+		supply:      supply,
+		vestings:    vestings,
+		blocks:      blocks,
+		state:       state,
+		indexerName: indexerName,
+	}
+}
+
+// Get godoc
+//
+//	@Summary		Get supply breakdown
+//	@Description	Returns total, circulating, staked, unbonding, vesting locked and community pool amounts at the last indexed block.
+//	@Description	Circulating supply is the total supply without tokens locked in vesting accounts.
+//	@Tags			stats
+//	@ID				stats-supply
+//	@Produce		json
+//	@Success		200	{object}	responses.Supply
+//	@Failure		500	{object}	Error
+//	@Router			/stats/supply [get]
+func (handler *SupplyHandler) Get(c echo.Context) error {
+	supply, err := handler.supply.Last(c.Request().Context())
+	if err != nil {
+		return handleError(c, err, handler.supply)
+	}
+	return c.JSON(http.StatusOK, responses.NewSupply(supply))
+}
+
+// Circulating godoc
+//
+//	@Summary		Get circulating supply
+//	@Description	Returns circulating supply in TIA as plain text. The format is suitable for market data aggregators.
+//	@Tags			stats
+//	@ID				stats-supply-circulating
+//	@Produce		plain
+//	@Success		200	{string}	string	"123456789.123456"
+//	@Failure		500	{object}	Error
+//	@Router			/stats/supply/circulating [get]
+func (handler *SupplyHandler) Circulating(c echo.Context) error {
+	supply, err := handler.supply.Last(c.Request().Context())
+	if err != nil {
+		return handleError(c, err, handler.supply)
+	}
+	return c.String(http.StatusOK, currency.StringTiaFromUtia(supply.Circulating.Decimal))
+}
+
+type supplySeriesRequest struct {
+	Timeframe storage.Timeframe `example:"hour"       param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day week month"`
+	From      int64             `example:"1692892095" query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64             `example:"1692892095" query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// Series godoc
+//
+//	@Summary		Get supply history
+//	@Description	Returns supply breakdown at the end of each time bucket
+//	@Tags			stats
+//	@ID				stats-supply-series
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, week, month)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Supply
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/stats/supply/series/{timeframe} [get]
+func (handler *SupplyHandler) Series(c echo.Context) error {
+	req, err := bindAndValidate[supplySeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	items, err := handler.supply.Series(c.Request().Context(), req.Timeframe, storage.NewSeriesRequest(req.From, req.To))
+	if err != nil {
+		return handleError(c, err, handler.supply)
+	}
+
+	response := make([]responses.Supply, len(items))
+	for i := range items {
+		response[i] = responses.NewSupply(items[i])
+	}
+	return returnArray(c, response)
+}
+
+type supplyForecastRequest struct {
+	Timeframe storage.Timeframe `example:"month"      query:"timeframe" swaggertype:"string"  validate:"omitempty,oneof=day week month"`
+	From      int64             `example:"1692892095" query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64             `example:"1692892095" query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (req *supplyForecastRequest) SetDefault() {
+	if req.Timeframe == "" {
+		req.Timeframe = storage.TimeframeMonth
+	}
+}
+
+// Forecast godoc
+//
+//	@Summary		Get supply forecast
+//	@Description	Returns unlock calendar of all vesting accounts aggregated by time buckets together with projection of total and circulating supply.
+//	@Description	Past buckets contain unlocked and still locked amounts. Future buckets are projected: minted tokens are computed from the current inflation rate which decreases by 6.7% each year since genesis down to 1.5%.
+//	@Description	Circulating supply is the total supply without tokens locked in vesting accounts. By default the range is from a year ago to two years ahead.
+//	@Tags			stats
+//	@ID				stats-supply-forecast
+//	@Param			timeframe	query	string	false	"Timeframe"						Enums(day, week, month)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.SupplyForecast
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/stats/supply/forecast [get]
+func (handler *SupplyHandler) Forecast(c echo.Context) error {
+	req, err := bindAndValidate[supplyForecastRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	ctx := c.Request().Context()
+	state, err := handler.state.ByName(ctx, handler.indexerName)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+	now := state.LastTime.UTC()
+
+	seriesRequest := storage.NewSeriesRequest(req.From, req.To)
+	if seriesRequest.From.IsZero() {
+		seriesRequest.From = now.AddDate(-1, 0, 0)
+	}
+	if seriesRequest.To.IsZero() {
+		seriesRequest.To = now.AddDate(2, 0, 0)
+	}
+	if !seriesRequest.From.Before(seriesRequest.To) {
+		return badRequestError(c, errors.New("'from' should be less than 'to'"))
+	}
+
+	buckets := forecastBuckets(req.Timeframe, seriesRequest.From, seriesRequest.To)
+	if len(buckets) > maxForecastBuckets {
+		return badRequestError(c, errors.Errorf("too many buckets in the range: %d. Use wider timeframe or narrower range", len(buckets)))
+	}
+	seriesRequest.From = buckets[0]
+
+	block, err := handler.blocks.ByHeightWithStats(ctx, state.LastHeight)
+	if err != nil {
+		return handleError(c, err, handler.blocks)
+	}
+	// genesis block is stored with zero height
+	genesis, err := handler.blocks.Time(ctx, 0)
+	if err != nil {
+		return handleError(c, err, handler.blocks)
+	}
+
+	unlocks, err := handler.vestings.UnlockSeries(ctx, req.Timeframe, seriesRequest)
+	if err != nil {
+		return handleError(c, err, handler.vestings)
+	}
+	locked, err := handler.vestings.Locked(ctx, seriesRequest.From)
+	if err != nil {
+		return handleError(c, err, handler.vestings)
+	}
+	lockedNow, err := handler.vestings.Locked(ctx, now)
+	if err != nil {
+		return handleError(c, err, handler.vestings)
+	}
+
+	unlocked := make(map[time.Time]types.Numeric, len(unlocks))
+	for i := range unlocks {
+		unlocked[unlocks[i].Bucket.UTC()] = unlocks[i].Amount
+	}
+
+	response := responses.SupplyForecast{
+		TotalSupply:       state.TotalSupply.String(),
+		CirculatingSupply: storage.CirculatingSupply(state.TotalSupply, lockedNow).String(),
+		Locked:            lockedNow.String(),
+		InflationRate:     block.Stats.InflationRate.String(),
+		Series:            make([]responses.SupplyForecastItem, len(buckets)),
+	}
+
+	projection := math.NewSupplyProjection(genesis, now, state.TotalSupply.Decimal, block.Stats.InflationRate.Decimal)
+	for i := range buckets {
+		amount, ok := unlocked[buckets[i]]
+		if !ok {
+			amount = types.NumericZero()
+		}
+		locked = locked.Sub(amount)
+		if locked.IsNegative() {
+			locked = types.NumericZero()
+		}
+
+		item := responses.SupplyForecastItem{
+			Time:     buckets[i],
+			Unlocked: amount.String(),
+			Locked:   locked.String(),
+		}
+
+		end := nextForecastBucket(req.Timeframe, buckets[i])
+		if end.After(now) {
+			minted := projection.Mint(end)
+			supply := types.NewNumeric(projection.Supply())
+			item.Projected = true
+			item.Minted = minted.String()
+			item.TotalSupply = supply.String()
+			item.CirculatingSupply = storage.CirculatingSupply(supply, locked).String()
+			item.InflationRate = projection.Rate().String()
+		}
+		response.Series[i] = item
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// forecastBuckets - returns start times of the buckets covering the range. Buckets are aligned the same way as time_bucket does it:
+// days to midnight, weeks to Monday and months to the first day of month.
+func forecastBuckets(timeframe storage.Timeframe, from, to time.Time) []time.Time {
+	var start time.Time
+	switch timeframe {
+	case storage.TimeframeDay:
+		start = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	case storage.TimeframeWeek:
+		start = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+	default:
+		start = time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	buckets := make([]time.Time, 0)
+	for bucket := start; bucket.Before(to) && len(buckets) <= maxForecastBuckets; bucket = nextForecastBucket(timeframe, bucket) {
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+func nextForecastBucket(timeframe storage.Timeframe, bucket time.Time) time.Time {
+	switch timeframe {
+	case storage.TimeframeDay:
+		return bucket.AddDate(0, 0, 1)
+	case storage.TimeframeWeek:
+		return bucket.AddDate(0, 0, 7)
+	default:
+		return bucket.AddDate(0, 1, 0)
+	}
+}
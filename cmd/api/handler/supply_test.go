@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// SupplyTestSuite -
+type SupplyTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	supply   *mock.MockISupply
+	vestings *mock.MockIVestingAccount
+	blocks   *mock.MockIBlock
+	state    *mock.MockIState
+	handler  *SupplyHandler
+	ctrl     *gomock.Controller
+}
+
+// SetupSuite -
+func (s *SupplyTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.supply = mock.NewMockISupply(s.ctrl)
+	s.vestings = mock.NewMockIVestingAccount(s.ctrl)
+	s.blocks = mock.NewMockIBlock(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+	s.handler = NewSupplyHandler(s.supply, s.vestings, s.blocks, s.state, testIndexerName)
+}
+
+// TearDownSuite -
+func (s *SupplyTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(s.T().Context()))
+}
+
+func TestSuiteSupply_Run(t *testing.T) {
+	suite.Run(t, new(SupplyTestSuite))
+}
+
+func testSupply() storage.Supply {
+	return storage.Supply{
+		Height:        100,
+		Time:          testTime,
+		Total:         storageTypes.NumericFromInt64(1_000_000_000_000),
+		Circulating:   storageTypes.NumericFromInt64(900_123_456_789),
+		Staked:        storageTypes.NumericFromInt64(600_000_000_000),
+		Unbonding:     storageTypes.NumericFromInt64(1_000_000),
+		VestingLocked: storageTypes.NumericFromInt64(90_000_000_000),
+		CommunityPool: storageTypes.NumericFromInt64(9_876_543_211),
+	}
+}
+
+func (s *SupplyTestSuite) TestGet() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/supply")
+
+	s.supply.EXPECT().
+		Last(gomock.Any()).
+		Return(testSupply(), nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.Supply
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, response.Height)
+	s.Require().Equal(testTime, response.Time)
+	s.Require().Equal("1000000000000", response.Total)
+	s.Require().Equal("900123456789", response.Circulating)
+	s.Require().Equal("600000000000", response.Staked)
+	s.Require().Equal("1000000", response.Unbonding)
+	s.Require().Equal("90000000000", response.VestingLocked)
+	s.Require().Equal("9876543211", response.CommunityPool)
+}
+
+func (s *SupplyTestSuite) TestCirculating() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/supply/circulating")
+
+	s.supply.EXPECT().
+		Last(gomock.Any()).
+		Return(testSupply(), nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Circulating(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Contains(rec.Header().Get(echo.HeaderContentType), echo.MIMETextPlain)
+	s.Require().Equal("900123.456789", rec.Body.String())
+}
+
+func (s *SupplyTestSuite) TestSeries() {
+	q := make(url.Values)
+	q.Set("from", "1692892095")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/supply/series/:timeframe")
+	c.SetParamNames("timeframe")
+	c.SetParamValues("day")
+
+	s.supply.EXPECT().
+		Series(gomock.Any(), storage.TimeframeDay, storage.SeriesRequest{
+			From: time.Unix(1692892095, 0).UTC(),
+		}).
+		Return([]storage.Supply{testSupply()}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Series(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response []responses.Supply
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Len(response, 1)
+	s.Require().Equal("900123456789", response[0].Circulating)
+}
+
+func (s *SupplyTestSuite) TestSeriesInvalidTimeframe() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/supply/series/:timeframe")
+	c.SetParamNames("timeframe")
+	c.SetParamValues("year")
+
+	s.Require().NoError(s.handler.Series(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *SupplyTestSuite) TestForecast() {
+	now := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	from := time.Date(2023, 11, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	q := make(url.Values)
+	q.Set("timeframe", "month")
+	q.Set("from", strconv.FormatInt(from.Unix(), 10))
+	q.Set("to", strconv.FormatInt(to.Unix(), 10))
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/supply/forecast")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			LastHeight:  100,
+			LastTime:    now,
+			TotalSupply: storageTypes.NumericFromInt64(1_000_000_000),
+		}, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		ByHeightWithStats(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.Block{
+			Height: 100,
+			Stats: storage.BlockStats{
+				InflationRate: storageTypes.MustNumericFromString("0.05"),
+			},
+		}, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		Time(gomock.Any(), pkgTypes.Level(0)).
+		Return(time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC), nil).
+		Times(1)
+
+	s.vestings.EXPECT().
+		UnlockSeries(gomock.Any(), storage.TimeframeMonth, storage.SeriesRequest{
+			From: time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+			To:   to,
+		}).
+		Return([]storage.VestingUnlockItem{
+			{
+				Bucket: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+				Amount: storageTypes.NumericFromInt64(1000),
+			}, {
+				Bucket: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				Amount: storageTypes.NumericFromInt64(2000),
+			},
+		}, nil).
+		Times(1)
+
+	s.vestings.EXPECT().
+		Locked(gomock.Any(), time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)).
+		Return(storageTypes.NumericFromInt64(10000), nil).
+		Times(1)
+
+	s.vestings.EXPECT().
+		Locked(gomock.Any(), now).
+		Return(storageTypes.NumericFromInt64(9000), nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Forecast(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response responses.SupplyForecast
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal("1000000000", response.TotalSupply)
+	s.Require().Equal("999991000", response.CirculatingSupply)
+	s.Require().Equal("9000", response.Locked)
+	s.Require().Equal("0.05", response.InflationRate)
+	s.Require().Len(response.Series, 4)
+
+	s.Require().Equal("0", response.Series[0].Unlocked)
+	s.Require().Equal("10000", response.Series[0].Locked)
+	s.Require().False(response.Series[0].Projected)
+	s.Require().Empty(response.Series[0].TotalSupply)
+
+	s.Require().Equal("1000", response.Series[1].Unlocked)
+	s.Require().Equal("9000", response.Series[1].Locked)
+	s.Require().False(response.Series[1].Projected)
+
+	s.Require().Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), response.Series[2].Time)
+	s.Require().True(response.Series[2].Projected)
+	s.Require().Equal("9000", response.Series[2].Locked)
+	s.Require().NotEmpty(response.Series[2].Minted)
+	s.Require().Equal("0.05", response.Series[2].InflationRate)
+
+	s.Require().Equal("2000", response.Series[3].Unlocked)
+	s.Require().Equal("7000", response.Series[3].Locked)
+	s.Require().True(response.Series[3].Projected)
+
+	supply, err := storageTypes.NumericFromString(response.Series[3].TotalSupply)
+	s.Require().NoError(err)
+	circulating, err := storageTypes.NumericFromString(response.Series[3].CirculatingSupply)
+	s.Require().NoError(err)
+	s.Require().True(supply.GreaterThan(storageTypes.NumericFromInt64(1_000_000_000)))
+	s.Require().Equal(supply.Sub(storageTypes.NumericFromInt64(7000)).String(), circulating.String())
+}
+
+func (s *SupplyTestSuite) TestForecastTooManyBuckets() {
+	q := make(url.Values)
+	q.Set("timeframe", "day")
+	q.Set("from", strconv.FormatInt(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), 10))
+	q.Set("to", strconv.FormatInt(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), 10))
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/supply/forecast")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			LastHeight: 100,
+			LastTime:   testTime,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Forecast(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *SupplyTestSuite) TestForecastInvalidTimeframe() {
+	q := make(url.Values)
+	q.Set("timeframe", "hour")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/supply/forecast")
+
+	s.Require().NoError(s.handler.Forecast(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
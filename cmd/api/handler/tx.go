@@ -22,6 +22,7 @@ type TxHandler struct {
 	messages    storage.IMessage
 	namespaces  storage.INamespace
 	blobLogs    storage.IBlobLog
+	labels      storage.IAddressLabel
 	state       storage.IState
 	indexerName string
 }
@@ -33,6 +34,7 @@ func NewTxHandler(
 	messages storage.IMessage,
 	namespaces storage.INamespace,
 	blobLogs storage.IBlobLog,
+	labels storage.IAddressLabel,
 	state storage.IState,
 	indexerName string,
 ) *TxHandler {
@@ -43,6 +45,7 @@ func NewTxHandler(
 		messages:    messages,
 		namespaces:  namespaces,
 		blobLogs:    blobLogs,
+		labels:      labels,
 		state:       state,
 		indexerName: indexerName,
 	}
@@ -274,9 +277,26 @@ func (handler *TxHandler) GetMessages(c echo.Context) error {
 	if err != nil {
 		return handleError(c, err, handler.tx)
 	}
+
+	ids := make([]uint64, len(messages))
+	for i := range messages {
+		ids[i] = messages[i].Id
+	}
+	labels, err := handler.labels.ByMessages(c.Request().Context(), ids...)
+	if err != nil {
+		return handleError(c, err, handler.labels)
+	}
+
 	response := make([]responses.Message, len(messages))
+	index := make(map[uint64]int, len(messages))
 	for i := range messages {
 		response[i] = responses.NewMessage(messages[i])
+		index[messages[i].Id] = i
+	}
+	for i := range labels {
+		if idx, ok := index[labels[i].MsgId]; ok {
+			response[idx].AddLabel(labels[i])
+		}
 	}
 	return returnArray(c, response)
 }
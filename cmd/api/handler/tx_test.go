@@ -67,6 +67,7 @@ type TxTestSuite struct {
 	messages  *mock.MockIMessage
 	namespace *mock.MockINamespace
 	blobLogs  *mock.MockIBlobLog
+	labels    *mock.MockIAddressLabel
 	state     *mock.MockIState
 	echo      *echo.Echo
 	handler   *TxHandler
@@ -85,7 +86,8 @@ func (s *TxTestSuite) SetupSuite() {
 	s.blobLogs = mock.NewMockIBlobLog(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
 	s.messages = mock.NewMockIMessage(s.ctrl)
-	s.handler = NewTxHandler(s.tx, s.blocks, s.events, s.messages, s.namespace, s.blobLogs, s.state, testIndexerName)
+	s.labels = mock.NewMockIAddressLabel(s.ctrl)
+	s.handler = NewTxHandler(s.tx, s.blocks, s.events, s.messages, s.namespace, s.blobLogs, s.labels, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -587,6 +589,17 @@ func (s *TxTestSuite) TestGetMessage() {
 			},
 		}, nil)
 
+	s.labels.EXPECT().
+		ByMessages(gomock.Any(), uint64(1)).
+		Return([]storage.MessageLabel{
+			{
+				MsgId:    1,
+				Address:  testAddress,
+				Name:     "Binance hot wallet",
+				Category: types.AddressLabelCategoryExchange,
+			},
+		}, nil)
+
 	s.Require().NoError(s.handler.GetMessages(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -600,6 +613,9 @@ func (s *TxTestSuite) TestGetMessage() {
 	s.Require().Equal(testTime, msgs[0].Time)
 	s.Require().EqualValues(1, msgs[0].TxId)
 	s.Require().EqualValues(string(types.MsgBeginRedelegate), msgs[0].Type)
+	s.Require().Contains(msgs[0].Labels, testAddress)
+	s.Require().Equal("Binance hot wallet", msgs[0].Labels[testAddress].Name)
+	s.Require().Equal("exchange", msgs[0].Labels[testAddress].Category)
 }
 
 func (s *TxTestSuite) TestCount() {
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/cmd/api/quota"
+	"github.com/labstack/echo/v4"
+)
+
+type UsageHandler struct {
+	meter quota.IMeter
+}
+
+func NewUsageHandler(meter quota.IMeter) *UsageHandler {
+	return &UsageHandler{
+		meter: meter,
+	}
+}
+
+type usageRequest struct {
+	Days int `query:"days" validate:"omitempty,min=1,max=30"`
+}
+
+// Get godoc
+//
+//	@Summary		Get usage of the api key
+//	@Description	Returns tier and limits of the api key passed in `apikey` header and count of requests per day and per route. Days are sorted from today to the past, day is counted in UTC. Requests rejected by rate limit or daily quota are not counted. Usage is kept for 30 days.
+//	@Tags			usage
+//	@ID				get-usage
+//	@Param			days	query	integer	false	"Count of days including today. Default: 1"	minimum(1)	maximum(30)
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	responses.Usage
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/usage [get]
+func (handler *UsageHandler) Get(c echo.Context) error {
+	apiKey, ok := quota.ApiKey(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, Error{
+			Message: "api key is required",
+		})
+	}
+
+	req, err := bindAndValidate[usageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Days == 0 {
+		req.Days = 1
+	}
+
+	usage, err := handler.meter.Usage(c.Request().Context(), apiKey, req.Days)
+	if err != nil {
+		return internalServerError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewUsage(apiKey, handler.meter.Limits(apiKey), usage))
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/cmd/api/quota"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testUsageApiKey = storage.ApiKey{
+	Key:  "test_key",
+	Tier: types.ApiKeyTierBasic,
+}
+
+// UsageTestSuite -
+type UsageTestSuite struct {
+	suite.Suite
+	meter   *quota.MockIMeter
+	echo    *echo.Echo
+	handler *UsageHandler
+	ctrl    *gomock.Controller
+}
+
+// SetupSuite -
+func (s *UsageTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.meter = quota.NewMockIMeter(s.ctrl)
+	s.handler = NewUsageHandler(s.meter)
+}
+
+// TearDownSuite -
+func (s *UsageTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(s.T().Context()))
+}
+
+func TestSuiteUsage_Run(t *testing.T) {
+	suite.Run(t, new(UsageTestSuite))
+}
+
+func (s *UsageTestSuite) TestGet() {
+	q := make(url.Values)
+	q.Set("days", "2")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/usage")
+	c.Set(quota.ContextKey, testUsageApiKey)
+
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	s.meter.EXPECT().
+		Usage(gomock.Any(), testUsageApiKey, 2).
+		Return([]quota.DayUsage{
+			{
+				Date:  today,
+				Total: 12,
+				Routes: map[string]int64{
+					"/v1/tx/:hash":      2,
+					"/v1/block/:height": 10,
+				},
+			}, {
+				Date:   today.AddDate(0, 0, -1),
+				Routes: map[string]int64{},
+			},
+		}, nil).
+		Times(1)
+	s.meter.EXPECT().
+		Limits(testUsageApiKey).
+		Return(quota.Tier{RateLimit: 20, DailyQuota: 100}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var usage responses.Usage
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&usage))
+	s.Require().Equal("basic", usage.Tier)
+	s.Require().EqualValues(20, usage.RateLimit)
+	s.Require().EqualValues(100, usage.DailyQuota)
+	s.Require().EqualValues(12, usage.UsedToday)
+	s.Require().NotNil(usage.Remaining)
+	s.Require().EqualValues(88, *usage.Remaining)
+	s.Require().Equal(today.AddDate(0, 0, 1), usage.ResetAt.UTC())
+
+	s.Require().Len(usage.Days, 2)
+	s.Require().Equal("2024-03-15", usage.Days[0].Date)
+	s.Require().Equal([]responses.RouteUsage{
+		{Route: "/v1/block/:height", Count: 10},
+		{Route: "/v1/tx/:hash", Count: 2},
+	}, usage.Days[0].Routes)
+	s.Require().Equal("2024-03-14", usage.Days[1].Date)
+	s.Require().Empty(usage.Days[1].Routes)
+}
+
+func (s *UsageTestSuite) TestGetUnlimited() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/usage")
+	c.Set(quota.ContextKey, testUsageApiKey)
+
+	s.meter.EXPECT().
+		Usage(gomock.Any(), testUsageApiKey, 1).
+		Return([]quota.DayUsage{
+			{Date: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), Total: 12},
+		}, nil).
+		Times(1)
+	s.meter.EXPECT().
+		Limits(testUsageApiKey).
+		Return(quota.Tier{RateLimit: 500}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var usage responses.Usage
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&usage))
+	s.Require().Nil(usage.Remaining)
+	s.Require().EqualValues(12, usage.UsedToday)
+}
+
+func (s *UsageTestSuite) TestGetWithoutApiKey() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/usage")
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (s *UsageTestSuite) TestGetInvalidDays() {
+	q := make(url.Values)
+	q.Set("days", "31")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/usage")
+	c.Set(quota.ContextKey, testUsageApiKey)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
@@ -14,8 +14,11 @@ import (
 	st "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
+var errHistoricalValidatorFilters = errors.New("jailed and version filters can't be used with height")
+
 type ValidatorHandler struct {
 	validators      storage.IValidator
 	blocks          storage.IBlock
@@ -24,6 +27,8 @@ type ValidatorHandler struct {
 	constants       storage.IConstant
 	jails           storage.IJail
 	votes           storage.IVote
+	stakingLogs     storage.IStakingLog
+	address         storage.IAddress
 	state           storage.IState
 	indexerName     string
 }
@@ -36,6 +41,8 @@ func NewValidatorHandler(
 	constants storage.IConstant,
 	jails storage.IJail,
 	votes storage.IVote,
+	stakingLogs storage.IStakingLog,
+	address storage.IAddress,
 	state storage.IState,
 	indexerName string,
 ) *ValidatorHandler {
@@ -47,6 +54,8 @@ func NewValidatorHandler(
 		constants:       constants,
 		jails:           jails,
 		votes:           votes,
+		stakingLogs:     stakingLogs,
+		address:         address,
 		state:           state,
 		indexerName:     indexerName,
 	}
@@ -84,10 +93,11 @@ func (handler *ValidatorHandler) Get(c echo.Context) error {
 }
 
 type validatorsPagination struct {
-	Limit   int   `query:"limit"   validate:"omitempty,min=1,max=100"`
-	Offset  int   `query:"offset"  validate:"omitempty,min=0"`
-	Jailed  *bool `query:"jailed"  validate:"omitempty"`
-	Version *int  `query:"version" validate:"omitempty,min=0"`
+	Limit   int         `query:"limit"   validate:"omitempty,min=1,max=100"`
+	Offset  int         `query:"offset"  validate:"omitempty,min=0"`
+	Jailed  *bool       `query:"jailed"  validate:"omitempty"`
+	Version *int        `query:"version" validate:"omitempty,min=0"`
+	Height  types.Level `query:"height"  validate:"omitempty,min=1"`
 }
 
 func (req *validatorsPagination) SetDefault() {
@@ -100,12 +110,14 @@ func (req *validatorsPagination) SetDefault() {
 //
 //	@Summary		List validators
 //	@Description	Returns a paginated list of validators ordered by voting power. Supports filtering by jailed status and app version.
+//	@Description	If height is set, validator set is reconstructed at the height: stake, voting power and commission rate are historical, other fields are current. Validators jailed at the height are skipped and the set is capped by max_validators. Commission rate is null if it's unknown at the height. Height can't be combined with jailed and version filters.
 //	@Tags			validator
 //	@ID				list-validator
 //	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)	maximum(100)
 //	@Param			offset	query	integer	false	"Offset"						minimum(0)
 //	@Param			jailed	query	boolean	false	"Return only jailed validators"
 //	@Param			version	query	integer	false	"Current validator app version"	minimum(0)
+//	@Param			height	query	integer	false	"Height at which validator set is reconstructed"	minimum(1)
 //	@Produce		json
 //	@Success		200	{array}		responses.Validator
 //	@Failure		400	{object}	Error
@@ -118,11 +130,16 @@ func (handler *ValidatorHandler) List(c echo.Context) error {
 	}
 	req.SetDefault()
 
+	if req.Height > 0 && (req.Jailed != nil || req.Version != nil) {
+		return badRequestError(c, errHistoricalValidatorFilters)
+	}
+
 	validators, err := handler.validators.ListByPower(c.Request().Context(), storage.ValidatorFilters{
 		Limit:   req.Limit,
 		Offset:  req.Offset,
 		Jailed:  req.Jailed,
 		Version: req.Version,
+		Height:  req.Height,
 	})
 	if err != nil {
 		return handleError(c, err, handler.validators)
@@ -401,6 +418,123 @@ func (handler *ValidatorHandler) Votes(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+type validatorRewardsRequest struct {
+	Id      uint64 `param:"id"      validate:"required,min=1"`
+	Limit   int    `query:"limit"   validate:"omitempty,min=1,max=100"`
+	Offset  int    `query:"offset"  validate:"omitempty,min=0"`
+	Sort    string `query:"sort"    validate:"omitempty,oneof=asc desc"`
+	From    int64  `query:"from"    validate:"omitempty,min=1"`
+	To      int64  `query:"to"      validate:"omitempty,min=1"`
+	Address string `query:"address" validate:"omitempty,address"`
+}
+
+func (req *validatorRewardsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Rewards godoc
+//
+//	@Summary		Get rewards ledger of validator
+//	@Description	Returns a paginated rewards ledger of the validator delegators built from staking rewards withdrawals (explicit or automatic on delegation change) and delegation changes. Every entry contains running delegated amount and withdrawn rewards of the (address, validator) pair after the entry.
+//	@Tags			validator
+//	@ID				validator-rewards
+//	@Param			id		path	integer	true	"Internal validator id"
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			from	query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to		query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Param			address	query	string	false	"Delegator address"				minlength(47)	maxlength(47)
+//	@Produce		json
+//	@Success		200	{array}		responses.RewardsLedgerItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/rewards [get]
+func (handler *ValidatorHandler) Rewards(c echo.Context) error {
+	req, err := bindAndValidate[validatorRewardsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	seriesRequest := storage.NewSeriesRequest(req.From, req.To)
+	fltrs := storage.RewardsLedgerFilters{
+		ValidatorId: &req.Id,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		Sort:        pgSort(req.Sort),
+		From:        seriesRequest.From,
+		To:          seriesRequest.To,
+	}
+	if req.Address != "" {
+		addressId, err := handler.address.IdByAddress(c.Request().Context(), req.Address)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		fltrs.AddressId = &addressId
+	}
+
+	ledger, err := handler.stakingLogs.RewardsLedger(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.stakingLogs)
+	}
+
+	response := make([]responses.RewardsLedgerItem, len(ledger))
+	for i := range ledger {
+		response[i] = responses.NewRewardsLedgerItem(ledger[i])
+	}
+	return returnArray(c, response)
+}
+
+type validatorRewardsSeriesRequest struct {
+	Id        uint64 `example:"1"          param:"id"        swaggertype:"integer" validate:"required,min=1"`
+	Timeframe string `example:"day"        param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day week month"`
+	From      int64  `example:"1692892095" query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64  `example:"1692892095" query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// RewardsSeries godoc
+//
+//	@Summary		Get rewards time series of validator
+//	@Description	Returns a time series of validator rewards and commissions: accrued every block and withdrawn by delegators and the validator operator.
+//	@Tags			validator
+//	@ID				validator-rewards-series
+//	@Param			id			path	integer	true	"Internal validator id"
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, week, month)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.RewardsSeriesItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/rewards/{timeframe} [get]
+func (handler *ValidatorHandler) RewardsSeries(c echo.Context) error {
+	req, err := bindAndValidate[validatorRewardsSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	series, err := handler.stakingLogs.RewardsSeries(c.Request().Context(), storage.RewardsSeriesFilters{
+		ValidatorId:   &req.Id,
+		Timeframe:     storage.Timeframe(req.Timeframe),
+		SeriesRequest: storage.NewSeriesRequest(req.From, req.To),
+	})
+	if err != nil {
+		return handleError(c, err, handler.stakingLogs)
+	}
+
+	response := make([]responses.RewardsSeriesItem, len(series))
+	for i := range series {
+		response[i] = responses.NewRewardsSeriesItem(series[i])
+	}
+	return returnArray(c, response)
+}
+
 type getValidatorMessages struct {
 	Id     uint64 `param:"id"     validate:"required,min=1"`
 	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
@@ -470,6 +604,60 @@ func (handler *ValidatorHandler) Messages(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+type getValidatorHistory struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+}
+
+func (req *getValidatorHistory) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// History godoc
+//
+//	@Summary		Get validator's stake and commission history
+//	@Description	Returns validator's stake, voting power and commission rate after each block in which they were changed by delegations, unbondings, slashings or commission edits. Rate is null if it is unknown at the block.
+//	@Tags			validator
+//	@ID				validator-history
+//	@Param			id		path	integer	true	"Internal validator id"
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(0)
+//	@Param			sort	query	string	false	"Sort order. Default: desc"		Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.ValidatorHistoryItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/history [get]
+func (handler *ValidatorHandler) History(c echo.Context) error {
+	req, err := bindAndValidate[getValidatorHistory](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	items, err := handler.validators.History(c.Request().Context(), req.Id, storage.ValidatorHistoryFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+	})
+	if err != nil {
+		return handleError(c, err, handler.validators)
+	}
+
+	response := make([]responses.ValidatorHistoryItem, len(items))
+	for i := range items {
+		response[i] = responses.NewValidatorHistoryItem(items[i])
+	}
+	return returnArray(c, response)
+}
+
 // Metrics godoc
 //
 //	@Summary		Get validator's metrics
@@ -16,6 +16,7 @@ import (
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -31,6 +32,8 @@ type ValidatorTestSuite struct {
 	jails           *mock.MockIJail
 	constants       *mock.MockIConstant
 	votes           *mock.MockIVote
+	stakingLogs     *mock.MockIStakingLog
+	address         *mock.MockIAddress
 	state           *mock.MockIState
 	echo            *echo.Echo
 	handler         *ValidatorHandler
@@ -49,8 +52,10 @@ func (s *ValidatorTestSuite) SetupSuite() {
 	s.constants = mock.NewMockIConstant(s.ctrl)
 	s.jails = mock.NewMockIJail(s.ctrl)
 	s.votes = mock.NewMockIVote(s.ctrl)
+	s.stakingLogs = mock.NewMockIStakingLog(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewValidatorHandler(s.validators, s.blocks, s.blockSignatures, s.delegations, s.constants, s.jails, s.votes, s.state, testIndexerName)
+	s.handler = NewValidatorHandler(s.validators, s.blocks, s.blockSignatures, s.delegations, s.constants, s.jails, s.votes, s.stakingLogs, s.address, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -142,6 +147,48 @@ func (s *ValidatorTestSuite) TestListWithVersion() {
 	s.Require().EqualValues(4, validators[0].Version)
 }
 
+func (s *ValidatorTestSuite) TestListAtHeight() {
+	q := make(url.Values)
+	q.Add("height", "100")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validator")
+
+	s.validators.EXPECT().
+		ListByPower(gomock.Any(), storage.ValidatorFilters{
+			Limit:  10,
+			Height: 100,
+		}).
+		Return([]storage.Validator{
+			testValidator,
+		}, nil)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var validators []responses.Validator
+	err := json.NewDecoder(rec.Body).Decode(&validators)
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1)
+	s.Require().EqualValues(1, validators[0].Id)
+}
+
+func (s *ValidatorTestSuite) TestListAtHeightWithJailed() {
+	q := make(url.Values)
+	q.Add("height", "100")
+	q.Add("jailed", "true")
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validator")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *ValidatorTestSuite) TestByProposer() {
 	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -406,6 +453,95 @@ func (s *ValidatorTestSuite) TestVotes() {
 	s.Require().EqualValues("012345", votes[0].Validator.ConsAddress)
 }
 
+func (s *ValidatorTestSuite) TestRewards() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("sort", "asc")
+	q.Set("address", testAddress)
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:id/rewards")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.address.EXPECT().
+		IdByAddress(gomock.Any(), testAddress).
+		Return(2, nil).
+		Times(1)
+
+	s.stakingLogs.EXPECT().
+		RewardsLedger(gomock.Any(), storage.RewardsLedgerFilters{
+			AddressId:   testsuite.Ptr(uint64(2)),
+			ValidatorId: testsuite.Ptr(uint64(1)),
+			Limit:       10,
+			Sort:        sdk.SortOrderAsc,
+		}).
+		Return([]storage.RewardsLedgerItem{
+			{
+				Height:      100,
+				AddressId:   2,
+				ValidatorId: 1,
+				Type:        storageTypes.StakingLogTypeDelegation,
+				Change:      storageTypes.NumericFromInt64(10000),
+				Delegated:   storageTypes.NumericFromInt64(10000),
+				Withdrawn:   storageTypes.NumericZero(),
+				Validator:   &testValidator,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Rewards(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []responses.RewardsLedgerItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Equal("delegation", items[0].Type)
+	s.Require().Equal("10000", items[0].Delegated)
+	s.Require().Equal("0", items[0].Withdrawn)
+	s.Require().EqualValues(1, items[0].Validator.Id)
+}
+
+func (s *ValidatorTestSuite) TestRewardsSeries() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:id/rewards/:timeframe")
+	c.SetParamNames("id", "timeframe")
+	c.SetParamValues("1", "month")
+
+	s.stakingLogs.EXPECT().
+		RewardsSeries(gomock.Any(), storage.RewardsSeriesFilters{
+			ValidatorId: testsuite.Ptr(uint64(1)),
+			Timeframe:   storage.TimeframeMonth,
+		}).
+		Return([]storage.RewardsSeriesItem{
+			{
+				Bucket:               testTime,
+				Rewards:              storageTypes.NumericFromInt64(1000),
+				Withdrawn:            storageTypes.NumericFromInt64(400),
+				Commissions:          storageTypes.NumericFromInt64(100),
+				CommissionsWithdrawn: storageTypes.NumericFromInt64(50),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.RewardsSeries(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []responses.RewardsSeriesItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Equal("1000", items[0].Rewards)
+	s.Require().Equal("400", items[0].Withdrawn)
+	s.Require().Equal("100", items[0].Commissions)
+	s.Require().Equal("50", items[0].CommissionsWithdrawn)
+}
+
 func (s *ValidatorTestSuite) TestMessages() {
 	q := make(url.Values)
 	q.Set("limit", "10")
@@ -539,3 +675,48 @@ func (s *ValidatorTestSuite) TestTopNMetrics() {
 	s.Require().EqualValues("0.8", metrics.SelfDelegationMetric)
 	s.Require().EqualValues("0.75", metrics.BlockMissedMetric)
 }
+
+func (s *ValidatorTestSuite) TestHistory() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:id/history")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.validators.EXPECT().
+		History(gomock.Any(), uint64(1), storage.ValidatorHistoryFilters{
+			Limit: 10,
+			Sort:  pgSort(desc),
+		}).
+		Return([]storage.ValidatorHistoryItem{
+			{
+				Height: 100,
+				Time:   testTime,
+				Stake:  storageTypes.NumericFromInt64(2_500_000),
+				Rate:   storageTypes.NumericFromInt64(0),
+			}, {
+				Height:      99,
+				Time:        testTime,
+				Stake:       storageTypes.NumericFromInt64(2_000_000),
+				RateUnknown: true,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.ValidatorHistoryItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Require().EqualValues(100, items[0].Height)
+	s.Require().Equal("2500000", items[0].Stake)
+	s.Require().Equal("2", items[0].VotingPower)
+	s.Require().NotNil(items[0].Rate)
+	s.Require().Equal("0", *items[0].Rate)
+
+	s.Require().EqualValues(99, items[1].Height)
+	s.Require().Nil(items[1].Rate)
+}
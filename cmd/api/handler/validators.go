@@ -53,12 +53,18 @@ func NewCelestiaApiValidator() *CelestiaApiValidator {
 	if err := v.RegisterValidation("ibc_channel_status", ibcChannelStatusValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("ibc_transfer_status", ibcTransferStatusValidator()); err != nil {
+		panic(err)
+	}
 	if err := v.RegisterValidation("hl_token_type", hyperlaneTokenTypeValidator()); err != nil {
 		panic(err)
 	}
 	if err := v.RegisterValidation("hl_transfer_type", hyperlaneTransferTypeValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("hl_delivery_status", hyperlaneDeliveryStatusValidator()); err != nil {
+		panic(err)
+	}
 	return &CelestiaApiValidator{validator: v}
 }
 
@@ -187,6 +193,13 @@ func ibcChannelStatusValidator() validator.Func {
 	}
 }
 
+func ibcTransferStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseIbcTransferStatus(fl.Field().String())
+		return err == nil
+	}
+}
+
 func hyperlaneTokenTypeValidator() validator.Func {
 	return func(fl validator.FieldLevel) bool {
 		_, err := types.ParseHLTokenType(fl.Field().String())
@@ -200,3 +213,10 @@ func hyperlaneTransferTypeValidator() validator.Func {
 		return err == nil
 	}
 }
+
+func hyperlaneDeliveryStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseHLDeliveryStatus(fl.Field().String())
+		return err == nil
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/workerpool"
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
@@ -40,12 +41,17 @@ const (
 
 type ClientHandler func(string, *Client)
 
+// RollupProvidersFunc - returns providers of the rollup. It's used to apply rollup filter on blobs channel.
+type RollupProvidersFunc func(ctx context.Context, rollupId uint64) ([]storage.RollupProvider, error)
+
 type Client struct {
 	id      uint64
 	filters *Filters
 	ch      chan any
 	g       workerpool.Group
 
+	rollupProviders RollupProvidersFunc
+
 	subscribeHandler   ClientHandler
 	unsubscribeHandler ClientHandler
 
@@ -84,12 +90,94 @@ func (c *Client) ApplyFilters(msg Subscribe) error {
 		c.filters.blocks = true
 	case ChannelGasPrice:
 		c.filters.gasPrice = true
+	case ChannelTxs:
+		var req TransactionFilters
+		if err := unmarshalFilters(msg.Filters, &req); err != nil {
+			return err
+		}
+		fltrs, err := newTxFilters(req)
+		if err != nil {
+			return err
+		}
+		c.filters.txs = fltrs
+	case ChannelMessages:
+		var req MessageFilters
+		if err := unmarshalFilters(msg.Filters, &req); err != nil {
+			return err
+		}
+		fltrs, err := newMessageFilters(req)
+		if err != nil {
+			return err
+		}
+		c.filters.messages = fltrs
+	case ChannelBlobs:
+		var req BlobFilters
+		if err := unmarshalFilters(msg.Filters, &req); err != nil {
+			return err
+		}
+		providers, err := c.getRollupProviders(req.Rollups)
+		if err != nil {
+			return err
+		}
+		fltrs, err := newBlobFilters(req, providers)
+		if err != nil {
+			return err
+		}
+		c.filters.blobs = fltrs
+	case ChannelAlerts:
+		var req AlertFilters
+		if err := unmarshalFilters(msg.Filters, &req); err != nil {
+			return err
+		}
+		c.filters.alerts = newAlertFilters(req)
+	case ChannelMempool:
+		var req MempoolFilters
+		if err := unmarshalFilters(msg.Filters, &req); err != nil {
+			return err
+		}
+		fltrs, err := newMempoolFilters(req)
+		if err != nil {
+			return err
+		}
+		c.filters.mempool = fltrs
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
 	return nil
 }
 
+func unmarshalFilters(data []byte, output any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, output); err != nil {
+		return errors.Wrap(ErrUnavailableFilter, err.Error())
+	}
+	return nil
+}
+
+func (c *Client) getRollupProviders(rollupIds []uint64) ([]storage.RollupProvider, error) {
+	if len(rollupIds) == 0 {
+		return nil, nil
+	}
+	if c.rollupProviders == nil {
+		return nil, errors.Wrap(ErrUnavailableFilter, "rollup_id")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result := make([]storage.RollupProvider, 0)
+	for i := range rollupIds {
+		providers, err := c.rollupProviders(ctx, rollupIds[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, providers...)
+	}
+	return result, nil
+}
+
 func (c *Client) DetachFilters(msg Unsubscribe) error {
 	if c.filters == nil {
 		return nil
@@ -101,6 +189,16 @@ func (c *Client) DetachFilters(msg Unsubscribe) error {
 		c.filters.blocks = false
 	case ChannelGasPrice:
 		c.filters.gasPrice = false
+	case ChannelTxs:
+		c.filters.txs = nil
+	case ChannelMessages:
+		c.filters.messages = nil
+	case ChannelBlobs:
+		c.filters.blobs = nil
+	case ChannelAlerts:
+		c.filters.alerts = nil
+	case ChannelMempool:
+		c.filters.mempool = nil
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
@@ -196,6 +294,21 @@ func (c *Client) ReadMessages(ctx context.Context, ws *websocket.Conn, log echo.
 			if c.filters.gasPrice {
 				c.unsubscribeHandler(ChannelGasPrice, c)
 			}
+			if c.filters.txs != nil {
+				c.unsubscribeHandler(ChannelTxs, c)
+			}
+			if c.filters.messages != nil {
+				c.unsubscribeHandler(ChannelMessages, c)
+			}
+			if c.filters.blobs != nil {
+				c.unsubscribeHandler(ChannelBlobs, c)
+			}
+			if c.filters.alerts != nil {
+				c.unsubscribeHandler(ChannelAlerts, c)
+			}
+			if c.filters.mempool != nil {
+				c.unsubscribeHandler(ChannelMempool, c)
+			}
 		}
 	}()
 
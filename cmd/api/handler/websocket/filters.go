@@ -4,7 +4,15 @@
 package websocket
 
 import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
 )
 
 type Filterable[M INotification] interface {
@@ -50,8 +58,373 @@ func (f GasPriceFilter) Filter(c client, msg Notification[*responses.GasPrice])
 	return fltrs.gasPrice
 }
 
+type TxFilter struct{}
+
+func (f TxFilter) Filter(c client, msg Notification[*responses.Tx]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.txs == nil {
+		return false
+	}
+	return fltrs.txs.Filter(msg.Body)
+}
+
+type MessageFilter struct{}
+
+func (f MessageFilter) Filter(c client, msg Notification[*responses.Message]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.messages == nil {
+		return false
+	}
+	return fltrs.messages.Filter(msg.Body)
+}
+
+type BlobFilter struct{}
+
+func (f BlobFilter) Filter(c client, msg Notification[*responses.BlobLog]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.blobs == nil {
+		return false
+	}
+	return fltrs.blobs.Filter(msg.Body)
+}
+
+type AlertFilter struct{}
+
+func (f AlertFilter) Filter(c client, msg Notification[*responses.Alert]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.alerts == nil {
+		return false
+	}
+	return fltrs.alerts.Filter(msg.Body)
+}
+
+type MempoolFilter struct{}
+
+func (f MempoolFilter) Filter(c client, msg Notification[*responses.MempoolTx]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.mempool == nil {
+		return false
+	}
+	return fltrs.mempool.Filter(msg.Body)
+}
+
 type Filters struct {
 	head     bool
 	blocks   bool
 	gasPrice bool
+	txs      *txFilters
+	messages *messageFilters
+	blobs    *blobFilters
+	alerts   *alertFilters
+	mempool  *mempoolFilters
+}
+
+type txFilters struct {
+	status    map[types.Status]struct{}
+	msgTypes  map[types.MsgType]struct{}
+	addresses map[string]struct{}
+}
+
+func newTxFilters(req TransactionFilters) (*txFilters, error) {
+	fltrs := &txFilters{
+		status:    make(map[types.Status]struct{}, len(req.Status)),
+		msgTypes:  make(map[types.MsgType]struct{}, len(req.Messages)),
+		addresses: make(map[string]struct{}, len(req.Addresses)),
+	}
+	for i := range req.Status {
+		status, err := types.ParseStatus(req.Status[i])
+		if err != nil {
+			return nil, errors.Wrap(ErrUnavailableFilter, req.Status[i])
+		}
+		fltrs.status[status] = struct{}{}
+	}
+	msgTypes, err := parseMsgTypes(req.Messages)
+	if err != nil {
+		return nil, err
+	}
+	fltrs.msgTypes = msgTypes
+
+	addresses, err := parseAddresses(req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	fltrs.addresses = addresses
+	return fltrs, nil
+}
+
+func (f *txFilters) Filter(tx *responses.Tx) bool {
+	if len(f.status) > 0 {
+		if _, ok := f.status[tx.Status]; !ok {
+			return false
+		}
+	}
+
+	if len(f.msgTypes) > 0 {
+		var found bool
+		for i := range tx.MessageTypes {
+			if _, found = f.msgTypes[tx.MessageTypes[i]]; found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.addresses) > 0 {
+		var found bool
+		for i := range tx.Signers {
+			if tx.Signers[i] == nil {
+				continue
+			}
+			if _, found = f.addresses[tx.Signers[i].Hash]; found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+type messageFilters struct {
+	msgTypes  map[types.MsgType]struct{}
+	addresses map[string]struct{}
+}
+
+func newMessageFilters(req MessageFilters) (*messageFilters, error) {
+	msgTypes, err := parseMsgTypes(req.Messages)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := parseAddresses(req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	return &messageFilters{
+		msgTypes:  msgTypes,
+		addresses: addresses,
+	}, nil
+}
+
+func (f *messageFilters) Filter(msg *responses.Message) bool {
+	if len(f.msgTypes) > 0 {
+		if _, ok := f.msgTypes[msg.Type]; !ok {
+			return false
+		}
+	}
+
+	if len(f.addresses) > 0 {
+		var found bool
+		for i := range msg.Addresses {
+			if _, found = f.addresses[msg.Addresses[i]]; found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+type blobFilters struct {
+	namespaces map[string]struct{}
+	signers    map[string]struct{}
+	// providers - map from signer id to set of namespace ids. Zero namespace id means any namespace.
+	providers map[uint64]map[uint64]struct{}
+	byRollup  bool
+}
+
+func newBlobFilters(req BlobFilters, providers []storage.RollupProvider) (*blobFilters, error) {
+	fltrs := &blobFilters{
+		namespaces: make(map[string]struct{}, len(req.Namespaces)),
+		providers:  make(map[uint64]map[uint64]struct{}, len(providers)),
+		byRollup:   len(req.Rollups) > 0,
+	}
+
+	for i := range req.Namespaces {
+		nsId, err := hex.DecodeString(req.Namespaces[i].Id)
+		if err != nil || len(nsId) != 28 {
+			return nil, errors.Wrap(ErrUnavailableFilter, req.Namespaces[i].Id)
+		}
+		fltrs.namespaces[namespaceKey(req.Namespaces[i].Version, req.Namespaces[i].Id)] = struct{}{}
+	}
+
+	signers, err := parseAddresses(req.Signers)
+	if err != nil {
+		return nil, err
+	}
+	fltrs.signers = signers
+
+	for i := range providers {
+		namespaces, ok := fltrs.providers[providers[i].AddressId]
+		if !ok {
+			namespaces = make(map[uint64]struct{})
+			fltrs.providers[providers[i].AddressId] = namespaces
+		}
+		namespaces[providers[i].NamespaceId] = struct{}{}
+	}
+	return fltrs, nil
+}
+
+func (f *blobFilters) Filter(blob *responses.BlobLog) bool {
+	if len(f.namespaces) > 0 {
+		if blob.Namespace == nil {
+			return false
+		}
+		if _, ok := f.namespaces[namespaceKey(blob.Namespace.Version, blob.Namespace.NamespaceID)]; !ok {
+			return false
+		}
+	}
+
+	if len(f.signers) > 0 {
+		if blob.Signer == nil {
+			return false
+		}
+		if _, ok := f.signers[blob.Signer.Hash]; !ok {
+			return false
+		}
+	}
+
+	if f.byRollup {
+		namespaces, ok := f.providers[blob.SignerId]
+		if !ok {
+			return false
+		}
+		if _, anyNamespace := namespaces[0]; !anyNamespace {
+			if blob.Namespace == nil {
+				return false
+			}
+			if _, ok := namespaces[blob.Namespace.ID]; !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+type alertFilters struct {
+	rollups    map[uint64]struct{}
+	namespaces map[uint64]struct{}
+}
+
+func newAlertFilters(req AlertFilters) *alertFilters {
+	fltrs := &alertFilters{
+		rollups:    make(map[uint64]struct{}, len(req.Rollups)),
+		namespaces: make(map[uint64]struct{}, len(req.Namespaces)),
+	}
+	for i := range req.Rollups {
+		fltrs.rollups[req.Rollups[i]] = struct{}{}
+	}
+	for i := range req.Namespaces {
+		fltrs.namespaces[req.Namespaces[i]] = struct{}{}
+	}
+	return fltrs
+}
+
+func (f *alertFilters) Filter(alert *responses.Alert) bool {
+	if len(f.rollups) == 0 && len(f.namespaces) == 0 {
+		return true
+	}
+	if _, ok := f.rollups[alert.RollupId]; ok && alert.RollupId > 0 {
+		return true
+	}
+	if _, ok := f.namespaces[alert.NamespaceId]; ok && alert.NamespaceId > 0 {
+		return true
+	}
+	return false
+}
+
+type mempoolFilters struct {
+	status    map[types.MempoolTxStatus]struct{}
+	addresses map[string]struct{}
+}
+
+func newMempoolFilters(req MempoolFilters) (*mempoolFilters, error) {
+	fltrs := &mempoolFilters{
+		status: make(map[types.MempoolTxStatus]struct{}, len(req.Status)),
+	}
+	for i := range req.Status {
+		status, err := types.ParseMempoolTxStatus(req.Status[i])
+		if err != nil {
+			return nil, errors.Wrap(ErrUnavailableFilter, req.Status[i])
+		}
+		fltrs.status[status] = struct{}{}
+	}
+
+	addresses, err := parseAddresses(req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	fltrs.addresses = addresses
+	return fltrs, nil
+}
+
+func (f *mempoolFilters) Filter(tx *responses.MempoolTx) bool {
+	if len(f.status) > 0 {
+		if _, ok := f.status[types.MempoolTxStatus(tx.Status)]; !ok {
+			return false
+		}
+	}
+
+	if len(f.addresses) > 0 {
+		var found bool
+		for i := range tx.Signers {
+			if _, found = f.addresses[tx.Signers[i]]; found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func namespaceKey(version byte, namespaceId string) string {
+	return fmt.Sprintf("%d_%s", version, strings.ToLower(namespaceId))
+}
+
+func parseMsgTypes(values []string) (map[types.MsgType]struct{}, error) {
+	msgTypes := make(map[types.MsgType]struct{}, len(values))
+	for i := range values {
+		msgType, err := types.ParseMsgType(values[i])
+		if err != nil {
+			return nil, errors.Wrap(ErrUnavailableFilter, values[i])
+		}
+		msgTypes[msgType] = struct{}{}
+	}
+	return msgTypes, nil
+}
+
+func parseAddresses(values []string) (map[string]struct{}, error) {
+	addresses := make(map[string]struct{}, len(values))
+	for i := range values {
+		if _, _, err := pkgTypes.Address(values[i]).Decode(); err != nil {
+			return nil, errors.Wrap(ErrUnavailableFilter, values[i])
+		}
+		addresses[values[i]] = struct{}{}
+	}
+	return addresses, nil
 }
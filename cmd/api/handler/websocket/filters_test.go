@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress      = "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+	testOtherAddress = "celestia1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3y3clr6"
+	testNamespaceId  = "00000000000000000000000000000000000000000000000000000001"
+)
+
+func TestTxFilters(t *testing.T) {
+	fltrs, err := newTxFilters(TransactionFilters{
+		Status:    []string{"success"},
+		Messages:  []string{"MsgSend"},
+		Addresses: []string{testAddress},
+	})
+	require.NoError(t, err)
+
+	tx := &responses.Tx{
+		Status:       types.StatusSuccess,
+		MessageTypes: []types.MsgType{types.MsgPayForBlobs, types.MsgSend},
+		Signers: []*responses.ShortAddress{
+			{Hash: testAddress},
+		},
+	}
+	require.True(t, fltrs.Filter(tx))
+
+	tx.Status = types.StatusFailed
+	require.False(t, fltrs.Filter(tx))
+
+	tx.Status = types.StatusSuccess
+	tx.MessageTypes = []types.MsgType{types.MsgPayForBlobs}
+	require.False(t, fltrs.Filter(tx))
+
+	tx.MessageTypes = []types.MsgType{types.MsgSend}
+	tx.Signers[0].Hash = testOtherAddress
+	require.False(t, fltrs.Filter(tx))
+}
+
+func TestTxFiltersEmpty(t *testing.T) {
+	fltrs, err := newTxFilters(TransactionFilters{})
+	require.NoError(t, err)
+	require.True(t, fltrs.Filter(&responses.Tx{Status: types.StatusFailed}))
+}
+
+func TestTxFiltersInvalid(t *testing.T) {
+	_, err := newTxFilters(TransactionFilters{Status: []string{"unknown"}})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+
+	_, err = newTxFilters(TransactionFilters{Messages: []string{"MsgUnknown"}})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+
+	_, err = newTxFilters(TransactionFilters{Addresses: []string{"invalid"}})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+}
+
+func TestMessageFilters(t *testing.T) {
+	fltrs, err := newMessageFilters(MessageFilters{
+		Messages:  []string{"MsgSend"},
+		Addresses: []string{testAddress},
+	})
+	require.NoError(t, err)
+
+	msg := &responses.Message{
+		Type:      types.MsgSend,
+		Addresses: []string{testOtherAddress, testAddress},
+	}
+	require.True(t, fltrs.Filter(msg))
+
+	msg.Type = types.MsgPayForBlobs
+	require.False(t, fltrs.Filter(msg))
+
+	msg.Type = types.MsgSend
+	msg.Addresses = []string{testOtherAddress}
+	require.False(t, fltrs.Filter(msg))
+}
+
+func TestBlobFilters(t *testing.T) {
+	fltrs, err := newBlobFilters(BlobFilters{
+		Namespaces: []NamespaceFilter{
+			{Id: testNamespaceId, Version: 0},
+		},
+		Signers: []string{testAddress},
+	}, nil)
+	require.NoError(t, err)
+
+	blob := &responses.BlobLog{
+		Namespace: &responses.Namespace{
+			NamespaceID: testNamespaceId,
+		},
+		Signer: &responses.ShortAddress{Hash: testAddress},
+	}
+	require.True(t, fltrs.Filter(blob))
+
+	blob.Namespace.Version = 1
+	require.False(t, fltrs.Filter(blob))
+
+	blob.Namespace.Version = 0
+	blob.Signer.Hash = testOtherAddress
+	require.False(t, fltrs.Filter(blob))
+}
+
+func TestBlobFiltersByRollup(t *testing.T) {
+	fltrs, err := newBlobFilters(BlobFilters{Rollups: []uint64{1}}, []storage.RollupProvider{
+		{RollupId: 1, AddressId: 10, NamespaceId: 100},
+		{RollupId: 1, AddressId: 20, NamespaceId: 0},
+	})
+	require.NoError(t, err)
+
+	require.True(t, fltrs.Filter(&responses.BlobLog{
+		SignerId:  10,
+		Namespace: &responses.Namespace{ID: 100},
+	}))
+	require.False(t, fltrs.Filter(&responses.BlobLog{
+		SignerId:  10,
+		Namespace: &responses.Namespace{ID: 101},
+	}))
+	require.True(t, fltrs.Filter(&responses.BlobLog{
+		SignerId:  20,
+		Namespace: &responses.Namespace{ID: 101},
+	}))
+	require.False(t, fltrs.Filter(&responses.BlobLog{
+		SignerId:  30,
+		Namespace: &responses.Namespace{ID: 100},
+	}))
+}
+
+func TestBlobFiltersByUnknownRollup(t *testing.T) {
+	fltrs, err := newBlobFilters(BlobFilters{Rollups: []uint64{1}}, nil)
+	require.NoError(t, err)
+	require.False(t, fltrs.Filter(&responses.BlobLog{
+		SignerId:  10,
+		Namespace: &responses.Namespace{ID: 100},
+	}))
+}
+
+func TestBlobFiltersInvalidNamespace(t *testing.T) {
+	_, err := newBlobFilters(BlobFilters{
+		Namespaces: []NamespaceFilter{
+			{Id: "zz"},
+		},
+	}, nil)
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+
+	_, err = newBlobFilters(BlobFilters{
+		Namespaces: []NamespaceFilter{
+			{Id: "0001"},
+		},
+	}, nil)
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+}
+
+func TestAlertFilters(t *testing.T) {
+	fltrs := newAlertFilters(AlertFilters{
+		Rollups:    []uint64{1},
+		Namespaces: []uint64{5},
+	})
+	require.True(t, fltrs.Filter(&responses.Alert{RollupId: 1}))
+	require.True(t, fltrs.Filter(&responses.Alert{NamespaceId: 5}))
+	require.False(t, fltrs.Filter(&responses.Alert{RollupId: 2}))
+	require.False(t, fltrs.Filter(&responses.Alert{NamespaceId: 1}))
+
+	all := newAlertFilters(AlertFilters{})
+	require.True(t, all.Filter(&responses.Alert{RollupId: 2}))
+}
+
+func TestMempoolFilters(t *testing.T) {
+	fltrs, err := newMempoolFilters(MempoolFilters{
+		Status:    []string{"included"},
+		Addresses: []string{"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+	})
+	require.NoError(t, err)
+	require.True(t, fltrs.Filter(&responses.MempoolTx{
+		Status:  "included",
+		Signers: []string{"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+	}))
+	require.False(t, fltrs.Filter(&responses.MempoolTx{
+		Status:  "pending",
+		Signers: []string{"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+	}))
+	require.False(t, fltrs.Filter(&responses.MempoolTx{
+		Status:  "included",
+		Signers: []string{"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"},
+	}))
+
+	all, err := newMempoolFilters(MempoolFilters{})
+	require.NoError(t, err)
+	require.True(t, all.Filter(&responses.MempoolTx{Status: "dropped"}))
+
+	_, err = newMempoolFilters(MempoolFilters{Status: []string{"unknown"}})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+}
+
+func TestClientApplyFilters(t *testing.T) {
+	client := newClient(1, nil, nil)
+	client.rollupProviders = func(ctx context.Context, rollupId uint64) ([]storage.RollupProvider, error) {
+		return []storage.RollupProvider{
+			{RollupId: rollupId, AddressId: 10},
+		}, nil
+	}
+
+	err := client.ApplyFilters(Subscribe{
+		Channel: ChannelTxs,
+		Filters: []byte(`{"status":["success"],"msg_type":["MsgSend"]}`),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, client.Filters().txs)
+
+	err = client.ApplyFilters(Subscribe{
+		Channel: ChannelMessages,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, client.Filters().messages)
+
+	err = client.ApplyFilters(Subscribe{
+		Channel: ChannelBlobs,
+		Filters: []byte(`{"rollup_id":[1]}`),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, client.Filters().blobs)
+	require.True(t, BlobFilter{}.Filter(client, NewBlobNotification(responses.BlobLog{SignerId: 10})))
+
+	err = client.DetachFilters(Unsubscribe{Channel: ChannelBlobs})
+	require.NoError(t, err)
+	require.Nil(t, client.Filters().blobs)
+
+	err = client.ApplyFilters(Subscribe{
+		Channel: ChannelAlerts,
+		Filters: []byte(`{"rollup_id":[1]}`),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, client.Filters().alerts)
+	require.True(t, AlertFilter{}.Filter(client, NewAlertNotification(responses.Alert{RollupId: 1})))
+	require.False(t, AlertFilter{}.Filter(client, NewAlertNotification(responses.Alert{NamespaceId: 1})))
+
+	err = client.ApplyFilters(Subscribe{
+		Channel: ChannelMempool,
+		Filters: []byte(`{"status":["pending"]}`),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, client.Filters().mempool)
+	require.True(t, MempoolFilter{}.Filter(client, NewMempoolNotification(responses.MempoolTx{Status: "pending"})))
+	require.False(t, MempoolFilter{}.Filter(client, NewMempoolNotification(responses.MempoolTx{Status: "dropped"})))
+
+	err = client.DetachFilters(Unsubscribe{Channel: ChannelMempool})
+	require.NoError(t, err)
+	require.Nil(t, client.Filters().mempool)
+}
+
+func TestClientApplyInvalidFilters(t *testing.T) {
+	client := newClient(1, nil, nil)
+
+	err := client.ApplyFilters(Subscribe{
+		Channel: ChannelTxs,
+		Filters: []byte(`{"status":["unknown"]}`),
+	})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+	require.Equal(t, errInvalidFilters, errorMessage(err))
+
+	err = client.ApplyFilters(Subscribe{
+		Channel: ChannelMessages,
+		Filters: []byte(`{"msg_type":`),
+	})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+
+	err = client.ApplyFilters(Subscribe{
+		Channel: ChannelBlobs,
+		Filters: []byte(`{"rollup_id":[1]}`),
+	})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+}
@@ -33,6 +33,13 @@ type Manager struct {
 	blocks   *Channel[storage.Block, *responses.Block]
 	head     *Channel[storage.State, *responses.State]
 	gasPrice *Channel[gas.GasPrice, *responses.GasPrice]
+	txs      *Channel[storage.Tx, *responses.Tx]
+	messages *Channel[storage.Message, *responses.Message]
+	blobs    *Channel[storage.BlobLog, *responses.BlobLog]
+	alerts   *Channel[storage.Alert, *responses.Alert]
+	mempool  *Channel[storage.MempoolTx, *responses.MempoolTx]
+
+	rollups storage.IRollup
 
 	g workerpool.Group
 }
@@ -68,6 +75,31 @@ func NewManager(observer *bus.Observer, opts ...ManagerOption) *Manager {
 		GasPriceFilter{},
 	)
 
+	manager.txs = NewChannel(
+		txProcessor,
+		TxFilter{},
+	)
+
+	manager.messages = NewChannel(
+		messageProcessor,
+		MessageFilter{},
+	)
+
+	manager.blobs = NewChannel(
+		blobProcessor,
+		BlobFilter{},
+	)
+
+	manager.alerts = NewChannel(
+		alertProcessor,
+		AlertFilter{},
+	)
+
+	manager.mempool = NewChannel(
+		mempoolProcessor,
+		MempoolFilter{},
+	)
+
 	for _, opt := range opts {
 		opt(manager)
 	}
@@ -106,6 +138,75 @@ func (manager *Manager) listenHead(ctx context.Context) {
 	}
 }
 
+func (manager *Manager) listenTxs(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case tx := <-manager.observer.Txs():
+			if err := manager.txs.processMessage(*tx); err != nil {
+				log.Err(err).Msg("handle tx")
+			}
+		}
+	}
+}
+
+func (manager *Manager) listenMessages(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-manager.observer.Messages():
+			if err := manager.messages.processMessage(*msg); err != nil {
+				log.Err(err).Msg("handle message")
+			}
+		}
+	}
+}
+
+func (manager *Manager) listenBlobs(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case blob := <-manager.observer.Blobs():
+			if err := manager.blobs.processMessage(*blob); err != nil {
+				log.Err(err).Msg("handle blob")
+			}
+		}
+	}
+}
+
+func (manager *Manager) listenAlerts(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case alert := <-manager.observer.Alerts():
+			if err := manager.alerts.processMessage(*alert); err != nil {
+				log.Err(err).Msg("handle alert")
+			}
+		}
+	}
+}
+
+func (manager *Manager) listenMempool(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case tx := <-manager.observer.Mempool():
+			if err := manager.mempool.processMessage(*tx); err != nil {
+				log.Err(err).Msg("handle mempool transaction")
+			}
+		}
+	}
+}
+
+func (manager *Manager) rollupProviders(ctx context.Context, rollupId uint64) ([]storage.RollupProvider, error) {
+	return manager.rollups.Providers(ctx, rollupId)
+}
+
 // Handle godoc
 //
 //	@Summary				Websocket API
@@ -141,6 +242,9 @@ func (manager *Manager) Handle(c echo.Context) error {
 
 	sId := manager.clientId.Add(1)
 	sub := newClient(sId, manager.AddClientToChannel, manager.RemoveClientFromChannel)
+	if manager.rollups != nil {
+		sub.rollupProviders = manager.rollupProviders
+	}
 
 	manager.clients.Set(sId, sub)
 
@@ -160,6 +264,11 @@ func (manager *Manager) Handle(c echo.Context) error {
 func (manager *Manager) Start(ctx context.Context) {
 	manager.g.GoCtx(ctx, manager.listenHead)
 	manager.g.GoCtx(ctx, manager.listenBlocks)
+	manager.g.GoCtx(ctx, manager.listenTxs)
+	manager.g.GoCtx(ctx, manager.listenMessages)
+	manager.g.GoCtx(ctx, manager.listenBlobs)
+	manager.g.GoCtx(ctx, manager.listenAlerts)
+	manager.g.GoCtx(ctx, manager.listenMempool)
 }
 
 func (manager *Manager) Close() error {
@@ -185,6 +294,21 @@ func (manager *Manager) AddClientToChannel(channel string, client *Client) {
 	case ChannelGasPrice:
 		manager.gasPrice.AddClient(client)
 		wsSubscriptions.WithLabelValues(channel).Inc()
+	case ChannelTxs:
+		manager.txs.AddClient(client)
+		wsSubscriptions.WithLabelValues(channel).Inc()
+	case ChannelMessages:
+		manager.messages.AddClient(client)
+		wsSubscriptions.WithLabelValues(channel).Inc()
+	case ChannelBlobs:
+		manager.blobs.AddClient(client)
+		wsSubscriptions.WithLabelValues(channel).Inc()
+	case ChannelAlerts:
+		manager.alerts.AddClient(client)
+		wsSubscriptions.WithLabelValues(channel).Inc()
+	case ChannelMempool:
+		manager.mempool.AddClient(client)
+		wsSubscriptions.WithLabelValues(channel).Inc()
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 		wsErrors.WithLabelValues("unknown_channel").Inc()
@@ -202,6 +326,21 @@ func (manager *Manager) RemoveClientFromChannel(channel string, client *Client)
 	case ChannelGasPrice:
 		manager.gasPrice.RemoveClient(client.id)
 		wsSubscriptions.WithLabelValues(channel).Dec()
+	case ChannelTxs:
+		manager.txs.RemoveClient(client.id)
+		wsSubscriptions.WithLabelValues(channel).Dec()
+	case ChannelMessages:
+		manager.messages.RemoveClient(client.id)
+		wsSubscriptions.WithLabelValues(channel).Dec()
+	case ChannelBlobs:
+		manager.blobs.RemoveClient(client.id)
+		wsSubscriptions.WithLabelValues(channel).Dec()
+	case ChannelAlerts:
+		manager.alerts.RemoveClient(client.id)
+		wsSubscriptions.WithLabelValues(channel).Dec()
+	case ChannelMempool:
+		manager.mempool.RemoveClient(client.id)
+		wsSubscriptions.WithLabelValues(channel).Dec()
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 	}
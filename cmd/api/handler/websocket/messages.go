@@ -21,6 +21,11 @@ const (
 	ChannelHead     = "head"
 	ChannelBlocks   = "blocks"
 	ChannelGasPrice = "gas_price"
+	ChannelTxs      = "txs"
+	ChannelMessages = "messages"
+	ChannelBlobs    = "blobs"
+	ChannelAlerts   = "alerts"
+	ChannelMempool  = "mempool"
 	ChannelError    = "error"
 )
 
@@ -30,21 +35,48 @@ type Message struct {
 }
 
 type Subscribe struct {
-	Channel string          `json:"channel" validate:"required,oneof=head blocks gas_price"`
+	Channel string          `json:"channel" validate:"required,oneof=head blocks gas_price txs messages blobs alerts mempool"`
 	Filters json.RawMessage `json:"filters" validate:"required"`
 }
 
 type Unsubscribe struct {
-	Channel string `json:"channel" validate:"required,oneof=head blocks gas_price"`
+	Channel string `json:"channel" validate:"required,oneof=head blocks gas_price txs messages blobs alerts mempool"`
 }
 
 type TransactionFilters struct {
-	Status   []string `json:"status,omitempty"`
-	Messages []string `json:"msg_type,omitempty"`
+	Status    []string `json:"status,omitempty"`
+	Messages  []string `json:"msg_type,omitempty"`
+	Addresses []string `json:"address,omitempty"`
+}
+
+type MessageFilters struct {
+	Messages  []string `json:"msg_type,omitempty"`
+	Addresses []string `json:"address,omitempty"`
+}
+
+type BlobFilters struct {
+	Namespaces []NamespaceFilter `json:"namespace,omitempty"`
+	Signers    []string          `json:"address,omitempty"`
+	Rollups    []uint64          `json:"rollup_id,omitempty"`
+}
+
+type AlertFilters struct {
+	Rollups    []uint64 `json:"rollup_id,omitempty"`
+	Namespaces []uint64 `json:"namespace_id,omitempty"`
+}
+
+type MempoolFilters struct {
+	Status    []string `json:"status,omitempty"`
+	Addresses []string `json:"address,omitempty"`
+}
+
+type NamespaceFilter struct {
+	Id      string `json:"id"`
+	Version byte   `json:"version"`
 }
 
 type INotification interface {
-	*responses.Block | *responses.State | *responses.GasPrice
+	*responses.Block | *responses.State | *responses.GasPrice | *responses.Tx | *responses.Message | *responses.BlobLog | *responses.Alert | *responses.MempoolTx
 }
 
 type Notification[T INotification] struct {
@@ -77,6 +109,41 @@ func NewGasPriceNotification(value responses.GasPrice) Notification[*responses.G
 	}
 }
 
+func NewTxNotification(tx responses.Tx) Notification[*responses.Tx] {
+	return Notification[*responses.Tx]{
+		Channel: ChannelTxs,
+		Body:    &tx,
+	}
+}
+
+func NewMessageNotification(msg responses.Message) Notification[*responses.Message] {
+	return Notification[*responses.Message]{
+		Channel: ChannelMessages,
+		Body:    &msg,
+	}
+}
+
+func NewBlobNotification(blob responses.BlobLog) Notification[*responses.BlobLog] {
+	return Notification[*responses.BlobLog]{
+		Channel: ChannelBlobs,
+		Body:    &blob,
+	}
+}
+
+func NewAlertNotification(alert responses.Alert) Notification[*responses.Alert] {
+	return Notification[*responses.Alert]{
+		Channel: ChannelAlerts,
+		Body:    &alert,
+	}
+}
+
+func NewMempoolNotification(tx responses.MempoolTx) Notification[*responses.MempoolTx] {
+	return Notification[*responses.MempoolTx]{
+		Channel: ChannelMempool,
+		Body:    &tx,
+	}
+}
+
 // error codes reported to the client. Codes are stable and safe to expose;
 // internal error details are never sent to the client to avoid leaking
 // sensitive information.
@@ -84,6 +151,7 @@ const (
 	ErrCodeInvalidMessage = 1
 	ErrCodeUnknownMethod  = 2
 	ErrCodeUnknownChannel = 3
+	ErrCodeInvalidFilters = 4
 )
 
 // ErrorMessage is sent to the client when an incoming message cannot be handled.
@@ -116,6 +184,7 @@ var (
 	errInvalidMessage = newErrorMessage(ErrCodeInvalidMessage, "invalid message")
 	errUnknownMethod  = newErrorMessage(ErrCodeUnknownMethod, "unknown method")
 	errUnknownChannel = newErrorMessage(ErrCodeUnknownChannel, "unknown channel")
+	errInvalidFilters = newErrorMessage(ErrCodeInvalidFilters, "invalid filters")
 )
 
 // errorMessage maps an internal error to a safe, predefined client-facing message.
@@ -127,6 +196,8 @@ func errorMessage(err error) ErrorMessage {
 		return errUnknownMethod
 	case errors.Is(err, ErrUnknownChannel):
 		return errUnknownChannel
+	case errors.Is(err, ErrUnavailableFilter):
+		return errInvalidFilters
 	default:
 		return errInvalidMessage
 	}
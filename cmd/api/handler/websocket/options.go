@@ -3,6 +3,8 @@
 
 package websocket
 
+import "github.com/celenium-io/celestia-indexer/internal/storage"
+
 type ManagerOption func(*Manager)
 
 func WithWebsocketClientsPerIp(limit int) ManagerOption {
@@ -10,3 +12,9 @@ func WithWebsocketClientsPerIp(limit int) ManagerOption {
 		m.websocketClientsPerIp = limit
 	}
 }
+
+func WithRollups(rollups storage.IRollup) ManagerOption {
+	return func(m *Manager) {
+		m.rollups = rollups
+	}
+}
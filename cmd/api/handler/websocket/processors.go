@@ -21,8 +21,36 @@ func headProcessor(state storage.State) Notification[*responses.State] {
 
 func gasPriceProcessor(data gas.GasPrice) Notification[*responses.GasPrice] {
 	return NewGasPriceNotification(responses.GasPrice{
-		Slow:   data.Slow,
-		Median: data.Median,
-		Fast:   data.Fast,
+		Slow:                data.Slow,
+		Median:              data.Median,
+		Fast:                data.Fast,
+		SlowInclusionTime:   data.SlowInclusionTime,
+		MedianInclusionTime: data.MedianInclusionTime,
+		FastInclusionTime:   data.FastInclusionTime,
 	})
 }
+
+func txProcessor(tx storage.Tx) Notification[*responses.Tx] {
+	return NewTxNotification(responses.NewTx(tx))
+}
+
+func messageProcessor(msg storage.Message) Notification[*responses.Message] {
+	response := responses.NewMessage(msg)
+	response.Addresses = make([]string, len(msg.Addresses))
+	for i := range msg.Addresses {
+		response.Addresses[i] = msg.Addresses[i].Address.Address
+	}
+	return NewMessageNotification(response)
+}
+
+func blobProcessor(blob storage.BlobLog) Notification[*responses.BlobLog] {
+	return NewBlobNotification(responses.NewBlobLog(blob))
+}
+
+func alertProcessor(alert storage.Alert) Notification[*responses.Alert] {
+	return NewAlertNotification(responses.NewAlert(alert))
+}
+
+func mempoolProcessor(tx storage.MempoolTx) Notification[*responses.MempoolTx] {
+	return NewMempoolNotification(responses.NewMempoolTx(tx))
+}
@@ -12,18 +12,23 @@ import (
 	"strings"
 	"time"
 
+	"github.com/celenium-io/celestia-indexer/cmd/api/alert"
 	"github.com/celenium-io/celestia-indexer/cmd/api/bus"
 	"github.com/celenium-io/celestia-indexer/cmd/api/cache"
 	"github.com/celenium-io/celestia-indexer/cmd/api/gas"
+	"github.com/celenium-io/celestia-indexer/cmd/api/graphql"
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler"
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/websocket"
 	"github.com/celenium-io/celestia-indexer/cmd/api/hyperlane"
 	"github.com/celenium-io/celestia-indexer/cmd/api/ibc_relayer"
+	"github.com/celenium-io/celestia-indexer/cmd/api/quota"
+	"github.com/celenium-io/celestia-indexer/cmd/api/webhook"
 	"github.com/celenium-io/celestia-indexer/internal/blob"
 	"github.com/celenium-io/celestia-indexer/internal/profiler"
 	"github.com/celenium-io/celestia-indexer/internal/realip"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/node"
 	nodeApi "github.com/celenium-io/celestia-indexer/pkg/node/dal"
 	"github.com/celenium-io/celestia-indexer/pkg/node/rpc"
@@ -133,6 +138,11 @@ func postSkipper(c echo.Context) bool {
 	return false
 }
 
+// apiKeySkipper - requests with active api key are limited by the key instead of IP
+func apiKeySkipper(c echo.Context) bool {
+	return usageMeter != nil && usageMeter.Skipper(c)
+}
+
 func gzipSkipper(c echo.Context) bool {
 	if c.Path() == "/v1/swagger/doc.json" {
 		return true
@@ -248,12 +258,17 @@ func initEcho(cfg ApiConfig, env string) *echo.Echo {
 			DoNotUseRequestPathFor404: true,
 		}))
 	}
-	if cfg.RateLimit > 0 {
+	// IP limiter goes first, so requests with unknown keys are limited before the key lookup
+	if ipLimiter != nil {
 		e.Use(middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
-			Skipper: websocketSkipper,
-			Store:   middleware.NewRateLimiterMemoryStore(rate.Limit(cfg.RateLimit)),
+			Skipper: func(c echo.Context) bool {
+				return websocketSkipper(c) || apiKeySkipper(c)
+			},
+			Store: ipLimiter,
 		}))
-
+	}
+	if usageMeter != nil {
+		e.Use(usageMeter.Middleware)
 	}
 
 	if err := initSentry(e, cfg.SentryDsn, env); err != nil {
@@ -285,6 +300,9 @@ func initDatabase(cfg config.Database, viewsDir string) postgres.Storage {
 	return db
 }
 
+// immutableCacheTTL - lifetime of cached responses which are invalidated by tags
+const immutableCacheTTL = 24 * time.Hour
+
 var ttlCache cache.ICache
 
 func initCache(url string, ttl int) {
@@ -319,34 +337,44 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		}
 		return diff
 	})
+	// block and transaction pages are changed only by rollbacks and label changes, so they are invalidated by tags and cached for a long time
+	immutableMiddlewareCache := cache.TaggedMiddleware(ttlCache, cache.Tags(cache.TagRollback, cache.TagLabel), func() time.Duration {
+		return immutableCacheTTL
+	})
+	addressMiddlewareCache := cache.TaggedMiddleware(ttlCache, cache.ParamTags("hash", cache.AddressTag, cache.TagRollback, cache.TagLabel), nil)
+	namespaceMiddlewareCache := cache.TaggedMiddleware(ttlCache, cache.ParamTags("id", cache.NamespaceTag, cache.TagRollback), nil)
 
 	constantsHandler := handler.NewConstantHandler(db.Constants, db.DenomMetadata, db.Rollup)
 	v1.GET("/constants", constantsHandler.Get, defaultMiddlewareCache)
 	v1.GET("/enums", constantsHandler.Enums, defaultMiddlewareCache)
 
-	searchHandler := handler.NewSearchHandler(db.Search, db.Address, db.Blocks, db.Tx, db.Namespace, db.Validator, db.Rollup, db.Celestials)
+	searchHandler := handler.NewSearchHandler(db.Search, db.Address, db.Blocks, db.Tx, db.Namespace, db.Validator, db.Rollup, db.AddressLabels, db.Proposals, db.IbcTransfers, db.Celestials)
 	v1.GET("/search", searchHandler.Search)
 
-	addressHandlers := handler.NewAddressHandler(db.Address, db.Blocks, db.Tx, db.BlobLogs, db.Message, db.Delegation, db.Undelegation, db.Redelegation, db.VestingAccounts, db.Grants, db.Celestials, db.Votes, db.State, cfg.Indexer.Name)
+	addressHandlers := handler.NewAddressHandler(db.Address, db.Blocks, db.Tx, db.BlobLogs, db.Message, db.Delegation, db.Undelegation, db.Redelegation, db.VestingAccounts, db.Grants, db.Celestials, db.Votes, db.StakingLogs, db.Validator, db.State, cfg.Indexer.Name)
 	addressesGroup := v1.Group("/address")
 	{
 		addressesGroup.GET("", addressHandlers.List)
 		addressesGroup.GET("/count", addressHandlers.Count)
 		addressGroup := addressesGroup.Group("/:hash")
 		{
-			addressGroup.GET("", addressHandlers.Get)
-			addressGroup.GET("/txs", addressHandlers.Transactions)
-			addressGroup.GET("/messages", addressHandlers.Messages)
-			addressGroup.GET("/blobs", addressHandlers.Blobs)
-			addressGroup.GET("/delegations", addressHandlers.Delegations)
-			addressGroup.GET("/undelegations", addressHandlers.Undelegations)
-			addressGroup.GET("/redelegations", addressHandlers.Redelegations)
-			addressGroup.GET("/vestings", addressHandlers.Vestings)
-			addressGroup.GET("/grants", addressHandlers.Grants)
-			addressGroup.GET("/granters", addressHandlers.Grantee)
+			addressGroup.GET("", addressHandlers.Get, addressMiddlewareCache)
+			addressGroup.GET("/txs", addressHandlers.Transactions, addressMiddlewareCache)
+			addressGroup.GET("/messages", addressHandlers.Messages, addressMiddlewareCache)
+			addressGroup.GET("/blobs", addressHandlers.Blobs, addressMiddlewareCache)
+			addressGroup.GET("/delegations", addressHandlers.Delegations, addressMiddlewareCache)
+			addressGroup.GET("/undelegations", addressHandlers.Undelegations, addressMiddlewareCache)
+			addressGroup.GET("/redelegations", addressHandlers.Redelegations, addressMiddlewareCache)
+			addressGroup.GET("/vestings", addressHandlers.Vestings, addressMiddlewareCache)
+			addressGroup.GET("/grants", addressHandlers.Grants, addressMiddlewareCache)
+			addressGroup.GET("/granters", addressHandlers.Grantee, addressMiddlewareCache)
 			addressGroup.GET("/celestials", addressHandlers.Celestials)
-			addressGroup.GET("/votes", addressHandlers.Votes)
+			addressGroup.GET("/votes", addressHandlers.Votes, addressMiddlewareCache)
+			addressGroup.GET("/effective_votes", addressHandlers.EffectiveVotes)
+			addressGroup.GET("/rewards", addressHandlers.Rewards)
+			addressGroup.GET("/rewards/:timeframe", addressHandlers.RewardsSeries, statsMiddlewareCache)
 			addressGroup.GET("/balances", addressHandlers.Balances)
+			addressGroup.GET("/balances/history/:timeframe", addressHandlers.BalanceHistory, statsMiddlewareCache)
 			addressGroup.GET("/stats/:name/:timeframe", addressHandlers.Stats, statsMiddlewareCache)
 		}
 	}
@@ -356,24 +384,25 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	}
 	node := rpc.NewAPI(ds)
 
-	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.Event, db.Namespace, db.Message, db.BlobLogs, db.State, &node, cfg.Indexer.Name)
+	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.Event, db.Namespace, db.Message, db.BlobLogs, db.ShareRanges, db.State, &node, cfg.Indexer.Name)
 	blockGroup := v1.Group("/block")
 	{
 		blockGroup.GET("", blockHandlers.List)
 		blockGroup.GET("/count", blockHandlers.Count)
 		heightGroup := blockGroup.Group("/:height")
 		{
-			heightGroup.GET("", blockHandlers.Get, defaultMiddlewareCache)
-			heightGroup.GET("/events", blockHandlers.GetEvents, defaultMiddlewareCache)
-			heightGroup.GET("/messages", blockHandlers.GetMessages, defaultMiddlewareCache)
-			heightGroup.GET("/stats", blockHandlers.GetStats, defaultMiddlewareCache)
-			heightGroup.GET("/blobs", blockHandlers.Blobs, defaultMiddlewareCache)
-			heightGroup.GET("/blobs/count", blockHandlers.BlobsCount, defaultMiddlewareCache)
-			heightGroup.GET("/ods", blockHandlers.BlockODS, defaultMiddlewareCache)
+			heightGroup.GET("", blockHandlers.Get, immutableMiddlewareCache)
+			heightGroup.GET("/events", blockHandlers.GetEvents, immutableMiddlewareCache)
+			heightGroup.GET("/messages", blockHandlers.GetMessages, immutableMiddlewareCache)
+			heightGroup.GET("/stats", blockHandlers.GetStats, immutableMiddlewareCache)
+			heightGroup.GET("/blobs", blockHandlers.Blobs, immutableMiddlewareCache)
+			heightGroup.GET("/blobs/count", blockHandlers.BlobsCount, immutableMiddlewareCache)
+			heightGroup.GET("/ods", blockHandlers.BlockODS, immutableMiddlewareCache)
+			heightGroup.GET("/shares", blockHandlers.BlockShares, immutableMiddlewareCache)
 		}
 	}
 
-	txHandlers := handler.NewTxHandler(db.Tx, db.Blocks, db.Event, db.Message, db.Namespace, db.BlobLogs, db.State, cfg.Indexer.Name)
+	txHandlers := handler.NewTxHandler(db.Tx, db.Blocks, db.Event, db.Message, db.Namespace, db.BlobLogs, db.AddressLabels, db.State, cfg.Indexer.Name)
 	txGroup := v1.Group("/tx")
 	{
 		txGroup.GET("", txHandlers.List)
@@ -381,11 +410,11 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		txGroup.GET("/genesis", txHandlers.Genesis, defaultMiddlewareCache)
 		hashGroup := txGroup.Group("/:hash")
 		{
-			hashGroup.GET("", txHandlers.Get, defaultMiddlewareCache)
-			hashGroup.GET("/events", txHandlers.GetEvents, defaultMiddlewareCache)
-			hashGroup.GET("/messages", txHandlers.GetMessages, defaultMiddlewareCache)
-			hashGroup.GET("/blobs", txHandlers.Blobs, defaultMiddlewareCache)
-			hashGroup.GET("/blobs/count", txHandlers.BlobsCount, defaultMiddlewareCache)
+			hashGroup.GET("", txHandlers.Get, immutableMiddlewareCache)
+			hashGroup.GET("/events", txHandlers.GetEvents, immutableMiddlewareCache)
+			hashGroup.GET("/messages", txHandlers.GetMessages, immutableMiddlewareCache)
+			hashGroup.GET("/blobs", txHandlers.Blobs, immutableMiddlewareCache)
+			hashGroup.GET("/blobs/count", txHandlers.BlobsCount, immutableMiddlewareCache)
 		}
 	}
 
@@ -416,10 +445,10 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	namespaceGroup := v1.Group("/namespace")
 	{
 		namespaceGroup.GET("", namespaceHandlers.List)
-		namespaceGroup.GET("/:id", namespaceHandlers.Get)
-		namespaceGroup.GET("/:id/:version", namespaceHandlers.GetWithVersion)
-		namespaceGroup.GET("/:id/:version/messages", namespaceHandlers.GetMessages)
-		namespaceGroup.GET("/:id/:version/blobs", namespaceHandlers.GetBlobLogs)
+		namespaceGroup.GET("/:id", namespaceHandlers.Get, namespaceMiddlewareCache)
+		namespaceGroup.GET("/:id/:version", namespaceHandlers.GetWithVersion, namespaceMiddlewareCache)
+		namespaceGroup.GET("/:id/:version/messages", namespaceHandlers.GetMessages, namespaceMiddlewareCache)
+		namespaceGroup.GET("/:id/:version/blobs", namespaceHandlers.GetBlobLogs, namespaceMiddlewareCache)
 		namespaceGroup.GET("/:id/:version/rollups", namespaceHandlers.Rollups)
 	}
 
@@ -429,7 +458,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		namespaceByHash.GET("/:hash/:height", namespaceHandlers.GetBlobs)
 	}
 
-	validatorsHandler := handler.NewValidatorHandler(db.Validator, db.Blocks, db.BlockSignatures, db.Delegation, db.Constants, db.Jails, db.Votes, db.State, cfg.Indexer.Name)
+	validatorsHandler := handler.NewValidatorHandler(db.Validator, db.Blocks, db.BlockSignatures, db.Delegation, db.Constants, db.Jails, db.Votes, db.StakingLogs, db.Address, db.State, cfg.Indexer.Name)
 	validators := v1.Group("/validators")
 	{
 		validators.GET("", validatorsHandler.List)
@@ -445,10 +474,14 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 			validator.GET("/votes", validatorsHandler.Votes)
 			validator.GET("/messages", validatorsHandler.Messages)
 			validator.GET("/metrics", validatorsHandler.Metrics)
+			validator.GET("/history", validatorsHandler.History)
+			validator.GET("/rewards", validatorsHandler.Rewards)
+			validator.GET("/rewards/:timeframe", validatorsHandler.RewardsSeries, statsMiddlewareCache)
 		}
 	}
 
 	statsHandler := handler.NewStatsHandler(db.Stats, db.Namespace, db.IbcTransfers, db.IbcChannels, db.HLTransfer, chainStore, db.State)
+	supplyHandler := handler.NewSupplyHandler(db.Supply, db.VestingAccounts, db.Blocks, db.State, cfg.Indexer.Name)
 	stats := v1.Group("/stats")
 	{
 		stats.GET("/summary/:table/:function", statsHandler.Summary)
@@ -480,12 +513,20 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 			hl.GET("/series/:id/:name/:timeframe", statsHandler.HlSeries, statsMiddlewareCache)
 			hl.GET("/chains/:name/:timeframe", statsHandler.HlTotalSeries, statsMiddlewareCache)
 			hl.GET("/chains", statsHandler.HlByDomain, statsMiddlewareCache)
+			hl.GET("/latency", statsHandler.HlLatency, statsMiddlewareCache)
 		}
 		series := stats.Group("/series")
 		{
 			series.GET("/:name/:timeframe", statsHandler.Series, statsMiddlewareCache)
 			series.GET("/:name/:timeframe/cumulative", statsHandler.SeriesCumulative, statsMiddlewareCache)
 		}
+		supply := stats.Group("/supply")
+		{
+			supply.GET("", supplyHandler.Get)
+			supply.GET("/circulating", supplyHandler.Circulating)
+			supply.GET("/series/:timeframe", supplyHandler.Series, statsMiddlewareCache)
+			supply.GET("/forecast", supplyHandler.Forecast, statsMiddlewareCache)
+		}
 	}
 
 	gasHandler := handler.NewGasHandler(db.State, db.Tx, db.Constants, db.BlockStats, gasTracker)
@@ -502,12 +543,14 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		vesting.GET("/:id/periods", vestingHandler.Periods)
 	}
 
-	proposalHandler := handler.NewProposalsHandler(db.Proposals, db.Votes, db.Address, db.Validator)
+	proposalHandler := handler.NewProposalsHandler(db.Proposals, db.Votes, db.Address, db.Validator, db.Constants)
 	proposal := v1.Group("/proposal")
 	{
 		proposal.GET("", proposalHandler.List)
 		proposal.GET("/:id", proposalHandler.Get)
 		proposal.GET("/:id/votes", proposalHandler.Votes)
+		proposal.GET("/:id/timeline", proposalHandler.Timeline)
+		proposal.GET("/:id/effective_votes", proposalHandler.EffectiveVotes)
 	}
 
 	relayers, err := ibc_relayer.NewRelayerStore(ctx, "./assets/relayers_celestia.json", db.Address)
@@ -584,6 +627,16 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		signalGroup.GET("/upgrade/:version", signalHandler.Upgrade)
 	}
 
+	alertHandler := handler.NewAlertHandler(db.Alerts)
+	v1.GET("/alert", alertHandler.List)
+
+	mempoolHandler := handler.NewMempoolHandler(db.MempoolTxs)
+	mempool := v1.Group("/mempool")
+	{
+		mempool.GET("", mempoolHandler.List)
+		mempool.GET("/:hash", mempoolHandler.Get)
+	}
+
 	fwdHandler := handler.NewForwardingsHandler(db.Forwardings, db.Address, db.Tx, chainStore)
 	forwarding := v1.Group("/forwarding")
 	{
@@ -591,6 +644,15 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		forwarding.GET("/:id", fwdHandler.Get)
 	}
 
+	graphqlHandler, err := graphql.NewHandler(
+		db.Blocks, db.Tx, db.Message, db.Event, db.Namespace, db.BlobLogs, db.Address, db.Validator, db.Rollup,
+		graphql.WithMaxCost(cfg.ApiConfig.GraphqlMaxCost),
+	)
+	if err != nil {
+		panic(err)
+	}
+	v1.POST("/graphql", graphqlHandler.Serve)
+
 	htmlContent, err := scalar.ApiReferenceHTML(&scalar.Options{
 		SpecURL: "./docs/swagger.json",
 		CustomOptions: scalar.CustomOptions{
@@ -622,7 +684,12 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	})
 
 	if cfg.ApiConfig.Websocket {
-		initWebsocket(ctx, v1)
+		initWebsocket(ctx, v1, db)
+	}
+
+	if usageMeter != nil {
+		usageHandler := handler.NewUsageHandler(usageMeter)
+		v1.GET("/usage", usageHandler.Get)
 	}
 
 	rollupHandler := handler.NewRollupHandler(db.Rollup, db.RollupProvider, db.Namespace, db.BlobLogs)
@@ -680,9 +747,17 @@ var (
 	wsManager *websocket.Manager
 )
 
-func initWebsocket(ctx context.Context, group *echo.Group) {
-	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock)
-	wsManager = websocket.NewManager(observer)
+func initWebsocket(ctx context.Context, group *echo.Group, db postgres.Storage) {
+	observer := dispatcher.Observe(
+		storage.ChannelHead,
+		storage.ChannelBlock,
+		storage.ChannelTx,
+		storage.ChannelMessage,
+		storage.ChannelBlob,
+		storage.ChannelAlert,
+		storage.ChannelMempool,
+	)
+	wsManager = websocket.NewManager(observer, websocket.WithRollups(db.Rollup))
 	if gasTracker != nil {
 		gasTracker.SubscribeOnCompute(wsManager.GasTrackerHandler)
 	}
@@ -690,11 +765,95 @@ func initWebsocket(ctx context.Context, group *echo.Group) {
 	group.GET("/ws", wsManager.Handle)
 }
 
+var webhooks *webhook.Dispatcher
+
+func initWebhooks(ctx context.Context, cfg ApiConfig, db postgres.Storage) {
+	if !cfg.Webhooks {
+		return
+	}
+	observer := dispatcher.Observe(
+		storage.ChannelBlob,
+		storage.ChannelMessage,
+		storage.ChannelJail,
+		storage.ChannelProposal,
+	)
+	webhooks = webhook.NewDispatcher(observer, db.Webhooks, db.WebhookDelivery, db.WebhookTasks, db.Validator, db.Proposals)
+	webhooks.Start(ctx)
+}
+
+var alerts *alert.Evaluator
+
+func initAlerts(ctx context.Context, cfg ApiConfig, db postgres.Storage) {
+	if !cfg.Alerts {
+		return
+	}
+	alerts = alert.NewEvaluator(db.AlertRules, db.Alerts, db.Notificator)
+	alerts.Start(ctx)
+}
+
+var ipLimiter middleware.RateLimiterStore
+
+// initIpLimiter - creates store of the rate limiter by IP. It's shared between IP rate limiting middleware and api key meter.
+func initIpLimiter(cfg ApiConfig) {
+	if cfg.RateLimit > 0 {
+		ipLimiter = middleware.NewRateLimiterMemoryStore(rate.Limit(cfg.RateLimit))
+	}
+}
+
+var usageMeter *quota.Meter
+
+// initUsageMeter - enables api keys in public API. Counters of limits and usage are stored in Valkey, so api keys are disabled without cache.
+// If Valkey becomes unavailable requests with api keys are limited by IP.
+func initUsageMeter(ctx context.Context, cfg ApiConfig, db postgres.Storage) {
+	counter, ok := ttlCache.(cache.ICounter)
+	if !ok {
+		return
+	}
+
+	tiers := make(map[types.ApiKeyTier]quota.Tier, len(cfg.Tiers))
+	for name, tier := range cfg.Tiers {
+		apiKeyTier, err := types.ParseApiKeyTier(name)
+		if err != nil {
+			panic(err)
+		}
+		tiers[apiKeyTier] = quota.Tier{
+			RateLimit:  tier.RateLimit,
+			DailyQuota: tier.DailyQuota,
+		}
+	}
+	opts := []quota.MeterOption{
+		quota.WithTiers(tiers),
+		quota.WithObserver(dispatcher.Observe(storage.ChannelApiKey)),
+	}
+	if ipLimiter != nil {
+		opts = append(opts, quota.WithFallbackLimiter(ipLimiter))
+	}
+	usageMeter = quota.NewMeter(db.ApiKeys, db.ApiKeys, counter, opts...)
+	usageMeter.Start(ctx)
+}
+
+var cacheInvalidator *cache.Invalidator
+
+func initCacheInvalidator(ctx context.Context) {
+	if ttlCache == nil {
+		return
+	}
+	observer := dispatcher.Observe(
+		storage.ChannelHead,
+		storage.ChannelAddress,
+		storage.ChannelBlob,
+		storage.ChannelRollback,
+		storage.ChannelLabel,
+	)
+	cacheInvalidator = cache.NewInvalidator(ttlCache, observer)
+	cacheInvalidator.Start(ctx)
+}
+
 var gasTracker *gas.Tracker
 
 func initGasTracker(ctx context.Context, db postgres.Storage) {
-	observer := dispatcher.Observe(storage.ChannelBlock)
-	gasTracker = gas.NewTracker(db.State, db.BlockStats, db.Tx, observer)
+	observer := dispatcher.Observe(storage.ChannelBlock, storage.ChannelMempool)
+	gasTracker = gas.NewTracker(db.State, db.BlockStats, db.Tx, observer, gas.WithMempool(db.MempoolTxs))
 	if err := gasTracker.Init(ctx); err != nil {
 		panic(err)
 	}
@@ -58,9 +58,14 @@ func main() {
 
 	initCache(cfg.ApiConfig.Cache, cfg.ApiConfig.DefaultCacheTTL)
 	db := initDatabase(cfg.Database, cfg.Indexer.ScriptsDir)
-	e := initEcho(cfg.ApiConfig, cfg.Environment)
 	initDispatcher(ctx, db)
+	initIpLimiter(cfg.ApiConfig)
+	initUsageMeter(ctx, cfg.ApiConfig, db)
+	e := initEcho(cfg.ApiConfig, cfg.Environment)
+	initCacheInvalidator(ctx)
 	initGasTracker(ctx, db)
+	initWebhooks(ctx, cfg.ApiConfig, db)
+	initAlerts(ctx, cfg.ApiConfig, db)
 	initChainStore(ctx, cfg.ApiConfig.HyperlaneNodeUrl)
 	initHandlers(ctx, e, *cfg, db)
 
@@ -82,6 +87,30 @@ func main() {
 		}
 	}
 
+	if cacheInvalidator != nil {
+		if err := cacheInvalidator.Close(); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
+
+	if usageMeter != nil {
+		if err := usageMeter.Close(); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
+
+	if webhooks != nil {
+		if err := webhooks.Close(); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
+
+	if alerts != nil {
+		if err := alerts.Close(); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
+
 	if wsManager != nil {
 		if err := wsManager.Close(); err != nil {
 			e.Logger.Fatal(err)
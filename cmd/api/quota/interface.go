@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package quota
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock.go -package=quota -typed
+type IMeter interface {
+	// Limits - returns limits of the api key: limits of its tier overridden by limits of the key
+	Limits(key storage.ApiKey) Tier
+	// Usage - returns usage of the api key per day from today to the past
+	Usage(ctx context.Context, key storage.ApiKey, days int) ([]DayUsage, error)
+}
+
+type NoRows interface {
+	IsNoRows(err error) bool
+}
@@ -0,0 +1,339 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/bus"
+	"github.com/celenium-io/celestia-indexer/cmd/api/cache"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/workerpool"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// HeaderApiKey - request header with the api key
+	HeaderApiKey = "apikey"
+	// ContextKey - key of the api key in request context
+	ContextKey = "api_key"
+
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderQuotaLimit         = "X-Quota-Limit"
+	HeaderQuotaRemaining     = "X-Quota-Remaining"
+
+	// MaxUsageDays - count of days for which usage is stored
+	MaxUsageDays = 30
+
+	totalField    = "total"
+	rateTTL       = 2 * time.Second
+	usageTTL      = (MaxUsageDays + 1) * 24 * time.Hour
+	keyCacheTTL   = time.Minute
+	maxCachedKeys = 100_000
+)
+
+// cachedKey - result of the api key lookup. Unknown secrets are cached too, so guessing of secrets doesn't hit the database on every request.
+type cachedKey struct {
+	key       storage.ApiKey
+	found     bool
+	expiredAt time.Time
+}
+
+// Meter - applies per-key rate limits and daily quotas to public API requests and accounts usage per route.
+// Counters are stored in Valkey so limits are shared between API instances.
+// Cached keys are dropped on notifications about changes of the keys in private API.
+type Meter struct {
+	apiKeys    storage.IApiKey
+	errChecker NoRows
+	counter    cache.ICounter
+	fallback   middleware.RateLimiterStore
+	observer   *bus.Observer
+	tiers      map[types.ApiKeyTier]Tier
+	keys       map[string]cachedKey
+	mx         *sync.RWMutex
+	log        zerolog.Logger
+	now        func() time.Time
+	g          workerpool.Group
+}
+
+func NewMeter(apiKeys storage.IApiKey, errChecker NoRows, counter cache.ICounter, opts ...MeterOption) *Meter {
+	m := &Meter{
+		apiKeys:    apiKeys,
+		errChecker: errChecker,
+		counter:    counter,
+		tiers:      maps.Clone(DefaultTiers),
+		keys:       make(map[string]cachedKey),
+		mx:         new(sync.RWMutex),
+		log:        log.With().Str("module", "quota_meter").Logger(),
+		now:        time.Now,
+		g:          workerpool.NewGroup(),
+	}
+
+	for i := range opts {
+		opts[i](m)
+	}
+
+	return m
+}
+
+// Start - listens notifications about changed keys if the observer is set
+func (m *Meter) Start(ctx context.Context) {
+	if m.observer == nil {
+		return
+	}
+	m.g.GoCtx(ctx, m.listen)
+}
+
+func (m *Meter) Close() error {
+	m.g.Wait()
+	return nil
+}
+
+func (m *Meter) listen(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case key, ok := <-m.observer.ApiKeys():
+			if !ok {
+				return
+			}
+			m.forget(key)
+		}
+	}
+}
+
+// forget - removes cached lookup results of the key, so its changes, rotation or revocation are applied to the next request
+func (m *Meter) forget(key string) {
+	m.mx.Lock()
+	for hash, cached := range m.keys {
+		if cached.found && cached.key.Key == key {
+			delete(m.keys, hash)
+		}
+	}
+	m.mx.Unlock()
+}
+
+// ApiKey - returns the api key of the request if it was passed
+func ApiKey(c echo.Context) (storage.ApiKey, bool) {
+	key, ok := c.Get(ContextKey).(storage.ApiKey)
+	return key, ok
+}
+
+// Skipper - returns true if the request is authorized by the api key which is known as active. Such requests are limited by the key instead of IP.
+// Keys which were not requested recently are not known yet, so the first request with the key is limited by IP too.
+func (m *Meter) Skipper(c echo.Context) bool {
+	value := c.Request().Header.Get(HeaderApiKey)
+	if value == "" {
+		return false
+	}
+
+	now := m.now()
+	m.mx.RLock()
+	cached, ok := m.keys[storage.HashApiKeySecret(value)]
+	m.mx.RUnlock()
+	return ok && cached.found && now.Before(cached.expiredAt) && cached.key.IsActive(now)
+}
+
+// Middleware - checks limits of the api key passed in the request. Requests without api key are passed as is and limited by IP.
+// If Valkey is unavailable requests are limited by the fallback IP limiter or rejected if it's not set.
+func (m *Meter) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		value := c.Request().Header.Get(HeaderApiKey)
+		if value == "" {
+			return next(c)
+		}
+
+		ctx := c.Request().Context()
+		apiKey, err := m.apiKey(ctx, value)
+		if err != nil {
+			if m.errChecker.IsNoRows(err) {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid api key")
+			}
+			return err
+		}
+
+		var (
+			limits = m.Limits(apiKey)
+			now    = m.now().UTC()
+			header = c.Response().Header()
+		)
+		if !apiKey.IsActive(now) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "api key is expired or revoked")
+		}
+		c.Set(ContextKey, apiKey)
+
+		if limits.RateLimit > 0 {
+			count, err := m.counter.Increment(ctx, rateKey(apiKey.Key, now), rateTTL)
+			if err != nil {
+				m.log.Err(err).Msg("increment rate counter")
+				return m.fallbackLimit(c, next)
+			}
+			header.Set(HeaderRateLimitLimit, strconv.FormatInt(limits.RateLimit, 10))
+			header.Set(HeaderRateLimitRemaining, strconv.FormatInt(max(limits.RateLimit-count, 0), 10))
+			if count > limits.RateLimit {
+				header.Set(echo.HeaderRetryAfter, "1")
+				return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+			}
+		}
+
+		route := c.Path()
+		if route == "" {
+			route = c.Request().URL.Path
+		}
+		key := usageKey(apiKey.Key, now)
+		counts, err := m.counter.IncrementFields(ctx, key, 1, usageTTL, totalField, route)
+		if err != nil {
+			m.log.Err(err).Msg("increment usage counters")
+			return m.fallbackLimit(c, next)
+		}
+
+		if limits.DailyQuota > 0 {
+			header.Set(HeaderQuotaLimit, strconv.FormatInt(limits.DailyQuota, 10))
+			header.Set(HeaderQuotaRemaining, strconv.FormatInt(max(limits.DailyQuota-counts[0], 0), 10))
+			if counts[0] > limits.DailyQuota {
+				// rejected request is not counted in usage
+				if _, err := m.counter.IncrementFields(ctx, key, -1, usageTTL, totalField, route); err != nil {
+					m.log.Err(err).Msg("decrement usage counters")
+				}
+				header.Set(echo.HeaderRetryAfter, strconv.FormatInt(int64(nextDay(now).Sub(now).Seconds())+1, 10))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "daily quota exceeded")
+			}
+		}
+
+		return next(c)
+	}
+}
+
+// fallbackLimit - limits the request by IP when counters of the key are unavailable
+func (m *Meter) fallbackLimit(c echo.Context, next echo.HandlerFunc) error {
+	if m.fallback == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "api key limits are unavailable")
+	}
+	allowed, err := m.fallback.Allow(c.RealIP())
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+	}
+	return next(c)
+}
+
+func (m *Meter) Limits(key storage.ApiKey) Tier {
+	tier := m.tiers[key.Tier]
+	if key.RateLimit > 0 {
+		tier.RateLimit = key.RateLimit
+	}
+	if key.DailyQuota > 0 {
+		tier.DailyQuota = key.DailyQuota
+	}
+	return tier
+}
+
+func (m *Meter) Usage(ctx context.Context, key storage.ApiKey, days int) ([]DayUsage, error) {
+	days = min(max(days, 1), MaxUsageDays)
+
+	today := m.now().UTC().Truncate(24 * time.Hour)
+	usage := make([]DayUsage, days)
+	for i := range usage {
+		date := today.AddDate(0, 0, -i)
+		fields, err := m.counter.Fields(ctx, usageKey(key.Key, date))
+		if err != nil {
+			return nil, err
+		}
+
+		usage[i] = DayUsage{
+			Date:   date,
+			Total:  fields[totalField],
+			Routes: make(map[string]int64, len(fields)),
+		}
+		for route, count := range fields {
+			if route != totalField && count > 0 {
+				usage[i].Routes[route] = count
+			}
+		}
+	}
+	return usage, nil
+}
+
+// apiKey - returns the api key by the secret from the database. Lookup results are cached by hash of the secret for a short time
+// to avoid database request on every API request, so last usage time of the key is updated once per cache period.
+func (m *Meter) apiKey(ctx context.Context, value string) (storage.ApiKey, error) {
+	var (
+		now  = m.now()
+		hash = storage.HashApiKeySecret(value)
+	)
+
+	m.mx.RLock()
+	cached, ok := m.keys[hash]
+	m.mx.RUnlock()
+	if ok && now.Before(cached.expiredAt) {
+		if !cached.found {
+			return cached.key, sql.ErrNoRows
+		}
+		return cached.key, nil
+	}
+
+	apiKey, err := m.apiKeys.BySecret(ctx, value)
+	switch {
+	case err == nil:
+		if apiKey.IsActive(now) {
+			if err := m.apiKeys.SetLastUsed(ctx, apiKey.Key, now.UTC()); err != nil {
+				m.log.Err(err).Str("key", apiKey.Key).Msg("set last usage time of api key")
+			}
+		}
+	case m.errChecker.IsNoRows(err):
+	default:
+		return apiKey, err
+	}
+
+	m.mx.Lock()
+	if len(m.keys) >= maxCachedKeys {
+		m.removeExpired(now)
+	}
+	m.keys[hash] = cachedKey{
+		key:       apiKey,
+		found:     err == nil,
+		expiredAt: now.Add(keyCacheTTL),
+	}
+	m.mx.Unlock()
+	return apiKey, err
+}
+
+// removeExpired - removes expired lookup results from the cache. Whole cache is dropped if all results are actual.
+// Must be called under the lock.
+func (m *Meter) removeExpired(now time.Time) {
+	for hash, cached := range m.keys {
+		if !now.Before(cached.expiredAt) {
+			delete(m.keys, hash)
+		}
+	}
+	if len(m.keys) >= maxCachedKeys {
+		clear(m.keys)
+	}
+}
+
+func rateKey(key string, now time.Time) string {
+	return "ratelimit:" + key + ":" + strconv.FormatInt(now.Unix(), 10)
+}
+
+func usageKey(key string, date time.Time) string {
+	return "usage:" + key + ":" + date.Format(time.DateOnly)
+}
+
+func nextDay(now time.Time) time.Time {
+	return now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// memoryCounter - in-memory implementation of cache.ICounter for tests
+type memoryCounter struct {
+	mx       sync.Mutex
+	counters map[string]int64
+	hashes   map[string]map[string]int64
+	err      error
+}
+
+func newMemoryCounter() *memoryCounter {
+	return &memoryCounter{
+		counters: make(map[string]int64),
+		hashes:   make(map[string]map[string]int64),
+	}
+}
+
+func (c *memoryCounter) Increment(_ context.Context, key string, _ time.Duration) (int64, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.err != nil {
+		return 0, c.err
+	}
+	c.counters[key]++
+	return c.counters[key], nil
+}
+
+func (c *memoryCounter) IncrementFields(_ context.Context, key string, by int64, _ time.Duration, fields ...string) ([]int64, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	if _, ok := c.hashes[key]; !ok {
+		c.hashes[key] = make(map[string]int64)
+	}
+	values := make([]int64, len(fields))
+	for i := range fields {
+		c.hashes[key][fields[i]] += by
+		values[i] = c.hashes[key][fields[i]]
+	}
+	return values, nil
+}
+
+func (c *memoryCounter) Fields(_ context.Context, key string) (map[string]int64, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	values := make(map[string]int64, len(c.hashes[key]))
+	for field, value := range c.hashes[key] {
+		values[field] = value
+	}
+	return values, nil
+}
+
+// allowStore - fallback limiter which allows the passed count of requests
+type allowStore struct {
+	left int
+}
+
+func (s *allowStore) Allow(_ string) (bool, error) {
+	s.left--
+	return s.left >= 0, nil
+}
+
+var testNow = time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+// testSecret - secret of the test key. It differs from the key identity which is used in counters.
+const testSecret = "secret"
+
+func newTestMeter(t *testing.T, key storage.ApiKey, opts ...MeterOption) (*Meter, *memoryCounter) {
+	ctrl := gomock.NewController(t)
+	apiKeys := mock.NewMockIApiKey(ctrl)
+	apiKeys.EXPECT().
+		BySecret(gomock.Any(), testSecret).
+		Return(key, nil).
+		AnyTimes()
+	// unknown secrets are cached, so they are requested once
+	apiKeys.EXPECT().
+		BySecret(gomock.Any(), gomock.Not(testSecret)).
+		Return(storage.ApiKey{}, sql.ErrNoRows).
+		MaxTimes(1)
+	apiKeys.EXPECT().
+		SetLastUsed(gomock.Any(), key.Key, gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	errChecker := NewMockNoRows(ctrl)
+	errChecker.EXPECT().
+		IsNoRows(gomock.Any()).
+		DoAndReturn(func(err error) bool {
+			return err == sql.ErrNoRows
+		}).
+		AnyTimes()
+
+	counter := newMemoryCounter()
+	meter := NewMeter(apiKeys, errChecker, counter, opts...)
+	meter.now = func() time.Time { return testNow }
+	return meter, counter
+}
+
+func newRequestContext(t *testing.T, secret string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/block/100", nil)
+	if secret != "" {
+		req.Header.Set(HeaderApiKey, secret)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/v1/block/:height")
+	return c, rec
+}
+
+func request(t *testing.T, meter *Meter, secret string) (*httptest.ResponseRecorder, error) {
+	c, rec := newRequestContext(t, secret)
+	err := meter.Middleware(func(c echo.Context) error {
+		if _, ok := ApiKey(c); !ok && secret != "" {
+			t.Error("api key is not set in context")
+		}
+		return c.NoContent(http.StatusOK)
+	})(c)
+	return rec, err
+}
+
+func requireStatus(t *testing.T, err error, code int) {
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, code, httpErr.Code)
+}
+
+func TestMeter_Middleware(t *testing.T) {
+	t.Run("without api key", func(t *testing.T) {
+		meter, counter := newTestMeter(t, storage.ApiKey{Key: "test"})
+
+		rec, err := request(t, meter, "")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, counter.counters)
+		require.Empty(t, counter.hashes)
+	})
+
+	t.Run("invalid api key", func(t *testing.T) {
+		meter, _ := newTestMeter(t, storage.ApiKey{Key: "test"})
+
+		_, err := request(t, meter, "unknown")
+		requireStatus(t, err, http.StatusUnauthorized)
+
+		// result of the lookup is cached
+		_, err = request(t, meter, "unknown")
+		requireStatus(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("revoked api key", func(t *testing.T) {
+		meter, counter := newTestMeter(t, storage.ApiKey{
+			Key:       "test",
+			RevokedAt: testNow.Add(-time.Hour),
+		})
+
+		_, err := request(t, meter, testSecret)
+		requireStatus(t, err, http.StatusUnauthorized)
+		require.Empty(t, counter.counters)
+		require.Empty(t, counter.hashes)
+	})
+
+	t.Run("expired api key", func(t *testing.T) {
+		meter, _ := newTestMeter(t, storage.ApiKey{
+			Key:       "test",
+			ExpiresAt: testNow.Add(time.Hour),
+		})
+
+		_, err := request(t, meter, testSecret)
+		require.NoError(t, err)
+
+		meter.now = func() time.Time { return testNow.Add(2 * time.Hour) }
+		_, err = request(t, meter, testSecret)
+		requireStatus(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		meter, _ := newTestMeter(t, storage.ApiKey{
+			Key:       "test",
+			Tier:      types.ApiKeyTierFree,
+			RateLimit: 2,
+		})
+
+		for range 2 {
+			rec, err := request(t, meter, testSecret)
+			require.NoError(t, err)
+			require.Equal(t, "2", rec.Header().Get(HeaderRateLimitLimit))
+		}
+
+		rec, err := request(t, meter, testSecret)
+		requireStatus(t, err, http.StatusTooManyRequests)
+		require.Equal(t, "0", rec.Header().Get(HeaderRateLimitRemaining))
+		require.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+
+		// next second opens a new window
+		meter.now = func() time.Time { return testNow.Add(time.Second) }
+		_, err = request(t, meter, testSecret)
+		require.NoError(t, err)
+	})
+
+	t.Run("daily quota", func(t *testing.T) {
+		meter, counter := newTestMeter(t, storage.ApiKey{
+			Key:  "test",
+			Tier: types.ApiKeyTierBasic,
+		}, WithTiers(map[types.ApiKeyTier]Tier{
+			types.ApiKeyTierBasic: {DailyQuota: 3},
+		}))
+
+		for range 3 {
+			rec, err := request(t, meter, testSecret)
+			require.NoError(t, err)
+			require.Equal(t, "3", rec.Header().Get(HeaderQuotaLimit))
+			require.Empty(t, rec.Header().Get(HeaderRateLimitLimit))
+		}
+
+		rec, err := request(t, meter, testSecret)
+		requireStatus(t, err, http.StatusTooManyRequests)
+		require.Equal(t, "0", rec.Header().Get(HeaderQuotaRemaining))
+		require.Equal(t, "43201", rec.Header().Get(echo.HeaderRetryAfter))
+
+		usage := counter.hashes["usage:test:2024-03-15"]
+		require.EqualValues(t, 3, usage[totalField])
+		require.EqualValues(t, 3, usage["/v1/block/:height"])
+
+		// quota is reset on the next day
+		meter.now = func() time.Time { return testNow.Add(12 * time.Hour) }
+		_, err = request(t, meter, testSecret)
+		require.NoError(t, err)
+	})
+}
+
+func TestMeter_UnavailableCounter(t *testing.T) {
+	key := storage.ApiKey{
+		Key:  "test",
+		Tier: types.ApiKeyTierFree,
+	}
+
+	t.Run("fallback limiter", func(t *testing.T) {
+		meter, counter := newTestMeter(t, key, WithFallbackLimiter(&allowStore{left: 1}))
+		counter.err = errors.New("connection refused")
+
+		_, err := request(t, meter, testSecret)
+		require.NoError(t, err)
+
+		_, err = request(t, meter, testSecret)
+		requireStatus(t, err, http.StatusTooManyRequests)
+	})
+
+	t.Run("without fallback limiter", func(t *testing.T) {
+		meter, counter := newTestMeter(t, key)
+		counter.err = errors.New("connection refused")
+
+		_, err := request(t, meter, testSecret)
+		requireStatus(t, err, http.StatusServiceUnavailable)
+	})
+}
+
+func TestMeter_Skipper(t *testing.T) {
+	meter, _ := newTestMeter(t, storage.ApiKey{Key: "test"})
+
+	c, _ := newRequestContext(t, "")
+	require.False(t, meter.Skipper(c))
+
+	// key is not known before the first request
+	c, _ = newRequestContext(t, testSecret)
+	require.False(t, meter.Skipper(c))
+
+	_, err := request(t, meter, testSecret)
+	require.NoError(t, err)
+	require.True(t, meter.Skipper(c))
+
+	_, err = request(t, meter, "unknown")
+	requireStatus(t, err, http.StatusUnauthorized)
+	c, _ = newRequestContext(t, "unknown")
+	require.False(t, meter.Skipper(c))
+
+	// cached key expires
+	meter.now = func() time.Time { return testNow.Add(keyCacheTTL) }
+	c, _ = newRequestContext(t, testSecret)
+	require.False(t, meter.Skipper(c))
+}
+
+func TestMeter_forget(t *testing.T) {
+	meter, _ := newTestMeter(t, storage.ApiKey{Key: "test"})
+
+	_, err := request(t, meter, testSecret)
+	require.NoError(t, err)
+
+	c, _ := newRequestContext(t, testSecret)
+	require.True(t, meter.Skipper(c))
+
+	meter.forget("other")
+	require.True(t, meter.Skipper(c))
+
+	meter.forget("test")
+	require.False(t, meter.Skipper(c))
+}
+
+func TestMeter_Limits(t *testing.T) {
+	meter, _ := newTestMeter(t, storage.ApiKey{Key: "test"})
+
+	require.Equal(t, DefaultTiers[types.ApiKeyTierPro], meter.Limits(storage.ApiKey{Tier: types.ApiKeyTierPro}))
+	require.Equal(t, Tier{RateLimit: 100, DailyQuota: 5_000_000}, meter.Limits(storage.ApiKey{
+		Tier:       types.ApiKeyTierPro,
+		DailyQuota: 5_000_000,
+	}))
+	require.Equal(t, Tier{RateLimit: 1000}, meter.Limits(storage.ApiKey{
+		Tier:      types.ApiKeyTierEnterprise,
+		RateLimit: 1000,
+	}))
+}
+
+func TestMeter_Usage(t *testing.T) {
+	meter, counter := newTestMeter(t, storage.ApiKey{Key: "test"})
+	counter.hashes["usage:test:2024-03-15"] = map[string]int64{
+		totalField:          12,
+		"/v1/block/:height": 10,
+		"/v1/tx/:hash":      2,
+		"/v1/head":          0,
+	}
+	counter.hashes["usage:test:2024-03-13"] = map[string]int64{
+		totalField: 1,
+		"/v1/head": 1,
+	}
+
+	usage, err := meter.Usage(t.Context(), storage.ApiKey{Key: "test"}, 3)
+	require.NoError(t, err)
+	require.Len(t, usage, 3)
+
+	require.Equal(t, testNow.Truncate(24*time.Hour), usage[0].Date)
+	require.EqualValues(t, 12, usage[0].Total)
+	require.Equal(t, map[string]int64{
+		"/v1/block/:height": 10,
+		"/v1/tx/:hash":      2,
+	}, usage[0].Routes)
+
+	require.EqualValues(t, 0, usage[1].Total)
+	require.Empty(t, usage[1].Routes)
+
+	require.EqualValues(t, 1, usage[2].Total)
+	require.Equal(t, "2024-03-13", usage[2].Date.Format(time.DateOnly))
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock.go -package=quota -typed
+//
+
+// Package quota is a generated GoMock package.
+package quota
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMeter is a mock of IMeter interface.
+type MockIMeter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMeterMockRecorder
+	isgomock struct{}
+}
+
+// MockIMeterMockRecorder is the mock recorder for MockIMeter.
+type MockIMeterMockRecorder struct {
+	mock *MockIMeter
+}
+
+// NewMockIMeter creates a new mock instance.
+func NewMockIMeter(ctrl *gomock.Controller) *MockIMeter {
+	mock := &MockIMeter{ctrl: ctrl}
+	mock.recorder = &MockIMeterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMeter) EXPECT() *MockIMeterMockRecorder {
+	return m.recorder
+}
+
+// Limits mocks base method.
+func (m *MockIMeter) Limits(key storage.ApiKey) Tier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limits", key)
+	ret0, _ := ret[0].(Tier)
+	return ret0
+}
+
+// Limits indicates an expected call of Limits.
+func (mr *MockIMeterMockRecorder) Limits(key any) *MockIMeterLimitsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limits", reflect.TypeOf((*MockIMeter)(nil).Limits), key)
+	return &MockIMeterLimitsCall{Call: call}
+}
+
+// MockIMeterLimitsCall wrap *gomock.Call
+type MockIMeterLimitsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMeterLimitsCall) Return(arg0 Tier) *MockIMeterLimitsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMeterLimitsCall) Do(f func(storage.ApiKey) Tier) *MockIMeterLimitsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMeterLimitsCall) DoAndReturn(f func(storage.ApiKey) Tier) *MockIMeterLimitsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Usage mocks base method.
+func (m *MockIMeter) Usage(ctx context.Context, key storage.ApiKey, days int) ([]DayUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage", ctx, key, days)
+	ret0, _ := ret[0].([]DayUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockIMeterMockRecorder) Usage(ctx, key, days any) *MockIMeterUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockIMeter)(nil).Usage), ctx, key, days)
+	return &MockIMeterUsageCall{Call: call}
+}
+
+// MockIMeterUsageCall wrap *gomock.Call
+type MockIMeterUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMeterUsageCall) Return(arg0 []DayUsage, arg1 error) *MockIMeterUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMeterUsageCall) Do(f func(context.Context, storage.ApiKey, int) ([]DayUsage, error)) *MockIMeterUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMeterUsageCall) DoAndReturn(f func(context.Context, storage.ApiKey, int) ([]DayUsage, error)) *MockIMeterUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockNoRows is a mock of NoRows interface.
+type MockNoRows struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoRowsMockRecorder
+	isgomock struct{}
+}
+
+// MockNoRowsMockRecorder is the mock recorder for MockNoRows.
+type MockNoRowsMockRecorder struct {
+	mock *MockNoRows
+}
+
+// NewMockNoRows creates a new mock instance.
+func NewMockNoRows(ctrl *gomock.Controller) *MockNoRows {
+	mock := &MockNoRows{ctrl: ctrl}
+	mock.recorder = &MockNoRowsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoRows) EXPECT() *MockNoRowsMockRecorder {
+	return m.recorder
+}
+
+// IsNoRows mocks base method.
+func (m *MockNoRows) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockNoRowsMockRecorder) IsNoRows(err any) *MockNoRowsIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockNoRows)(nil).IsNoRows), err)
+	return &MockNoRowsIsNoRowsCall{Call: call}
+}
+
+// MockNoRowsIsNoRowsCall wrap *gomock.Call
+type MockNoRowsIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNoRowsIsNoRowsCall) Return(arg0 bool) *MockNoRowsIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNoRowsIsNoRowsCall) Do(f func(error) bool) *MockNoRowsIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNoRowsIsNoRowsCall) DoAndReturn(f func(error) bool) *MockNoRowsIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
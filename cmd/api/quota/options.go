@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package quota
+
+import (
+	"github.com/celenium-io/celestia-indexer/cmd/api/bus"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+type MeterOption func(*Meter)
+
+// WithTiers - overrides limits of the passed tiers. Other tiers keep default limits.
+func WithTiers(tiers map[types.ApiKeyTier]Tier) MeterOption {
+	return func(m *Meter) {
+		for name, tier := range tiers {
+			m.tiers[name] = tier
+		}
+	}
+}
+
+// WithFallbackLimiter - sets IP limiter which is applied to requests with api key when Valkey is unavailable
+func WithFallbackLimiter(store middleware.RateLimiterStore) MeterOption {
+	return func(m *Meter) {
+		m.fallback = store
+	}
+}
+
+// WithObserver - sets observer of notifications about changed keys. Without it changes of keys are applied after expiration of the cache.
+func WithObserver(observer *bus.Observer) MeterOption {
+	return func(m *Meter) {
+		m.observer = observer
+	}
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package quota
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+)
+
+// Tier - limits of api keys in public API. Zero value of a limit means unlimited.
+type Tier struct {
+	// RateLimit - requests per second
+	RateLimit int64
+	// DailyQuota - requests per UTC day
+	DailyQuota int64
+}
+
+// DefaultTiers - limits of tiers which are used if they are not set in config
+var DefaultTiers = map[types.ApiKeyTier]Tier{
+	types.ApiKeyTierFree:       {RateLimit: 5, DailyQuota: 10_000},
+	types.ApiKeyTierBasic:      {RateLimit: 20, DailyQuota: 100_000},
+	types.ApiKeyTierPro:        {RateLimit: 100, DailyQuota: 1_000_000},
+	types.ApiKeyTierEnterprise: {RateLimit: 500},
+}
+
+// DayUsage - count of requests of the api key during UTC day. Requests rejected by limits are not counted.
+type DayUsage struct {
+	Date   time.Time
+	Total  int64
+	Routes map[string]int64
+}
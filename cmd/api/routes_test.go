@@ -32,6 +32,7 @@ func TestRoutes(t *testing.T) {
 		"/v1/validators/metrics GET":                          {},
 		"/v1/validators/:id GET":                              {},
 		"/v1/validators/:id/metrics GET":                      {},
+		"/v1/validators/:id/history GET":                      {},
 		"/v1/stats/tps GET":                                   {},
 		"/v1/stats/namespace/series/:id/:name/:timeframe GET": {},
 		"/v1/stats/series/:name/:timeframe GET":               {},
@@ -43,6 +44,11 @@ func TestRoutes(t *testing.T) {
 		"/v1/stats/hyperlane/series/:id/:name/:timeframe GET": {},
 		"/v1/stats/hyperlane/chains/:name/:timeframe GET":     {},
 		"/v1/stats/hyperlane/chains GET":                      {},
+		"/v1/stats/hyperlane/latency GET":                     {},
+		"/v1/stats/supply GET":                                {},
+		"/v1/stats/supply/circulating GET":                    {},
+		"/v1/stats/supply/series/:timeframe GET":              {},
+		"/v1/stats/supply/forecast GET":                       {},
 		"/v1/rollup/:id GET":                                  {},
 		"/v1/address/:hash GET":                               {},
 		"/v1/address/:hash/txs GET":                           {},
@@ -72,6 +78,7 @@ func TestRoutes(t *testing.T) {
 		"/v1/address/count GET":                               {},
 		"/v1/address/:hash/blobs GET":                         {},
 		"/v1/address/:hash/balances GET":                      {},
+		"/v1/address/:hash/balances/history/:timeframe GET":   {},
 		"/v1/block/:height/blobs/count GET":                   {},
 		"/v1/namespace/:id GET":                               {},
 		"/v1/stats/summary/:table/:function GET":              {},
@@ -96,6 +103,7 @@ func TestRoutes(t *testing.T) {
 		"/v1/gas/price GET":                                   {},
 		"/v1/gas/price/:priority GET":                         {},
 		"/v1/block/:height/ods GET":                           {},
+		"/v1/block/:height/shares GET":                        {},
 		"/v1/tx/:hash/blobs GET":                              {},
 		"/v1/namespace/:id/:version/messages GET":             {},
 		"/v1/validators/:id/uptime GET":                       {},
@@ -124,7 +132,14 @@ func TestRoutes(t *testing.T) {
 		"/v1/ibc/transfer/:id GET":                            {},
 		"/v1/ibc/relayers GET":                                {},
 		"/v1/proposal/:id/votes GET":                          {},
+		"/v1/proposal/:id/timeline GET":                       {},
+		"/v1/proposal/:id/effective_votes GET":                {},
 		"/v1/address/:hash/votes GET":                         {},
+		"/v1/address/:hash/effective_votes GET":               {},
+		"/v1/address/:hash/rewards GET":                       {},
+		"/v1/address/:hash/rewards/:timeframe GET":            {},
+		"/v1/validators/:id/rewards GET":                      {},
+		"/v1/validators/:id/rewards/:timeframe GET":           {},
 		"/v1/validators/:id/votes GET":                        {},
 		"/v1/blob/proofs POST":                                {},
 		"/v1/hyperlane/mailbox GET":                           {},
@@ -141,10 +156,14 @@ func TestRoutes(t *testing.T) {
 		"/v1/hyperlane/zkism/:id/updates GET":                 {},
 		"/v1/hyperlane/zkism/:id/messages GET":                {},
 		"/v1/signal GET":                                      {},
+		"/v1/alert GET":                                       {},
+		"/v1/mempool GET":                                     {},
+		"/v1/mempool/:hash GET":                               {},
 		"/v1/signal/upgrade GET":                              {},
 		"/v1/signal/upgrade/:version GET":                     {},
 		"/v1/forwarding GET":                                  {},
 		"/v1/forwarding/:id GET":                              {},
+		"/v1/graphql POST":                                    {},
 	}
 
 	ctx, cancel := context.WithCancel(t.Context())
@@ -0,0 +1,480 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/cmd/api/bus"
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	hooks "github.com/celenium-io/celestia-indexer/internal/webhook"
+	"github.com/dipdup-io/workerpool"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	HeaderSignature  = "X-Celenium-Signature"
+	HeaderTimestamp  = "X-Celenium-Timestamp"
+	HeaderEvent      = "X-Celenium-Event"
+	HeaderWebhookId  = "X-Celenium-Webhook-Id"
+	HeaderDeliveryId = "X-Celenium-Delivery-Id"
+)
+
+// transferTypes - message types which move funds between addresses
+var transferTypes = map[types.MsgType]struct{}{
+	types.MsgSend:           {},
+	types.MsgMultiSend:      {},
+	types.MsgTransfer:       {},
+	types.MsgRecvPacket:     {},
+	types.MsgRemoteTransfer: {},
+}
+
+// Payload - body of webhook request. Delivery identity is the same for all attempts of the delivery, so receivers can skip duplicates.
+type Payload struct {
+	DeliveryId string             `json:"delivery_id"`
+	Event      types.WebhookEvent `json:"event"`
+	WebhookId  uint64             `json:"webhook_id"`
+	Time       time.Time          `json:"time"`
+	Data       any                `json:"data"`
+}
+
+// Dispatcher - sends indexed events received from bus to registered webhooks. Every API replica receives the same events, so
+// deliveries are enqueued to the database with deterministic identity and duplicates are skipped. Due deliveries are claimed
+// by one of replicas and failed attempts are retried with exponential backoff by the scheduler.
+type Dispatcher struct {
+	observer   *bus.Observer
+	webhooks   storage.IWebhook
+	deliveries storage.IWebhookDelivery
+	tasks      storage.IWebhookTask
+	validators storage.IValidator
+	proposals  storage.IProposal
+	client     *http.Client
+
+	mx    *sync.RWMutex
+	hooks map[types.WebhookEvent][]storage.Webhook
+	queue chan storage.WebhookTask
+
+	workers         int
+	batchSize       int
+	maxAttempts     int
+	backoff         time.Duration
+	lease           time.Duration
+	pollInterval    time.Duration
+	refreshInterval time.Duration
+
+	log zerolog.Logger
+	g   workerpool.Group
+}
+
+func NewDispatcher(
+	observer *bus.Observer,
+	webhooks storage.IWebhook,
+	deliveries storage.IWebhookDelivery,
+	tasks storage.IWebhookTask,
+	validators storage.IValidator,
+	proposals storage.IProposal,
+	opts ...DispatcherOption,
+) *Dispatcher {
+	d := &Dispatcher{
+		observer:        observer,
+		webhooks:        webhooks,
+		deliveries:      deliveries,
+		tasks:           tasks,
+		validators:      validators,
+		proposals:       proposals,
+		client:          hooks.NewClient(10 * time.Second),
+		mx:              new(sync.RWMutex),
+		hooks:           make(map[types.WebhookEvent][]storage.Webhook),
+		workers:         4,
+		batchSize:       100,
+		maxAttempts:     5,
+		backoff:         time.Second,
+		lease:           time.Minute,
+		pollInterval:    time.Second,
+		refreshInterval: 30 * time.Second,
+		log:             log.With().Str("module", "webhooks").Logger(),
+		g:               workerpool.NewGroup(),
+	}
+
+	for i := range opts {
+		opts[i](d)
+	}
+	d.queue = make(chan storage.WebhookTask, d.batchSize)
+
+	return d
+}
+
+func (d *Dispatcher) Start(ctx context.Context) {
+	if err := d.refresh(ctx); err != nil {
+		d.log.Err(err).Msg("receiving webhooks")
+	}
+
+	d.g.GoCtx(ctx, d.listen)
+	d.g.GoCtx(ctx, d.sync)
+	d.g.GoCtx(ctx, d.schedule)
+	for range d.workers {
+		d.g.GoCtx(ctx, d.work)
+	}
+}
+
+func (d *Dispatcher) Close() error {
+	d.g.Wait()
+	close(d.queue)
+	return nil
+}
+
+// sync - periodically receives active webhooks since they are registered by another process
+func (d *Dispatcher) sync(ctx context.Context) {
+	ticker := time.NewTicker(d.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.refresh(ctx); err != nil {
+				d.log.Err(err).Msg("refreshing webhooks")
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) refresh(ctx context.Context) error {
+	webhooks, err := d.webhooks.Active(ctx)
+	if err != nil {
+		return err
+	}
+
+	hooks := make(map[types.WebhookEvent][]storage.Webhook)
+	for i := range webhooks {
+		hooks[webhooks[i].Event] = append(hooks[webhooks[i].Event], webhooks[i])
+	}
+
+	d.mx.Lock()
+	d.hooks = hooks
+	d.mx.Unlock()
+	return nil
+}
+
+func (d *Dispatcher) listen(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case blob, ok := <-d.observer.Blobs():
+			if !ok {
+				return
+			}
+			d.handleBlob(ctx, *blob)
+		case msg, ok := <-d.observer.Messages():
+			if !ok {
+				return
+			}
+			d.handleMessage(ctx, *msg)
+		case jail, ok := <-d.observer.Jails():
+			if !ok {
+				return
+			}
+			d.handleJail(ctx, *jail)
+		case proposal, ok := <-d.observer.Proposals():
+			if !ok {
+				return
+			}
+			d.handleProposal(ctx, *proposal)
+		}
+	}
+}
+
+func (d *Dispatcher) matched(event types.WebhookEvent, match func(hook storage.Webhook) bool) []storage.Webhook {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	result := make([]storage.Webhook, 0)
+	for _, hook := range d.hooks[event] {
+		if match(hook) {
+			result = append(result, hook)
+		}
+	}
+	return result
+}
+
+func (d *Dispatcher) handleBlob(ctx context.Context, blob storage.BlobLog) {
+	hooks := d.matched(types.WebhookEventBlob, func(hook storage.Webhook) bool {
+		return hook.NamespaceId == 0 || hook.NamespaceId == blob.NamespaceId
+	})
+	if len(hooks) == 0 {
+		return
+	}
+	// blob log identity isn't sent by the indexer, so the blob is identified by message, namespace and commitment
+	key := fmt.Sprintf("%d:%d:%s", blob.MsgId, blob.NamespaceId, blob.Commitment)
+	d.enqueue(ctx, hooks, types.WebhookEventBlob, key, responses.NewBlobLog(blob))
+}
+
+func (d *Dispatcher) handleMessage(ctx context.Context, msg storage.Message) {
+	if _, ok := transferTypes[msg.Type]; !ok {
+		return
+	}
+	hooks := d.matched(types.WebhookEventTransfer, func(hook storage.Webhook) bool {
+		if hook.Address == "" {
+			return true
+		}
+		for i := range msg.Addresses {
+			if msg.Addresses[i].Address.Address == hook.Address {
+				return true
+			}
+		}
+		return false
+	})
+	if len(hooks) == 0 {
+		return
+	}
+	d.enqueue(ctx, hooks, types.WebhookEventTransfer, strconv.FormatUint(msg.Id, 10), responses.NewMessage(msg))
+}
+
+func (d *Dispatcher) handleJail(ctx context.Context, jail storage.Jail) {
+	hooks := d.matched(types.WebhookEventJail, func(hook storage.Webhook) bool {
+		return hook.ValidatorId == 0 || hook.ValidatorId == jail.ValidatorId
+	})
+	if len(hooks) == 0 {
+		return
+	}
+
+	validator, err := d.validators.GetByID(ctx, jail.ValidatorId)
+	if err != nil {
+		d.log.Err(err).Uint64("validator_id", jail.ValidatorId).Msg("receiving jailed validator")
+	} else {
+		jail.Validator = validator
+	}
+	key := fmt.Sprintf("%d:%d", jail.Height, jail.ValidatorId)
+	d.enqueue(ctx, hooks, types.WebhookEventJail, key, responses.NewJail(jail))
+}
+
+func (d *Dispatcher) handleProposal(ctx context.Context, proposal storage.Proposal) {
+	hooks := d.matched(types.WebhookEventProposalVoting, func(hook storage.Webhook) bool {
+		return true
+	})
+	if len(hooks) == 0 {
+		return
+	}
+
+	full, err := d.proposals.ById(ctx, proposal.Id)
+	if err != nil {
+		d.log.Err(err).Uint64("proposal_id", proposal.Id).Msg("receiving proposal")
+		full = proposal
+	}
+	d.enqueue(ctx, hooks, types.WebhookEventProposalVoting, strconv.FormatUint(proposal.Id, 10), responses.NewProposal(full))
+}
+
+// enqueue - saves deliveries of the event to matched webhooks. Key identifies the event, so the same event received
+// by several API replicas gets the same delivery identity.
+func (d *Dispatcher) enqueue(ctx context.Context, hooks []storage.Webhook, event types.WebhookEvent, key string, data any) {
+	now := time.Now().UTC()
+	tasks := make([]storage.WebhookTask, 0, len(hooks))
+	for i := range hooks {
+		id := deliveryId(hooks[i].Id, event, key)
+		body, err := json.Marshal(Payload{
+			DeliveryId: id,
+			Event:      event,
+			WebhookId:  hooks[i].Id,
+			Time:       now,
+			Data:       data,
+		})
+		if err != nil {
+			d.log.Err(err).Uint64("webhook_id", hooks[i].Id).Str("event", event.String()).Msg("marshal payload")
+			continue
+		}
+		tasks = append(tasks, storage.WebhookTask{
+			DeliveryId:    id,
+			WebhookId:     hooks[i].Id,
+			Event:         event,
+			Payload:       string(body),
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+	}
+
+	if err := d.tasks.Enqueue(ctx, tasks...); err != nil {
+		d.log.Err(err).Str("event", event.String()).Msg("enqueue webhook deliveries")
+	}
+}
+
+// deliveryId - returns identity of the event delivery to the webhook
+func deliveryId(webhookId uint64, event types.WebhookEvent, key string) string {
+	hash := sha256.Sum256(fmt.Appendf(nil, "%d:%s:%s", webhookId, event.String(), key))
+	return hex.EncodeToString(hash[:16])
+}
+
+// schedule - periodically claims due deliveries and passes them to workers
+func (d *Dispatcher) schedule(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.claim(ctx)
+		}
+	}
+}
+
+// claim - claims due deliveries while full batches are returned
+func (d *Dispatcher) claim(ctx context.Context) {
+	for {
+		tasks, err := d.tasks.Claim(ctx, time.Now().UTC(), d.lease, d.batchSize)
+		if err != nil {
+			d.log.Err(err).Msg("claiming webhook deliveries")
+			return
+		}
+
+		for i := range tasks {
+			select {
+			case <-ctx.Done():
+				return
+			case d.queue <- tasks[i]:
+			}
+		}
+
+		if len(tasks) < d.batchSize {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case t, ok := <-d.queue:
+			if !ok {
+				return
+			}
+			if err := d.attempt(ctx, t); err != nil {
+				d.log.Err(err).Uint64("webhook_id", t.WebhookId).Str("delivery_id", t.DeliveryId).Msg("webhook delivery")
+			}
+		}
+	}
+}
+
+// webhook - returns the webhook of the task. Webhooks registered after the last refresh are received from the database.
+func (d *Dispatcher) webhook(ctx context.Context, t storage.WebhookTask) (storage.Webhook, bool, error) {
+	d.mx.RLock()
+	for _, hook := range d.hooks[t.Event] {
+		if hook.Id == t.WebhookId {
+			d.mx.RUnlock()
+			return hook, true, nil
+		}
+	}
+	d.mx.RUnlock()
+
+	hook, err := d.webhooks.GetByID(ctx, t.WebhookId)
+	if err != nil {
+		if d.webhooks.IsNoRows(err) {
+			return storage.Webhook{}, false, nil
+		}
+		return storage.Webhook{}, false, err
+	}
+	return *hook, hook.Active, nil
+}
+
+// attempt - makes one attempt of the delivery and writes it to delivery log. Failed attempt is rescheduled with exponential backoff.
+// The task is removed after successful delivery, not retryable failure or the last attempt.
+func (d *Dispatcher) attempt(ctx context.Context, t storage.WebhookTask) error {
+	hook, ok, err := d.webhook(ctx, t)
+	if err != nil {
+		return errors.Wrap(err, "receive webhook")
+	}
+	if !ok {
+		// webhook was deleted or deactivated
+		return d.tasks.Delete(ctx, t.Id)
+	}
+
+	attempt := t.Attempt + 1
+	statusCode, sendErr := d.send(ctx, hook, t.Event, t.DeliveryId, []byte(t.Payload))
+
+	delivery := storage.WebhookDelivery{
+		WebhookId:  t.WebhookId,
+		DeliveryId: t.DeliveryId,
+		Time:       time.Now().UTC(),
+		Event:      t.Event,
+		Attempt:    attempt,
+		StatusCode: statusCode,
+		Success:    sendErr == nil,
+		Payload:    t.Payload,
+	}
+	if sendErr != nil {
+		delivery.Error = sendErr.Error()
+	}
+	if err := d.deliveries.Save(ctx, &delivery); err != nil {
+		d.log.Err(err).Uint64("webhook_id", t.WebhookId).Msg("saving webhook delivery")
+	}
+
+	if sendErr == nil || !retryable(statusCode) || attempt >= d.maxAttempts {
+		if err := d.tasks.Delete(ctx, t.Id); err != nil {
+			return errors.Wrap(err, "delete webhook task")
+		}
+		if sendErr != nil && attempt >= d.maxAttempts {
+			return errors.Wrapf(sendErr, "delivery failed after %d attempts", attempt)
+		}
+		return sendErr
+	}
+
+	next := time.Now().UTC().Add(d.backoff << (attempt - 1))
+	if err := d.tasks.Retry(ctx, t.Id, attempt, next); err != nil {
+		return errors.Wrap(err, "reschedule webhook task")
+	}
+	return nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, hook storage.Webhook, event types.WebhookEvent, deliveryId string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, event.String())
+	req.Header.Set(HeaderWebhookId, strconv.FormatUint(hook.Id, 10))
+	req.Header.Set(HeaderDeliveryId, deliveryId)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, hooks.Sign(hook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return resp.StatusCode, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// retryable - network errors (zero status code), rate limiting and server errors are retried
+func retryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
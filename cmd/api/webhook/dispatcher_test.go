@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	hooks "github.com/celenium-io/celestia-indexer/internal/webhook"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type testDispatcher struct {
+	*Dispatcher
+
+	webhooks   *mock.MockIWebhook
+	deliveries *mock.MockIWebhookDelivery
+	tasks      *mock.MockIWebhookTask
+}
+
+func newTestDispatcher(t *testing.T, opts ...DispatcherOption) testDispatcher {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	webhooks := mock.NewMockIWebhook(ctrl)
+	deliveries := mock.NewMockIWebhookDelivery(ctrl)
+	tasks := mock.NewMockIWebhookTask(ctrl)
+	validators := mock.NewMockIValidator(ctrl)
+	proposals := mock.NewMockIProposal(ctrl)
+
+	return testDispatcher{
+		Dispatcher: NewDispatcher(nil, webhooks, deliveries, tasks, validators, proposals, opts...),
+		webhooks:   webhooks,
+		deliveries: deliveries,
+		tasks:      tasks,
+	}
+}
+
+// expectEnqueue - collects enqueued tasks
+func (d testDispatcher) expectEnqueue(enqueued *[]storage.WebhookTask) {
+	d.tasks.EXPECT().
+		Enqueue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tasks ...storage.WebhookTask) error {
+			*enqueued = append(*enqueued, tasks...)
+			return nil
+		}).
+		AnyTimes()
+}
+
+func TestRefreshAndMatch(t *testing.T) {
+	d := newTestDispatcher(t)
+
+	d.webhooks.EXPECT().
+		Active(gomock.Any()).
+		Return([]storage.Webhook{
+			{Id: 1, Event: types.WebhookEventBlob, NamespaceId: 10},
+			{Id: 2, Event: types.WebhookEventBlob},
+			{Id: 3, Event: types.WebhookEventTransfer, Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+			{Id: 4, Event: types.WebhookEventJail, ValidatorId: 5},
+		}, nil).
+		Times(1)
+
+	enqueued := make([]storage.WebhookTask, 0)
+	d.expectEnqueue(&enqueued)
+
+	require.NoError(t, d.refresh(t.Context()))
+
+	d.handleBlob(t.Context(), storage.BlobLog{NamespaceId: 11})
+	require.Len(t, enqueued, 1)
+	require.EqualValues(t, 2, enqueued[0].WebhookId)
+
+	d.handleBlob(t.Context(), storage.BlobLog{NamespaceId: 10})
+	require.Len(t, enqueued, 3)
+	enqueued = enqueued[:0]
+
+	d.handleMessage(t.Context(), storage.Message{
+		Type: types.MsgDelegate,
+		Addresses: []storage.AddressWithType{
+			{Address: storage.Address{Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"}},
+		},
+	})
+	require.Len(t, enqueued, 0)
+
+	d.handleMessage(t.Context(), storage.Message{
+		Type: types.MsgSend,
+		Addresses: []storage.AddressWithType{
+			{Address: storage.Address{Address: "celestia1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3y3clr6"}},
+		},
+	})
+	require.Len(t, enqueued, 0)
+
+	d.handleMessage(t.Context(), storage.Message{
+		Id:   100,
+		Type: types.MsgSend,
+		Addresses: []storage.AddressWithType{
+			{Address: storage.Address{Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"}},
+		},
+	})
+	require.Len(t, enqueued, 1)
+	require.EqualValues(t, 3, enqueued[0].WebhookId)
+	require.Equal(t, types.WebhookEventTransfer, enqueued[0].Event)
+	require.Equal(t, deliveryId(3, types.WebhookEventTransfer, "100"), enqueued[0].DeliveryId)
+	require.Zero(t, enqueued[0].Attempt)
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal([]byte(enqueued[0].Payload), &payload))
+	require.Equal(t, enqueued[0].DeliveryId, payload.DeliveryId)
+	require.EqualValues(t, 3, payload.WebhookId)
+
+	d.handleJail(t.Context(), storage.Jail{ValidatorId: 6})
+	require.Len(t, enqueued, 1)
+}
+
+func TestDeliveryId(t *testing.T) {
+	id := deliveryId(1, types.WebhookEventBlob, "1:2:commitment")
+	require.Len(t, id, 32)
+	require.Equal(t, id, deliveryId(1, types.WebhookEventBlob, "1:2:commitment"))
+	require.NotEqual(t, id, deliveryId(2, types.WebhookEventBlob, "1:2:commitment"))
+	require.NotEqual(t, id, deliveryId(1, types.WebhookEventBlob, "1:3:commitment"))
+	require.NotEqual(t, id, deliveryId(1, types.WebhookEventJail, "1:2:commitment"))
+}
+
+func TestClaim(t *testing.T) {
+	d := newTestDispatcher(t, WithBatchSize(2))
+
+	d.tasks.EXPECT().
+		Claim(gomock.Any(), gomock.Any(), time.Minute, 2).
+		Return([]storage.WebhookTask{{Id: 1}, {Id: 2}}, nil).
+		Times(1)
+	d.tasks.EXPECT().
+		Claim(gomock.Any(), gomock.Any(), time.Minute, 2).
+		Return([]storage.WebhookTask{{Id: 3}}, nil).
+		Times(1)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		d.claim(ctx)
+		close(done)
+	}()
+
+	for i := 1; i <= 3; i++ {
+		task := <-d.queue
+		require.EqualValues(t, i, task.Id)
+	}
+	<-done
+}
+
+func TestAttemptRetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.True(t, hooks.Verify("secret", r.Header.Get(HeaderTimestamp), body, r.Header.Get(HeaderSignature)))
+		require.Equal(t, "blob", r.Header.Get(HeaderEvent))
+		require.Equal(t, "1", r.Header.Get(HeaderWebhookId))
+		require.Equal(t, "delivery", r.Header.Get(HeaderDeliveryId))
+		require.Equal(t, `{"event":"blob"}`, string(body))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	d := newTestDispatcher(t, WithHttpClient(server.Client()), WithBackoff(time.Minute))
+	d.hooks[types.WebhookEventBlob] = []storage.Webhook{{Id: 1, Url: server.URL, Secret: "secret", Event: types.WebhookEventBlob}}
+
+	d.deliveries.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delivery *storage.WebhookDelivery) error {
+			require.Equal(t, "delivery", delivery.DeliveryId)
+			require.Equal(t, 2, delivery.Attempt)
+			require.False(t, delivery.Success)
+			require.Equal(t, http.StatusServiceUnavailable, delivery.StatusCode)
+			return nil
+		}).
+		Times(1)
+
+	now := time.Now().UTC()
+	d.tasks.EXPECT().
+		Retry(gomock.Any(), uint64(10), 2, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, _ int, next time.Time) error {
+			// second attempt is postponed by doubled backoff
+			require.True(t, next.After(now.Add(2*time.Minute-time.Second)))
+			return nil
+		}).
+		Times(1)
+
+	err := d.attempt(t.Context(), storage.WebhookTask{
+		Id:         10,
+		DeliveryId: "delivery",
+		WebhookId:  1,
+		Event:      types.WebhookEventBlob,
+		Payload:    `{"event":"blob"}`,
+		Attempt:    1,
+	})
+	require.NoError(t, err)
+}
+
+func TestAttemptSuccess(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	d := newTestDispatcher(t, WithHttpClient(server.Client()))
+
+	// webhook registered after the last refresh
+	d.webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Webhook{Id: 1, Url: server.URL, Secret: "secret", Event: types.WebhookEventJail, Active: true}, nil).
+		Times(1)
+	d.deliveries.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delivery *storage.WebhookDelivery) error {
+			require.True(t, delivery.Success)
+			require.Equal(t, 1, delivery.Attempt)
+			return nil
+		}).
+		Times(1)
+	d.tasks.EXPECT().
+		Delete(gomock.Any(), uint64(10)).
+		Return(nil).
+		Times(1)
+
+	err := d.attempt(t.Context(), storage.WebhookTask{
+		Id:         10,
+		DeliveryId: "delivery",
+		WebhookId:  1,
+		Event:      types.WebhookEventJail,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestAttemptNotRetryable(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	d := newTestDispatcher(t, WithHttpClient(server.Client()))
+	d.hooks[types.WebhookEventJail] = []storage.Webhook{{Id: 1, Url: server.URL, Secret: "secret", Event: types.WebhookEventJail}}
+
+	d.deliveries.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+	d.tasks.EXPECT().
+		Delete(gomock.Any(), uint64(10)).
+		Return(nil).
+		Times(1)
+
+	err := d.attempt(t.Context(), storage.WebhookTask{
+		Id:        10,
+		WebhookId: 1,
+		Event:     types.WebhookEventJail,
+	})
+	require.Error(t, err)
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestAttemptMaxAttempts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	d := newTestDispatcher(t, WithHttpClient(server.Client()), WithMaxAttempts(2))
+	d.hooks[types.WebhookEventProposalVoting] = []storage.Webhook{{Id: 1, Url: server.URL, Secret: "secret", Event: types.WebhookEventProposalVoting}}
+
+	d.deliveries.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+	d.tasks.EXPECT().
+		Delete(gomock.Any(), uint64(10)).
+		Return(nil).
+		Times(1)
+
+	err := d.attempt(t.Context(), storage.WebhookTask{
+		Id:        10,
+		WebhookId: 1,
+		Event:     types.WebhookEventProposalVoting,
+		Attempt:   1,
+	})
+	require.Error(t, err)
+}
+
+func TestAttemptInactiveWebhook(t *testing.T) {
+	d := newTestDispatcher(t)
+
+	d.webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Webhook{Id: 1, Event: types.WebhookEventBlob, Active: false}, nil).
+		Times(1)
+	d.tasks.EXPECT().
+		Delete(gomock.Any(), uint64(10)).
+		Return(nil).
+		Times(1)
+
+	err := d.attempt(t.Context(), storage.WebhookTask{
+		Id:        10,
+		WebhookId: 1,
+		Event:     types.WebhookEventBlob,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"net/http"
+	"time"
+)
+
+type DispatcherOption func(*Dispatcher)
+
+func WithWorkers(workers int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if workers > 0 {
+			d.workers = workers
+		}
+	}
+}
+
+func WithMaxAttempts(attempts int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if attempts > 0 {
+			d.maxAttempts = attempts
+		}
+	}
+}
+
+func WithBackoff(backoff time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		if backoff > 0 {
+			d.backoff = backoff
+		}
+	}
+}
+
+func WithPollInterval(interval time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		if interval > 0 {
+			d.pollInterval = interval
+		}
+	}
+}
+
+func WithLease(lease time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		if lease > 0 {
+			d.lease = lease
+		}
+	}
+}
+
+func WithBatchSize(size int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if size > 0 {
+			d.batchSize = size
+		}
+	}
+}
+
+func WithRefreshInterval(interval time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		if interval > 0 {
+			d.refreshInterval = interval
+		}
+	}
+}
+
+func WithHttpClient(client *http.Client) DispatcherOption {
+	return func(d *Dispatcher) {
+		if client != nil {
+			d.client = client
+		}
+	}
+}
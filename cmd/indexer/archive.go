@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
+	"github.com/celenium-io/celestia-indexer/pkg/node/archive"
+	"github.com/celenium-io/celestia-indexer/pkg/node/rpc"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	archiveDir     string
+	archiveFrom    uint64
+	archiveTo      uint64
+	archiveWorkers int
+	archiveGenesis bool
+)
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Manage local archive of blocks",
+}
+
+var archiveRecordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Record blocks from node RPC to local archive",
+	Run: func(cmd *cobra.Command, args []string) {
+		command = recordArchive
+	},
+}
+
+func init() {
+	archiveRecordCmd.Flags().StringVarP(&archiveDir, "output", "o", "archive", "path to archive directory")
+	archiveRecordCmd.Flags().Uint64Var(&archiveFrom, "from", 1, "first level of range")
+	archiveRecordCmd.Flags().Uint64Var(&archiveTo, "to", 0, "last level of range, by default current head of node")
+	archiveRecordCmd.Flags().IntVar(&archiveWorkers, "workers", 4, "count of parallel requests to node")
+	archiveRecordCmd.Flags().BoolVar(&archiveGenesis, "genesis", false, "record genesis, it's required to replay archive from the first block")
+
+	archiveCmd.AddCommand(archiveRecordCmd)
+	rootCmd.AddCommand(archiveCmd)
+}
+
+func recordArchive(ctx context.Context, cfg config.Config) error {
+	opts := make([]rpc.ApiOption, 0)
+	if cfg.Indexer.DisableGzip {
+		opts = append(opts, rpc.WithDisableGzip())
+	}
+	nodeRpc := rpc.NewAPI(cfg.DataSources["node_rpc"], opts...)
+
+	to := types.Level(archiveTo)
+	if to == 0 {
+		head, err := nodeRpc.CurrentHead(ctx)
+		if err != nil {
+			return errors.Wrap(err, "receive current head")
+		}
+		to = head
+	}
+
+	recorder := archive.NewRecorder(&nodeRpc, archiveDir, archive.WithWorkers(archiveWorkers))
+	if archiveGenesis {
+		if err := recorder.RecordGenesis(ctx); err != nil {
+			return err
+		}
+	}
+	if err := recorder.Record(ctx, types.Level(archiveFrom), to); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("dir", archiveDir).
+		Uint64("from", archiveFrom).
+		Uint64("to", uint64(to)).
+		Msg("blocks were recorded")
+	return nil
+}
@@ -37,6 +37,14 @@ func main() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	if command != nil {
+		defer cancel()
+		if err := command(ctx, *cfg); err != nil {
+			log.Panic().Err(err).Msg("command execution")
+		}
+		return
+	}
+
 	notifyCtx, notifyCancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
 	defer notifyCancel()
 
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/parser"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/node"
+	"github.com/celenium-io/celestia-indexer/pkg/node/archive"
+	"github.com/celenium-io/celestia-indexer/pkg/node/rpc"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reindexFrom uint64
+	reindexTo   uint64
+)
+
+var reindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "Re-parse already indexed range of blocks and rewrite derived data in place",
+	Run: func(cmd *cobra.Command, args []string) {
+		command = reindex
+	},
+}
+
+func init() {
+	reindexCmd.Flags().Uint64Var(&reindexFrom, "from", 0, "first level of range")
+	reindexCmd.Flags().Uint64Var(&reindexTo, "to", 0, "last level of range")
+	_ = reindexCmd.MarkFlagRequired("from")
+	_ = reindexCmd.MarkFlagRequired("to")
+
+	rootCmd.AddCommand(reindexCmd)
+}
+
+func reindex(ctx context.Context, cfg config.Config) error {
+	from, to := types.Level(reindexFrom), types.Level(reindexTo)
+	if from < 1 || from > to {
+		return errors.Errorf("invalid range of levels: %d - %d", from, to)
+	}
+
+	pg, err := postgres.Create(ctx, cfg.Database, cfg.Indexer.ScriptsDir, false)
+	if err != nil {
+		return errors.Wrap(err, "create database connection")
+	}
+	defer closeStorage(pg)
+
+	api, err := createNodeApi(cfg)
+	if err != nil {
+		return err
+	}
+
+	parserModule := parser.NewModule(cfg.Indexer)
+	storageModule := storage.NewModule(pg.Transactable, pg.Constants, pg.Validator, pg.Notificator, nil, cfg.Indexer)
+
+	bulkSize := types.Level(max(cfg.Indexer.RequestBulkSize, 1))
+	var since *time.Time
+	for start := from; start <= to; start += bulkSize {
+		levels := make([]types.Level, 0, bulkSize)
+		for level := start; level <= to && level < start+bulkSize; level++ {
+			levels = append(levels, level)
+		}
+
+		data, err := api.BlockBulkData(ctx, levels...)
+		if err != nil {
+			return errors.Wrapf(err, "receive blocks %d - %d", levels[0], levels[len(levels)-1])
+		}
+
+		for i := range data {
+			stored, err := pg.Blocks.ByHeight(ctx, data[i].Height)
+			if err != nil {
+				return errors.Wrapf(err, "receive indexed block %d", data[i].Height)
+			}
+			if !bytes.Equal(stored.Hash, data[i].BlockID.Hash) {
+				return errors.Errorf("hash of block %d differs from indexed one, it should be rolled back instead", data[i].Height)
+			}
+
+			dCtx, err := parserModule.Decode(&data[i])
+			if err != nil {
+				return err
+			}
+			if err := storageModule.Reindex(ctx, dCtx); err != nil {
+				return errors.Wrapf(err, "reindex block %d", data[i].Height)
+			}
+			if since == nil {
+				since = &dCtx.Block.Time
+			}
+		}
+	}
+
+	conn, err := pg.Connection().Pool().Acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "acquire connection")
+	}
+	defer conn.Release()
+
+	if err := postgres.RefreshViews(ctx, conn.Conn(), since); err != nil {
+		return errors.Wrap(err, "refresh views")
+	}
+
+	log.Info().
+		Uint64("from", uint64(from)).
+		Uint64("to", uint64(to)).
+		Msg("blocks were reindexed")
+	return nil
+}
+
+func createNodeApi(cfg config.Config) (node.Api, error) {
+	if cfg.Indexer.Archive != "" {
+		archiveApi, err := archive.NewAPI(cfg.Indexer.Archive)
+		if err != nil {
+			return nil, errors.Wrap(err, "open blocks archive")
+		}
+		return archiveApi, nil
+	}
+
+	opts := make([]rpc.ApiOption, 0)
+	if cfg.Indexer.DisableGzip {
+		opts = append(opts, rpc.WithDisableGzip())
+	}
+	nodeRpc := rpc.NewAPI(cfg.DataSources["node_rpc"], opts...)
+	return &nodeRpc, nil
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/celenium-io/celestia-indexer/internal/snapshot"
+	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// command - action selected by subcommand. If it's nil indexer is started.
+var command func(ctx context.Context, cfg config.Config) error
+
+var (
+	snapshotPath   string
+	snapshotHeight uint64
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Export or import snapshot of indexed database",
+}
+
+var snapshotExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write snapshot of all indexer tables to archive",
+	Run: func(cmd *cobra.Command, args []string) {
+		command = exportSnapshot
+	},
+}
+
+var snapshotImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Restore snapshot archive into empty database",
+	Run: func(cmd *cobra.Command, args []string) {
+		command = importSnapshot
+	},
+}
+
+func init() {
+	snapshotExportCmd.Flags().StringVarP(&snapshotPath, "output", "o", "snapshot.tar.gz", "path to output archive")
+	snapshotExportCmd.Flags().Uint64Var(&snapshotHeight, "height", 0, "height of snapshot, by default the last indexed height is used")
+	snapshotImportCmd.Flags().StringVarP(&snapshotPath, "input", "i", "snapshot.tar.gz", "path to snapshot archive")
+
+	snapshotCmd.AddCommand(snapshotExportCmd, snapshotImportCmd)
+	rootCmd.AddCommand(snapshotCmd)
+}
+
+func exportSnapshot(ctx context.Context, cfg config.Config) error {
+	pg, err := postgres.Create(ctx, cfg.Database, cfg.Indexer.ScriptsDir, false)
+	if err != nil {
+		return errors.Wrap(err, "create database connection")
+	}
+	defer closeStorage(pg)
+
+	f, err := os.Create(snapshotPath)
+	if err != nil {
+		return errors.Wrap(err, "create snapshot file")
+	}
+	defer f.Close()
+
+	manifest, err := snapshot.Export(ctx, pg.Connection().Pool(), cfg.Indexer.Name, types.Level(snapshotHeight), f)
+	if err != nil {
+		if removeErr := os.Remove(snapshotPath); removeErr != nil {
+			log.Err(removeErr).Msg("remove incomplete snapshot")
+		}
+		return err
+	}
+
+	log.Info().
+		Str("path", snapshotPath).
+		Str("chain_id", manifest.ChainId).
+		Uint64("height", uint64(manifest.Height)).
+		Msg("snapshot was exported")
+	return nil
+}
+
+func importSnapshot(ctx context.Context, cfg config.Config) error {
+	f, err := os.Open(snapshotPath)
+	if err != nil {
+		return errors.Wrap(err, "open snapshot file")
+	}
+	defer f.Close()
+
+	pg, err := postgres.Create(ctx, cfg.Database, cfg.Indexer.ScriptsDir, true)
+	if err != nil {
+		return errors.Wrap(err, "create database connection")
+	}
+	defer closeStorage(pg)
+
+	manifest, err := snapshot.Import(ctx, pg.Connection().Pool(), f)
+	if err != nil {
+		return err
+	}
+	if manifest.Indexer != cfg.Indexer.Name {
+		log.Warn().
+			Str("snapshot", manifest.Indexer).
+			Str("config", cfg.Indexer.Name).
+			Msg("indexer name differs from snapshot, indexer will not resume from the snapshot state")
+	}
+
+	log.Info().
+		Str("chain_id", manifest.ChainId).
+		Uint64("height", uint64(manifest.Height)).
+		Msg("snapshot was imported")
+	return nil
+}
+
+func closeStorage(pg postgres.Storage) {
+	if err := pg.Close(); err != nil {
+		log.Err(err).Msg("closing database connection")
+	}
+}
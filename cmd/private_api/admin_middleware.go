@@ -5,9 +5,11 @@ package main
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/celenium-io/celestia-indexer/cmd/private_api/handler"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/labstack/echo/v4"
 )
 
@@ -26,9 +28,31 @@ func checkOnAdminPermission(next echo.HandlerFunc) echo.HandlerFunc {
 		if !ok {
 			return ctx.JSON(http.StatusForbidden, accessDeniedErr)
 		}
-		if !apiKey.Admin {
+		if !apiKey.IsAdmin() {
 			return ctx.JSON(http.StatusForbidden, accessDeniedErr)
 		}
 		return next(ctx)
 	}
 }
+
+// ScopeMiddleware - allows requests of keys with one of the scopes. Admin keys are allowed everywhere and read-only keys are allowed to make GET requests.
+func ScopeMiddleware(scopes ...types.ApiKeyScope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			val := ctx.Get(handler.ApiKeyName)
+			apiKey, ok := val.(storage.ApiKey)
+			if !ok {
+				return ctx.JSON(http.StatusForbidden, accessDeniedErr)
+			}
+
+			switch {
+			case apiKey.IsAdmin(), slices.Contains(scopes, apiKey.Scope):
+				return next(ctx)
+			case apiKey.Scope == types.ApiKeyScopeReadOnly && ctx.Request().Method == http.MethodGet:
+				return next(ctx)
+			default:
+				return ctx.JSON(http.StatusForbidden, accessDeniedErr)
+			}
+		}
+	}
+}
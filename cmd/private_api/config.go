@@ -20,4 +20,6 @@ type ApiConfig struct {
 	Bind           string  `validate:"required,hostname_port" yaml:"bind"`
 	RateLimit      float64 `validate:"omitempty,min=0"        yaml:"rate_limit"`
 	RequestTimeout int     `validate:"omitempty,min=1"        yaml:"request_timeout"`
+	Discovery      bool    `validate:"omitempty"              yaml:"discovery"`
+	BlobReceiver   string  `validate:"omitempty"              yaml:"blob_receiver"`
 }
@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package discovery
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/workerpool"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// TagDiscovered - tag of rollups proposed by the analyzer
+const TagDiscovered = "discovered"
+
+const (
+	// maximum count of signers which is considered as dedicated to a single rollup
+	maxDedicatedSigners = 3
+	// maximum average interval between blobs which is considered as regular posting
+	regularCadence = time.Hour
+	// namespaces with closer cadence or average blob size are clustered together
+	clusterTolerance = 2.0
+)
+
+// Analyzer - periodically clusters namespaces which are not linked to rollups by signer set, cadence and blob-size profile.
+// Clusters which look like rollups are saved as unverified rollups with evidence, so curators can review them in `Unverified` flow.
+type Analyzer struct {
+	candidates storage.IRollupCandidate
+	blobLogs   storage.IBlobLog
+	receiver   node.DalApi
+	tx         sdk.Transactable
+	txBeginner func(ctx context.Context, tx sdk.Transactable) (storage.Transaction, error)
+
+	interval time.Duration
+	window   time.Duration
+	minBlobs int
+	minScore float64
+	samples  int
+	now      func() time.Time
+
+	log zerolog.Logger
+	g   workerpool.Group
+}
+
+func NewAnalyzer(
+	candidates storage.IRollupCandidate,
+	blobLogs storage.IBlobLog,
+	tx sdk.Transactable,
+	txBeginner func(ctx context.Context, tx sdk.Transactable) (storage.Transaction, error),
+	opts ...AnalyzerOption,
+) *Analyzer {
+	a := &Analyzer{
+		candidates: candidates,
+		blobLogs:   blobLogs,
+		tx:         tx,
+		txBeginner: txBeginner,
+		interval:   time.Hour,
+		window:     7 * 24 * time.Hour,
+		minBlobs:   100,
+		minScore:   0.5,
+		samples:    5,
+		now:        time.Now,
+		log:        log.With().Str("module", "rollup_discovery").Logger(),
+		g:          workerpool.NewGroup(),
+	}
+
+	for i := range opts {
+		opts[i](a)
+	}
+
+	return a
+}
+
+func (a *Analyzer) Start(ctx context.Context) {
+	a.g.GoCtx(ctx, a.run)
+}
+
+func (a *Analyzer) Close() error {
+	a.g.Wait()
+	return nil
+}
+
+func (a *Analyzer) run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := a.Analyze(ctx); err != nil {
+				a.log.Err(err).Msg("analyzing namespace activity")
+			}
+		}
+	}
+}
+
+// Analyze - runs discovery once and returns ids of proposed rollups
+func (a *Analyzer) Analyze(ctx context.Context) ([]uint64, error) {
+	now := a.now().UTC()
+	activity, err := a.candidates.Activity(ctx, now.Add(-a.window), a.minBlobs)
+	if err != nil {
+		return nil, errors.Wrap(err, "receive namespace activity")
+	}
+
+	clusters := Clusterize(activity, clusterTolerance)
+	proposed := make([]uint64, 0)
+	for i := range clusters {
+		candidate := a.evaluate(ctx, clusters[i])
+		if candidate.Score < a.minScore {
+			continue
+		}
+		candidate.CreatedAt = now
+
+		id, err := a.propose(ctx, clusters[i], &candidate)
+		if err != nil {
+			a.log.Err(err).Uints64("namespaces", candidate.NamespaceIds).Msg("proposing rollup")
+			continue
+		}
+		a.log.Info().
+			Uint64("rollup_id", id).
+			Uints64("namespaces", candidate.NamespaceIds).
+			Str("stack", candidate.Stack).
+			Float64("score", candidate.Score).
+			Msg("new rollup candidate")
+		proposed = append(proposed, id)
+	}
+	return proposed, nil
+}
+
+// evaluate - scores the cluster and collects evidence
+func (a *Analyzer) evaluate(ctx context.Context, cluster Cluster) storage.RollupCandidate {
+	candidate := storage.RollupCandidate{
+		NamespaceIds: cluster.NamespaceIds(),
+		SignerIds:    cluster.SignerIds,
+		BlobsCount:   cluster.BlobsCount,
+		AvgSize:      cluster.AvgSize,
+		SizeStddev:   cluster.SizeStddev,
+		Cadence:      cluster.Cadence,
+		Evidence: []string{
+			fmt.Sprintf("%d blobs during last %s", cluster.BlobsCount, a.window),
+		},
+	}
+
+	stack, detected, sampled := a.detectStack(ctx, cluster)
+	if stack != "" {
+		candidate.Stack = stack
+		candidate.Score += 0.4 * float64(detected) / float64(sampled)
+		candidate.Evidence = append(candidate.Evidence, fmt.Sprintf("%d of %d sampled blobs have %s header", detected, sampled, stack))
+	}
+
+	if cluster.Cadence > 0 && cluster.Cadence <= regularCadence.Seconds() {
+		candidate.Score += 0.2
+		cadence := time.Duration(cluster.Cadence * float64(time.Second)).Round(time.Second)
+		candidate.Evidence = append(candidate.Evidence, fmt.Sprintf("blobs are posted every %s on average", cadence))
+	}
+
+	if len(cluster.SignerIds) > 0 && len(cluster.SignerIds) <= maxDedicatedSigners {
+		candidate.Score += 0.2
+		candidate.Evidence = append(candidate.Evidence, fmt.Sprintf("blobs are signed by %d dedicated signer(s)", len(cluster.SignerIds)))
+	}
+
+	if cluster.AvgSize > 0 && cluster.SizeStddev <= cluster.AvgSize {
+		candidate.Score += 0.1
+		candidate.Evidence = append(candidate.Evidence, fmt.Sprintf("stable blob size: %.0f bytes on average with deviation %.0f bytes", cluster.AvgSize, cluster.SizeStddev))
+	}
+
+	if len(cluster.Namespaces) > 1 {
+		candidate.Score += 0.1
+		candidate.Evidence = append(candidate.Evidence, fmt.Sprintf("%d namespaces share signers and activity profile", len(cluster.Namespaces)))
+	}
+
+	return candidate
+}
+
+// detectStack - downloads the latest blobs of clustered namespaces and votes for the stack by their headers
+func (a *Analyzer) detectStack(ctx context.Context, cluster Cluster) (stack string, detected, sampled int) {
+	if a.receiver == nil {
+		return
+	}
+
+	votes := make(map[string]int)
+	for _, activity := range cluster.Namespaces {
+		ns := activity.Namespace()
+		blobs, err := a.blobLogs.ByNamespace(ctx, ns.Id, storage.BlobLogFilters{
+			Limit: a.samples,
+			Sort:  sdk.SortOrderDesc,
+		})
+		if err != nil {
+			a.log.Err(err).Uint64("namespace_id", ns.Id).Msg("receive blobs for sampling")
+			continue
+		}
+
+		for i := range blobs {
+			blob, err := a.receiver.Blob(ctx, blobs[i].Height, ns.Hash(), blobs[i].Commitment)
+			if err != nil {
+				a.log.Err(err).Uint64("namespace_id", ns.Id).Uint64("height", uint64(blobs[i].Height)).Msg("receive blob")
+				continue
+			}
+			data, err := base64.StdEncoding.DecodeString(blob.Data)
+			if err != nil {
+				continue
+			}
+			sampled++
+			if s := DetectStack(data); s != "" {
+				votes[s]++
+			}
+		}
+	}
+
+	for i := range detectors {
+		if count := votes[detectors[i].stack]; count > detected {
+			stack = detectors[i].stack
+			detected = count
+		}
+	}
+	return
+}
+
+// propose - saves unverified rollup with providers for every clustered namespace and evidence of discovery
+func (a *Analyzer) propose(ctx context.Context, cluster Cluster, candidate *storage.RollupCandidate) (uint64, error) {
+	if a.txBeginner == nil {
+		return 0, errors.New("tx beginner is nil")
+	}
+
+	tx, err := a.txBeginner(ctx, a.tx)
+	if err != nil {
+		return 0, err
+	}
+
+	rollupId, err := a.save(ctx, tx, cluster, candidate)
+	if err != nil {
+		if handleErr := tx.HandleError(ctx, err); handleErr != nil {
+			return 0, errors.Wrap(err, handleErr.Error())
+		}
+		return 0, err
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return 0, err
+	}
+	return rollupId, nil
+}
+
+func (a *Analyzer) save(ctx context.Context, tx storage.Transaction, cluster Cluster, candidate *storage.RollupCandidate) (uint64, error) {
+	name := candidateName(cluster.Namespaces[0])
+	rollup := storage.Rollup{
+		Name:        name,
+		Slug:        slug.Make(name),
+		Description: strings.Join(candidate.Evidence, "; "),
+		Stack:       candidate.Stack,
+		Type:        types.RollupTypeOther,
+		Category:    types.RollupCategoryUncategorized,
+		Tags:        []string{TagDiscovered},
+		Verified:    false,
+	}
+	if err := tx.SaveRollup(ctx, &rollup); err != nil {
+		return 0, err
+	}
+
+	providers := make([]storage.RollupProvider, len(cluster.Namespaces))
+	for i := range cluster.Namespaces {
+		providers[i] = storage.RollupProvider{
+			RollupId:    rollup.Id,
+			NamespaceId: cluster.Namespaces[i].NamespaceId,
+		}
+	}
+	if err := tx.SaveProviders(ctx, providers...); err != nil {
+		return 0, err
+	}
+
+	candidate.RollupId = rollup.Id
+	if err := tx.Add(ctx, candidate); err != nil {
+		return 0, err
+	}
+	return rollup.Id, nil
+}
+
+// candidateName - builds name of proposed rollup from the meaningful part of its first namespace
+func candidateName(activity storage.NamespaceActivity) string {
+	id := strings.TrimLeft(hex.EncodeToString(activity.NamespaceID), "0")
+	if id == "" {
+		id = "0"
+	}
+	return fmt.Sprintf("Discovered rollup %s", id)
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package discovery
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	nodeMock "github.com/celenium-io/celestia-indexer/pkg/node/mock"
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testNow = time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+type testAnalyzer struct {
+	*Analyzer
+
+	candidates *mock.MockIRollupCandidate
+	blobLogs   *mock.MockIBlobLog
+	receiver   *nodeMock.MockDalApi
+	tx         *mock.MockTransaction
+}
+
+func newTestAnalyzer(t *testing.T, withReceiver bool) testAnalyzer {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	ta := testAnalyzer{
+		candidates: mock.NewMockIRollupCandidate(ctrl),
+		blobLogs:   mock.NewMockIBlobLog(ctrl),
+		receiver:   nodeMock.NewMockDalApi(ctrl),
+		tx:         mock.NewMockTransaction(ctrl),
+	}
+	txBeginner := func(_ context.Context, _ sdk.Transactable) (storage.Transaction, error) {
+		return ta.tx, nil
+	}
+
+	opts := []AnalyzerOption{WithMinBlobs(10)}
+	if withReceiver {
+		opts = append(opts, WithBlobReceiver(ta.receiver, 1))
+	}
+	ta.Analyzer = NewAnalyzer(ta.candidates, ta.blobLogs, nil, txBeginner, opts...)
+	ta.now = func() time.Time { return testNow }
+	return ta
+}
+
+func TestAnalyzeProposesCandidate(t *testing.T) {
+	ta := newTestAnalyzer(t, true)
+
+	activity := []storage.NamespaceActivity{
+		{
+			NamespaceId: 1,
+			NamespaceID: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xde, 0xad, 0xbe, 0xef},
+			BlobsCount:  300,
+			AvgSize:     100_000,
+			SizeStddev:  10_000,
+			Cadence:     12,
+			SignerIds:   []uint64{10},
+		}, {
+			NamespaceId: 2,
+			NamespaceID: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xca, 0xfe},
+			BlobsCount:  100,
+			AvgSize:     2_000,
+			SizeStddev:  100,
+			Cadence:     20,
+			SignerIds:   []uint64{10},
+		},
+	}
+
+	ta.candidates.EXPECT().
+		Activity(gomock.Any(), testNow.Add(-7*24*time.Hour), 10).
+		Return(activity, nil).
+		Times(1)
+
+	for i := range activity {
+		ta.blobLogs.EXPECT().
+			ByNamespace(gomock.Any(), activity[i].NamespaceId, storage.BlobLogFilters{
+				Limit: 1,
+				Sort:  sdk.SortOrderDesc,
+			}).
+			Return([]storage.BlobLog{
+				{
+					Height:     pkgTypes.Level(100 + i),
+					Commitment: "commitment",
+				},
+			}, nil).
+			Times(1)
+
+		ta.receiver.EXPECT().
+			Blob(gomock.Any(), pkgTypes.Level(100+i), activity[i].Namespace().Hash(), "commitment").
+			Return(nodeTypes.Blob{
+				Data: base64.StdEncoding.EncodeToString(append([]byte{opDerivationVersion0}, opFrame(0xaa, 0, []byte("channel"), true)...)),
+			}, nil).
+			Times(1)
+	}
+
+	ta.tx.EXPECT().
+		SaveRollup(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rollup *storage.Rollup) error {
+			require.Equal(t, "Discovered rollup deadbeef", rollup.Name)
+			require.Equal(t, "discovered-rollup-deadbeef", rollup.Slug)
+			require.Equal(t, StackOP, rollup.Stack)
+			require.Equal(t, types.RollupTypeOther, rollup.Type)
+			require.Equal(t, types.RollupCategoryUncategorized, rollup.Category)
+			require.Equal(t, []string{TagDiscovered}, rollup.Tags)
+			require.False(t, rollup.Verified)
+			require.NotEmpty(t, rollup.Description)
+			rollup.Id = 7
+			return nil
+		}).
+		Times(1)
+
+	ta.tx.EXPECT().
+		SaveProviders(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, providers ...storage.RollupProvider) error {
+			require.Equal(t, []storage.RollupProvider{
+				{RollupId: 7, NamespaceId: 1},
+				{RollupId: 7, NamespaceId: 2},
+			}, providers)
+			return nil
+		}).
+		Times(1)
+
+	ta.tx.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			candidate, ok := model.(*storage.RollupCandidate)
+			require.True(t, ok)
+			require.EqualValues(t, 7, candidate.RollupId)
+			require.Equal(t, []uint64{1, 2}, candidate.NamespaceIds)
+			require.Equal(t, []uint64{10}, candidate.SignerIds)
+			require.Equal(t, StackOP, candidate.Stack)
+			require.EqualValues(t, 400, candidate.BlobsCount)
+			require.EqualValues(t, 12, candidate.Cadence)
+			require.InDelta(t, 1.0, candidate.Score, 1e-9)
+			require.Contains(t, candidate.Evidence, "2 of 2 sampled blobs have OP Stack header")
+			require.Contains(t, candidate.Evidence, "blobs are posted every 12s on average")
+			require.Equal(t, testNow, candidate.CreatedAt)
+			return nil
+		}).
+		Times(1)
+
+	ta.tx.EXPECT().
+		Flush(gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	proposed, err := ta.Analyze(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7}, proposed)
+}
+
+func TestAnalyzeSkipsLowScore(t *testing.T) {
+	ta := newTestAnalyzer(t, false)
+
+	ta.candidates.EXPECT().
+		Activity(gomock.Any(), testNow.Add(-7*24*time.Hour), 10).
+		Return([]storage.NamespaceActivity{
+			{
+				NamespaceId: 3,
+				BlobsCount:  20,
+				AvgSize:     500,
+				SizeStddev:  2_000,
+				Cadence:     7200,
+				SignerIds:   []uint64{1, 2, 3, 4, 5},
+			},
+		}, nil).
+		Times(1)
+
+	proposed, err := ta.Analyze(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, proposed)
+}
+
+func TestEvaluateWithoutReceiver(t *testing.T) {
+	ta := newTestAnalyzer(t, false)
+
+	candidate := ta.evaluate(t.Context(), Cluster{
+		Namespaces: []storage.NamespaceActivity{{NamespaceId: 1}},
+		SignerIds:  []uint64{1},
+		BlobsCount: 200,
+		AvgSize:    1000,
+		SizeStddev: 10,
+		Cadence:    60,
+	})
+	require.Empty(t, candidate.Stack)
+	require.InDelta(t, 0.5, candidate.Score, 1e-9)
+	require.Len(t, candidate.Evidence, 4)
+}
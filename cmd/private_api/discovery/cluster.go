@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package discovery
+
+import (
+	"math"
+	"slices"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+// Cluster - group of namespaces which are probably used by the same rollup
+type Cluster struct {
+	Namespaces []storage.NamespaceActivity
+	SignerIds  []uint64
+	BlobsCount int64
+	AvgSize    float64
+	SizeStddev float64
+	Cadence    float64
+}
+
+// similar - returns true if values differ less than `tolerance` times
+func similar(a, b, tolerance float64) bool {
+	if a <= 0 || b <= 0 {
+		return a == b
+	}
+	return math.Max(a, b)/math.Min(a, b) <= tolerance
+}
+
+func sharesSigner(a, b []uint64) bool {
+	for i := range a {
+		if slices.Contains(b, a[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// linked - namespaces belong to the same cluster if they have a common signer and similar cadence or blob-size profile
+func linked(a, b storage.NamespaceActivity, tolerance float64) bool {
+	if !sharesSigner(a.SignerIds, b.SignerIds) {
+		return false
+	}
+	return similar(a.Cadence, b.Cadence, tolerance) || similar(a.AvgSize, b.AvgSize, tolerance)
+}
+
+// Clusterize - groups namespaces by signer set, cadence and blob-size profile.
+// Clusters are returned in order of their first namespace in `activity`.
+func Clusterize(activity []storage.NamespaceActivity, tolerance float64) []Cluster {
+	parent := make([]int, len(activity))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range activity {
+		for j := i + 1; j < len(activity); j++ {
+			if !linked(activity[i], activity[j], tolerance) {
+				continue
+			}
+			ri, rj := find(i), find(j)
+			if ri == rj {
+				continue
+			}
+			if ri > rj {
+				ri, rj = rj, ri
+			}
+			parent[rj] = ri
+		}
+	}
+
+	index := make(map[int]int)
+	clusters := make([]Cluster, 0)
+	for i := range activity {
+		root := find(i)
+		idx, ok := index[root]
+		if !ok {
+			idx = len(clusters)
+			index[root] = idx
+			clusters = append(clusters, Cluster{})
+		}
+		clusters[idx].Namespaces = append(clusters[idx].Namespaces, activity[i])
+	}
+
+	for i := range clusters {
+		clusters[i].aggregate()
+	}
+	return clusters
+}
+
+// aggregate - computes profile of the cluster. Sizes are weighted by blobs count, cadence is taken from the most active namespace.
+func (c *Cluster) aggregate() {
+	var (
+		sizeSum   float64
+		stddevSum float64
+	)
+	for _, ns := range c.Namespaces {
+		c.BlobsCount += ns.BlobsCount
+		sizeSum += ns.AvgSize * float64(ns.BlobsCount)
+		stddevSum += ns.SizeStddev * float64(ns.BlobsCount)
+
+		if c.Cadence == 0 || (ns.Cadence > 0 && ns.Cadence < c.Cadence) {
+			c.Cadence = ns.Cadence
+		}
+		for _, signer := range ns.SignerIds {
+			if !slices.Contains(c.SignerIds, signer) {
+				c.SignerIds = append(c.SignerIds, signer)
+			}
+		}
+	}
+	slices.Sort(c.SignerIds)
+
+	if c.BlobsCount > 0 {
+		c.AvgSize = sizeSum / float64(c.BlobsCount)
+		c.SizeStddev = stddevSum / float64(c.BlobsCount)
+	}
+}
+
+// NamespaceIds - returns internal ids of clustered namespaces
+func (c Cluster) NamespaceIds() []uint64 {
+	ids := make([]uint64, len(c.Namespaces))
+	for i := range c.Namespaces {
+		ids[i] = c.Namespaces[i].NamespaceId
+	}
+	return ids
+}
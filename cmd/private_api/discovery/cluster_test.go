@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterize(t *testing.T) {
+	activity := []storage.NamespaceActivity{
+		// batches and proofs of the same rollup: common signer, same cadence, different sizes
+		{NamespaceId: 1, BlobsCount: 300, AvgSize: 100_000, SizeStddev: 10_000, Cadence: 12, SignerIds: []uint64{10}},
+		{NamespaceId: 2, BlobsCount: 100, AvgSize: 2_000, SizeStddev: 100, Cadence: 20, SignerIds: []uint64{10, 11}},
+		// common signer, but different cadence and sizes
+		{NamespaceId: 3, BlobsCount: 10, AvgSize: 500, SizeStddev: 10, Cadence: 3600, SignerIds: []uint64{11}},
+		// similar profile without common signer
+		{NamespaceId: 4, BlobsCount: 300, AvgSize: 100_000, SizeStddev: 10_000, Cadence: 12, SignerIds: []uint64{12}},
+	}
+
+	clusters := Clusterize(activity, clusterTolerance)
+	require.Len(t, clusters, 3)
+
+	require.Equal(t, []uint64{1, 2}, clusters[0].NamespaceIds())
+	require.Equal(t, []uint64{10, 11}, clusters[0].SignerIds)
+	require.EqualValues(t, 400, clusters[0].BlobsCount)
+	require.EqualValues(t, 75_500, clusters[0].AvgSize)
+	require.EqualValues(t, 7_525, clusters[0].SizeStddev)
+	require.EqualValues(t, 12, clusters[0].Cadence)
+
+	require.Equal(t, []uint64{3}, clusters[1].NamespaceIds())
+	require.Equal(t, []uint64{11}, clusters[1].SignerIds)
+
+	require.Equal(t, []uint64{4}, clusters[2].NamespaceIds())
+	require.Equal(t, []uint64{12}, clusters[2].SignerIds)
+}
+
+func TestClusterizeTransitive(t *testing.T) {
+	activity := []storage.NamespaceActivity{
+		{NamespaceId: 1, BlobsCount: 10, AvgSize: 1000, Cadence: 10, SignerIds: []uint64{1}},
+		{NamespaceId: 2, BlobsCount: 10, AvgSize: 1000, Cadence: 10, SignerIds: []uint64{2}},
+		{NamespaceId: 3, BlobsCount: 10, AvgSize: 1000, Cadence: 10, SignerIds: []uint64{1, 2}},
+	}
+
+	clusters := Clusterize(activity, clusterTolerance)
+	require.Len(t, clusters, 1)
+	require.Equal(t, []uint64{1, 2, 3}, clusters[0].NamespaceIds())
+	require.Equal(t, []uint64{1, 2}, clusters[0].SignerIds)
+}
+
+func TestClusterizeEmpty(t *testing.T) {
+	require.Empty(t, Clusterize(nil, clusterTolerance))
+}
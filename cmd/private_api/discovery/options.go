@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package discovery
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/pkg/node"
+)
+
+type AnalyzerOption func(*Analyzer)
+
+func WithInterval(interval time.Duration) AnalyzerOption {
+	return func(a *Analyzer) {
+		if interval > 0 {
+			a.interval = interval
+		}
+	}
+}
+
+// WithWindow - sets period of blob activity which is analyzed
+func WithWindow(window time.Duration) AnalyzerOption {
+	return func(a *Analyzer) {
+		if window > 0 {
+			a.window = window
+		}
+	}
+}
+
+// WithMinBlobs - sets minimal count of blobs in the window for namespace to be analyzed
+func WithMinBlobs(count int) AnalyzerOption {
+	return func(a *Analyzer) {
+		if count > 0 {
+			a.minBlobs = count
+		}
+	}
+}
+
+// WithMinScore - sets minimal score of cluster to be proposed as rollup
+func WithMinScore(score float64) AnalyzerOption {
+	return func(a *Analyzer) {
+		if score > 0 && score <= 1 {
+			a.minScore = score
+		}
+	}
+}
+
+// WithBlobReceiver - enables stack detection by headers of the latest blobs in namespace
+func WithBlobReceiver(receiver node.DalApi, samples int) AnalyzerOption {
+	return func(a *Analyzer) {
+		a.receiver = receiver
+		if samples > 0 {
+			a.samples = samples
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package discovery
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// Known rollup stacks. Names are the same as curators use in `stack` field of rollup.
+const (
+	StackOP           = "OP Stack"
+	StackArbitrum     = "Arbitrum Nitro"
+	StackSovereignSDK = "Sovereign SDK"
+	StackRollkit      = "Rollkit"
+)
+
+type stackDetector struct {
+	stack  string
+	detect func(data []byte) bool
+}
+
+// detectors are ordered from the most strict format to the least one
+var detectors = []stackDetector{
+	{StackOP, isOpFrames},
+	{StackArbitrum, isNitroBatch},
+	{StackSovereignSDK, isSovereignBatch},
+	{StackRollkit, isRollkitBlock},
+}
+
+// DetectStack - guesses rollup stack by the blob header. Returns empty string if blob has unknown format.
+func DetectStack(data []byte) string {
+	for i := range detectors {
+		if detectors[i].detect(data) {
+			return detectors[i].stack
+		}
+	}
+	return ""
+}
+
+const (
+	opDerivationVersion0 = 0x00
+	// channel_id (16 bytes) + frame_number (uint16) + frame_data_length (uint32)
+	opFrameHeaderSize = 16 + 2 + 4
+)
+
+// isOpFrames - checks that data is batcher transaction of OP stack: derivation version byte
+// followed by one or more frames `channel_id ++ frame_number ++ frame_data_length ++ frame_data ++ is_last`.
+func isOpFrames(data []byte) bool {
+	if len(data) < 1+opFrameHeaderSize+1 || data[0] != opDerivationVersion0 {
+		return false
+	}
+
+	offset := 1
+	for offset < len(data) {
+		if len(data)-offset < opFrameHeaderSize+1 {
+			return false
+		}
+		length := uint64(binary.BigEndian.Uint32(data[offset+18 : offset+opFrameHeaderSize]))
+		end := uint64(offset+opFrameHeaderSize) + length
+		if end >= uint64(len(data)) {
+			return false
+		}
+		if isLast := data[end]; isLast > 1 {
+			return false
+		}
+		offset = int(end) + 1
+	}
+	return true
+}
+
+const (
+	// min_timestamp, max_timestamp, min_l1_block, max_l1_block, after_delayed_messages
+	nitroHeaderSize         = 5 * 8
+	nitroBrotliMessageFlag  = 0x00
+	nitroMinBatchTimestamp  = 1577836800 // 2020-01-01
+	nitroMaxTimestampSpread = uint64(24 * time.Hour / time.Second)
+)
+
+// isNitroBatch - checks that data is sequencer batch of Arbitrum Nitro: 40-bytes header with timestamp and L1 block bounds
+// followed by brotli compressed payload
+func isNitroBatch(data []byte) bool {
+	if len(data) <= nitroHeaderSize || data[nitroHeaderSize] != nitroBrotliMessageFlag {
+		return false
+	}
+
+	minTimestamp := binary.BigEndian.Uint64(data[0:8])
+	maxTimestamp := binary.BigEndian.Uint64(data[8:16])
+	minBlock := binary.BigEndian.Uint64(data[16:24])
+	maxBlock := binary.BigEndian.Uint64(data[24:32])
+
+	return minTimestamp >= nitroMinBatchTimestamp &&
+		minTimestamp <= maxTimestamp &&
+		maxTimestamp-minTimestamp <= nitroMaxTimestampSpread &&
+		minBlock <= maxBlock
+}
+
+const sovereignMaxTxCount = 1 << 16
+
+// isSovereignBatch - checks that data is borsh serialized batch of Sovereign SDK: vector of raw transactions,
+// where vector and every transaction are prefixed with little-endian uint32 length
+func isSovereignBatch(data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	count := binary.LittleEndian.Uint32(data[:4])
+	if count == 0 || count > sovereignMaxTxCount {
+		return false
+	}
+
+	offset := uint64(4)
+	for range count {
+		if uint64(len(data))-offset < 4 {
+			return false
+		}
+		length := uint64(binary.LittleEndian.Uint32(data[offset : offset+4]))
+		offset += 4 + length
+		if offset > uint64(len(data)) {
+			return false
+		}
+	}
+	return offset == uint64(len(data))
+}
+
+const (
+	protoLengthDelimitedField1 = 0x0a // field 1, wire type 2
+	protoVarintField1          = 0x08 // field 1, wire type 0
+)
+
+// isRollkitBlock - checks that data is protobuf encoded rollkit `SignedHeader` or `Data`:
+// both start with embedded message in the first field and the header starts with `Version` message.
+func isRollkitBlock(data []byte) bool {
+	if len(data) < 2 || data[0] != protoLengthDelimitedField1 {
+		return false
+	}
+	length, n := binary.Uvarint(data[1:])
+	if n <= 0 || length == 0 || uint64(1+n)+length > uint64(len(data)) {
+		return false
+	}
+
+	inner := data[1+n:]
+	if inner[0] != protoLengthDelimitedField1 {
+		return false
+	}
+	versionLength, m := binary.Uvarint(inner[1:])
+	if m <= 0 || versionLength == 0 || uint64(1+m)+versionLength > length {
+		return false
+	}
+	return inner[1+m] == protoVarintField1
+}
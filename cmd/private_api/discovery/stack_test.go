@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package discovery
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func opFrame(channel byte, number uint16, payload []byte, isLast bool) []byte {
+	frame := bytes.Repeat([]byte{channel}, 16)
+	frame = binary.BigEndian.AppendUint16(frame, number)
+	frame = binary.BigEndian.AppendUint32(frame, uint32(len(payload)))
+	frame = append(frame, payload...)
+	if isLast {
+		return append(frame, 1)
+	}
+	return append(frame, 0)
+}
+
+func nitroBatch(minTs, maxTs, minBlock, maxBlock uint64, payload []byte) []byte {
+	data := binary.BigEndian.AppendUint64(nil, minTs)
+	data = binary.BigEndian.AppendUint64(data, maxTs)
+	data = binary.BigEndian.AppendUint64(data, minBlock)
+	data = binary.BigEndian.AppendUint64(data, maxBlock)
+	data = binary.BigEndian.AppendUint64(data, 1024)
+	data = append(data, nitroBrotliMessageFlag)
+	return append(data, payload...)
+}
+
+func sovereignBatch(txs ...[]byte) []byte {
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(txs)))
+	for i := range txs {
+		data = binary.LittleEndian.AppendUint32(data, uint32(len(txs[i])))
+		data = append(data, txs[i]...)
+	}
+	return data
+}
+
+func TestDetectStack(t *testing.T) {
+	rollkitHeader := []byte{
+		0x0a, 0x0a, // SignedHeader.header
+		0x0a, 0x04, // Header.version
+		0x08, 0x0b, // Version.block
+		0x10, 0x01, // Version.app
+		0x10, 0x64, // Header.height
+		0x12, 0x01, 0xff, // SignedHeader.signature
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{
+			name: "OP stack single frame",
+			data: append([]byte{opDerivationVersion0}, opFrame(0xaa, 0, []byte("compressed channel"), true)...),
+			want: StackOP,
+		}, {
+			name: "OP stack several frames",
+			data: append(append([]byte{opDerivationVersion0}, opFrame(0xaa, 3, []byte("first"), false)...), opFrame(0xbb, 0, []byte("second"), true)...),
+			want: StackOP,
+		}, {
+			name: "Arbitrum Nitro batch",
+			data: nitroBatch(1717000000, 1717000120, 20000000, 20000010, []byte{0x1b, 0x2f, 0x00}),
+			want: StackArbitrum,
+		}, {
+			name: "Sovereign SDK batch",
+			data: sovereignBatch([]byte("tx 1"), []byte("transaction 2")),
+			want: StackSovereignSDK,
+		}, {
+			name: "Rollkit signed header",
+			data: rollkitHeader,
+			want: StackRollkit,
+		}, {
+			name: "OP frame with broken length",
+			data: append([]byte{opDerivationVersion0}, opFrame(0xaa, 0, []byte("payload"), true)[:25]...),
+			want: "",
+		}, {
+			name: "Nitro batch with reversed timestamps",
+			data: nitroBatch(1717000120, 1717000000, 1, 2, []byte{0x1b}),
+			want: "",
+		}, {
+			name: "Sovereign batch with trailing bytes",
+			data: append(sovereignBatch([]byte("tx")), 0x01),
+			want: "",
+		}, {
+			name: "plain text",
+			data: []byte("hello, celestia"),
+			want: "",
+		}, {
+			name: "empty",
+			data: nil,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, DetectStack(tt.data))
+		})
+	}
+}
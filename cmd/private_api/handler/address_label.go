@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	enums "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var errAddressAlreadyLabeled = errors.New("address is already labeled")
+
+type AddressLabelHandler struct {
+	labels      storage.IAddressLabel
+	address     storage.IAddress
+	notificator storage.Notificator
+}
+
+func NewAddressLabelHandler(
+	labels storage.IAddressLabel,
+	address storage.IAddress,
+	notificator storage.Notificator,
+) AddressLabelHandler {
+	return AddressLabelHandler{
+		labels:      labels,
+		address:     address,
+		notificator: notificator,
+	}
+}
+
+type addressLabelResponse struct {
+	Id        uint64    `json:"id"`
+	Address   string    `json:"address,omitempty"`
+	Name      string    `json:"name"`
+	Category  string    `json:"category"`
+	Source    string    `json:"source,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newAddressLabelResponse(label storage.AddressLabel) addressLabelResponse {
+	response := addressLabelResponse{
+		Id:        label.Id,
+		Name:      label.Name,
+		Category:  label.Category.String(),
+		Source:    label.Source,
+		CreatedAt: label.CreatedAt,
+		UpdatedAt: label.UpdatedAt,
+	}
+	if label.Address != nil {
+		response.Address = label.Address.Address
+	}
+	return response
+}
+
+type createAddressLabelRequest struct {
+	Address  string `json:"address"  validate:"required,address"`
+	Name     string `json:"name"     validate:"required,max=128"`
+	Category string `json:"category" validate:"required,address_label_category"`
+	Source   string `json:"source"   validate:"omitempty,max=256"`
+}
+
+// Create - attaches label to the address. Address can have only one label.
+func (handler AddressLabelHandler) Create(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.labels)
+	}
+
+	req, err := bindAndValidate[createAddressLabelRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	category, err := enums.ParseAddressLabelCategory(req.Category)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	_, hash, err := types.Address(req.Address).Decode()
+	if err != nil {
+		return badRequestError(c, errors.Wrap(errInvalidAddress, req.Address))
+	}
+
+	ctx := c.Request().Context()
+	address, err := handler.address.ByHash(ctx, hash)
+	if err != nil {
+		if handler.address.IsNoRows(err) {
+			return badRequestError(c, errors.Wrap(errUnknownAddress, req.Address))
+		}
+		return handleError(c, err, handler.address)
+	}
+
+	if _, err := handler.labels.ByAddressId(ctx, address.Id); err == nil {
+		return badRequestError(c, errors.Wrap(errAddressAlreadyLabeled, req.Address))
+	} else if !handler.labels.IsNoRows(err) {
+		return handleError(c, err, handler.labels)
+	}
+
+	now := time.Now().UTC()
+	label := storage.AddressLabel{
+		AddressId: address.Id,
+		ApiKey:    apiKey.Key,
+		Name:      req.Name,
+		Category:  category,
+		Source:    req.Source,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := handler.labels.Save(ctx, &label); err != nil {
+		return handleError(c, err, handler.labels)
+	}
+	label.Address = &address
+	handler.notify(ctx, label)
+
+	return c.JSON(http.StatusOK, newAddressLabelResponse(label))
+}
+
+type listAddressLabelsRequest struct {
+	Limit    int    `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   int    `query:"offset"   validate:"omitempty,min=0"`
+	Category string `query:"category" validate:"omitempty,address_label_category"`
+}
+
+// List - returns address labels
+func (handler AddressLabelHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listAddressLabelsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	fltrs := storage.AddressLabelFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+	if req.Category != "" {
+		category, err := enums.ParseAddressLabelCategory(req.Category)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		fltrs.Category = []enums.AddressLabelCategory{category}
+	}
+
+	labels, err := handler.labels.List(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.labels)
+	}
+
+	response := make([]addressLabelResponse, len(labels))
+	for i := range labels {
+		response[i] = newAddressLabelResponse(labels[i])
+	}
+	return returnArray(c, response)
+}
+
+type updateAddressLabelRequest struct {
+	Id       uint64 `param:"id"       validate:"required,min=1"`
+	Name     string `json:"name"      validate:"omitempty,max=128"`
+	Category string `json:"category"  validate:"omitempty,address_label_category"`
+	Source   string `json:"source"    validate:"omitempty,max=256"`
+}
+
+// Update - changes name, category or source of the label
+func (handler AddressLabelHandler) Update(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.labels)
+	}
+
+	req, err := bindAndValidate[updateAddressLabelRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	label, err := handler.owned(ctx, req.Id, apiKey)
+	if err != nil {
+		return handleError(c, err, handler.labels)
+	}
+
+	if req.Name != "" {
+		label.Name = req.Name
+	}
+	if req.Category != "" {
+		if label.Category, err = enums.ParseAddressLabelCategory(req.Category); err != nil {
+			return badRequestError(c, err)
+		}
+	}
+	if req.Source != "" {
+		label.Source = req.Source
+	}
+	label.UpdatedAt = time.Now().UTC()
+
+	if err := handler.labels.Update(ctx, label); err != nil {
+		return handleError(c, err, handler.labels)
+	}
+	handler.notify(ctx, *label)
+
+	return c.JSON(http.StatusOK, newAddressLabelResponse(*label))
+}
+
+type addressLabelIdRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Delete - removes label
+func (handler AddressLabelHandler) Delete(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.labels)
+	}
+
+	req, err := bindAndValidate[addressLabelIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	label, err := handler.owned(ctx, req.Id, apiKey)
+	if err != nil {
+		return handleError(c, err, handler.labels)
+	}
+
+	if err := handler.labels.Delete(ctx, req.Id); err != nil {
+		return handleError(c, err, handler.labels)
+	}
+	handler.notify(ctx, *label)
+
+	return success(c)
+}
+
+// owned - returns label if it was created by the api key. Admin has access to all labels.
+func (handler AddressLabelHandler) owned(ctx context.Context, id uint64, apiKey storage.ApiKey) (*storage.AddressLabel, error) {
+	label, err := handler.labels.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if label.ApiKey != apiKey.Key && !apiKey.IsAdmin() {
+		return nil, errAccessDenied
+	}
+	return label, nil
+}
+
+// notify - informs public API that the label was changed, so cached responses containing the label are outdated.
+// Failed notification doesn't fail the request: cached responses expire by TTL anyway.
+func (handler AddressLabelHandler) notify(ctx context.Context, label storage.AddressLabel) {
+	if handler.notificator == nil {
+		return
+	}
+	// api key of label owner must not be sent to public channel
+	label.ApiKey = ""
+	label.Address = nil
+	payload, err := json.Marshal(label)
+	if err != nil {
+		log.Err(err).Msg("marshal address label notification")
+		return
+	}
+	if err := handler.notificator.Notify(ctx, storage.ChannelLabel, string(payload)); err != nil {
+		log.Err(err).Uint64("label_id", label.Id).Msg("notify about address label change")
+	}
+}
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// AddressLabelTestSuite -
+type AddressLabelTestSuite struct {
+	suite.Suite
+	labels      *mock.MockIAddressLabel
+	address     *mock.MockIAddress
+	notificator *mock.MockNotificator
+	handler     AddressLabelHandler
+	echo        *echo.Echo
+	ctrl        *gomock.Controller
+}
+
+// SetupSuite -
+func (s *AddressLabelTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.labels = mock.NewMockIAddressLabel(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.notificator = mock.NewMockNotificator(s.ctrl)
+	s.handler = NewAddressLabelHandler(s.labels, s.address, s.notificator)
+}
+
+// TearDownSuite -
+func (s *AddressLabelTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteAddressLabel_Run(t *testing.T) {
+	suite.Run(t, new(AddressLabelTestSuite))
+}
+
+func (s *AddressLabelTestSuite) newContext(method, body string, admin bool) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequestWithContext(context.Background(), method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	apiKey := storage.ApiKey{
+		Key:         "test",
+		Description: "test",
+		Scope:       types.ApiKeyScopeStandard,
+	}
+	if admin {
+		apiKey.Scope = types.ApiKeyScopeAdmin
+	}
+	c.Set(ApiKeyName, apiKey)
+	return c, rec
+}
+
+func (s *AddressLabelTestSuite) TestCreate() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"address": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+		"name": "Binance hot wallet",
+		"category": "exchange",
+		"source": "https://example.com/labels"
+	}`, false)
+	c.SetPath("/v1/auth/label")
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), gomock.Any()).
+		Return(storage.Address{
+			Id:      2,
+			Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+		}, nil).
+		Times(1)
+
+	s.labels.EXPECT().
+		ByAddressId(gomock.Any(), uint64(2)).
+		Return(storage.AddressLabel{}, sql.ErrNoRows).
+		Times(1)
+
+	s.labels.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.labels.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, label *storage.AddressLabel) error {
+			s.Require().Equal("test", label.ApiKey)
+			s.Require().EqualValues(2, label.AddressId)
+			s.Require().Equal("Binance hot wallet", label.Name)
+			s.Require().Equal(types.AddressLabelCategoryExchange, label.Category)
+			s.Require().Equal("https://example.com/labels", label.Source)
+			label.Id = 1
+			return nil
+		}).
+		Times(1)
+
+	s.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelLabel, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response addressLabelResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().EqualValues(1, response.Id)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", response.Address)
+	s.Require().Equal("exchange", response.Category)
+}
+
+func (s *AddressLabelTestSuite) TestCreateAlreadyLabeled() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"address": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+		"name": "Binance hot wallet",
+		"category": "exchange"
+	}`, false)
+	c.SetPath("/v1/auth/label")
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), gomock.Any()).
+		Return(storage.Address{Id: 2}, nil).
+		Times(1)
+
+	s.labels.EXPECT().
+		ByAddressId(gomock.Any(), uint64(2)).
+		Return(storage.AddressLabel{Id: 1, AddressId: 2}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *AddressLabelTestSuite) TestCreateInvalidCategory() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"address": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+		"name": "Binance hot wallet",
+		"category": "casino"
+	}`, false)
+	c.SetPath("/v1/auth/label")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *AddressLabelTestSuite) TestList() {
+	c, rec := s.newContext(http.MethodGet, "", false)
+	c.SetPath("/v1/auth/label")
+	c.QueryParams().Set("category", "bridge")
+
+	s.labels.EXPECT().
+		List(gomock.Any(), storage.AddressLabelFilters{
+			Limit:    10,
+			Category: []types.AddressLabelCategory{types.AddressLabelCategoryBridge},
+		}).
+		Return([]storage.AddressLabel{
+			{
+				Id:        2,
+				AddressId: 3,
+				Name:      "Bridge relayer",
+				Category:  types.AddressLabelCategoryBridge,
+				Address: &storage.Address{
+					Address: "celestia1cr2t0y5zu9sya67t9sp0vt9cxum408yuphkhex",
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response []addressLabelResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response, 1)
+	s.Require().Equal("Bridge relayer", response[0].Name)
+	s.Require().Equal("bridge", response[0].Category)
+	s.Require().Equal("celestia1cr2t0y5zu9sya67t9sp0vt9cxum408yuphkhex", response[0].Address)
+}
+
+func (s *AddressLabelTestSuite) TestUpdate() {
+	c, rec := s.newContext(http.MethodPatch, `{"category": "team", "source": "manual"}`, false)
+	c.SetPath("/v1/auth/label/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.labels.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.AddressLabel{
+			Id:       1,
+			ApiKey:   "test",
+			Name:     "Binance hot wallet",
+			Category: types.AddressLabelCategoryExchange,
+		}, nil).
+		Times(1)
+
+	s.labels.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, label *storage.AddressLabel) error {
+			s.Require().Equal("Binance hot wallet", label.Name)
+			s.Require().Equal(types.AddressLabelCategoryTeam, label.Category)
+			s.Require().Equal("manual", label.Source)
+			return nil
+		}).
+		Times(1)
+
+	s.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelLabel, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Update(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *AddressLabelTestSuite) TestDeleteForeign() {
+	c, rec := s.newContext(http.MethodDelete, "", false)
+	c.SetPath("/v1/auth/label/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	s.labels.EXPECT().
+		GetByID(gomock.Any(), uint64(2)).
+		Return(&storage.AddressLabel{
+			Id:     2,
+			ApiKey: "other",
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Delete(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+}
+
+func (s *AddressLabelTestSuite) TestDeleteByAdmin() {
+	c, rec := s.newContext(http.MethodDelete, "", true)
+	c.SetPath("/v1/auth/label/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	s.labels.EXPECT().
+		GetByID(gomock.Any(), uint64(2)).
+		Return(&storage.AddressLabel{
+			Id:        2,
+			AddressId: 3,
+			ApiKey:    "other",
+			Category:  types.AddressLabelCategoryExchange,
+		}, nil).
+		Times(1)
+
+	s.labels.EXPECT().
+		Delete(gomock.Any(), uint64(2)).
+		Return(nil).
+		Times(1)
+
+	s.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelLabel, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, payload string) error {
+			var label storage.AddressLabel
+			s.Require().NoError(json.Unmarshal([]byte(payload), &label))
+			s.Require().EqualValues(2, label.Id)
+			s.Require().EqualValues(3, label.AddressId)
+			s.Require().Empty(label.ApiKey)
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Delete(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	enums "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+var (
+	errUnknownRollup         = errors.New("unknown rollup")
+	errInvalidAlertScope     = errors.New("exactly one of rollup_id or namespace should be set")
+	errInvalidAlertThreshold = errors.New("threshold should be a non-negative number")
+)
+
+type AlertHandler struct {
+	rules     storage.IAlertRule
+	alerts    storage.IAlert
+	rollups   storage.IRollup
+	namespace storage.INamespace
+}
+
+func NewAlertHandler(
+	rules storage.IAlertRule,
+	alerts storage.IAlert,
+	rollups storage.IRollup,
+	namespace storage.INamespace,
+) AlertHandler {
+	return AlertHandler{
+		rules:     rules,
+		alerts:    alerts,
+		rollups:   rollups,
+		namespace: namespace,
+	}
+}
+
+type alertRuleResponse struct {
+	Id          uint64    `json:"id"`
+	Name        string    `json:"name"`
+	Kind        string    `json:"kind"`
+	RollupId    uint64    `json:"rollup_id,omitempty"`
+	NamespaceId uint64    `json:"namespace_id,omitempty"`
+	Threshold   string    `json:"threshold"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newAlertRuleResponse(rule storage.AlertRule) alertRuleResponse {
+	return alertRuleResponse{
+		Id:          rule.Id,
+		Name:        rule.Name,
+		Kind:        rule.Kind.String(),
+		RollupId:    rule.RollupId,
+		NamespaceId: rule.NamespaceId,
+		Threshold:   rule.Threshold.String(),
+		Active:      rule.Active,
+		CreatedAt:   rule.CreatedAt,
+		UpdatedAt:   rule.UpdatedAt,
+	}
+}
+
+type createAlertRuleRequest struct {
+	Name      string `json:"name"      validate:"omitempty,max=128"`
+	Kind      string `json:"kind"      validate:"required,alert_rule_kind"`
+	RollupId  uint64 `json:"rollup_id" validate:"omitempty,min=1"`
+	Namespace string `json:"namespace" validate:"omitempty,base64,namespace"`
+	Threshold string `json:"threshold" validate:"required"`
+}
+
+// Create - registers new alert rule for rollup or namespace
+func (handler AlertHandler) Create(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.rules)
+	}
+
+	req, err := bindAndValidate[createAlertRuleRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if (req.RollupId == 0) == (req.Namespace == "") {
+		return badRequestError(c, errInvalidAlertScope)
+	}
+
+	kind, err := enums.ParseAlertRuleKind(req.Kind)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	threshold, err := parseThreshold(req.Threshold)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	now := time.Now().UTC()
+	rule := storage.AlertRule{
+		ApiKey:    apiKey.Key,
+		Name:      req.Name,
+		Kind:      kind,
+		RollupId:  req.RollupId,
+		Threshold: threshold,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if req.RollupId > 0 {
+		if _, err := handler.rollups.GetByID(ctx, req.RollupId); err != nil {
+			if handler.rollups.IsNoRows(err) {
+				return badRequestError(c, errUnknownRollup)
+			}
+			return handleError(c, err, handler.rollups)
+		}
+	}
+
+	if req.Namespace != "" {
+		if rule.NamespaceId, err = namespaceId(ctx, handler.namespace, req.Namespace); err != nil {
+			if errors.Is(err, errUnknownNamespace) {
+				return badRequestError(c, err)
+			}
+			return handleError(c, err, handler.namespace)
+		}
+	}
+
+	if err := handler.rules.Save(ctx, &rule); err != nil {
+		return handleError(c, err, handler.rules)
+	}
+
+	return c.JSON(http.StatusOK, newAlertRuleResponse(rule))
+}
+
+func parseThreshold(value string) (enums.Numeric, error) {
+	threshold, err := enums.NumericFromString(value)
+	if err != nil {
+		return threshold, errInvalidAlertThreshold
+	}
+	if threshold.LessThan(enums.NumericZero()) {
+		return threshold, errInvalidAlertThreshold
+	}
+	return threshold, nil
+}
+
+type listAlertRulesRequest struct {
+	Limit  int `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int `query:"offset" validate:"omitempty,min=0"`
+}
+
+// List - returns alert rules registered by the api key
+func (handler AlertHandler) List(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.rules)
+	}
+
+	req, err := bindAndValidate[listAlertRulesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	rules, err := handler.rules.ByApiKey(c.Request().Context(), apiKey.Key, req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.rules)
+	}
+
+	response := make([]alertRuleResponse, len(rules))
+	for i := range rules {
+		response[i] = newAlertRuleResponse(rules[i])
+	}
+	return returnArray(c, response)
+}
+
+type updateAlertRuleRequest struct {
+	Id        uint64 `param:"id"        validate:"required,min=1"`
+	Name      string `json:"name"       validate:"omitempty,max=128"`
+	Threshold string `json:"threshold"  validate:"omitempty"`
+	Active    *bool  `json:"active"     validate:"omitempty"`
+}
+
+// Update - changes name, threshold or activity flag of alert rule. Active alert of deactivated rule is resolved.
+func (handler AlertHandler) Update(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.rules)
+	}
+
+	req, err := bindAndValidate[updateAlertRuleRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	rule, err := handler.owned(ctx, req.Id, apiKey)
+	if err != nil {
+		return handleError(c, err, handler.rules)
+	}
+
+	if req.Name != "" {
+		rule.Name = req.Name
+	}
+	if req.Threshold != "" {
+		if rule.Threshold, err = parseThreshold(req.Threshold); err != nil {
+			return badRequestError(c, err)
+		}
+	}
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+	rule.UpdatedAt = time.Now().UTC()
+
+	if err := handler.rules.Update(ctx, rule); err != nil {
+		return handleError(c, err, handler.rules)
+	}
+
+	if !rule.Active {
+		if _, err := handler.alerts.Resolve(ctx, rule.Id, rule.UpdatedAt); err != nil {
+			return handleError(c, err, handler.alerts)
+		}
+	}
+
+	return c.JSON(http.StatusOK, newAlertRuleResponse(*rule))
+}
+
+type alertRuleIdRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Delete - removes alert rule and resolves its active alert
+func (handler AlertHandler) Delete(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.rules)
+	}
+
+	req, err := bindAndValidate[alertRuleIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	if _, err := handler.owned(ctx, req.Id, apiKey); err != nil {
+		return handleError(c, err, handler.rules)
+	}
+
+	if err := handler.rules.Delete(ctx, req.Id); err != nil {
+		return handleError(c, err, handler.rules)
+	}
+	if _, err := handler.alerts.Resolve(ctx, req.Id, time.Now().UTC()); err != nil {
+		return handleError(c, err, handler.alerts)
+	}
+
+	return success(c)
+}
+
+// owned - returns alert rule if it was registered by the api key. Admin has access to all rules.
+func (handler AlertHandler) owned(ctx context.Context, id uint64, apiKey storage.ApiKey) (*storage.AlertRule, error) {
+	rule, err := handler.rules.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule.ApiKey != apiKey.Key && !apiKey.IsAdmin() {
+		return nil, errAccessDenied
+	}
+	return rule, nil
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// AlertTestSuite -
+type AlertTestSuite struct {
+	suite.Suite
+	rules     *mock.MockIAlertRule
+	alerts    *mock.MockIAlert
+	rollups   *mock.MockIRollup
+	namespace *mock.MockINamespace
+	handler   AlertHandler
+	echo      *echo.Echo
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *AlertTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.rules = mock.NewMockIAlertRule(s.ctrl)
+	s.alerts = mock.NewMockIAlert(s.ctrl)
+	s.rollups = mock.NewMockIRollup(s.ctrl)
+	s.namespace = mock.NewMockINamespace(s.ctrl)
+	s.handler = NewAlertHandler(s.rules, s.alerts, s.rollups, s.namespace)
+}
+
+// TearDownSuite -
+func (s *AlertTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteAlert_Run(t *testing.T) {
+	suite.Run(t, new(AlertTestSuite))
+}
+
+func (s *AlertTestSuite) newContext(method, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequestWithContext(context.Background(), method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set(ApiKeyName, storage.ApiKey{
+		Key:         "test",
+		Description: "test",
+	})
+	return c, rec
+}
+
+func (s *AlertTestSuite) TestCreateRollupRule() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"name": "silence",
+		"kind": "no_blob",
+		"rollup_id": 3,
+		"threshold": "600"
+	}`)
+	c.SetPath("/v1/auth/alert")
+
+	s.rollups.EXPECT().
+		GetByID(gomock.Any(), uint64(3)).
+		Return(&storage.Rollup{Id: 3}, nil).
+		Times(1)
+
+	s.rules.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rule *storage.AlertRule) error {
+			s.Require().Equal("test", rule.ApiKey)
+			s.Require().Equal(types.AlertRuleKindNoBlob, rule.Kind)
+			s.Require().EqualValues(3, rule.RollupId)
+			s.Require().Equal("600", rule.Threshold.String())
+			s.Require().True(rule.Active)
+			rule.Id = 1
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response alertRuleResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().EqualValues(1, response.Id)
+	s.Require().Equal("no_blob", response.Kind)
+	s.Require().Equal("600", response.Threshold)
+}
+
+func (s *AlertTestSuite) TestCreateNamespaceRule() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"kind": "hourly_fee",
+		"namespace": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0=",
+		"threshold": "1000000"
+	}`)
+	c.SetPath("/v1/auth/alert")
+
+	s.namespace.EXPECT().
+		ByNamespaceIdAndVersion(gomock.Any(), gomock.Any(), byte(0)).
+		Return(storage.Namespace{Id: 10}, nil).
+		Times(1)
+
+	s.rules.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rule *storage.AlertRule) error {
+			s.Require().EqualValues(10, rule.NamespaceId)
+			s.Require().Zero(rule.RollupId)
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *AlertTestSuite) TestCreateInvalidScope() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"kind": "hourly_fee",
+		"rollup_id": 3,
+		"namespace": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0=",
+		"threshold": "1000000"
+	}`)
+	c.SetPath("/v1/auth/alert")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *AlertTestSuite) TestCreateInvalidThreshold() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"kind": "hourly_size",
+		"rollup_id": 3,
+		"threshold": "-1"
+	}`)
+	c.SetPath("/v1/auth/alert")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *AlertTestSuite) TestCreateInvalidKind() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"kind": "unknown",
+		"rollup_id": 3,
+		"threshold": "1"
+	}`)
+	c.SetPath("/v1/auth/alert")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *AlertTestSuite) TestList() {
+	c, rec := s.newContext(http.MethodGet, "")
+	c.SetPath("/v1/auth/alert")
+
+	s.rules.EXPECT().
+		ByApiKey(gomock.Any(), "test", 10, 0).
+		Return([]storage.AlertRule{
+			{
+				Id:        1,
+				ApiKey:    "test",
+				Kind:      types.AlertRuleKindBlobSizeP99,
+				RollupId:  3,
+				Threshold: types.NumericFromInt64(100_000),
+				Active:    true,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response []alertRuleResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response, 1)
+	s.Require().Equal("blob_size_p99", response[0].Kind)
+	s.Require().Equal("100000", response[0].Threshold)
+}
+
+func (s *AlertTestSuite) TestUpdateDeactivate() {
+	c, rec := s.newContext(http.MethodPatch, `{"active": false, "threshold": "1200"}`)
+	c.SetPath("/v1/auth/alert/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.rules.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.AlertRule{
+			Id:        1,
+			ApiKey:    "test",
+			Kind:      types.AlertRuleKindNoBlob,
+			Threshold: types.NumericFromInt64(600),
+			Active:    true,
+		}, nil).
+		Times(1)
+
+	s.rules.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rule *storage.AlertRule) error {
+			s.Require().False(rule.Active)
+			s.Require().Equal("1200", rule.Threshold.String())
+			return nil
+		}).
+		Times(1)
+
+	s.alerts.EXPECT().
+		Resolve(gomock.Any(), uint64(1), gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Update(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *AlertTestSuite) TestDeleteForeign() {
+	c, rec := s.newContext(http.MethodDelete, "")
+	c.SetPath("/v1/auth/alert/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	s.rules.EXPECT().
+		GetByID(gomock.Any(), uint64(2)).
+		Return(&storage.AlertRule{
+			Id:     2,
+			ApiKey: "other",
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Delete(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+}
+
+func (s *AlertTestSuite) TestDelete() {
+	c, rec := s.newContext(http.MethodDelete, "")
+	c.SetPath("/v1/auth/alert/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.rules.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.AlertRule{
+			Id:     1,
+			ApiKey: "test",
+		}, nil).
+		Times(1)
+
+	s.rules.EXPECT().
+		Delete(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	s.alerts.EXPECT().
+		Resolve(gomock.Any(), uint64(1), gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Delete(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	enums "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	apiKeyIdentityLength = 8
+	apiKeySecretLength   = 32
+)
+
+var (
+	errApiKeyRevoked    = errors.New("api key is revoked")
+	errExpirationInPast = errors.New("expiration time is in the past")
+)
+
+// ApiKeyHandler - lifecycle management of api keys: issue, rotation, changing of scope and limits, expiration and revocation.
+type ApiKeyHandler struct {
+	apiKeys     storage.IApiKey
+	errChecker  NoRows
+	notificator storage.Notificator
+}
+
+func NewApiKeyHandler(apiKeys storage.IApiKey, errChecker NoRows, notificator storage.Notificator) ApiKeyHandler {
+	return ApiKeyHandler{
+		apiKeys:     apiKeys,
+		errChecker:  errChecker,
+		notificator: notificator,
+	}
+}
+
+type apiKeyResponse struct {
+	Key         string     `json:"key"`
+	Secret      string     `json:"secret,omitempty"`
+	Description string     `json:"description"`
+	Scope       string     `json:"scope"`
+	Tier        string     `json:"tier"`
+	RateLimit   int64      `json:"rate_limit"`
+	DailyQuota  int64      `json:"daily_quota"`
+	Active      bool       `json:"active"`
+	CreatedAt   time.Time  `json:"created_at"`
+	RotatedAt   *time.Time `json:"rotated_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+}
+
+func newApiKeyResponse(key storage.ApiKey) apiKeyResponse {
+	return apiKeyResponse{
+		Key:         key.Key,
+		Description: key.Description,
+		Scope:       key.Scope.String(),
+		Tier:        key.Tier.String(),
+		RateLimit:   key.RateLimit,
+		DailyQuota:  key.DailyQuota,
+		Active:      key.IsActive(time.Now()),
+		CreatedAt:   key.CreatedAt,
+		RotatedAt:   optionalTime(key.RotatedAt),
+		ExpiresAt:   optionalTime(key.ExpiresAt),
+		RevokedAt:   optionalTime(key.RevokedAt),
+		LastUsedAt:  optionalTime(key.LastUsedAt),
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+type createApiKeyRequest struct {
+	Description string     `json:"description" validate:"required,min=1"`
+	Scope       string     `json:"scope"       validate:"omitempty,api_key_scope"`
+	Tier        string     `json:"tier"        validate:"omitempty,api_key_tier"`
+	RateLimit   int64      `json:"rate_limit"  validate:"omitempty,min=0"`
+	DailyQuota  int64      `json:"daily_quota" validate:"omitempty,min=0"`
+	ExpiresAt   *time.Time `json:"expires_at"  validate:"omitempty"`
+}
+
+// Create - issues new api key. Secret is returned only once and only its hash is stored.
+func (handler ApiKeyHandler) Create(c echo.Context) error {
+	req, err := bindAndValidate[createApiKeyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	now := time.Now().UTC()
+	key := storage.ApiKey{
+		Description: req.Description,
+		Scope:       enums.ApiKeyScopeStandard,
+		Tier:        enums.ApiKeyTierFree,
+		RateLimit:   req.RateLimit,
+		DailyQuota:  req.DailyQuota,
+		CreatedAt:   now,
+	}
+	if req.Scope != "" {
+		key.Scope = enums.ApiKeyScope(req.Scope)
+	}
+	if req.Tier != "" {
+		key.Tier = enums.ApiKeyTier(req.Tier)
+	}
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) {
+			return badRequestError(c, errExpirationInPast)
+		}
+		key.ExpiresAt = req.ExpiresAt.UTC()
+	}
+
+	if key.Key, err = randomHex(apiKeyIdentityLength); err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	secret, err := randomHex(apiKeySecretLength)
+	if err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	key.SecretHash = storage.HashApiKeySecret(secret)
+
+	if err := handler.apiKeys.Save(c.Request().Context(), &key); err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+
+	response := newApiKeyResponse(key)
+	response.Secret = secret
+	return c.JSON(http.StatusOK, response)
+}
+
+type listApiKeysRequest struct {
+	Limit  int `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int `query:"offset" validate:"omitempty,min=0"`
+}
+
+// List - returns issued api keys without secrets
+func (handler ApiKeyHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listApiKeysRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	keys, err := handler.apiKeys.List(c.Request().Context(), req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+
+	response := make([]apiKeyResponse, len(keys))
+	for i := range keys {
+		response[i] = newApiKeyResponse(keys[i])
+	}
+	return returnArray(c, response)
+}
+
+type updateApiKeyRequest struct {
+	Key          string     `param:"key"          validate:"required"`
+	Description  string     `json:"description"   validate:"omitempty,min=1"`
+	Scope        string     `json:"scope"         validate:"omitempty,api_key_scope"`
+	Tier         string     `json:"tier"          validate:"omitempty,api_key_tier"`
+	RateLimit    *int64     `json:"rate_limit"    validate:"omitempty,min=0"`
+	DailyQuota   *int64     `json:"daily_quota"   validate:"omitempty,min=0"`
+	ExpiresAt    *time.Time `json:"expires_at"    validate:"omitempty"`
+	NoExpiration bool       `json:"no_expiration" validate:"omitempty"`
+}
+
+// Update - changes description, scope, limits or expiration time of the key. Expiration time in the past expires the key immediately.
+func (handler ApiKeyHandler) Update(c echo.Context) error {
+	req, err := bindAndValidate[updateApiKeyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	key, err := handler.apiKeys.Get(ctx, req.Key)
+	if err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+
+	if req.Description != "" {
+		key.Description = req.Description
+	}
+	if req.Scope != "" {
+		key.Scope = enums.ApiKeyScope(req.Scope)
+	}
+	if req.Tier != "" {
+		key.Tier = enums.ApiKeyTier(req.Tier)
+	}
+	if req.RateLimit != nil {
+		key.RateLimit = *req.RateLimit
+	}
+	if req.DailyQuota != nil {
+		key.DailyQuota = *req.DailyQuota
+	}
+	switch {
+	case req.NoExpiration:
+		key.ExpiresAt = time.Time{}
+	case req.ExpiresAt != nil:
+		key.ExpiresAt = req.ExpiresAt.UTC()
+	}
+
+	if err := handler.apiKeys.Update(ctx, &key); err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	handler.notify(ctx, key.Key)
+
+	return c.JSON(http.StatusOK, newApiKeyResponse(key))
+}
+
+type apiKeyRequest struct {
+	Key string `param:"key" validate:"required"`
+}
+
+// Rotate - replaces secret of the key. Previous secret stops working as soon as public API receives the notification. New secret is returned only once.
+func (handler ApiKeyHandler) Rotate(c echo.Context) error {
+	req, err := bindAndValidate[apiKeyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	key, err := handler.apiKeys.Get(ctx, req.Key)
+	if err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	if !key.RevokedAt.IsZero() {
+		return badRequestError(c, errApiKeyRevoked)
+	}
+
+	secret, err := randomHex(apiKeySecretLength)
+	if err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	key.SecretHash = storage.HashApiKeySecret(secret)
+	key.RotatedAt = time.Now().UTC()
+
+	if err := handler.apiKeys.Update(ctx, &key); err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	handler.notify(ctx, key.Key)
+
+	response := newApiKeyResponse(key)
+	response.Secret = secret
+	return c.JSON(http.StatusOK, response)
+}
+
+// Revoke - disables the key forever. Public API rejects the key as soon as it receives the notification. Records created by the key are kept.
+func (handler ApiKeyHandler) Revoke(c echo.Context) error {
+	req, err := bindAndValidate[apiKeyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	key, err := handler.apiKeys.Get(ctx, req.Key)
+	if err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	if !key.RevokedAt.IsZero() {
+		return badRequestError(c, errApiKeyRevoked)
+	}
+
+	key.RevokedAt = time.Now().UTC()
+	if err := handler.apiKeys.Update(ctx, &key); err != nil {
+		return handleError(c, err, handler.errChecker)
+	}
+	handler.notify(ctx, key.Key)
+
+	return success(c)
+}
+
+// notify - informs public API that the key was changed, so the cached key has to be dropped.
+// Failed notification doesn't fail the request: cached keys expire in a minute anyway.
+func (handler ApiKeyHandler) notify(ctx context.Context, key string) {
+	if handler.notificator == nil {
+		return
+	}
+	if err := handler.notificator.Notify(ctx, storage.ChannelApiKey, key); err != nil {
+		log.Err(err).Str("key", key).Msg("notify about api key change")
+	}
+}
+
+func randomHex(length int) (string, error) {
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
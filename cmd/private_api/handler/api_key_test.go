@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// ApiKeyTestSuite -
+type ApiKeyTestSuite struct {
+	suite.Suite
+	apiKeys     *mock.MockIApiKey
+	errChecker  *mock.MockIDelegation
+	notificator *mock.MockNotificator
+	echo        *echo.Echo
+	handler     ApiKeyHandler
+	ctrl        *gomock.Controller
+}
+
+// SetupSuite -
+func (s *ApiKeyTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.apiKeys = mock.NewMockIApiKey(s.ctrl)
+	s.errChecker = mock.NewMockIDelegation(s.ctrl)
+	s.notificator = mock.NewMockNotificator(s.ctrl)
+	s.handler = NewApiKeyHandler(s.apiKeys, s.errChecker, s.notificator)
+}
+
+// TearDownSuite -
+func (s *ApiKeyTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteApiKey_Run(t *testing.T) {
+	suite.Run(t, new(ApiKeyTestSuite))
+}
+
+func (s *ApiKeyTestSuite) newContext(method, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequestWithContext(context.Background(), method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set(ApiKeyName, storage.ApiKey{
+		Key:   "admin",
+		Scope: types.ApiKeyScopeAdmin,
+	})
+	return c, rec
+}
+
+func (s *ApiKeyTestSuite) withKey(c echo.Context, path, key string) {
+	c.SetPath(path)
+	c.SetParamNames("key")
+	c.SetParamValues(key)
+}
+
+func (s *ApiKeyTestSuite) TestCreate() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"description": "partner",
+		"scope": "rollup_auth",
+		"tier": "pro",
+		"daily_quota": 1000
+	}`)
+	c.SetPath("/v1/auth/keys")
+
+	var saved storage.ApiKey
+	s.apiKeys.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key *storage.ApiKey) error {
+			saved = *key
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response apiKeyResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response.Key, 2*apiKeyIdentityLength)
+	s.Require().Len(response.Secret, 2*apiKeySecretLength)
+	s.Require().Equal("rollup_auth", response.Scope)
+	s.Require().Equal("pro", response.Tier)
+	s.Require().EqualValues(1000, response.DailyQuota)
+	s.Require().True(response.Active)
+	s.Require().Nil(response.ExpiresAt)
+
+	s.Require().Equal(response.Key, saved.Key)
+	s.Require().Equal(storage.HashApiKeySecret(response.Secret), saved.SecretHash)
+	s.Require().NotContains(saved.SecretHash, response.Secret)
+	s.Require().Equal(types.ApiKeyScopeRollupAuth, saved.Scope)
+}
+
+func (s *ApiKeyTestSuite) TestCreateDefaults() {
+	c, rec := s.newContext(http.MethodPost, `{"description": "reader"}`)
+	c.SetPath("/v1/auth/keys")
+
+	s.apiKeys.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response apiKeyResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Equal("standard", response.Scope)
+	s.Require().Equal("free", response.Tier)
+}
+
+func (s *ApiKeyTestSuite) TestCreateInvalidScope() {
+	c, rec := s.newContext(http.MethodPost, `{"description": "partner", "scope": "owner"}`)
+	c.SetPath("/v1/auth/keys")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ApiKeyTestSuite) TestCreateExpired() {
+	c, rec := s.newContext(http.MethodPost, `{"description": "partner", "expires_at": "2020-01-01T00:00:00Z"}`)
+	c.SetPath("/v1/auth/keys")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ApiKeyTestSuite) TestList() {
+	c, rec := s.newContext(http.MethodGet, "")
+	c.SetPath("/v1/auth/keys")
+
+	lastUsed := time.Now().UTC().Add(-time.Minute)
+	s.apiKeys.EXPECT().
+		List(gomock.Any(), 10, 0).
+		Return([]storage.ApiKey{
+			{
+				Key:        "partner",
+				SecretHash: storage.HashApiKeySecret("secret"),
+				Scope:      types.ApiKeyScopeRollupAuth,
+				LastUsedAt: lastUsed,
+			}, {
+				Key:       "old",
+				Scope:     types.ApiKeyScopeStandard,
+				RevokedAt: time.Now().UTC().Add(-time.Hour),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().NotContains(rec.Body.String(), storage.HashApiKeySecret("secret"))
+
+	var response []apiKeyResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response, 2)
+	s.Require().Equal("partner", response[0].Key)
+	s.Require().Empty(response[0].Secret)
+	s.Require().True(response[0].Active)
+	s.Require().NotNil(response[0].LastUsedAt)
+	s.Require().Equal("old", response[1].Key)
+	s.Require().False(response[1].Active)
+	s.Require().NotNil(response[1].RevokedAt)
+}
+
+func (s *ApiKeyTestSuite) TestUpdate() {
+	c, rec := s.newContext(http.MethodPatch, `{
+		"scope": "read_only",
+		"rate_limit": 50,
+		"expires_at": "2020-01-01T00:00:00Z"
+	}`)
+	s.withKey(c, "/v1/auth/keys/:key", "partner")
+
+	s.apiKeys.EXPECT().
+		Get(gomock.Any(), "partner").
+		Return(storage.ApiKey{
+			Key:         "partner",
+			Description: "partner",
+			Scope:       types.ApiKeyScopeStandard,
+			Tier:        types.ApiKeyTierBasic,
+			DailyQuota:  100,
+		}, nil).
+		Times(1)
+
+	s.apiKeys.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key *storage.ApiKey) error {
+			s.Require().Equal("partner", key.Description)
+			s.Require().Equal(types.ApiKeyScopeReadOnly, key.Scope)
+			s.Require().Equal(types.ApiKeyTierBasic, key.Tier)
+			s.Require().EqualValues(50, key.RateLimit)
+			s.Require().EqualValues(100, key.DailyQuota)
+			s.Require().Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), key.ExpiresAt)
+			return nil
+		}).
+		Times(1)
+
+	s.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelApiKey, "partner").
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Update(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response apiKeyResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().False(response.Active)
+}
+
+func (s *ApiKeyTestSuite) TestUpdateUnknown() {
+	c, rec := s.newContext(http.MethodPatch, `{"description": "partner"}`)
+	s.withKey(c, "/v1/auth/keys/:key", "unknown")
+
+	s.apiKeys.EXPECT().
+		Get(gomock.Any(), "unknown").
+		Return(storage.ApiKey{}, sql.ErrNoRows).
+		Times(1)
+	s.errChecker.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Update(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ApiKeyTestSuite) TestRotate() {
+	c, rec := s.newContext(http.MethodPost, "")
+	s.withKey(c, "/v1/auth/keys/:key/rotate", "partner")
+
+	oldHash := storage.HashApiKeySecret("old_secret")
+	s.apiKeys.EXPECT().
+		Get(gomock.Any(), "partner").
+		Return(storage.ApiKey{
+			Key:        "partner",
+			SecretHash: oldHash,
+		}, nil).
+		Times(1)
+
+	var updated storage.ApiKey
+	s.apiKeys.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key *storage.ApiKey) error {
+			updated = *key
+			return nil
+		}).
+		Times(1)
+
+	s.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelApiKey, "partner").
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Rotate(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response apiKeyResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Equal("partner", response.Key)
+	s.Require().Len(response.Secret, 2*apiKeySecretLength)
+	s.Require().NotNil(response.RotatedAt)
+
+	s.Require().NotEqual(oldHash, updated.SecretHash)
+	s.Require().Equal(storage.HashApiKeySecret(response.Secret), updated.SecretHash)
+}
+
+func (s *ApiKeyTestSuite) TestRotateRevoked() {
+	c, rec := s.newContext(http.MethodPost, "")
+	s.withKey(c, "/v1/auth/keys/:key/rotate", "old")
+
+	s.apiKeys.EXPECT().
+		Get(gomock.Any(), "old").
+		Return(storage.ApiKey{
+			Key:       "old",
+			RevokedAt: time.Now().UTC(),
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Rotate(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ApiKeyTestSuite) TestRevoke() {
+	c, rec := s.newContext(http.MethodDelete, "")
+	s.withKey(c, "/v1/auth/keys/:key", "partner")
+
+	s.apiKeys.EXPECT().
+		Get(gomock.Any(), "partner").
+		Return(storage.ApiKey{
+			Key: "partner",
+		}, nil).
+		Times(1)
+
+	s.apiKeys.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key *storage.ApiKey) error {
+			s.Require().False(key.RevokedAt.IsZero())
+			s.Require().False(key.IsActive(time.Now()))
+			return nil
+		}).
+		Times(1)
+
+	s.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelApiKey, "partner").
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Revoke(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
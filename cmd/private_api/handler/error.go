@@ -19,6 +19,7 @@ var (
 	errUnknownAddress      = errors.New("unknown address")
 	errUnknownNamespace    = errors.New("unknown namespace")
 	errInvalidApiKey       = errors.New("invalid api key")
+	errAccessDenied        = errors.New("access denied")
 	errInternalServerError = "Internal Server Error"
 )
 
@@ -54,6 +55,11 @@ func handleError(c echo.Context, err error, noRows NoRows) error {
 	if errors.Is(err, errInvalidAddress) || errors.Is(err, errUnknownAddress) {
 		return badRequestError(c, err)
 	}
+	if errors.Is(err, errAccessDenied) {
+		return c.JSON(http.StatusForbidden, Error{
+			Message: err.Error(),
+		})
+	}
 	return internalServerError(c, err)
 }
 
@@ -7,10 +7,11 @@ import (
 	"context"
 	"encoding/base64"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
-	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
 	enums "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
@@ -23,6 +24,8 @@ type RollupAuthHandler struct {
 	address    storage.IAddress
 	namespace  storage.INamespace
 	rollups    storage.IRollup
+	candidates storage.IRollupCandidate
+	audit      storage.IRollupAudit
 	tx         sdk.Transactable
 	txBeginner func(ctx context.Context, tx sdk.Transactable) (storage.Transaction, error)
 }
@@ -31,6 +34,8 @@ func NewRollupAuthHandler(
 	rollups storage.IRollup,
 	address storage.IAddress,
 	namespace storage.INamespace,
+	candidates storage.IRollupCandidate,
+	audit storage.IRollupAudit,
 	tx sdk.Transactable,
 	txBeginner func(ctx context.Context, tx sdk.Transactable) (storage.Transaction, error),
 ) RollupAuthHandler {
@@ -38,6 +43,8 @@ func NewRollupAuthHandler(
 		rollups:    rollups,
 		address:    address,
 		namespace:  namespace,
+		candidates: candidates,
+		audit:      audit,
 		tx:         tx,
 		txBeginner: txBeginner,
 	}
@@ -92,13 +99,13 @@ func (handler RollupAuthHandler) Bulk(c echo.Context) error {
 		var rollupId uint64
 		txErr := handler.runTx(ctx, func(ctx context.Context, tx storage.Transaction) error {
 			if item.Id == 0 {
-				id, err := handler.createRollup(ctx, tx, item.toCreate(), apiKey.Admin)
+				id, err := handler.createRollup(ctx, tx, item.toCreate(), apiKey)
 				if err != nil {
 					return err
 				}
 				rollupId = id
 			} else {
-				if err := handler.updateRollup(ctx, tx, item, apiKey.Admin); err != nil {
+				if err := handler.updateRollup(ctx, tx, item, apiKey); err != nil {
 					return err
 				}
 				rollupId = item.Id
@@ -158,7 +165,7 @@ func (handler RollupAuthHandler) Create(c echo.Context) error {
 
 	var rollupId uint64
 	err = handler.runTx(c.Request().Context(), func(ctx context.Context, tx storage.Transaction) error {
-		rollupId, err = handler.createRollup(ctx, tx, req, apiKey.Admin)
+		rollupId, err = handler.createRollup(ctx, tx, req, apiKey)
 		return err
 	})
 	if err != nil {
@@ -170,7 +177,7 @@ func (handler RollupAuthHandler) Create(c echo.Context) error {
 	})
 }
 
-func (handler RollupAuthHandler) createRollup(ctx context.Context, tx storage.Transaction, req *createRollupRequest, isAdmin bool) (uint64, error) {
+func (handler RollupAuthHandler) createRollup(ctx context.Context, tx storage.Transaction, req *createRollupRequest, apiKey storage.ApiKey) (uint64, error) {
 	rollup := storage.Rollup{
 		Name:           req.Name,
 		Description:    req.Description,
@@ -193,7 +200,7 @@ func (handler RollupAuthHandler) createRollup(ctx context.Context, tx storage.Tr
 		Category:       enums.RollupCategory(req.Category),
 		Slug:           slug.Make(req.Name),
 		Tags:           req.Tags,
-		Verified:       isAdmin,
+		Verified:       apiKey.IsAdmin(),
 	}
 
 	if rollup.Type == "" {
@@ -216,6 +223,12 @@ func (handler RollupAuthHandler) createRollup(ctx context.Context, tx storage.Tr
 		return 0, err
 	}
 
+	changes := rollupChanges(storage.Rollup{}, rollup)
+	changes["providers"] = storage.RollupFieldChange{New: req.Providers}
+	if err := saveAudit(ctx, tx, apiKey, rollup.Id, enums.RollupAuditActionCreate, changes); err != nil {
+		return 0, err
+	}
+
 	return rollup.Id, nil
 }
 
@@ -320,7 +333,7 @@ func (handler RollupAuthHandler) Update(c echo.Context) error {
 	}
 
 	if err := handler.runTx(c.Request().Context(), func(ctx context.Context, tx storage.Transaction) error {
-		return handler.updateRollup(c.Request().Context(), tx, req, apiKey.Admin)
+		return handler.updateRollup(ctx, tx, req, apiKey)
 	}); err != nil {
 		return handleError(c, err, handler.rollups)
 	}
@@ -328,8 +341,9 @@ func (handler RollupAuthHandler) Update(c echo.Context) error {
 	return success(c)
 }
 
-func (handler RollupAuthHandler) updateRollup(ctx context.Context, tx storage.Transaction, req *updateRollupRequest, isAdmin bool) error {
-	if _, err := handler.rollups.GetByID(ctx, req.Id); err != nil {
+func (handler RollupAuthHandler) updateRollup(ctx context.Context, tx storage.Transaction, req *updateRollupRequest, apiKey storage.ApiKey) error {
+	current, err := handler.rollups.GetByID(ctx, req.Id)
+	if err != nil {
 		return err
 	}
 
@@ -356,13 +370,15 @@ func (handler RollupAuthHandler) updateRollup(ctx context.Context, tx storage.Tr
 		Category:       enums.RollupCategory(req.Category),
 		Links:          req.Links,
 		Tags:           req.Tags,
-		Verified:       isAdmin,
+		Verified:       apiKey.IsAdmin(),
 	}
 
 	if err := tx.UpdateRollup(ctx, &rollup); err != nil {
 		return err
 	}
 
+	changes := rollupChanges(*current, rollup)
+
 	if len(req.Providers) > 0 {
 		if err := tx.DeleteProviders(ctx, req.Id); err != nil {
 			return err
@@ -376,9 +392,10 @@ func (handler RollupAuthHandler) updateRollup(ctx context.Context, tx storage.Tr
 		if err := tx.SaveProviders(ctx, providers...); err != nil {
 			return err
 		}
+		changes["providers"] = storage.RollupFieldChange{New: req.Providers}
 	}
 
-	return nil
+	return saveAudit(ctx, tx, apiKey, req.Id, enums.RollupAuditActionUpdate, changes)
 }
 
 type deleteRollupRequest struct {
@@ -386,44 +403,102 @@ type deleteRollupRequest struct {
 }
 
 func (handler RollupAuthHandler) Delete(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.address)
+	}
+
 	req, err := bindAndValidate[deleteRollupRequest](c)
 	if err != nil {
 		return badRequestError(c, err)
 	}
 
-	if err := handler.deleteRollup(c.Request().Context(), req.Id); err != nil {
+	if err := handler.runTx(c.Request().Context(), func(ctx context.Context, tx storage.Transaction) error {
+		return handler.deleteRollup(ctx, tx, req.Id, apiKey)
+	}); err != nil {
 		return handleError(c, err, handler.rollups)
 	}
 
 	return success(c)
 }
 
-func (handler RollupAuthHandler) deleteRollup(ctx context.Context, id uint64) error {
-	tx, err := postgres.BeginTransaction(ctx, handler.tx)
+func (handler RollupAuthHandler) deleteRollup(ctx context.Context, tx storage.Transaction, id uint64, apiKey storage.ApiKey) error {
+	rollup, err := handler.rollups.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
 	if err := tx.DeleteProviders(ctx, id); err != nil {
-		return tx.HandleError(ctx, err)
+		return err
 	}
 
 	if err := tx.DeleteRollup(ctx, id); err != nil {
-		return tx.HandleError(ctx, err)
+		return err
 	}
 
-	return tx.Flush(ctx)
+	return saveAudit(ctx, tx, apiKey, id, enums.RollupAuditActionDelete, rollupChanges(*rollup, storage.Rollup{}))
+}
+
+type rollupCandidateResponse struct {
+	Stack      string   `json:"stack,omitempty"`
+	Score      float64  `json:"score"`
+	BlobsCount int64    `json:"blobs_count"`
+	AvgSize    float64  `json:"avg_size"`
+	SizeStddev float64  `json:"size_stddev"`
+	Cadence    float64  `json:"cadence"`
+	Namespaces []uint64 `json:"namespace_ids"`
+	Signers    []uint64 `json:"signer_ids"`
+	Evidence   []string `json:"evidence"`
+}
+
+func newRollupCandidateResponse(candidate storage.RollupCandidate) *rollupCandidateResponse {
+	return &rollupCandidateResponse{
+		Stack:      candidate.Stack,
+		Score:      candidate.Score,
+		BlobsCount: candidate.BlobsCount,
+		AvgSize:    candidate.AvgSize,
+		SizeStddev: candidate.SizeStddev,
+		Cadence:    candidate.Cadence,
+		Namespaces: candidate.NamespaceIds,
+		Signers:    candidate.SignerIds,
+		Evidence:   candidate.Evidence,
+	}
+}
+
+type unverifiedRollup struct {
+	responses.Rollup
+
+	Discovery *rollupCandidateResponse `json:"discovery,omitempty"`
 }
 
+// Unverified - returns rollups waiting for verification. Rollups proposed by discovery contain its evidence.
 func (handler RollupAuthHandler) Unverified(c echo.Context) error {
-	rollups, err := handler.rollups.Unverified(c.Request().Context())
+	ctx := c.Request().Context()
+	rollups, err := handler.rollups.Unverified(ctx)
 	if err != nil {
 		return handleError(c, err, handler.rollups)
 	}
 
-	response := make([]responses.Rollup, len(rollups))
+	ids := make([]uint64, len(rollups))
 	for i := range rollups {
-		response[i] = responses.NewRollup(&rollups[i])
+		ids[i] = rollups[i].Id
+	}
+	candidates, err := handler.candidates.ByRollupIds(ctx, ids...)
+	if err != nil {
+		return handleError(c, err, handler.candidates)
+	}
+	evidence := make(map[uint64]storage.RollupCandidate, len(candidates))
+	for i := range candidates {
+		evidence[candidates[i].RollupId] = candidates[i]
+	}
+
+	response := make([]unverifiedRollup, len(rollups))
+	for i := range rollups {
+		response[i].Rollup = responses.NewRollup(&rollups[i])
+		if candidate, ok := evidence[rollups[i].Id]; ok {
+			response[i].Discovery = newRollupCandidateResponse(candidate)
+		}
 	}
 
 	return returnArray(c, response)
@@ -434,20 +509,28 @@ type verifyRollupRequest struct {
 }
 
 func (handler RollupAuthHandler) Verify(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.address)
+	}
+
 	req, err := bindAndValidate[verifyRollupRequest](c)
 	if err != nil {
 		return badRequestError(c, err)
 	}
 
-	if err := handler.verify(c.Request().Context(), req.Id); err != nil {
-		return handleError(c, err, handler.address)
+	if err := handler.runTx(c.Request().Context(), func(ctx context.Context, tx storage.Transaction) error {
+		return handler.verify(ctx, tx, req.Id, apiKey)
+	}); err != nil {
+		return handleError(c, err, handler.rollups)
 	}
 
 	return success(c)
 }
 
-func (handler RollupAuthHandler) verify(ctx context.Context, id uint64) error {
-	tx, err := postgres.BeginTransaction(ctx, handler.tx)
+func (handler RollupAuthHandler) verify(ctx context.Context, tx storage.Transaction, id uint64, apiKey storage.ApiKey) error {
+	rollup, err := handler.rollups.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -457,8 +540,132 @@ func (handler RollupAuthHandler) verify(ctx context.Context, id uint64) error {
 		Verified: true,
 	})
 	if err != nil {
-		return tx.HandleError(ctx, err)
+		return err
 	}
 
-	return tx.Flush(ctx)
+	return saveAudit(ctx, tx, apiKey, id, enums.RollupAuditActionVerify, map[string]storage.RollupFieldChange{
+		"verified": {Old: rollup.Verified, New: true},
+	})
+}
+
+type rollupAuditRequest struct {
+	RollupId uint64 `query:"rollup_id" validate:"omitempty,min=1"`
+	ApiKey   string `query:"api_key"   validate:"omitempty"`
+	Limit    int    `query:"limit"     validate:"omitempty,min=1,max=100"`
+	Offset   int    `query:"offset"    validate:"omitempty,min=0"`
+}
+
+type rollupAuditResponse struct {
+	Id       uint64                               `json:"id"`
+	Time     time.Time                            `json:"time"`
+	RollupId uint64                               `json:"rollup_id"`
+	ApiKey   string                               `json:"api_key"`
+	Action   string                               `json:"action"`
+	Changes  map[string]storage.RollupFieldChange `json:"changes,omitempty"`
+}
+
+func newRollupAuditResponse(change storage.RollupAudit) rollupAuditResponse {
+	return rollupAuditResponse{
+		Id:       change.Id,
+		Time:     change.Time,
+		RollupId: change.RollupId,
+		ApiKey:   change.ApiKey,
+		Action:   change.Action.String(),
+		Changes:  change.Changes,
+	}
+}
+
+// Audit - returns log of rollup changes made through private API. It can be filtered by rollup and by api key which made the change.
+func (handler RollupAuthHandler) Audit(c echo.Context) error {
+	req, err := bindAndValidate[rollupAuditRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	changes, err := handler.audit.List(c.Request().Context(), storage.RollupAuditFilters{
+		RollupId: req.RollupId,
+		ApiKey:   req.ApiKey,
+		Limit:    req.Limit,
+		Offset:   req.Offset,
+	})
+	if err != nil {
+		return handleError(c, err, handler.audit)
+	}
+
+	response := make([]rollupAuditResponse, len(changes))
+	for i := range changes {
+		response[i] = newRollupAuditResponse(changes[i])
+	}
+	return returnArray(c, response)
+}
+
+func saveAudit(ctx context.Context, tx storage.Transaction, apiKey storage.ApiKey, rollupId uint64, action enums.RollupAuditAction, changes map[string]storage.RollupFieldChange) error {
+	return tx.Add(ctx, &storage.RollupAudit{
+		Time:     time.Now().UTC(),
+		RollupId: rollupId,
+		ApiKey:   apiKey.Key,
+		Action:   action,
+		Changes:  changes,
+	})
+}
+
+// rollupChanges - returns fields which differ in the rollups. Empty fields of the new rollup are left as is on update, so only non-empty ones are compared.
+// Deleted rollup is passed as the empty new one, so all its fields are returned with old values.
+func rollupChanges(before, after storage.Rollup) map[string]storage.RollupFieldChange {
+	changes := make(map[string]storage.RollupFieldChange)
+	deleted := after.Id == 0 && before.Id != 0
+
+	diff := func(field, oldValue, newValue string) {
+		switch {
+		case deleted && oldValue != "":
+			changes[field] = storage.RollupFieldChange{Old: oldValue}
+		case newValue != "" && newValue != oldValue:
+			change := storage.RollupFieldChange{New: newValue}
+			if oldValue != "" {
+				change.Old = oldValue
+			}
+			changes[field] = change
+		}
+	}
+	diffList := func(field string, oldValue, newValue []string) {
+		switch {
+		case deleted && len(oldValue) > 0:
+			changes[field] = storage.RollupFieldChange{Old: oldValue}
+		case len(newValue) > 0 && !slices.Equal(newValue, oldValue):
+			change := storage.RollupFieldChange{New: newValue}
+			if len(oldValue) > 0 {
+				change.Old = oldValue
+			}
+			changes[field] = change
+		}
+	}
+
+	diff("name", before.Name, after.Name)
+	diff("description", before.Description, after.Description)
+	diff("website", before.Website, after.Website)
+	diff("github", before.GitHub, after.GitHub)
+	diff("twitter", before.Twitter, after.Twitter)
+	diff("logo", before.Logo, after.Logo)
+	diff("l2_beat", before.L2Beat, after.L2Beat)
+	diff("defi_lama", before.DeFiLama, after.DeFiLama)
+	diff("explorer", before.Explorer, after.Explorer)
+	diff("bridge_contract", before.BridgeContract, after.BridgeContract)
+	diff("stack", before.Stack, after.Stack)
+	diff("compression", before.Compression, after.Compression)
+	diff("provider", before.Provider, after.Provider)
+	diff("settled_on", before.SettledOn, after.SettledOn)
+	diff("color", before.Color, after.Color)
+	diff("vm", before.VM, after.VM)
+	diff("type", before.Type.String(), after.Type.String())
+	diff("category", before.Category.String(), after.Category.String())
+	diffList("links", before.Links, after.Links)
+	diffList("tags", before.Tags, after.Tags)
+
+	if after.Verified && !before.Verified {
+		changes["verified"] = storage.RollupFieldChange{Old: false, New: true}
+	}
+	return changes
 }
@@ -9,13 +9,17 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -23,11 +27,13 @@ import (
 // AuthTestSuite -
 type AuthTestSuite struct {
 	suite.Suite
-	address   *mock.MockIAddress
-	namespace *mock.MockINamespace
-	rollups   *mock.MockIRollup
-	echo      *echo.Echo
-	ctrl      *gomock.Controller
+	address    *mock.MockIAddress
+	namespace  *mock.MockINamespace
+	rollups    *mock.MockIRollup
+	candidates *mock.MockIRollupCandidate
+	audit      *mock.MockIRollupAudit
+	echo       *echo.Echo
+	ctrl       *gomock.Controller
 }
 
 // SetupSuite -
@@ -38,6 +44,8 @@ func (s *AuthTestSuite) SetupSuite() {
 	s.address = mock.NewMockIAddress(s.ctrl)
 	s.namespace = mock.NewMockINamespace(s.ctrl)
 	s.rollups = mock.NewMockIRollup(s.ctrl)
+	s.candidates = mock.NewMockIRollupCandidate(s.ctrl)
+	s.audit = mock.NewMockIRollupAudit(s.ctrl)
 }
 
 // TearDownSuite -
@@ -76,7 +84,7 @@ func (s *AuthTestSuite) TestBulk() {
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set(ApiKeyName, storage.ApiKey{
-		Admin:       true,
+		Scope:       types.ApiKeyScopeAdmin,
 		Description: "test",
 		Key:         "test",
 	})
@@ -101,6 +109,22 @@ func (s *AuthTestSuite) TestBulk() {
 		Return(nil).
 		Times(1)
 
+	txUpdate.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			audit, ok := model.(*storage.RollupAudit)
+			s.Require().True(ok)
+			s.Require().EqualValues(1, audit.RollupId)
+			s.Require().Equal("test", audit.ApiKey)
+			s.Require().Equal(types.RollupAuditActionUpdate, audit.Action)
+			s.Require().Equal(storage.RollupFieldChange{New: "evm"}, audit.Changes["vm"])
+			s.Require().Equal(storage.RollupFieldChange{Old: false, New: true}, audit.Changes["verified"])
+			s.Require().Contains(audit.Changes, "providers")
+			s.Require().NotContains(audit.Changes, "name")
+			return nil
+		}).
+		Times(1)
+
 	txUpdate.EXPECT().
 		Flush(gomock.Any()).
 		Return(nil).
@@ -120,6 +144,19 @@ func (s *AuthTestSuite) TestBulk() {
 		Return(nil).
 		Times(1)
 
+	txCreate.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			audit, ok := model.(*storage.RollupAudit)
+			s.Require().True(ok)
+			s.Require().EqualValues(2, audit.RollupId)
+			s.Require().Equal(types.RollupAuditActionCreate, audit.Action)
+			s.Require().Equal(storage.RollupFieldChange{New: "Test"}, audit.Changes["name"])
+			s.Require().Equal(storage.RollupFieldChange{New: "svm"}, audit.Changes["vm"])
+			return nil
+		}).
+		Times(1)
+
 	txCreate.EXPECT().
 		Flush(gomock.Any()).
 		Return(nil).
@@ -149,7 +186,7 @@ func (s *AuthTestSuite) TestBulk() {
 		}
 		return txCreate, nil
 	}
-	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, nil, txBeginner)
+	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, s.candidates, s.audit, nil, txBeginner)
 
 	s.Require().NoError(handler.Bulk(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
@@ -195,7 +232,7 @@ func (s *AuthTestSuite) TestBulkPartialError() {
 	rec := httptest.NewRecorder()
 	c := s.echo.NewContext(req, rec)
 	c.Set(ApiKeyName, storage.ApiKey{
-		Admin:       true,
+		Scope:       types.ApiKeyScopeAdmin,
 		Description: "test",
 		Key:         "test",
 	})
@@ -218,6 +255,11 @@ func (s *AuthTestSuite) TestBulkPartialError() {
 		Return(nil).
 		Times(1)
 
+	txSuccess.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
 	txSuccess.EXPECT().
 		Flush(gomock.Any()).
 		Return(nil).
@@ -250,7 +292,7 @@ func (s *AuthTestSuite) TestBulkPartialError() {
 		}
 		return txFail, nil
 	}
-	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, nil, txBeginner)
+	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, s.candidates, s.audit, nil, txBeginner)
 
 	s.Require().NoError(handler.Bulk(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
@@ -264,3 +306,256 @@ func (s *AuthTestSuite) TestBulkPartialError() {
 	s.Require().Zero(results[1].Id)
 	s.Require().Contains(results[1].Error, "duplicate slug")
 }
+
+func (s *AuthTestSuite) TestUnverified() {
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/auth/rollup/unverified")
+
+	s.rollups.EXPECT().
+		Unverified(gomock.Any()).
+		Return([]storage.Rollup{
+			{
+				Id:   4,
+				Name: "Rollup 4",
+				Slug: "rollup_4",
+			}, {
+				Id:    5,
+				Name:  "Discovered rollup 5",
+				Slug:  "discovered-rollup-5",
+				Stack: "OP Stack",
+				Tags:  []string{"discovered"},
+			},
+		}, nil).
+		Times(1)
+
+	s.candidates.EXPECT().
+		ByRollupIds(gomock.Any(), uint64(4), uint64(5)).
+		Return([]storage.RollupCandidate{
+			{
+				Id:           1,
+				RollupId:     5,
+				NamespaceIds: []uint64{1, 2},
+				SignerIds:    []uint64{3},
+				Stack:        "OP Stack",
+				BlobsCount:   120,
+				AvgSize:      1000,
+				Cadence:      12,
+				Score:        0.9,
+				Evidence:     []string{"5 of 5 sampled blobs have OP Stack header"},
+			},
+		}, nil).
+		Times(1)
+
+	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, s.candidates, s.audit, nil, nil)
+	s.Require().NoError(handler.Unverified(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response []unverifiedRollup
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response, 2)
+
+	s.Require().EqualValues(4, response[0].Id)
+	s.Require().Nil(response[0].Discovery)
+
+	s.Require().EqualValues(5, response[1].Id)
+	s.Require().NotNil(response[1].Discovery)
+	s.Require().Equal("OP Stack", response[1].Discovery.Stack)
+	s.Require().EqualValues(0.9, response[1].Discovery.Score)
+	s.Require().Equal([]uint64{1, 2}, response[1].Discovery.Namespaces)
+	s.Require().Len(response[1].Discovery.Evidence, 1)
+}
+
+func (s *AuthTestSuite) TestDelete() {
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set(ApiKeyName, storage.ApiKey{
+		Key:   "admin",
+		Scope: types.ApiKeyScopeAdmin,
+	})
+	c.SetPath("/v1/auth/rollup/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	s.rollups.EXPECT().
+		GetByID(gomock.Any(), uint64(3)).
+		Return(&storage.Rollup{
+			Id:      3,
+			Name:    "Rollup 3",
+			Website: "https://rollup.io",
+		}, nil).
+		Times(1)
+
+	tx := mock.NewMockTransaction(s.ctrl)
+	tx.EXPECT().
+		DeleteProviders(gomock.Any(), uint64(3)).
+		Return(nil).
+		Times(1)
+	tx.EXPECT().
+		DeleteRollup(gomock.Any(), uint64(3)).
+		Return(nil).
+		Times(1)
+	tx.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			audit, ok := model.(*storage.RollupAudit)
+			s.Require().True(ok)
+			s.Require().EqualValues(3, audit.RollupId)
+			s.Require().Equal("admin", audit.ApiKey)
+			s.Require().Equal(types.RollupAuditActionDelete, audit.Action)
+			s.Require().Equal(map[string]storage.RollupFieldChange{
+				"name":    {Old: "Rollup 3"},
+				"website": {Old: "https://rollup.io"},
+			}, audit.Changes)
+			return nil
+		}).
+		Times(1)
+	tx.EXPECT().
+		Flush(gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	txBeginner := func(_ context.Context, _ sdk.Transactable) (storage.Transaction, error) {
+		return tx, nil
+	}
+	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, s.candidates, s.audit, nil, txBeginner)
+	s.Require().NoError(handler.Delete(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *AuthTestSuite) TestVerify() {
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodPatch, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set(ApiKeyName, storage.ApiKey{
+		Key:   "admin",
+		Scope: types.ApiKeyScopeAdmin,
+	})
+	c.SetPath("/v1/auth/rollup/:id/verify")
+	c.SetParamNames("id")
+	c.SetParamValues("4")
+
+	s.rollups.EXPECT().
+		GetByID(gomock.Any(), uint64(4)).
+		Return(&storage.Rollup{
+			Id:   4,
+			Name: "Rollup 4",
+		}, nil).
+		Times(1)
+
+	tx := mock.NewMockTransaction(s.ctrl)
+	tx.EXPECT().
+		UpdateRollup(gomock.Any(), &storage.Rollup{Id: 4, Verified: true}).
+		Return(nil).
+		Times(1)
+	tx.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			audit, ok := model.(*storage.RollupAudit)
+			s.Require().True(ok)
+			s.Require().EqualValues(4, audit.RollupId)
+			s.Require().Equal(types.RollupAuditActionVerify, audit.Action)
+			s.Require().Equal(storage.RollupFieldChange{Old: false, New: true}, audit.Changes["verified"])
+			return nil
+		}).
+		Times(1)
+	tx.EXPECT().
+		Flush(gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	txBeginner := func(_ context.Context, _ sdk.Transactable) (storage.Transaction, error) {
+		return tx, nil
+	}
+	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, s.candidates, s.audit, nil, txBeginner)
+	s.Require().NoError(handler.Verify(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *AuthTestSuite) TestAudit() {
+	q := make(url.Values)
+	q.Set("rollup_id", "1")
+	q.Set("api_key", "partner")
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/auth/rollup/audit")
+
+	s.audit.EXPECT().
+		List(gomock.Any(), storage.RollupAuditFilters{
+			RollupId: 1,
+			ApiKey:   "partner",
+			Limit:    10,
+		}).
+		Return([]storage.RollupAudit{
+			{
+				Id:       2,
+				Time:     time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+				RollupId: 1,
+				ApiKey:   "partner",
+				Action:   types.RollupAuditActionUpdate,
+				Changes: map[string]storage.RollupFieldChange{
+					"website": {Old: "https://website.com", New: "https://rollup.io"},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	handler := NewRollupAuthHandler(s.rollups, s.address, s.namespace, s.candidates, s.audit, nil, nil)
+	s.Require().NoError(handler.Audit(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response []rollupAuditResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response, 1)
+	s.Require().EqualValues(2, response[0].Id)
+	s.Require().Equal("partner", response[0].ApiKey)
+	s.Require().Equal("update", response[0].Action)
+	s.Require().Equal("https://website.com", response[0].Changes["website"].Old)
+	s.Require().Equal("https://rollup.io", response[0].Changes["website"].New)
+}
+
+func TestRollupChanges(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		changes := rollupChanges(storage.Rollup{}, storage.Rollup{
+			Id:   1,
+			Name: "Rollup",
+			Tags: []string{"evm"},
+		})
+		require.Equal(t, map[string]storage.RollupFieldChange{
+			"name": {New: "Rollup"},
+			"tags": {New: []string{"evm"}},
+		}, changes)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		changes := rollupChanges(storage.Rollup{
+			Id:      1,
+			Name:    "Rollup",
+			Website: "https://website.com",
+			Links:   []string{"https://docs.rollup.io"},
+		}, storage.Rollup{
+			Id:      1,
+			Name:    "Rollup",
+			Website: "https://rollup.io",
+			Links:   []string{"https://docs.rollup.io"},
+		})
+		require.Equal(t, map[string]storage.RollupFieldChange{
+			"website": {Old: "https://website.com", New: "https://rollup.io"},
+		}, changes)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		changes := rollupChanges(storage.Rollup{
+			Id:       1,
+			Name:     "Rollup",
+			Verified: true,
+		}, storage.Rollup{})
+		require.Equal(t, map[string]storage.RollupFieldChange{
+			"name": {Old: "Rollup"},
+		}, changes)
+	})
+}
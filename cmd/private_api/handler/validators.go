@@ -6,9 +6,11 @@ package handler
 import (
 	"encoding/base64"
 	"net/http"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/internal/webhook"
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/go-playground/validator/v10"
@@ -52,6 +54,24 @@ func NewCelestiaApiValidator() *CelestiaApiValidator {
 	if err := v.RegisterValidation("voter_type", voterTypeValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("webhook_event", webhookEventValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("webhook_url", webhookUrlValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("alert_rule_kind", alertRuleKindValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("address_label_category", addressLabelCategoryValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("api_key_scope", apiKeyScopeValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("api_key_tier", apiKeyTierValidator()); err != nil {
+		panic(err)
+	}
 	return &CelestiaApiValidator{validator: v}
 }
 
@@ -173,6 +193,47 @@ func voterTypeValidator() validator.Func {
 	}
 }
 
+func webhookEventValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseWebhookEvent(fl.Field().String())
+		return err == nil
+	}
+}
+
+func webhookUrlValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return webhook.ValidateUrl(fl.Field().String()) == nil
+	}
+}
+
+func alertRuleKindValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseAlertRuleKind(fl.Field().String())
+		return err == nil
+	}
+}
+
+func addressLabelCategoryValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseAddressLabelCategory(fl.Field().String())
+		return err == nil
+	}
+}
+
+func apiKeyScopeValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseApiKeyScope(fl.Field().String())
+		return err == nil
+	}
+}
+
+func apiKeyTierValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseApiKeyTier(fl.Field().String())
+		return err == nil
+	}
+}
+
 type KeyValidator struct {
 	apiKeys    storage.IApiKey
 	errChecker NoRows
@@ -182,16 +243,35 @@ func NewKeyValidator(apiKeys storage.IApiKey, errChecker NoRows) KeyValidator {
 	return KeyValidator{apiKeys: apiKeys, errChecker: errChecker}
 }
 
-const ApiKeyName = "api_key"
+const (
+	ApiKeyName = "api_key"
+
+	// lastUsedInterval - minimal interval between updates of the last usage time of the key
+	lastUsedInterval = time.Minute
+)
 
+// Validate - finds the key by the secret passed in request. Revoked and expired keys are rejected.
 func (kv KeyValidator) Validate(key string, c echo.Context) (bool, error) {
-	apiKey, err := kv.apiKeys.Get(c.Request().Context(), key)
+	ctx := c.Request().Context()
+	apiKey, err := kv.apiKeys.BySecret(ctx, key)
 	if err != nil {
 		if kv.errChecker.IsNoRows(err) {
 			return false, nil
 		}
 		return false, err
 	}
+
+	now := time.Now().UTC()
+	if !apiKey.IsActive(now) {
+		return false, nil
+	}
+	if now.Sub(apiKey.LastUsedAt) > lastUsedInterval {
+		if err := kv.apiKeys.SetLastUsed(ctx, apiKey.Key, now); err != nil {
+			c.Logger().Errorf("set last usage time of apikey %s: %s", apiKey.Key, err)
+		}
+		apiKey.LastUsedAt = now
+	}
+
 	c.Logger().Infof("using apikey: %s", apiKey.Description)
 	c.Set(ApiKeyName, apiKey)
 	return true, nil
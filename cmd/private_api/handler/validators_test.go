@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -103,16 +104,105 @@ func TestKeyValidator_Validate(t *testing.T) {
 		kv := NewKeyValidator(apiKeys, errChecker)
 
 		apiKeys.EXPECT().
-			Get(gomock.Any(), "valid").
+			BySecret(gomock.Any(), "valid").
 			Return(storage.ApiKey{
 				Key:         "valid",
 				Description: "descr",
 			}, nil).
 			Times(1)
 
+		apiKeys.EXPECT().
+			SetLastUsed(gomock.Any(), "valid", gomock.Any()).
+			Return(nil).
+			Times(1)
+
 		ok, err := kv.Validate("valid", ctx)
 		require.NoError(t, err)
 		require.True(t, ok)
+
+		apiKey, ok := ctx.Get(ApiKeyName).(storage.ApiKey)
+		require.True(t, ok)
+		require.Equal(t, "valid", apiKey.Key)
+		require.False(t, apiKey.LastUsedAt.IsZero())
+	})
+
+	t.Run("recently used key", func(t *testing.T) {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		errChecker := mock.NewMockIDelegation(ctrl)
+		apiKeys := mock.NewMockIApiKey(ctrl)
+		kv := NewKeyValidator(apiKeys, errChecker)
+
+		apiKeys.EXPECT().
+			BySecret(gomock.Any(), "valid").
+			Return(storage.ApiKey{
+				Key:        "valid",
+				LastUsedAt: time.Now().UTC(),
+			}, nil).
+			Times(1)
+
+		ok, err := kv.Validate("valid", ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("revoked key", func(t *testing.T) {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		errChecker := mock.NewMockIDelegation(ctrl)
+		apiKeys := mock.NewMockIApiKey(ctrl)
+		kv := NewKeyValidator(apiKeys, errChecker)
+
+		apiKeys.EXPECT().
+			BySecret(gomock.Any(), "revoked").
+			Return(storage.ApiKey{
+				Key:       "revoked",
+				RevokedAt: time.Now().Add(-time.Hour),
+			}, nil).
+			Times(1)
+
+		ok, err := kv.Validate("revoked", ctx)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.Nil(t, ctx.Get(ApiKeyName))
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		errChecker := mock.NewMockIDelegation(ctrl)
+		apiKeys := mock.NewMockIApiKey(ctrl)
+		kv := NewKeyValidator(apiKeys, errChecker)
+
+		apiKeys.EXPECT().
+			BySecret(gomock.Any(), "expired").
+			Return(storage.ApiKey{
+				Key:       "expired",
+				ExpiresAt: time.Now().Add(-time.Second),
+			}, nil).
+			Times(1)
+
+		ok, err := kv.Validate("expired", ctx)
+		require.NoError(t, err)
+		require.False(t, ok)
 	})
 
 	t.Run("invalid key", func(t *testing.T) {
@@ -129,7 +219,7 @@ func TestKeyValidator_Validate(t *testing.T) {
 		kv := NewKeyValidator(apiKeys, errChecker)
 
 		apiKeys.EXPECT().
-			Get(gomock.Any(), "invalid").
+			BySecret(gomock.Any(), "invalid").
 			Return(storage.ApiKey{}, sql.ErrNoRows).
 			Times(1)
 
@@ -159,7 +249,7 @@ func TestKeyValidator_Validate(t *testing.T) {
 		unexpectedErr := errors.New("unexpected")
 
 		apiKeys.EXPECT().
-			Get(gomock.Any(), "invalid").
+			BySecret(gomock.Any(), "invalid").
 			Return(storage.ApiKey{}, unexpectedErr).
 			Times(1)
 
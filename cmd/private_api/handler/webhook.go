@@ -0,0 +1,351 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	enums "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/internal/webhook"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+var (
+	errUnknownValidator     = errors.New("unknown validator")
+	errInvalidWebhookFilter = errors.New("filter is not supported by the event")
+)
+
+type WebhookHandler struct {
+	webhooks   storage.IWebhook
+	deliveries storage.IWebhookDelivery
+	namespace  storage.INamespace
+	validators storage.IValidator
+}
+
+func NewWebhookHandler(
+	webhooks storage.IWebhook,
+	deliveries storage.IWebhookDelivery,
+	namespace storage.INamespace,
+	validators storage.IValidator,
+) WebhookHandler {
+	return WebhookHandler{
+		webhooks:   webhooks,
+		deliveries: deliveries,
+		namespace:  namespace,
+		validators: validators,
+	}
+}
+
+type webhookResponse struct {
+	Id          uint64    `json:"id"`
+	Url         string    `json:"url"`
+	Event       string    `json:"event"`
+	Secret      string    `json:"secret,omitempty"`
+	NamespaceId uint64    `json:"namespace_id,omitempty"`
+	Address     string    `json:"address,omitempty"`
+	ValidatorId uint64    `json:"validator_id,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newWebhookResponse(hook storage.Webhook) webhookResponse {
+	return webhookResponse{
+		Id:          hook.Id,
+		Url:         hook.Url,
+		Event:       hook.Event.String(),
+		NamespaceId: hook.NamespaceId,
+		Address:     hook.Address,
+		ValidatorId: hook.ValidatorId,
+		Active:      hook.Active,
+		CreatedAt:   hook.CreatedAt,
+		UpdatedAt:   hook.UpdatedAt,
+	}
+}
+
+type webhookDeliveryResponse struct {
+	Id         uint64    `json:"id"`
+	DeliveryId string    `json:"delivery_id"`
+	Time       time.Time `json:"time"`
+	Event      string    `json:"event"`
+	Attempt    int       `json:"attempt"`
+	StatusCode int       `json:"status_code"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error,omitempty"`
+}
+
+func newWebhookDeliveryResponse(delivery storage.WebhookDelivery) webhookDeliveryResponse {
+	return webhookDeliveryResponse{
+		Id:         delivery.Id,
+		DeliveryId: delivery.DeliveryId,
+		Time:       delivery.Time,
+		Event:      delivery.Event.String(),
+		Attempt:    delivery.Attempt,
+		StatusCode: delivery.StatusCode,
+		Success:    delivery.Success,
+		Error:      delivery.Error,
+	}
+}
+
+type createWebhookRequest struct {
+	Url       string `json:"url"       validate:"required,webhook_url"`
+	Event     string `json:"event"     validate:"required,webhook_event"`
+	Namespace string `json:"namespace" validate:"omitempty,base64,namespace"`
+	Address   string `json:"address"   validate:"omitempty,address"`
+	Validator string `json:"validator" validate:"omitempty,address"`
+}
+
+// Create - registers new webhook. Secret for payload signature verification is returned only once.
+func (handler WebhookHandler) Create(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.webhooks)
+	}
+
+	req, err := bindAndValidate[createWebhookRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	event, err := enums.ParseWebhookEvent(req.Event)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if err := checkWebhookFilters(event, req); err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	now := time.Now().UTC()
+	hook := storage.Webhook{
+		ApiKey:    apiKey.Key,
+		Url:       req.Url,
+		Secret:    secret,
+		Event:     event,
+		Address:   req.Address,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if req.Namespace != "" {
+		if hook.NamespaceId, err = namespaceId(ctx, handler.namespace, req.Namespace); err != nil {
+			if errors.Is(err, errUnknownNamespace) {
+				return badRequestError(c, err)
+			}
+			return handleError(c, err, handler.namespace)
+		}
+	}
+
+	if req.Validator != "" {
+		validator, err := handler.validators.ByAddress(ctx, req.Validator)
+		if err != nil {
+			if handler.validators.IsNoRows(err) {
+				return badRequestError(c, errors.Wrap(errUnknownValidator, req.Validator))
+			}
+			return handleError(c, err, handler.validators)
+		}
+		hook.ValidatorId = validator.Id
+	}
+
+	if err := handler.webhooks.Save(ctx, &hook); err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	response := newWebhookResponse(hook)
+	response.Secret = hook.Secret
+	return c.JSON(http.StatusOK, response)
+}
+
+func checkWebhookFilters(event enums.WebhookEvent, req *createWebhookRequest) error {
+	switch {
+	case req.Namespace != "" && event != enums.WebhookEventBlob:
+		return errors.Wrap(errInvalidWebhookFilter, "namespace")
+	case req.Address != "" && event != enums.WebhookEventTransfer:
+		return errors.Wrap(errInvalidWebhookFilter, "address")
+	case req.Validator != "" && event != enums.WebhookEventJail:
+		return errors.Wrap(errInvalidWebhookFilter, "validator")
+	}
+	return nil
+}
+
+// namespaceId - returns internal id of base64-encoded namespace with version prefix
+func namespaceId(ctx context.Context, namespaces storage.INamespace, namespace string) (uint64, error) {
+	hash, err := base64.StdEncoding.DecodeString(namespace)
+	if err != nil {
+		return 0, err
+	}
+	ns, err := namespaces.ByNamespaceIdAndVersion(ctx, hash[1:], hash[0])
+	if err != nil {
+		if namespaces.IsNoRows(err) {
+			return 0, errors.Wrap(errUnknownNamespace, namespace)
+		}
+		return 0, err
+	}
+	return ns.Id, nil
+}
+
+type listWebhooksRequest struct {
+	Limit  int `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int `query:"offset" validate:"omitempty,min=0"`
+}
+
+// List - returns webhooks registered by the api key
+func (handler WebhookHandler) List(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.webhooks)
+	}
+
+	req, err := bindAndValidate[listWebhooksRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	hooks, err := handler.webhooks.ByApiKey(c.Request().Context(), apiKey.Key, req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	response := make([]webhookResponse, len(hooks))
+	for i := range hooks {
+		response[i] = newWebhookResponse(hooks[i])
+	}
+	return returnArray(c, response)
+}
+
+type updateWebhookRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Url    string `json:"url"     validate:"omitempty,webhook_url"`
+	Active *bool  `json:"active"  validate:"omitempty"`
+}
+
+// Update - changes webhook url or activity flag
+func (handler WebhookHandler) Update(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.webhooks)
+	}
+
+	req, err := bindAndValidate[updateWebhookRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	hook, err := handler.owned(ctx, req.Id, apiKey)
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	if req.Url != "" {
+		hook.Url = req.Url
+	}
+	if req.Active != nil {
+		hook.Active = *req.Active
+	}
+	hook.UpdatedAt = time.Now().UTC()
+
+	if err := handler.webhooks.Update(ctx, hook); err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	return c.JSON(http.StatusOK, newWebhookResponse(*hook))
+}
+
+type webhookIdRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Delete - removes webhook
+func (handler WebhookHandler) Delete(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.webhooks)
+	}
+
+	req, err := bindAndValidate[webhookIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	if _, err := handler.owned(ctx, req.Id, apiKey); err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	if err := handler.webhooks.Delete(ctx, req.Id); err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	return success(c)
+}
+
+type webhookDeliveriesRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+}
+
+// Deliveries - returns delivery log of webhook
+func (handler WebhookHandler) Deliveries(c echo.Context) error {
+	val := c.Get(ApiKeyName)
+	apiKey, ok := val.(storage.ApiKey)
+	if !ok {
+		return handleError(c, errInvalidApiKey, handler.webhooks)
+	}
+
+	req, err := bindAndValidate[webhookDeliveriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	ctx := c.Request().Context()
+	if _, err := handler.owned(ctx, req.Id, apiKey); err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	deliveries, err := handler.deliveries.ByWebhookId(ctx, req.Id, req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.deliveries)
+	}
+
+	response := make([]webhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		response[i] = newWebhookDeliveryResponse(deliveries[i])
+	}
+	return returnArray(c, response)
+}
+
+// owned - returns webhook if it was registered by the api key. Admin has access to all webhooks.
+func (handler WebhookHandler) owned(ctx context.Context, id uint64, apiKey storage.ApiKey) (*storage.Webhook, error) {
+	hook, err := handler.webhooks.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hook.ApiKey != apiKey.Key && !apiKey.IsAdmin() {
+		return nil, errAccessDenied
+	}
+	return hook, nil
+}
@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// WebhookTestSuite -
+type WebhookTestSuite struct {
+	suite.Suite
+	webhooks   *mock.MockIWebhook
+	deliveries *mock.MockIWebhookDelivery
+	namespace  *mock.MockINamespace
+	validators *mock.MockIValidator
+	handler    WebhookHandler
+	echo       *echo.Echo
+	ctrl       *gomock.Controller
+}
+
+// SetupSuite -
+func (s *WebhookTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.webhooks = mock.NewMockIWebhook(s.ctrl)
+	s.deliveries = mock.NewMockIWebhookDelivery(s.ctrl)
+	s.namespace = mock.NewMockINamespace(s.ctrl)
+	s.validators = mock.NewMockIValidator(s.ctrl)
+	s.handler = NewWebhookHandler(s.webhooks, s.deliveries, s.namespace, s.validators)
+}
+
+// TearDownSuite -
+func (s *WebhookTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteWebhook_Run(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
+
+func (s *WebhookTestSuite) newContext(method, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequestWithContext(context.Background(), method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set(ApiKeyName, storage.ApiKey{
+		Key:         "test",
+		Description: "test",
+	})
+	return c, rec
+}
+
+func (s *WebhookTestSuite) TestCreateBlobWebhook() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"url": "https://example.com/hook",
+		"event": "blob",
+		"namespace": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="
+	}`)
+	c.SetPath("/v1/auth/webhook")
+
+	s.namespace.EXPECT().
+		ByNamespaceIdAndVersion(gomock.Any(), gomock.Any(), byte(0)).
+		Return(storage.Namespace{Id: 10}, nil).
+		Times(1)
+
+	s.webhooks.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, hook *storage.Webhook) error {
+			s.Require().Equal("test", hook.ApiKey)
+			s.Require().Equal(types.WebhookEventBlob, hook.Event)
+			s.Require().EqualValues(10, hook.NamespaceId)
+			s.Require().True(hook.Active)
+			s.Require().Len(hook.Secret, 64)
+			hook.Id = 1
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response webhookResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().EqualValues(1, response.Id)
+	s.Require().Equal("blob", response.Event)
+	s.Require().EqualValues(10, response.NamespaceId)
+	s.Require().Len(response.Secret, 64)
+}
+
+func (s *WebhookTestSuite) TestCreateInvalidFilter() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"url": "https://example.com/hook",
+		"event": "jail",
+		"address": "celestia1kywuhlvslyt0qy8yr4p5lgkzz74qryujkjgprx"
+	}`)
+	c.SetPath("/v1/auth/webhook")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *WebhookTestSuite) TestCreateInvalidEvent() {
+	c, rec := s.newContext(http.MethodPost, `{
+		"url": "https://example.com/hook",
+		"event": "unknown"
+	}`)
+	c.SetPath("/v1/auth/webhook")
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func (s *WebhookTestSuite) TestCreateForbiddenUrl() {
+	for _, url := range []string{
+		"ftp://example.com/hook",
+		"http://localhost:9876/hook",
+		"http://127.0.0.1/hook",
+		"http://10.0.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hook",
+	} {
+		c, rec := s.newContext(http.MethodPost, `{"url": "`+url+`", "event": "blob"}`)
+		c.SetPath("/v1/auth/webhook")
+
+		s.Require().NoError(s.handler.Create(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, url)
+	}
+}
+
+func (s *WebhookTestSuite) TestList() {
+	c, rec := s.newContext(http.MethodGet, "")
+	c.SetPath("/v1/auth/webhook")
+
+	s.webhooks.EXPECT().
+		ByApiKey(gomock.Any(), "test", 10, 0).
+		Return([]storage.Webhook{
+			{
+				Id:        1,
+				ApiKey:    "test",
+				Url:       "https://example.com/hook",
+				Secret:    "secret",
+				Event:     types.WebhookEventTransfer,
+				Address:   "celestia1kywuhlvslyt0qy8yr4p5lgkzz74qryujkjgprx",
+				Active:    true,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response []webhookResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response, 1)
+	s.Require().Equal("transfer", response[0].Event)
+	s.Require().Empty(response[0].Secret)
+}
+
+func (s *WebhookTestSuite) TestDeleteForeign() {
+	c, rec := s.newContext(http.MethodDelete, "")
+	c.SetPath("/v1/auth/webhook/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	s.webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(2)).
+		Return(&storage.Webhook{
+			Id:     2,
+			ApiKey: "other",
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Delete(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+}
+
+func (s *WebhookTestSuite) TestDelete() {
+	c, rec := s.newContext(http.MethodDelete, "")
+	c.SetPath("/v1/auth/webhook/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Webhook{
+			Id:     1,
+			ApiKey: "test",
+		}, nil).
+		Times(1)
+
+	s.webhooks.EXPECT().
+		Delete(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Delete(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *WebhookTestSuite) TestUpdate() {
+	c, rec := s.newContext(http.MethodPatch, `{"active": false}`)
+	c.SetPath("/v1/auth/webhook/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Webhook{
+			Id:     1,
+			ApiKey: "test",
+			Url:    "https://example.com/hook",
+			Event:  types.WebhookEventJail,
+			Active: true,
+		}, nil).
+		Times(1)
+
+	s.webhooks.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, hook *storage.Webhook) error {
+			s.Require().False(hook.Active)
+			s.Require().Equal("https://example.com/hook", hook.Url)
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Update(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *WebhookTestSuite) TestDeliveries() {
+	c, rec := s.newContext(http.MethodGet, "")
+	c.SetPath("/v1/auth/webhook/:id/deliveries")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Webhook{
+			Id:     1,
+			ApiKey: "test",
+		}, nil).
+		Times(1)
+
+	s.deliveries.EXPECT().
+		ByWebhookId(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.WebhookDelivery{
+			{
+				Id:         1,
+				WebhookId:  1,
+				Time:       time.Now(),
+				Event:      types.WebhookEventJail,
+				Attempt:    1,
+				StatusCode: 500,
+				Error:      "unexpected status code: 500",
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Deliveries(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response []webhookDeliveryResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Len(response, 1)
+	s.Require().Equal(500, response[0].StatusCode)
+	s.Require().False(response[0].Success)
+}
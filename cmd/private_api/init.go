@@ -12,11 +12,17 @@ import (
 
 	"golang.org/x/time/rate"
 
+	"github.com/celenium-io/celestia-indexer/cmd/private_api/discovery"
 	"github.com/celenium-io/celestia-indexer/cmd/private_api/handler"
+	"github.com/celenium-io/celestia-indexer/internal/blob"
 	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/node"
+	nodeApi "github.com/celenium-io/celestia-indexer/pkg/node/dal"
 	"github.com/dipdup-io/go-lib/config"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
@@ -170,23 +176,100 @@ func initHandlers(e *echo.Echo, db postgres.Storage) {
 	v1 := e.Group("v1")
 	auth := v1.Group("/auth")
 	{
-		keyValidator := handler.NewKeyValidator(db.ApiKeys, db.BlobLogs)
+		keyValidator := handler.NewKeyValidator(db.ApiKeys, db.ApiKeys)
 		keyMiddleware := middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
 			KeyLookup: "header:Authorization",
 			Validator: keyValidator.Validate,
 		})
 		adminMiddleware := AdminMiddleware()
+		rollupScope := ScopeMiddleware(types.ApiKeyScopeStandard, types.ApiKeyScopeRollupAuth)
+		standardScope := ScopeMiddleware(types.ApiKeyScopeStandard)
 
-		rollupAuthHandler := handler.NewRollupAuthHandler(db.Rollup, db.Address, db.Namespace, db.Transactable, postgres.BeginTransaction)
+		rollupAuthHandler := handler.NewRollupAuthHandler(db.Rollup, db.Address, db.Namespace, db.RollupCandidate, db.RollupAudit, db.Transactable, postgres.BeginTransaction)
 		rollup := auth.Group("/rollup")
 		{
-			rollup.POST("/new", rollupAuthHandler.Create, keyMiddleware)
-			rollup.PATCH("/:id", rollupAuthHandler.Update, keyMiddleware)
+			rollup.POST("/new", rollupAuthHandler.Create, keyMiddleware, rollupScope)
+			rollup.PATCH("/:id", rollupAuthHandler.Update, keyMiddleware, rollupScope)
 			rollup.DELETE("/:id", rollupAuthHandler.Delete, keyMiddleware, adminMiddleware)
 			rollup.PATCH("/:id/verify", rollupAuthHandler.Verify, keyMiddleware, adminMiddleware)
 			rollup.GET("/unverified", rollupAuthHandler.Unverified, keyMiddleware, adminMiddleware)
+			rollup.GET("/audit", rollupAuthHandler.Audit, keyMiddleware, adminMiddleware)
 		}
 
-		auth.POST("/bulk", rollupAuthHandler.Bulk, keyMiddleware)
+		auth.POST("/bulk", rollupAuthHandler.Bulk, keyMiddleware, rollupScope)
+
+		apiKeyHandler := handler.NewApiKeyHandler(db.ApiKeys, db.ApiKeys, db.Notificator)
+		keys := auth.Group("/keys", keyMiddleware, adminMiddleware)
+		{
+			keys.POST("", apiKeyHandler.Create)
+			keys.GET("", apiKeyHandler.List)
+			keys.PATCH("/:key", apiKeyHandler.Update)
+			keys.POST("/:key/rotate", apiKeyHandler.Rotate)
+			keys.DELETE("/:key", apiKeyHandler.Revoke)
+		}
+
+		webhookHandler := handler.NewWebhookHandler(db.Webhooks, db.WebhookDelivery, db.Namespace, db.Validator)
+		webhooks := auth.Group("/webhook", keyMiddleware, standardScope)
+		{
+			webhooks.POST("", webhookHandler.Create)
+			webhooks.GET("", webhookHandler.List)
+			webhooks.PATCH("/:id", webhookHandler.Update)
+			webhooks.DELETE("/:id", webhookHandler.Delete)
+			webhooks.GET("/:id/deliveries", webhookHandler.Deliveries)
+		}
+
+		alertHandler := handler.NewAlertHandler(db.AlertRules, db.Alerts, db.Rollup, db.Namespace)
+		alerts := auth.Group("/alert", keyMiddleware, standardScope)
+		{
+			alerts.POST("", alertHandler.Create)
+			alerts.GET("", alertHandler.List)
+			alerts.PATCH("/:id", alertHandler.Update)
+			alerts.DELETE("/:id", alertHandler.Delete)
+		}
+
+		labelHandler := handler.NewAddressLabelHandler(db.AddressLabels, db.Address, db.Notificator)
+		labels := auth.Group("/label", keyMiddleware, standardScope)
+		{
+			labels.POST("", labelHandler.Create)
+			labels.GET("", labelHandler.List)
+			labels.PATCH("/:id", labelHandler.Update)
+			labels.DELETE("/:id", labelHandler.Delete)
+		}
+	}
+}
+
+var analyzer *discovery.Analyzer
+
+func initDiscovery(ctx context.Context, cfg Config, db postgres.Storage) {
+	if !cfg.ApiConfig.Discovery {
+		return
+	}
+
+	opts := make([]discovery.AnalyzerOption, 0)
+	if cfg.ApiConfig.BlobReceiver != "" {
+		receiver, err := initBlobReceiver(cfg)
+		if err != nil {
+			panic(err)
+		}
+		opts = append(opts, discovery.WithBlobReceiver(receiver, 0))
+	}
+
+	analyzer = discovery.NewAnalyzer(db.RollupCandidate, db.BlobLogs, db.Transactable, postgres.BeginTransaction, opts...)
+	analyzer.Start(ctx)
+}
+
+func initBlobReceiver(cfg Config) (node.DalApi, error) {
+	datasource, ok := cfg.DataSources[cfg.ApiConfig.BlobReceiver]
+	if !ok {
+		return nil, errors.Errorf("unknown data source pointed in blob_receiver: %s", cfg.ApiConfig.BlobReceiver)
+	}
+
+	switch cfg.ApiConfig.BlobReceiver {
+	case "celenium_blobs":
+		return blob.NewCelenium(datasource), nil
+	default:
+		return nodeApi.New(datasource.URL).
+			WithAuthToken(os.Getenv("CELESTIA_NODE_AUTH_TOKEN")).
+			WithRateLimit(datasource.RequestsPerSecond), nil
 	}
 }
@@ -35,6 +35,7 @@ func main() {
 	db := initDatabase(cfg.Database, cfg.Indexer.ScriptsDir)
 	e := initEcho(cfg.ApiConfig)
 	initHandlers(e, db)
+	initDiscovery(ctx, *cfg, db)
 
 	go func() {
 		if err := e.Start(cfg.ApiConfig.Bind); err != nil && errors.Is(err, http.ErrServerClosed) {
@@ -49,6 +50,12 @@ func main() {
 		e.Logger.Fatal(err)
 	}
 
+	if analyzer != nil {
+		if err := analyzer.Close(); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
+
 	if err := db.Close(); err != nil {
 		e.Logger.Fatal(err)
 	}
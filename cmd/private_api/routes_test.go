@@ -15,12 +15,31 @@ import (
 
 func TestRoutes(t *testing.T) {
 	var expectedRoutes = map[string]struct{}{
-		"/v1/auth/rollup/new POST":         {},
-		"/v1/auth/rollup/:id PATCH":        {},
-		"/v1/auth/rollup/:id/verify PATCH": {},
-		"/v1/auth/rollup/unverified GET":   {},
-		"/v1/auth/rollup/:id DELETE":       {},
-		"/v1/auth/bulk POST":               {},
+		"/v1/auth/rollup/new POST":            {},
+		"/v1/auth/rollup/:id PATCH":           {},
+		"/v1/auth/rollup/:id/verify PATCH":    {},
+		"/v1/auth/rollup/unverified GET":      {},
+		"/v1/auth/rollup/:id DELETE":          {},
+		"/v1/auth/rollup/audit GET":           {},
+		"/v1/auth/bulk POST":                  {},
+		"/v1/auth/keys POST":                  {},
+		"/v1/auth/keys GET":                   {},
+		"/v1/auth/keys/:key PATCH":            {},
+		"/v1/auth/keys/:key/rotate POST":      {},
+		"/v1/auth/keys/:key DELETE":           {},
+		"/v1/auth/webhook POST":               {},
+		"/v1/auth/webhook GET":                {},
+		"/v1/auth/webhook/:id PATCH":          {},
+		"/v1/auth/webhook/:id DELETE":         {},
+		"/v1/auth/webhook/:id/deliveries GET": {},
+		"/v1/auth/alert POST":                 {},
+		"/v1/auth/alert GET":                  {},
+		"/v1/auth/alert/:id PATCH":            {},
+		"/v1/auth/alert/:id DELETE":           {},
+		"/v1/auth/label POST":                 {},
+		"/v1/auth/label GET":                  {},
+		"/v1/auth/label/:id PATCH":            {},
+		"/v1/auth/label/:id DELETE":           {},
 	}
 
 	db := postgres.Storage{
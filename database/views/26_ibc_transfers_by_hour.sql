@@ -6,6 +6,7 @@ WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
         sum(amount) as amount,
         count(ibc_transfer.id) as count
     from ibc_transfer
+    where status in ('received', 'acknowledged')
     group by 1, 2
 	with no data;
         
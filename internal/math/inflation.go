@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package math
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Constants of the celestia-app mint module. The inflation rate is recalculated on each anniversary
+// of the genesis: it decreases by the disinflation rate until it reaches the target inflation rate.
+const (
+	NanosecondsPerYear int64 = 31_556_952_000_000_000
+)
+
+var (
+	DisinflationRate    = decimal.RequireFromString("0.067")
+	TargetInflationRate = decimal.RequireFromString("0.015")
+
+	one  = decimal.NewFromInt(1)
+	year = decimal.NewFromInt(NanosecondsPerYear)
+)
+
+// NextInflationRate - returns inflation rate of the year following the year with the given rate
+func NextInflationRate(rate decimal.Decimal) decimal.Decimal {
+	next := rate.Mul(one.Sub(DisinflationRate))
+	if next.LessThan(TargetInflationRate) {
+		return TargetInflationRate
+	}
+	return next
+}
+
+// YearsSinceGenesis - returns count of full years passed since genesis
+func YearsSinceGenesis(genesis, t time.Time) int64 {
+	if !t.After(genesis) {
+		return 0
+	}
+	return int64(t.Sub(genesis)) / NanosecondsPerYear
+}
+
+// SupplyProjection - projects total supply growth by the mint module. Annual provisions are
+// fixed within the year as the product of the inflation rate and the supply at the start of the projection
+// or the year.
+type SupplyProjection struct {
+	genesis          time.Time
+	current          time.Time
+	year             int64
+	rate             decimal.Decimal
+	supply           decimal.Decimal
+	annualProvisions decimal.Decimal
+}
+
+// NewSupplyProjection - creates projection starting at the moment `now` with the current supply and inflation rate
+func NewSupplyProjection(genesis, now time.Time, supply, rate decimal.Decimal) *SupplyProjection {
+	return &SupplyProjection{
+		genesis:          genesis,
+		current:          now,
+		year:             YearsSinceGenesis(genesis, now),
+		rate:             rate,
+		supply:           supply,
+		annualProvisions: supply.Mul(rate),
+	}
+}
+
+// Supply - returns projected total supply at the current moment of the projection
+func (p *SupplyProjection) Supply() decimal.Decimal {
+	return p.supply
+}
+
+// Rate - returns projected inflation rate at the current moment of the projection
+func (p *SupplyProjection) Rate() decimal.Decimal {
+	return p.rate
+}
+
+// Mint - advances the projection to the moment `to` and returns amount of tokens minted in between
+func (p *SupplyProjection) Mint(to time.Time) decimal.Decimal {
+	minted := decimal.Zero
+	for p.current.Before(to) {
+		yearEnd := p.genesis.Add(time.Duration((p.year + 1) * NanosecondsPerYear))
+		end := to
+		if yearEnd.Before(end) {
+			end = yearEnd
+		}
+
+		segment := p.annualProvisions.
+			Mul(decimal.NewFromInt(int64(end.Sub(p.current)))).
+			Div(year).
+			Floor()
+		minted = minted.Add(segment)
+		p.supply = p.supply.Add(segment)
+		p.current = end
+
+		if end.Equal(yearEnd) {
+			p.year++
+			p.rate = NextInflationRate(p.rate)
+			p.annualProvisions = p.supply.Mul(p.rate)
+		}
+	}
+	return minted
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package math_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/math"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextInflationRate(t *testing.T) {
+	tests := []struct {
+		name string
+		rate decimal.Decimal
+		want decimal.Decimal
+	}{
+		{
+			name: "test 1: disinflation",
+			rate: decimal.RequireFromString("0.05"),
+			want: decimal.RequireFromString("0.04665"),
+		}, {
+			name: "test 2: target",
+			rate: decimal.RequireFromString("0.016"),
+			want: decimal.RequireFromString("0.015"),
+		}, {
+			name: "test 3: already target",
+			rate: decimal.RequireFromString("0.015"),
+			want: decimal.RequireFromString("0.015"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := math.NextInflationRate(tt.rate)
+			require.Equal(t, tt.want.String(), got.String())
+		})
+	}
+}
+
+func TestYearsSinceGenesis(t *testing.T) {
+	genesis := time.Date(2023, 10, 31, 14, 0, 0, 0, time.UTC)
+	require.EqualValues(t, 0, math.YearsSinceGenesis(genesis, genesis.Add(-time.Hour)))
+	require.EqualValues(t, 0, math.YearsSinceGenesis(genesis, genesis.AddDate(0, 11, 0)))
+	require.EqualValues(t, 1, math.YearsSinceGenesis(genesis, genesis.AddDate(1, 1, 0)))
+	require.EqualValues(t, 2, math.YearsSinceGenesis(genesis, genesis.AddDate(2, 1, 0)))
+}
+
+func TestSupplyProjection(t *testing.T) {
+	genesis := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	yearDuration := time.Duration(math.NanosecondsPerYear)
+	now := genesis.Add(yearDuration / 2)
+
+	projection := math.NewSupplyProjection(genesis, now, decimal.NewFromInt(1_000_000), decimal.RequireFromString("0.1"))
+
+	minted := projection.Mint(genesis.Add(yearDuration))
+	require.Equal(t, "50000", minted.String())
+	require.Equal(t, "1050000", projection.Supply().String())
+	require.Equal(t, "0.0933", projection.Rate().String())
+
+	minted = projection.Mint(genesis.Add(yearDuration * 3 / 2))
+	require.Equal(t, "48982", minted.String())
+	require.Equal(t, "1098982", projection.Supply().String())
+
+	minted = projection.Mint(now)
+	require.Equal(t, "0", minted.String())
+}
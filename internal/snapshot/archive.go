@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	json "github.com/bytedance/sonic"
+	"github.com/pkg/errors"
+)
+
+// writeArchive - packs manifest and table dumps from directory into gzipped tar.
+// Manifest is written first so it can be validated before any table is loaded.
+func writeArchive(w io.Writer, manifest Manifest, dir string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "marshal manifest")
+	}
+	if err := writeEntry(tw, manifestFile, int64(len(data)), manifest.CreatedAt); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Wrap(err, "write manifest")
+	}
+
+	for i := range manifest.Tables {
+		if err := writeTable(tw, filepath.Join(dir, manifest.Tables[i].Name+tableExt), tableFileName(manifest.Tables[i].Name), manifest.CreatedAt); err != nil {
+			return errors.Wrapf(err, "write table %s", manifest.Tables[i].Name)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(err, "close tar writer")
+	}
+	return errors.Wrap(gz.Close(), "close gzip writer")
+}
+
+func writeTable(tw *tar.Writer, path, name string, modTime time.Time) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(tw, name, info.Size(), modTime); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func writeEntry(tw *tar.Writer, name string, size int64, modTime time.Time) error {
+	return tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  modTime,
+	})
+}
+
+// readManifest - reads manifest which should be the first entry of archive
+func readManifest(tr *tar.Reader) (Manifest, error) {
+	var manifest Manifest
+	header, err := tr.Next()
+	if err != nil {
+		return manifest, errors.Wrap(ErrInvalidArchive, err.Error())
+	}
+	if header.Name != manifestFile {
+		return manifest, errors.Wrapf(ErrInvalidArchive, "expected %s as the first entry, got %s", manifestFile, header.Name)
+	}
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return manifest, errors.Wrap(err, "read manifest")
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return manifest, errors.Wrap(ErrInvalidArchive, err.Error())
+	}
+	return manifest, nil
+}
+
+// nextTable - moves archive reader to dump of the table
+func nextTable(tr *tar.Reader, table string) error {
+	header, err := tr.Next()
+	if err != nil {
+		return errors.Wrapf(ErrInvalidArchive, "table %s: %s", table, err.Error())
+	}
+	if header.Name != tableFileName(table) {
+		return errors.Wrapf(ErrInvalidArchive, "expected %s, got %s", tableFileName(table), header.Name)
+	}
+	return nil
+}
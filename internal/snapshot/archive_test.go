@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "block"+tableExt), []byte("id,height\n1,100\n2,101\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "state"+tableExt), []byte("id,name\n1,indexer\n"), 0o600))
+
+	manifest := Manifest{
+		Version:   Version,
+		Indexer:   "indexer",
+		ChainId:   "celestia",
+		Height:    101,
+		Hash:      "abcd",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Tables: []Table{
+			{Name: "state", Rows: 1},
+			{Name: "block", Rows: 2},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, manifest, dir))
+
+	gz, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	got, err := readManifest(tr)
+	require.NoError(t, err)
+	require.Equal(t, manifest, got)
+
+	require.NoError(t, nextTable(tr, "state"))
+	data, err := io.ReadAll(tr)
+	require.NoError(t, err)
+	require.Equal(t, "id,name\n1,indexer\n", string(data))
+
+	err = nextTable(tr, "address")
+	require.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func TestReadManifestFirstEntry(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, writeEntry(tw, tableFileName("block"), 0, time.Now()))
+	require.NoError(t, tw.Close())
+
+	_, err := readManifest(tar.NewReader(&buf))
+	require.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func TestManifestValidate(t *testing.T) {
+	known := tableSet([]string{"state", "block"})
+
+	tests := []struct {
+		name     string
+		manifest Manifest
+		wantErr  error
+	}{
+		{
+			name: "valid",
+			manifest: Manifest{
+				Version: Version,
+				Height:  10,
+				Tables:  []Table{{Name: "state"}, {Name: "block"}},
+			},
+		}, {
+			name: "unsupported version",
+			manifest: Manifest{
+				Version: Version + 1,
+				Height:  10,
+				Tables:  []Table{{Name: "state"}, {Name: "block"}},
+			},
+			wantErr: ErrUnsupportedVersion,
+		}, {
+			name: "empty height",
+			manifest: Manifest{
+				Version: Version,
+				Tables:  []Table{{Name: "state"}, {Name: "block"}},
+			},
+			wantErr: ErrInvalidArchive,
+		}, {
+			name: "missing table",
+			manifest: Manifest{
+				Version: Version,
+				Height:  10,
+				Tables:  []Table{{Name: "state"}},
+			},
+			wantErr: ErrInvalidArchive,
+		}, {
+			name: "unknown table",
+			manifest: Manifest{
+				Version: Version,
+				Height:  10,
+				Tables:  []Table{{Name: "state"}, {Name: "unknown"}},
+			},
+			wantErr: ErrInvalidArchive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.manifest.validate(known)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTableNames(t *testing.T) {
+	names, err := tableNames()
+	require.NoError(t, err)
+	require.NotEmpty(t, names)
+	require.Len(t, tableSet(names), len(names))
+}
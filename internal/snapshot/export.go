@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Export - writes snapshot of all indexer tables to the writer. Tables are dumped inside single
+// repeatable read transaction, so archive is consistent even if indexer is running.
+// By default snapshot is taken at the last indexed height. Non-zero height below it selects rows indexed up to
+// the height and recomputes mutable state which has history (see historicalQueries). Height should not be lower
+// than the height since which balance history is complete.
+func Export(ctx context.Context, pool *pgxpool.Pool, indexerName string, height pkgTypes.Level, w io.Writer) (Manifest, error) {
+	tables, err := tableNames()
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return Manifest{}, errors.Wrap(err, "acquire connection")
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return Manifest{}, errors.Wrap(err, "begin transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	manifest, balanceHistoryHeight, err := readState(ctx, tx, indexerName)
+	if err != nil {
+		return manifest, err
+	}
+
+	var hasMigrations bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, migrationsTable).Scan(&hasMigrations); err != nil {
+		return manifest, errors.Wrap(err, "check migrations table")
+	}
+	if !hasMigrations {
+		return manifest, errors.Errorf("table %s is not found: start indexer to initialize migrations", migrationsTable)
+	}
+	tables = append(tables, migrationsTable)
+
+	queries := make(map[string]string)
+	switch {
+	case height == 0 || height == manifest.Height:
+	case height > manifest.Height:
+		return manifest, errors.Errorf("requested height %d is above the last indexed height %d", height, manifest.Height)
+	case height < balanceHistoryHeight:
+		return manifest, errors.Errorf("balance history is complete since %d, requested height %d", balanceHistoryHeight, height)
+	default:
+		var hash []byte
+		if err := tx.QueryRow(ctx, `SELECT hash FROM block WHERE height = $1`, height).Scan(&hash); err != nil {
+			return manifest, errors.Wrapf(err, "receive block %d", height)
+		}
+		manifest.Height = height
+		manifest.Hash = hex.EncodeToString(hash)
+
+		queries, err = historicalQueries(ctx, tx, tables, height)
+		if err != nil {
+			return manifest, err
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "celestia-snapshot-")
+	if err != nil {
+		return manifest, errors.Wrap(err, "create temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	manifest.Tables = make([]Table, 0, len(tables))
+	for _, table := range tables {
+		query, ok := queries[table]
+		if !ok {
+			query = fmt.Sprintf("SELECT * FROM %s", pgx.Identifier{table}.Sanitize())
+		}
+		rows, err := dumpTable(ctx, tx.Conn().PgConn(), query, filepath.Join(dir, table+tableExt))
+		if err != nil {
+			return manifest, errors.Wrapf(err, "dump table %s", table)
+		}
+		manifest.Tables = append(manifest.Tables, Table{Name: table, Rows: rows})
+		log.Info().Str("table", table).Int64("rows", rows).Msg("table was dumped")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return manifest, errors.Wrap(err, "commit transaction")
+	}
+
+	if err := writeArchive(w, manifest, dir); err != nil {
+		return manifest, errors.Wrap(err, "write archive")
+	}
+	return manifest, nil
+}
+
+// readState - returns manifest at the last indexed height and the height since which balance history is complete
+func readState(ctx context.Context, tx pgx.Tx, indexerName string) (Manifest, pkgTypes.Level, error) {
+	var (
+		height               int64
+		hash                 []byte
+		chainId              string
+		balanceHistoryHeight int64
+	)
+	err := tx.QueryRow(ctx,
+		`SELECT last_height, last_hash, chain_id, balance_history_height FROM state WHERE name = $1`,
+		indexerName,
+	).Scan(&height, &hash, &chainId, &balanceHistoryHeight)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Manifest{}, 0, errors.Errorf("state of indexer %q is not found", indexerName)
+		}
+		return Manifest{}, 0, errors.Wrap(err, "read state")
+	}
+
+	return Manifest{
+		Version:   Version,
+		Indexer:   indexerName,
+		ChainId:   chainId,
+		Height:    pkgTypes.Level(height),
+		Hash:      hex.EncodeToString(hash),
+		CreatedAt: time.Now().UTC(),
+	}, pkgTypes.Level(balanceHistoryHeight), nil
+}
+
+// dumpTable - copies rows of select to CSV file. Hypertables can't be copied directly, so rows are always copied from select.
+func dumpTable(ctx context.Context, conn *pgconn.PgConn, selectQuery, path string) (int64, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	query := fmt.Sprintf("COPY (%s) TO STDOUT WITH CSV HEADER", selectQuery)
+	tag, err := conn.CopyTo(ctx, f, query)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
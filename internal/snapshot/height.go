@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// view - select of the table at the height below the last indexed one. Columns without expression are copied from the table as is.
+// COPY doesn't accept query parameters, so height is formatted into the query.
+type view struct {
+	from  string
+	where string
+	exprs map[string]string
+}
+
+func (v view) query(table string, columns []string) string {
+	fields := make([]string, len(columns))
+	for i := range columns {
+		column := pgx.Identifier{columns[i]}.Sanitize()
+		if expr, ok := v.exprs[columns[i]]; ok {
+			fields[i] = fmt.Sprintf("%s AS %s", expr, column)
+		} else {
+			fields[i] = fmt.Sprintf("%s.%s", pgx.Identifier{table}.Sanitize(), column)
+		}
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(fields, ", "), v.from)
+	if v.where != "" {
+		query += " WHERE " + v.where
+	}
+	return query
+}
+
+// historicalQueries - returns selects of tables at the height. Rows of tables with block height are cut by it.
+// Balances, validators and namespaces are recomputed from their logs and state points to the block at the height.
+// Other mutable entities (delegations, proposals, IBC clients, etc.) don't keep history: rows created after the height
+// are skipped, but the rest are exported with values of the last indexed height.
+func historicalQueries(ctx context.Context, tx pgx.Tx, tables []string, height pkgTypes.Level) (map[string]string, error) {
+	columns, err := tableColumns(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "receive table columns")
+	}
+
+	views := historicalViews(height)
+	queries := make(map[string]string, len(tables))
+	for _, table := range tables {
+		v, ok := views[table]
+		if !ok {
+			if !slices.Contains(columns[table], "height") {
+				continue
+			}
+			v = view{
+				from:  pgx.Identifier{table}.Sanitize(),
+				where: fmt.Sprintf("%s.height <= %d", pgx.Identifier{table}.Sanitize(), height),
+			}
+		}
+		queries[table] = v.query(table, columns[table])
+	}
+	return queries, nil
+}
+
+func historicalViews(height pkgTypes.Level) map[string]view {
+	return map[string]view{
+		"state": {
+			from: fmt.Sprintf("state, (SELECT height, hash, time FROM block WHERE height = %d) AS b", height),
+			exprs: map[string]string{
+				"last_height":       "b.height",
+				"last_hash":         "b.hash",
+				"last_time":         "b.time",
+				"total_tx":          fmt.Sprintf("(SELECT count(*) FROM tx WHERE height <= %d)", height),
+				"total_accounts":    fmt.Sprintf("(SELECT count(*) FROM address WHERE height <= %d)", height),
+				"total_namespaces":  fmt.Sprintf("(SELECT count(*) FROM namespace WHERE first_height <= %d)", height),
+				"total_blobs_size":  fmt.Sprintf("(SELECT coalesce(sum(blobs_size), 0) FROM block_stats WHERE height <= %d)", height),
+				"total_proposals":   fmt.Sprintf("(SELECT count(*) FROM proposal WHERE height <= %d)", height),
+				"total_ibc_clients": fmt.Sprintf("(SELECT count(*) FROM ibc_client WHERE height <= %d)", height),
+				"total_validators":  fmt.Sprintf("(SELECT count(*) FROM validator WHERE height <= %d)", height),
+				"total_fee":         fmt.Sprintf("(SELECT coalesce(sum(fee), 0) FROM block_stats WHERE height <= %d)", height),
+				"total_supply":      fmt.Sprintf("coalesce((SELECT total FROM supply WHERE height <= %d ORDER BY time DESC LIMIT 1), state.total_supply)", height),
+			},
+		},
+		"balance": {
+			from: fmt.Sprintf(`(
+				SELECT address_id AS id, currency, sum(spendable) AS spendable, sum(delegated) AS delegated, sum(unbonding) AS unbonding
+				FROM balance_update WHERE height <= %d GROUP BY address_id, currency
+			) AS balance`, height),
+		},
+		"address": {
+			from:  "address",
+			where: fmt.Sprintf("address.height <= %d", height),
+			exprs: map[string]string{
+				// the last occurrence before the height isn't stored, so it's capped by the height
+				"last_height": fmt.Sprintf("least(address.last_height, %d)", height),
+			},
+		},
+		"namespace": {
+			from: fmt.Sprintf(`namespace
+				LEFT JOIN (
+					SELECT namespace_id, sum(size) AS size, count(*) AS messages, max(height) AS last_height, max(time) AS last_message_time
+					FROM namespace_message WHERE height <= %d GROUP BY namespace_id
+				) AS m ON m.namespace_id = namespace.id`, height),
+			where: fmt.Sprintf("namespace.first_height <= %d", height),
+			exprs: map[string]string{
+				"size":              "coalesce(m.size, 0)",
+				"pfb_count":         "coalesce(m.messages, 0)",
+				"blobs_count":       "coalesce(m.messages, 0)",
+				"last_height":       "coalesce(m.last_height, namespace.first_height)",
+				"last_message_time": "coalesce(m.last_message_time, namespace.last_message_time)",
+			},
+		},
+		"validator": {
+			from: fmt.Sprintf(`validator
+				LEFT JOIN (
+					SELECT validator_id, sum(change) AS change FROM staking_log
+					WHERE height > %[1]d AND type IN ('%[2]s', '%[3]s') GROUP BY validator_id
+				) AS changes ON changes.validator_id = validator.id
+				LEFT JOIN (
+					SELECT validator_id, sum(burned) AS burned FROM jail WHERE height > %[1]d GROUP BY validator_id
+				) AS burned ON burned.validator_id = validator.id
+				LEFT JOIN (
+					SELECT DISTINCT ON (validator_id) validator_id, rate FROM validator_rate
+					WHERE height <= %[1]d ORDER BY validator_id, height DESC
+				) AS rates ON rates.validator_id = validator.id
+				LEFT JOIN (
+					SELECT validator_id, max(height) AS height FROM jail WHERE height <= %[1]d GROUP BY validator_id
+				) AS jails ON jails.validator_id = validator.id
+				LEFT JOIN (
+					SELECT msg_validator.validator_id, max(msg_validator.height) AS height FROM msg_validator
+					JOIN message ON message.id = msg_validator.msg_id
+					JOIN tx ON tx.id = message.tx_id
+					WHERE msg_validator.height <= %[1]d AND message.type = '%[4]s' AND tx.status = '%[5]s'
+					GROUP BY msg_validator.validator_id
+				) AS unjails ON unjails.validator_id = validator.id`,
+				height, types.StakingLogTypeDelegation, types.StakingLogTypeUnbonding, types.MsgUnjail, types.StatusSuccess,
+			),
+			where: fmt.Sprintf("validator.height <= %d", height),
+			exprs: map[string]string{
+				"stake":  "validator.stake - coalesce(changes.change, 0) + coalesce(burned.burned, 0)",
+				"rate":   "coalesce(rates.rate, validator.rate)",
+				"jailed": "jails.height IS NOT NULL AND (unjails.height IS NULL OR unjails.height < jails.height)",
+			},
+		},
+	}
+}
+
+// tableColumns - returns columns of all tables of the current schema in their order
+func tableColumns(ctx context.Context, tx pgx.Tx) (map[string][]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT table_name, column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema()
+		ORDER BY table_name, ordinal_position`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string][]string)
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			return nil, err
+		}
+		columns[table] = append(columns[table], column)
+	}
+	return columns, rows.Err()
+}
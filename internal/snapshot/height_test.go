@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewQuery(t *testing.T) {
+	v := view{
+		from:  "address",
+		where: "address.height <= 100",
+		exprs: map[string]string{
+			"last_height": "least(address.last_height, 100)",
+		},
+	}
+
+	query := v.query("address", []string{"id", "height", "last_height"})
+	require.Equal(t,
+		`SELECT "address"."id", "address"."height", least(address.last_height, 100) AS "last_height" FROM address WHERE address.height <= 100`,
+		query,
+	)
+}
+
+func TestHistoricalViews(t *testing.T) {
+	views := historicalViews(100)
+	for _, table := range []string{"state", "balance", "address", "namespace", "validator"} {
+		v, ok := views[table]
+		require.True(t, ok, table)
+		require.NotEmpty(t, v.from, table)
+	}
+	require.Contains(t, views["validator"].from, "'MsgUnjail'")
+	require.Contains(t, views["state"].from, "WHERE height = 100")
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Import - restores snapshot into empty database. Database schema should be created before import.
+// All tables are loaded in single transaction, after that sequences and materialized views are refreshed.
+// Applied migrations of the target database are replaced with ones of the snapshot.
+func Import(ctx context.Context, pool *pgxpool.Pool, r io.Reader) (Manifest, error) {
+	tables, err := tableNames()
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Manifest{}, errors.Wrap(ErrInvalidArchive, err.Error())
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	manifest, err := readManifest(tr)
+	if err != nil {
+		return manifest, err
+	}
+	if err := manifest.validate(tableSet(append(tables, migrationsTable))); err != nil {
+		return manifest, err
+	}
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return manifest, errors.Wrap(err, "acquire connection")
+	}
+	defer conn.Release()
+
+	if err := ensureEmpty(ctx, conn.Conn(), tables); err != nil {
+		return manifest, err
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return manifest, errors.Wrap(err, "begin transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for _, table := range manifest.Tables {
+		if err := nextTable(tr, table.Name); err != nil {
+			return manifest, err
+		}
+		if table.Name == migrationsTable {
+			if _, err := tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s", pgx.Identifier{migrationsTable}.Sanitize())); err != nil {
+				return manifest, errors.Wrap(err, "clear applied migrations")
+			}
+		}
+		rows, err := loadTable(ctx, tx.Conn().PgConn(), table.Name, tr)
+		if err != nil {
+			return manifest, errors.Wrapf(err, "load table %s", table.Name)
+		}
+		if rows != table.Rows {
+			return manifest, errors.Wrapf(ErrInvalidArchive, "table %s: loaded %d rows, expected %d", table.Name, rows, table.Rows)
+		}
+		log.Info().Str("table", table.Name).Int64("rows", rows).Msg("table was loaded")
+	}
+
+	if err := resetSequences(ctx, tx); err != nil {
+		return manifest, errors.Wrap(err, "reset sequences")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return manifest, errors.Wrap(err, "commit transaction")
+	}
+
+	if err := postgres.RefreshViews(ctx, conn.Conn(), nil); err != nil {
+		return manifest, errors.Wrap(err, "refresh views")
+	}
+	return manifest, nil
+}
+
+func ensureEmpty(ctx context.Context, conn *pgx.Conn, tables []string) error {
+	for _, table := range tables {
+		var exists bool
+		query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s)", pgx.Identifier{table}.Sanitize())
+		if err := conn.QueryRow(ctx, query).Scan(&exists); err != nil {
+			return errors.Wrapf(err, "check table %s", table)
+		}
+		if exists {
+			return errors.Wrapf(ErrDatabaseNotEmpty, "table %s contains rows", table)
+		}
+	}
+	return nil
+}
+
+// loadTable - copies CSV dump into the table. Columns are taken from dump header,
+// so column order of the target table doesn't matter.
+func loadTable(ctx context.Context, conn *pgconn.PgConn, table string, r io.Reader) (int64, error) {
+	reader := bufio.NewReader(r)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return 0, errors.Wrap(ErrInvalidArchive, "missing header")
+	}
+	columns, err := csv.NewReader(strings.NewReader(line)).Read()
+	if err != nil {
+		return 0, errors.Wrap(ErrInvalidArchive, err.Error())
+	}
+
+	sanitized := make([]string, len(columns))
+	for i := range columns {
+		sanitized[i] = pgx.Identifier{columns[i]}.Sanitize()
+	}
+
+	query := fmt.Sprintf("COPY %s (%s) FROM STDIN WITH CSV",
+		pgx.Identifier{table}.Sanitize(),
+		strings.Join(sanitized, ", "),
+	)
+	tag, err := conn.CopyFrom(ctx, reader, query)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// resetSequences - moves serial sequences after the maximum imported identity
+func resetSequences(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx, `
+		SELECT table_name, column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND column_default LIKE 'nextval(%'`,
+	)
+	if err != nil {
+		return err
+	}
+	type serial struct {
+		table  string
+		column string
+	}
+	serials, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (serial, error) {
+		var s serial
+		err := row.Scan(&s.table, &s.column)
+		return s, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range serials {
+		query := fmt.Sprintf(
+			"SELECT setval(pg_get_serial_sequence($1, $2), COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)",
+			pgx.Identifier{s.column}.Sanitize(),
+			pgx.Identifier{s.table}.Sanitize(),
+		)
+		if _, err := tx.Exec(ctx, query, s.table, s.column); err != nil {
+			return errors.Wrapf(err, "sequence of %s.%s", s.table, s.column)
+		}
+	}
+	return nil
+}
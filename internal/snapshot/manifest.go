@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Version - version of archive layout. It should be increased on every incompatible change of archive or tables structure.
+const Version = 2
+
+const (
+	manifestFile = "manifest.json"
+	tablesDir    = "tables/"
+	tableExt     = ".csv"
+
+	// migrationsTable - table of applied migrations. It's archived with indexer tables, so migrations
+	// which weren't applied to the source database are run after import.
+	migrationsTable = "bun_migrations"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+	ErrInvalidArchive     = errors.New("invalid snapshot archive")
+	ErrDatabaseNotEmpty   = errors.New("target database is not empty")
+)
+
+// Manifest - describes snapshot content. It's the first file of archive.
+type Manifest struct {
+	Version   int            `json:"version"`
+	Indexer   string         `json:"indexer"`
+	ChainId   string         `json:"chain_id"`
+	Height    pkgTypes.Level `json:"height"`
+	Hash      string         `json:"hash"`
+	CreatedAt time.Time      `json:"created_at"`
+	Tables    []Table        `json:"tables"`
+}
+
+// Table - single table entry of snapshot
+type Table struct {
+	Name string `json:"name"`
+	Rows int64  `json:"rows"`
+}
+
+func (m Manifest) validate(known map[string]struct{}) error {
+	if m.Version != Version {
+		return errors.Wrapf(ErrUnsupportedVersion, "got %d, expected %d", m.Version, Version)
+	}
+	if m.Height == 0 {
+		return errors.Wrap(ErrInvalidArchive, "empty height in manifest")
+	}
+	if len(m.Tables) != len(known) {
+		return errors.Wrapf(ErrInvalidArchive, "manifest contains %d tables, expected %d", len(m.Tables), len(known))
+	}
+	for i := range m.Tables {
+		if _, ok := known[m.Tables[i].Name]; !ok {
+			return errors.Wrapf(ErrInvalidArchive, "unknown table in manifest: %s", m.Tables[i].Name)
+		}
+	}
+	return nil
+}
+
+func tableFileName(table string) string {
+	return tablesDir + table + tableExt
+}
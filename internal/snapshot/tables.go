@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+type tableNamer interface {
+	TableName() string
+}
+
+// tableNames - returns names of all indexer tables in order of storage.Models
+func tableNames() ([]string, error) {
+	names := make([]string, 0, len(storage.Models))
+	for i := range storage.Models {
+		model, ok := storage.Models[i].(tableNamer)
+		if !ok {
+			return nil, errors.Errorf("model %T has no table name", storage.Models[i])
+		}
+		names = append(names, model.TableName())
+	}
+	return names, nil
+}
+
+func tableSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for i := range names {
+		set[names[i]] = struct{}{}
+	}
+	return set
+}
@@ -25,11 +25,15 @@ type IAddress interface {
 	storage.Table[*Address]
 
 	ByHash(ctx context.Context, hash []byte) (Address, error)
+	GetByIds(ctx context.Context, ids ...uint64) ([]Address, error)
 	ListWithBalance(ctx context.Context, filters AddressListFilter) ([]Address, error)
+	ListWithBalanceAfter(ctx context.Context, cursor uint64, limit int) ([]Address, error)
 	Series(ctx context.Context, addressId uint64, timeframe Timeframe, column string, req SeriesRequest) (items []HistogramItem, err error)
 	IdByHash(ctx context.Context, hash ...[]byte) ([]uint64, error)
 	IdByAddress(ctx context.Context, address string, ids ...uint64) (uint64, error)
 	Balances(ctx context.Context, addressId uint64, limit, offset int) ([]Balance, error)
+	BalancesAt(ctx context.Context, addressId uint64, height types.Level, limit, offset int) ([]Balance, error)
+	BalanceSeries(ctx context.Context, addressId uint64, denom string, timeframe Timeframe, req SeriesRequest) ([]BalanceSeriesItem, error)
 	AddressByString(ctx context.Context, readableHash string) (Address, error)
 }
 
@@ -48,6 +52,7 @@ type Address struct {
 	Balances       []Balance             `bun:"rel:has-many,join:id=id"`
 	DefaultBalance *Balance              `bun:"rel:has-one,join:id=id"`
 	Celestials     *celestials.Celestial `bun:"rel:has-one,join:id=address_id"`
+	Label          *AddressLabel         `bun:"rel:has-one,join:id=address_id"`
 }
 
 // TableName -
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type AddressLabelFilters struct {
+	Limit    int
+	Offset   int
+	Category []types.AddressLabelCategory
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IAddressLabel interface {
+	storage.Table[*AddressLabel]
+
+	List(ctx context.Context, fltrs AddressLabelFilters) ([]AddressLabel, error)
+	ByAddressId(ctx context.Context, addressId uint64) (AddressLabel, error)
+	ByAddressIds(ctx context.Context, addressIds ...uint64) ([]AddressLabel, error)
+	ByMessages(ctx context.Context, msgIds ...uint64) ([]MessageLabel, error)
+	Delete(ctx context.Context, id uint64) error
+}
+
+// AddressLabel - human-readable name and category of the address with attribution of the source. Address has at most one label.
+type AddressLabel struct {
+	bun.BaseModel `bun:"address_label" comment:"Table with address labels"`
+
+	Id        uint64                     `bun:"id,pk,notnull,autoincrement"               comment:"Unique internal identity"`
+	AddressId uint64                     `bun:"address_id,notnull,unique:address_label_idx" comment:"Address internal id"`
+	ApiKey    string                     `bun:"api_key,notnull"                           comment:"Api key which created the label"`
+	Name      string                     `bun:"name,notnull"                              comment:"Label name"`
+	Category  types.AddressLabelCategory `bun:"category,type:address_label_category"      comment:"Label category"`
+	Source    string                     `bun:"source"                                    comment:"Source of the label: link or name of the data provider"`
+	CreatedAt time.Time                  `bun:"created_at,notnull"                        comment:"Creation time"`
+	UpdatedAt time.Time                  `bun:"updated_at,notnull"                        comment:"Time of last update"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (AddressLabel) TableName() string {
+	return "address_label"
+}
+
+// MessageLabel - label of the address linked to the message
+type MessageLabel struct {
+	MsgId    uint64                     `bun:"msg_id"`
+	Address  string                     `bun:"address"`
+	Name     string                     `bun:"name"`
+	Category types.AddressLabelCategory `bun:"category"`
+	Source   string                     `bun:"source"`
+}
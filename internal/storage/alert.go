@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IAlertRule interface {
+	storage.Table[*AlertRule]
+
+	ByApiKey(ctx context.Context, key string, limit, offset int) ([]AlertRule, error)
+	Active(ctx context.Context) ([]AlertRule, error)
+	Delete(ctx context.Context, id uint64) error
+}
+
+// AlertRule - user-defined rule which is evaluated against blobs of rollup or namespace
+type AlertRule struct {
+	bun.BaseModel `bun:"alert_rule" comment:"Table with user-defined alert rules"`
+
+	Id          uint64              `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	ApiKey      string              `bun:"api_key,notnull"              comment:"Owner api key"`
+	Name        string              `bun:"name"                         comment:"Human-readable rule name"`
+	Kind        types.AlertRuleKind `bun:"kind,type:alert_rule_kind"    comment:"Rule kind"`
+	RollupId    uint64              `bun:"rollup_id"                    comment:"Rollup internal id. Rule is evaluated against all providers of rollup"`
+	NamespaceId uint64              `bun:"namespace_id"                 comment:"Namespace internal id. Used if rollup is not set"`
+	Threshold   types.Numeric       `bun:"threshold,type:numeric"       comment:"Threshold value. Seconds for no_blob, utia for hourly_fee and bytes for size rules"`
+	Active      bool                `bun:"active"                       comment:"Is rule active"`
+	CreatedAt   time.Time           `bun:"created_at,notnull"           comment:"Creation time"`
+	UpdatedAt   time.Time           `bun:"updated_at,notnull"           comment:"Time of last update"`
+}
+
+// TableName -
+func (AlertRule) TableName() string {
+	return "alert_rule"
+}
+
+type AlertFilters struct {
+	Limit       int
+	Offset      int
+	RollupId    uint64
+	NamespaceId uint64
+}
+
+type IAlert interface {
+	storage.Table[*Alert]
+
+	ActiveList(ctx context.Context, fltrs AlertFilters) ([]Alert, error)
+	Open(ctx context.Context, alert *Alert) (bool, error)
+	Resolve(ctx context.Context, ruleId uint64, resolvedAt time.Time) ([]Alert, error)
+
+	LastBlobTime(ctx context.Context, rule AlertRule) (time.Time, error)
+	HourlyFee(ctx context.Context, rule AlertRule, hour time.Time) (types.Numeric, error)
+	HourlySize(ctx context.Context, rule AlertRule, hour time.Time) (int64, error)
+	BlobSizeP99(ctx context.Context, rule AlertRule, from time.Time) (float64, error)
+}
+
+// Alert - triggered alert rule. Alert is active until it is resolved.
+type Alert struct {
+	bun.BaseModel `bun:"alert" comment:"Table with triggered alerts"`
+
+	Id          uint64              `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	RuleId      uint64              `bun:"rule_id,notnull"              comment:"Alert rule internal identity"`
+	Kind        types.AlertRuleKind `bun:"kind,type:alert_rule_kind"    comment:"Rule kind"`
+	RollupId    uint64              `bun:"rollup_id"                    comment:"Rollup internal id"`
+	NamespaceId uint64              `bun:"namespace_id"                 comment:"Namespace internal id"`
+	Value       types.Numeric       `bun:"value,type:numeric"           comment:"Metric value at the moment of triggering"`
+	Threshold   types.Numeric       `bun:"threshold,type:numeric"       comment:"Rule threshold at the moment of triggering"`
+	StartedAt   time.Time           `bun:"started_at,notnull"           comment:"Time when alert was triggered"`
+	ResolvedAt  *time.Time          `bun:"resolved_at"                  comment:"Time when alert was resolved. NULL while alert is active"`
+
+	Rule *AlertRule `bun:"rel:belongs-to,join:rule_id=id"`
+}
+
+// TableName -
+func (Alert) TableName() string {
+	return "alert"
+}
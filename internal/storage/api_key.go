@@ -5,23 +5,61 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
 
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/uptrace/bun"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IApiKey interface {
 	Get(ctx context.Context, key string) (ApiKey, error)
+	BySecret(ctx context.Context, secret string) (ApiKey, error)
+	List(ctx context.Context, limit, offset int) ([]ApiKey, error)
+	Save(ctx context.Context, key *ApiKey) error
+	Update(ctx context.Context, key *ApiKey) error
+	SetLastUsed(ctx context.Context, key string, ts time.Time) error
+	IsNoRows(err error) bool
 }
 
 type ApiKey struct {
-	bun.BaseModel `bun:"apikey" comment:"Table with private api keys"`
+	bun.BaseModel `bun:"apikey" comment:"Table with api keys"`
 
-	Key         string `bun:"key,pk,notnull" comment:"Key"`
-	Description string `bun:"description"    comment:"Additional info about issuer and user"`
-	Admin       bool   `bun:"admin"          comment:"Verified user"`
+	Key         string            `bun:"key,pk,notnull"                                      comment:"Public identity of the key. It's used as owner of webhooks, alerts and labels."`
+	SecretHash  string            `bun:"secret_hash,notnull,unique:apikey_secret_hash"       comment:"SHA-256 hash of the secret which is passed in requests"`
+	Description string            `bun:"description"                                         comment:"Additional info about issuer and user"`
+	Scope       types.ApiKeyScope `bun:"scope,type:api_key_scope,notnull,default:'standard'" comment:"Access scope of the key in private API"`
+	Tier        types.ApiKeyTier  `bun:"tier,type:api_key_tier,notnull,default:'free'"       comment:"Tier of the key which defines limits in public API"`
+	RateLimit   int64             `bun:"rate_limit,notnull,default:0"                        comment:"Requests per second in public API. Overrides limit of the tier if it's positive."`
+	DailyQuota  int64             `bun:"daily_quota,notnull,default:0"                       comment:"Requests per day in public API. Overrides quota of the tier if it's positive."`
+	CreatedAt   time.Time         `bun:"created_at,notnull,default:current_timestamp"        comment:"Creation time"`
+	RotatedAt   time.Time         `bun:"rotated_at,nullzero"                                 comment:"Time of the last secret rotation"`
+	ExpiresAt   time.Time         `bun:"expires_at,nullzero"                                 comment:"Expiration time. Key never expires if it's null."`
+	RevokedAt   time.Time         `bun:"revoked_at,nullzero"                                 comment:"Revocation time"`
+	LastUsedAt  time.Time         `bun:"last_used_at,nullzero"                               comment:"Time of the last request with the key"`
 }
 
 func (ApiKey) TableName() string {
 	return "apikey"
 }
+
+// IsAdmin - returns true if the key has access to all endpoints of private API
+func (key ApiKey) IsAdmin() bool {
+	return key.Scope == types.ApiKeyScopeAdmin
+}
+
+// IsActive - returns true if the key is neither revoked nor expired at the time
+func (key ApiKey) IsActive(now time.Time) bool {
+	if !key.RevokedAt.IsZero() {
+		return false
+	}
+	return key.ExpiresAt.IsZero() || now.Before(key.ExpiresAt)
+}
+
+// HashApiKeySecret - returns hex-encoded SHA-256 hash of the secret. Secrets are stored only in hashed form.
+func HashApiKeySecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
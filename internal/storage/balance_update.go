@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/uptrace/bun"
+)
+
+// BalanceUpdate - balance delta of the address applied in the block
+type BalanceUpdate struct {
+	bun.BaseModel `bun:"balance_update" comment:"Table with per-block changes of account balances."`
+
+	Id        uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height    pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time      time.Time      `bun:"time,pk,notnull"             comment:"The time of block"`
+	AddressId uint64         `bun:"address_id,notnull"          comment:"Foreign key to addresses table"`
+	Currency  string         `bun:"currency,notnull"            comment:"Balance currency"`
+	Spendable types.Numeric  `bun:"spendable,type:numeric"      comment:"Spendable balance change"`
+	Delegated types.Numeric  `bun:"delegated,type:numeric"      comment:"Delegated balance change"`
+	Unbonding types.Numeric  `bun:"unbonding,type:numeric"      comment:"Unbonding balance change"`
+	Slashing  bool           `bun:"slashing,default:false"      comment:"Change is caused by slashing of validator"`
+}
+
+func (BalanceUpdate) TableName() string {
+	return "balance_update"
+}
+
+func (bu BalanceUpdate) IsEmpty() bool {
+	return bu.Spendable.IsZero() && bu.Delegated.IsZero() && bu.Unbonding.IsZero()
+}
+
+// NewBalanceUpdate - creates balance update from the balance delta
+func NewBalanceUpdate(height pkgTypes.Level, ts time.Time, balance Balance) BalanceUpdate {
+	return BalanceUpdate{
+		Height:    height,
+		Time:      ts,
+		AddressId: balance.Id,
+		Currency:  balance.Currency,
+		Spendable: balance.Spendable,
+		Delegated: balance.Delegated,
+		Unbonding: balance.Unbonding,
+	}
+}
+
+type BalanceSeriesItem struct {
+	Time      time.Time     `bun:"bucket"`
+	Spendable types.Numeric `bun:"spendable"`
+	Delegated types.Numeric `bun:"delegated"`
+	Unbonding types.Numeric `bun:"unbonding"`
+}
@@ -25,6 +25,8 @@ type IBlock interface {
 	ByHash(ctx context.Context, hash []byte) (Block, error)
 	ByProposer(ctx context.Context, proposerId uint64, limit, offset int) ([]Block, error)
 	ListWithStats(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*Block, error)
+	ListWithStatsAfter(ctx context.Context, cursor uint64, limit int) ([]*Block, error)
+	ByProposerAfter(ctx context.Context, proposerId, cursor uint64, limit int) ([]Block, error)
 	Time(ctx context.Context, height pkgTypes.Level) (time.Time, error)
 }
 
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/uptrace/bun"
+)
+
+// EffectiveVote - effective vote of the delegation: the delegator's own vote or the vote inherited from the validator
+// if the delegator didn't vote. Weighted votes produce a row per option. Effective votes are recorded when the proposal
+// is finished and computed on demand from the current delegations for active proposals.
+type EffectiveVote struct {
+	bun.BaseModel `bun:"effective_vote" comment:"Table with effective votes of delegations in finished proposals"`
+
+	Id          uint64           `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height      pkgTypes.Level   `bun:"height,notnull"              comment:"The number (height) of the block when proposal was finished"`
+	ProposalId  uint64           `bun:"proposal_id,notnull"         comment:"Proposal id"`
+	AddressId   uint64           `bun:"address_id,notnull"          comment:"Delegator internal identity"`
+	ValidatorId uint64           `bun:"validator_id,notnull"        comment:"Validator internal identity"`
+	Option      types.VoteOption `bun:"option,type:vote_option"     comment:"Effective vote option"`
+	Weight      types.Numeric    `bun:"weight,type:numeric"         comment:"Weight of the option"`
+	VotingPower types.Numeric    `bun:"voting_power,type:numeric"   comment:"Voting power of the delegation given to the option"`
+	Inherited   bool             `bun:"inherited"                   comment:"Vote is inherited from the validator"`
+
+	Address   *Address   `bun:"rel:belongs-to,join:address_id=id"`
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+	Proposal  *Proposal  `bun:"rel:belongs-to,join:proposal_id=id"`
+}
+
+// TableName -
+func (EffectiveVote) TableName() string {
+	return "effective_vote"
+}
+
+type EffectiveVoteFilters struct {
+	Limit       int
+	Offset      int
+	Option      []types.VoteOption
+	Inherited   *bool
+	AddressId   *uint64
+	ValidatorId *uint64
+}
@@ -22,6 +22,7 @@ type EventFilter struct {
 	Limit  int
 	Offset int
 	Time   time.Time
+	Cursor uint64
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -21,11 +21,13 @@ var Models = []any{
 	&Constant{},
 	&DenomMetadata{},
 	&Balance{},
+	&BalanceUpdate{},
 	&Address{},
 	&VestingAccount{},
 	&VestingPeriod{},
 	&Block{},
 	&BlockStats{},
+	&Supply{},
 	&BlockSignature{},
 	&Tx{},
 	&Message{},
@@ -36,19 +38,32 @@ var Models = []any{
 	&MsgAddress{},
 	&MsgValidator{},
 	&Validator{},
+	&ValidatorRate{},
 	&Delegation{},
 	&Redelegation{},
 	&Undelegation{},
 	&StakingLog{},
 	&Jail{},
 	&BlobLog{},
+	&ShareRange{},
 	&Rollup{},
 	&RollupProvider{},
+	&RollupCandidate{},
+	&RollupAudit{},
 	&Grant{},
 	&ApiKey{},
+	&Webhook{},
+	&WebhookDelivery{},
+	&WebhookTask{},
+	&AlertRule{},
+	&Alert{},
+	&AddressLabel{},
+	&MempoolTx{},
 	&celestials.Celestial{},
 	&celestials.CelestialState{},
 	&Proposal{},
+	&ProposalTally{},
+	&EffectiveVote{},
 	&Vote{},
 	&IbcClient{},
 	&IbcConnection{},
@@ -94,16 +109,20 @@ type Transaction interface {
 	SaveTransactions(ctx context.Context, txs ...Tx) error
 	SaveNamespaces(ctx context.Context, namespaces ...*Namespace) (int64, error)
 	SaveAddresses(ctx context.Context, addresses ...*Address) (int64, error)
+	MergeAddresses(ctx context.Context, addresses ...*Address) (int64, error)
 	SaveVestingAccounts(ctx context.Context, accounts ...*VestingAccount) error
 	SaveVestingPeriods(ctx context.Context, periods ...VestingPeriod) error
 	SaveBalances(ctx context.Context, balances ...Balance) error
+	SaveBalanceUpdates(ctx context.Context, updates ...BalanceUpdate) error
 	SaveMessages(ctx context.Context, msgs ...*Message) error
 	SaveSigners(ctx context.Context, addresses ...Signer) error
 	SaveMsgAddresses(ctx context.Context, addresses ...*MsgAddress) error
 	SaveMsgValidator(ctx context.Context, validatorMsgs ...MsgValidator) error
 	SaveNamespaceMessage(ctx context.Context, nsMsgs ...*NamespaceMessage) error
 	SaveBlobLogs(ctx context.Context, logs ...*BlobLog) error
+	SaveShareRanges(ctx context.Context, ranges ...ShareRange) error
 	SaveValidators(ctx context.Context, validators ...*Validator) (int, error)
+	SaveValidatorRates(ctx context.Context, rates ...ValidatorRate) error
 	SaveEvents(ctx context.Context, events ...Event) error
 	SaveRollup(ctx context.Context, rollup *Rollup) error
 	SaveGrants(ctx context.Context, grants ...*Grant) error
@@ -118,10 +137,13 @@ type Transaction interface {
 	SaveBlockSignatures(ctx context.Context, signs ...BlockSignature) error
 	SaveProposals(ctx context.Context, proposals ...*Proposal) (int64, error)
 	SaveVotes(ctx context.Context, votes ...*Vote) (map[uint64]*VotesCount, error)
+	SaveProposalTallies(ctx context.Context, tallies ...ProposalTally) error
+	SaveEffectiveVotes(ctx context.Context, proposalId uint64, height pkgTypes.Level) error
 	SaveIbcClients(ctx context.Context, clients ...*IbcClient) (int64, error)
 	SaveIbcConnections(ctx context.Context, connections ...*IbcConnection) error
 	SaveIbcChannels(ctx context.Context, channels ...*IbcChannel) error
 	SaveIbcTransfers(ctx context.Context, transfers ...*IbcTransfer) error
+	ResolveIbcTransfer(ctx context.Context, transfer *IbcTransfer) (bool, error)
 	SaveHyperlaneMailbox(ctx context.Context, mailbox ...*HLMailbox) error
 	SaveHyperlaneTokens(ctx context.Context, tokens ...*HLToken) error
 	SaveHyperlaneTransfers(ctx context.Context, transfers ...*HLTransfer) error
@@ -152,7 +174,8 @@ type Transaction interface {
 	RollbackNamespaceMessages(ctx context.Context, height pkgTypes.Level) (msgs []NamespaceMessage, err error)
 	RollbackNamespaces(ctx context.Context, height pkgTypes.Level) (ns []Namespace, err error)
 	RollbackValidators(ctx context.Context, height pkgTypes.Level) ([]Validator, error)
-	RollbackBlobLog(ctx context.Context, height pkgTypes.Level) error
+	RollbackBlobLog(ctx context.Context, height pkgTypes.Level) ([]BlobLog, error)
+	RollbackShareRanges(ctx context.Context, height pkgTypes.Level) error
 	RollbackGrants(ctx context.Context, height pkgTypes.Level) error
 	RollbackBlockSignatures(ctx context.Context, height pkgTypes.Level) (err error)
 	RollbackSigners(ctx context.Context, txIds []uint64) (err error)
@@ -162,8 +185,12 @@ type Transaction interface {
 	RollbackRedelegations(ctx context.Context, height pkgTypes.Level) (err error)
 	RollbackStakingLogs(ctx context.Context, height pkgTypes.Level) ([]StakingLog, error)
 	RollbackJails(ctx context.Context, height pkgTypes.Level) ([]Jail, error)
+	RollbackValidatorRates(ctx context.Context, height pkgTypes.Level) error
 	RollbackProposals(ctx context.Context, height pkgTypes.Level) error
 	RollbackVotes(ctx context.Context, height pkgTypes.Level) error
+	RollbackProposalTallies(ctx context.Context, height pkgTypes.Level) error
+	RollbackEffectiveVotes(ctx context.Context, height pkgTypes.Level) error
+	RollbackSupply(ctx context.Context, height pkgTypes.Level) error
 	RollbackIbcClients(ctx context.Context, height pkgTypes.Level) error
 	RollbackIbcConnections(ctx context.Context, height pkgTypes.Level) error
 	RollbackIbcChannels(ctx context.Context, height pkgTypes.Level) error
@@ -180,6 +207,7 @@ type Transaction interface {
 	RollbackZkISMs(ctx context.Context, height pkgTypes.Level) error
 	RollbackZkISMUpdates(ctx context.Context, height pkgTypes.Level) error
 	RollbackZkISMMessages(ctx context.Context, height pkgTypes.Level) error
+	RollbackBalanceUpdates(ctx context.Context, height pkgTypes.Level) ([]BalanceUpdate, error)
 	ZkISMById(ctx context.Context, id []byte) (ZkISM, error)
 	DeleteBalances(ctx context.Context, ids []uint64) error
 	DeleteProviders(ctx context.Context, rollupId uint64) error
@@ -189,6 +217,11 @@ type Transaction interface {
 
 	State(ctx context.Context, name string) (state State, err error)
 	LastBlock(ctx context.Context) (block Block, err error)
+	LockedVesting(ctx context.Context, t time.Time) (types.Numeric, error)
+	StakingTotals(ctx context.Context, t time.Time) (staked types.Numeric, unbonding types.Numeric, err error)
+	LastCommunityPool(ctx context.Context) (types.Numeric, error)
+	UpdateCommunityPool(ctx context.Context, height pkgTypes.Level, t time.Time, amount types.Numeric) error
+	ShiftSupply(ctx context.Context, height pkgTypes.Level, total types.Numeric) error
 	Namespace(ctx context.Context, id uint64) (ns Namespace, err error)
 	LastNamespaceMessage(ctx context.Context, nsId uint64) (msg NamespaceMessage, err error)
 	LastAddressAction(ctx context.Context, address []byte) (uint64, error)
@@ -199,6 +232,8 @@ type Transaction interface {
 	AddressDelegations(ctx context.Context, addressId uint64) (val []Delegation, err error)
 	ActiveProposals(ctx context.Context) ([]Proposal, error)
 	ProposalVotes(ctx context.Context, proposalId uint64, limit, offset int) ([]Vote, error)
+	ProposalValidatorVotes(ctx context.Context, proposalId uint64) ([]Vote, error)
+	LastProposalTally(ctx context.Context, proposalId uint64) (ProposalTally, error)
 	Proposal(ctx context.Context, id uint64) (Proposal, error)
 	RefreshLeaderboard(ctx context.Context) error
 	IbcConnection(ctx context.Context, id string) (IbcConnection, error)
@@ -209,10 +244,30 @@ type Transaction interface {
 }
 
 const (
-	ChannelHead  = "head"
-	ChannelBlock = "block"
+	ChannelHead     = "head"
+	ChannelBlock    = "block"
+	ChannelTx       = "tx"
+	ChannelMessage  = "message"
+	ChannelBlob     = "blob"
+	ChannelJail     = "jail"
+	ChannelProposal = "proposal"
+	ChannelAlert    = "alert"
+	ChannelMempool  = "mempool"
+	ChannelRollback = "rollback"
+	ChannelLabel    = "address_label"
+	ChannelAddress  = "address"
+	ChannelApiKey   = "api_key"
 )
 
+// MaxNotificationPayloadSize - maximum size of notification payload in bytes. Postgres limits it with 8000 bytes.
+const MaxNotificationPayloadSize = 7900
+
+// Rollback - payload of `rollback` notification channel. Blocks after ToHeight up to FromHeight inclusively were removed.
+type Rollback struct {
+	FromHeight pkgTypes.Level `json:"from_height"`
+	ToHeight   pkgTypes.Level `json:"to_height"`
+}
+
 type Signal struct {
 	VotingPower types.Numeric `bun:"voting_power"`
 	Version     uint64        `bun:"version"`
@@ -224,10 +279,36 @@ type SearchResult struct {
 	Type  string `bun:"type"`
 }
 
+// Types of documents indexed by full-text search
+const (
+	SearchTypeProposal    = "proposal"
+	SearchTypeRollup      = "rollup"
+	SearchTypeValidator   = "validator"
+	SearchTypeTx          = "tx"
+	SearchTypeIbcTransfer = "ibc_transfer"
+)
+
+type FullTextFilters struct {
+	Query  string
+	Types  []string
+	Limit  int
+	Offset int
+}
+
+// FullTextResult - document matched by full-text search with its rank and highlighted snippet
+type FullTextResult struct {
+	Id      uint64  `bun:"id"`
+	Type    string  `bun:"type"`
+	Rank    float64 `bun:"rank"`
+	Snippet string  `bun:"snippet"`
+}
+
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type ISearch interface {
 	Search(ctx context.Context, query []byte) ([]SearchResult, error)
 	SearchText(ctx context.Context, text string) ([]SearchResult, error)
+	IsNoRows(err error) bool
+	FullText(ctx context.Context, fltrs FullTextFilters) ([]FullTextResult, error)
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -14,16 +14,17 @@ import (
 )
 
 type ListHyperlaneTransferFilters struct {
-	Limit     int
-	Offset    int
-	Sort      sdk.SortOrder
-	MailboxId uint64
-	AddressId uint64
-	TokenId   uint64
-	TxId      uint64
-	RelayerId uint64
-	Type      []types.HLTransferType
-	Domain    uint64
+	Limit          int
+	Offset         int
+	Sort           sdk.SortOrder
+	MailboxId      uint64
+	AddressId      uint64
+	TokenId        uint64
+	TxId           uint64
+	RelayerId      uint64
+	Type           []types.HLTransferType
+	Domain         uint64
+	DeliveryStatus types.HLDeliveryStatus
 }
 
 type DomainStats struct {
@@ -32,6 +33,17 @@ type DomainStats struct {
 	TxCount uint64        `bun:"tx_count"`
 }
 
+// DomainLatency - delivery latency percentiles of the messages sent to or received from the domain. Latency is measured in seconds.
+type DomainLatency struct {
+	Domain      uint64               `bun:"domain_id"`
+	Type        types.HLTransferType `bun:"type"`
+	Delivered   uint64               `bun:"delivered"`
+	Undelivered uint64               `bun:"undelivered"`
+	P50         float64              `bun:"p50"`
+	P90         float64              `bun:"p90"`
+	P99         float64              `bun:"p99"`
+}
+
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IHLTransfer interface {
 	ById(ctx context.Context, id uint64) (HLTransfer, error)
@@ -39,28 +51,38 @@ type IHLTransfer interface {
 	Series(ctx context.Context, domainId uint64, timeframe Timeframe, column string, req SeriesRequest) (items []HistogramItem, err error)
 	TotalSeries(ctx context.Context, timeframe Timeframe, column string, req SeriesRequest) (items []HistogramItem, err error)
 	StatsByDomain(ctx context.Context, limit, offset int) ([]DomainStats, error)
+	LatencyByDomain(ctx context.Context, from time.Time) ([]DomainLatency, error)
+	Undelivered(ctx context.Context, since time.Time, maxAttempts int, fromId uint64, limit int) ([]HLTransfer, error)
+	UpdateDelivery(ctx context.Context, transfers ...HLTransfer) error
+	IncDeliveryAttempts(ctx context.Context, transfers ...HLTransfer) error
 }
 
 type HLTransfer struct {
 	bun.BaseModel `bun:"hl_transfer" comment:"Table with hyperlane transfers"`
 
-	Id                  uint64               `bun:"id,pk,autoincrement"           comment:"Internal identity"`
-	Height              pkgTypes.Level       `bun:"height,notnull"                comment:"The number (height) of this block"`
-	Time                time.Time            `bun:"time,pk,notnull"               comment:"The time of block"`
-	TxId                uint64               `bun:"tx_id"                         comment:"Transaction identity"`
-	MailboxId           uint64               `bun:"mailbox_id"                    comment:"Mailbox address"`
-	RelayerId           uint64               `bun:"relayer_id"                    comment:"Relayer address"`
-	TokenId             uint64               `bun:"token_id"                      comment:"Token id"`
-	Counterparty        uint64               `bun:"counterparty"                  comment:"Counterparty domain"`
-	AddressId           uint64               `bun:"address_id"                    comment:"Internal celestia address identity"`
-	CounterpartyAddress string               `bun:"counterparty_address"          comment:"Counterparty address"`
-	Version             byte                 `bun:"version"                       comment:"Version"`
-	Nonce               uint32               `bun:"nonce"                         comment:"Nonce"`
-	Body                []byte               `bun:"body,type:bytea,nullzero"      comment:"Body"`
-	Metadata            []byte               `bun:"metadata,type:bytea,nullzero"  comment:"Metadata"`
-	Type                types.HLTransferType `bun:",type:hyperlane_transfer_type" comment:"Transfer type"`
-	Amount              types.Numeric        `bun:"amount,type:numeric"           comment:"Amount"`
-	Denom               string               `bun:"denom"                         comment:"Denom"`
+	Id                  uint64                 `bun:"id,pk,autoincrement"                                     comment:"Internal identity"`
+	Height              pkgTypes.Level         `bun:"height,notnull"                                          comment:"The number (height) of this block"`
+	Time                time.Time              `bun:"time,pk,notnull"                                         comment:"The time of block"`
+	TxId                uint64                 `bun:"tx_id"                                                   comment:"Transaction identity"`
+	MailboxId           uint64                 `bun:"mailbox_id"                                              comment:"Mailbox address"`
+	RelayerId           uint64                 `bun:"relayer_id"                                              comment:"Relayer address"`
+	TokenId             uint64                 `bun:"token_id"                                                comment:"Token id"`
+	Counterparty        uint64                 `bun:"counterparty"                                            comment:"Counterparty domain"`
+	AddressId           uint64                 `bun:"address_id"                                              comment:"Internal celestia address identity"`
+	CounterpartyAddress string                 `bun:"counterparty_address"                                    comment:"Counterparty address"`
+	Version             byte                   `bun:"version"                                                 comment:"Version"`
+	Nonce               uint32                 `bun:"nonce"                                                   comment:"Nonce"`
+	Body                []byte                 `bun:"body,type:bytea,nullzero"                                comment:"Body"`
+	Metadata            []byte                 `bun:"metadata,type:bytea,nullzero"                            comment:"Metadata"`
+	Type                types.HLTransferType   `bun:",type:hyperlane_transfer_type"                           comment:"Transfer type"`
+	Amount              types.Numeric          `bun:"amount,type:numeric"                                     comment:"Amount"`
+	Denom               string                 `bun:"denom"                                                   comment:"Denom"`
+	MessageId           []byte                 `bun:"message_id,type:bytea,nullzero"                          comment:"Hyperlane message id"`
+	DeliveryStatus      types.HLDeliveryStatus `bun:"delivery_status,type:hyperlane_delivery_status,nullzero" comment:"Delivery status of the message"`
+	DispatchedAt        *time.Time             `bun:"dispatched_at"                                           comment:"Time when the message was dispatched on origin chain"`
+	DeliveredAt         *time.Time             `bun:"delivered_at"                                            comment:"Time when the message was processed on destination chain"`
+	CounterpartyTxHash  string                 `bun:"counterparty_tx_hash"                                    comment:"Hash of the transaction on counterparty chain: delivery for sent messages and dispatch for received ones"`
+	DeliveryAttempts    int                    `bun:"delivery_attempts,default:0"                             comment:"Count of delivery info requests to hyperlane explorer"`
 
 	Mailbox    *HLMailbox    `bun:"rel:belongs-to,join:mailbox_id=id"`
 	Relayer    *Address      `bun:"rel:belongs-to,join:relayer_id=id"`
@@ -73,3 +95,11 @@ type HLTransfer struct {
 func (m *HLTransfer) TableName() string {
 	return "hl_transfer"
 }
+
+// Latency - time between dispatch on origin chain and processing on destination chain. It returns 0 if the message is not delivered yet.
+func (m *HLTransfer) Latency() time.Duration {
+	if m.DispatchedAt == nil || m.DeliveredAt == nil {
+		return 0
+	}
+	return m.DeliveredAt.Sub(*m.DispatchedAt)
+}
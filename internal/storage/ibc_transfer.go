@@ -30,11 +30,13 @@ type ListIbcTransferFilters struct {
 	AddressId     *uint64
 	ChannelId     string
 	ConnectionIds []string
+	Status        types.IbcTransferStatus
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IIbcTransfer interface {
 	ById(ctx context.Context, id uint64) (IbcTransferWithSigner, error)
+	ByIds(ctx context.Context, ids ...uint64) ([]IbcTransferWithSigner, error)
 	List(ctx context.Context, fltrs ListIbcTransferFilters) ([]IbcTransferWithSigner, error)
 	Series(ctx context.Context, channelId string, timeframe Timeframe, column string, req SeriesRequest) (items []HistogramItem, err error)
 	LargestTransfer24h(ctx context.Context) (IbcTransfer, error)
@@ -43,25 +45,29 @@ type IIbcTransfer interface {
 type IbcTransfer struct {
 	bun.BaseModel `bun:"ibc_transfer" comment:"Table with IBC transfers."`
 
-	Id              uint64         `bun:"id,pk,autoincrement"     comment:"Transfer internal identity"`
-	Time            time.Time      `bun:"time,notnull,pk"         comment:"Message time"`
-	Height          pkgTypes.Level `bun:"height"                  comment:"Block number"`
-	Amount          types.Numeric  `bun:"amount,type:numeric"     comment:"Transferred amount"`
-	Denom           string         `bun:"denom"                   comment:"Currency"`
-	Memo            string         `bun:"memo"                    comment:"Memo"`
-	ReceiverAddress *string        `bun:"receiver_address"        comment:"Receiver string. It's not null if it's not celestia address."`
-	ReceiverId      *uint64        `bun:"receiver_id"             comment:"Receiver id. It's not null if it's celestia address."`
-	SenderAddress   *string        `bun:"sender_address"          comment:"Sender string. It's not null if it's not celestia address."`
-	SenderId        *uint64        `bun:"sender_id"               comment:"Sender id. It's not null if it's celestia address."`
-	ConnectionId    string         `bun:"connection_id"           comment:"Connection identity"`
-	ChannelId       string         `bun:"channel_id"              comment:"Channel identity"`
-	Port            string         `bun:"port"                    comment:"Port"`
-	Timeout         *time.Time     `bun:"timeout,nullzero"        comment:"Date-time timeout"`
-	HeightTimeout   uint64         `bun:"height_timeout,nullzero" comment:"Height timeout"`
-	Sequence        uint64         `bun:"sequence"                comment:"Sequence number of packet"`
-	TxId            uint64         `bun:"tx_id"                   comment:"Transaction id where transfer occurred"`
+	Id              uint64                  `bun:"id,pk,autoincrement"             comment:"Transfer internal identity"`
+	Time            time.Time               `bun:"time,notnull,pk"                 comment:"Message time"`
+	Height          pkgTypes.Level          `bun:"height"                          comment:"Block number"`
+	Amount          types.Numeric           `bun:"amount,type:numeric"             comment:"Transferred amount"`
+	Denom           string                  `bun:"denom"                           comment:"Currency"`
+	Memo            string                  `bun:"memo"                            comment:"Memo"`
+	ReceiverAddress *string                 `bun:"receiver_address"                comment:"Receiver string. It's not null if it's not celestia address."`
+	ReceiverId      *uint64                 `bun:"receiver_id"                     comment:"Receiver id. It's not null if it's celestia address."`
+	SenderAddress   *string                 `bun:"sender_address"                  comment:"Sender string. It's not null if it's not celestia address."`
+	SenderId        *uint64                 `bun:"sender_id"                       comment:"Sender id. It's not null if it's celestia address."`
+	ConnectionId    string                  `bun:"connection_id"                   comment:"Connection identity"`
+	ChannelId       string                  `bun:"channel_id"                      comment:"Channel identity"`
+	Port            string                  `bun:"port"                            comment:"Port"`
+	Timeout         *time.Time              `bun:"timeout,nullzero"                comment:"Date-time timeout"`
+	HeightTimeout   uint64                  `bun:"height_timeout,nullzero"         comment:"Height timeout"`
+	Sequence        uint64                  `bun:"sequence"                        comment:"Sequence number of packet"`
+	TxId            uint64                  `bun:"tx_id"                           comment:"Transaction id where transfer occurred"`
+	Status          types.IbcTransferStatus `bun:"status,type:ibc_transfer_status" comment:"Packet lifecycle status"`
+	ResolveTxId     *uint64                 `bun:"resolve_tx_id"                   comment:"Transaction id where packet was acknowledged or timed out"`
+	ResolveHeight   pkgTypes.Level          `bun:"resolve_height,nullzero"         comment:"Block number where packet was acknowledged or timed out"`
 
 	Tx         *Tx            `bun:"rel:belongs-to,join:tx_id=id"`
+	ResolveTx  *Tx            `bun:"rel:belongs-to,join:resolve_tx_id=id"`
 	Receiver   *Address       `bun:"rel:belongs-to,join:receiver_id=id"`
 	Sender     *Address       `bun:"rel:belongs-to,join:sender_id=id"`
 	Connection *IbcConnection `bun:"rel:belongs-to,join:connection_id=connection_id"`
@@ -70,3 +76,18 @@ type IbcTransfer struct {
 func (IbcTransfer) TableName() string {
 	return "ibc_transfer"
 }
+
+// IsOutgoing - returns true if packet was sent from Celestia. Such transfers are resolved by acknowledgement or timeout.
+func (t IbcTransfer) IsOutgoing() bool {
+	switch t.Status {
+	case types.IbcTransferStatusPending, types.IbcTransferStatusAcknowledged, types.IbcTransferStatusRefunded, types.IbcTransferStatusTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsResolution - returns true if transfer was created by acknowledgement or timeout of the packet
+func (t IbcTransfer) IsResolution() bool {
+	return t.IsOutgoing() && t.Status != types.IbcTransferStatusPending
+}
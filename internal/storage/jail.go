@@ -30,6 +30,7 @@ type Jail struct {
 	ValidatorId uint64         `bun:"validator_id,notnull"        comment:"Internal validator id"`
 	Reason      string         `bun:"reason"                      comment:"Reason"`
 	Burned      types.Numeric  `bun:"burned,type:numeric"         comment:"Burned coins"`
+	EventId     *uint64        `bun:"event_id"                    comment:"Internal event id. It's set to jails restored from slash events by migration"`
 
 	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
 }
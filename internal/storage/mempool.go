@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IMempoolTx interface {
+	storage.Table[*MempoolTx]
+
+	Insert(ctx context.Context, txs ...*MempoolTx) error
+	ByHash(ctx context.Context, hash []byte) (MempoolTx, error)
+	Pending(ctx context.Context) ([]MempoolTx, error)
+	List(ctx context.Context, fltrs MempoolFilters) ([]MempoolTx, error)
+	LastIncluded(ctx context.Context, limit int) ([]MempoolTx, error)
+	DeleteFinished(ctx context.Context, before time.Time) (int, error)
+}
+
+type MempoolFilters struct {
+	Limit  int
+	Offset int
+	Sort   storage.SortOrder
+	Status []string
+	Signer string
+}
+
+// MempoolTx - transaction observed in the node's mempool. Row is kept until retention period is over after the transaction was included or dropped.
+type MempoolTx struct {
+	bun.BaseModel `bun:"mempool_tx" comment:"Table with transactions observed in mempool"`
+
+	Id            uint64                `bun:"id,pk,notnull,autoincrement"     comment:"Unique internal identity"`
+	Hash          []byte                `bun:"hash,notnull,unique:mempool_tx_hash_idx" comment:"Transaction hash"`
+	Status        types.MempoolTxStatus `bun:"status,type:mempool_tx_status"   comment:"Transaction status"`
+	FirstSeen     time.Time             `bun:"first_seen,notnull"              comment:"Time when transaction was observed in mempool first time"`
+	SeenHeight    pkgTypes.Level        `bun:"seen_height"                     comment:"Last indexed block height at the moment of the first observation"`
+	GasWanted     int64                 `bun:"gas_wanted"                      comment:"Gas limit of transaction"`
+	Fee           types.Numeric         `bun:"fee,type:numeric"                comment:"Fee of transaction"`
+	GasPrice      types.Numeric         `bun:"gas_price,type:numeric"          comment:"Offered gas price"`
+	Size          int64                 `bun:"size"                            comment:"Size of transaction in bytes"`
+	BlobsCount    int                   `bun:"blobs_count"                     comment:"Count of blobs in transaction"`
+	BlobsSize     int64                 `bun:"blobs_size"                      comment:"Summary size of blobs in transaction"`
+	Signers       []string              `bun:"signers,array"                   comment:"Addresses of transaction signers"`
+	MessageTypes  []string              `bun:"message_types,array"             comment:"Types of transaction messages"`
+	Memo          string                `bun:"memo,type:text"                  comment:"Note or comment to send with the transaction"`
+	Height        pkgTypes.Level        `bun:"height"                          comment:"Height of block which includes the transaction"`
+	FinishedAt    *time.Time            `bun:"finished_at"                     comment:"Time when transaction was included or dropped"`
+	InclusionTime int64                 `bun:"inclusion_time"                  comment:"Time from the first observation to inclusion in milliseconds"`
+}
+
+// TableName -
+func (MempoolTx) TableName() string {
+	return "mempool_tx"
+}
+
+// InclusionBlocks - count of blocks transaction waited in mempool
+func (tx MempoolTx) InclusionBlocks() int64 {
+	if tx.Height == 0 || tx.Height < tx.SeenHeight {
+		return 0
+	}
+	return int64(tx.Height - tx.SeenHeight)
+}
@@ -50,6 +50,7 @@ type IMessage interface {
 	storage.Table[*Message]
 
 	ByTxId(ctx context.Context, txId uint64, limit, offset int) ([]Message, error)
+	ByTxIdAfter(ctx context.Context, txId, cursor uint64, limit int) ([]Message, error)
 	ListWithTx(ctx context.Context, filters MessageListWithTxFilters) ([]MessageWithTx, error)
 	ByAddress(ctx context.Context, id uint64, filters AddressMsgsFilter) ([]AddressMessageWithTx, error)
 }
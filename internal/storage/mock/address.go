@@ -17,6 +17,7 @@ import (
 	reflect "reflect"
 
 	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -84,6 +85,45 @@ func (c *MockIAddressAddressByStringCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// BalanceSeries mocks base method.
+func (m *MockIAddress) BalanceSeries(ctx context.Context, addressId uint64, denom string, timeframe storage.Timeframe, req storage.SeriesRequest) ([]storage.BalanceSeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceSeries", ctx, addressId, denom, timeframe, req)
+	ret0, _ := ret[0].([]storage.BalanceSeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceSeries indicates an expected call of BalanceSeries.
+func (mr *MockIAddressMockRecorder) BalanceSeries(ctx, addressId, denom, timeframe, req any) *MockIAddressBalanceSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceSeries", reflect.TypeOf((*MockIAddress)(nil).BalanceSeries), ctx, addressId, denom, timeframe, req)
+	return &MockIAddressBalanceSeriesCall{Call: call}
+}
+
+// MockIAddressBalanceSeriesCall wrap *gomock.Call
+type MockIAddressBalanceSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressBalanceSeriesCall) Return(arg0 []storage.BalanceSeriesItem, arg1 error) *MockIAddressBalanceSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressBalanceSeriesCall) Do(f func(context.Context, uint64, string, storage.Timeframe, storage.SeriesRequest) ([]storage.BalanceSeriesItem, error)) *MockIAddressBalanceSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressBalanceSeriesCall) DoAndReturn(f func(context.Context, uint64, string, storage.Timeframe, storage.SeriesRequest) ([]storage.BalanceSeriesItem, error)) *MockIAddressBalanceSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Balances mocks base method.
 func (m *MockIAddress) Balances(ctx context.Context, addressId uint64, limit, offset int) ([]storage.Balance, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +163,45 @@ func (c *MockIAddressBalancesCall) DoAndReturn(f func(context.Context, uint64, i
 	return c
 }
 
+// BalancesAt mocks base method.
+func (m *MockIAddress) BalancesAt(ctx context.Context, addressId uint64, height types.Level, limit, offset int) ([]storage.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalancesAt", ctx, addressId, height, limit, offset)
+	ret0, _ := ret[0].([]storage.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalancesAt indicates an expected call of BalancesAt.
+func (mr *MockIAddressMockRecorder) BalancesAt(ctx, addressId, height, limit, offset any) *MockIAddressBalancesAtCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalancesAt", reflect.TypeOf((*MockIAddress)(nil).BalancesAt), ctx, addressId, height, limit, offset)
+	return &MockIAddressBalancesAtCall{Call: call}
+}
+
+// MockIAddressBalancesAtCall wrap *gomock.Call
+type MockIAddressBalancesAtCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressBalancesAtCall) Return(arg0 []storage.Balance, arg1 error) *MockIAddressBalancesAtCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressBalancesAtCall) Do(f func(context.Context, uint64, types.Level, int, int) ([]storage.Balance, error)) *MockIAddressBalancesAtCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressBalancesAtCall) DoAndReturn(f func(context.Context, uint64, types.Level, int, int) ([]storage.Balance, error)) *MockIAddressBalancesAtCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByHash mocks base method.
 func (m *MockIAddress) ByHash(ctx context.Context, hash []byte) (storage.Address, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +319,50 @@ func (c *MockIAddressGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*
 	return c
 }
 
+// GetByIds mocks base method.
+func (m *MockIAddress) GetByIds(ctx context.Context, ids ...uint64) ([]storage.Address, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockIAddressMockRecorder) GetByIds(ctx any, ids ...any) *MockIAddressGetByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockIAddress)(nil).GetByIds), varargs...)
+	return &MockIAddressGetByIdsCall{Call: call}
+}
+
+// MockIAddressGetByIdsCall wrap *gomock.Call
+type MockIAddressGetByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressGetByIdsCall) Return(arg0 []storage.Address, arg1 error) *MockIAddressGetByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressGetByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Address, error)) *MockIAddressGetByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressGetByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Address, error)) *MockIAddressGetByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IdByAddress mocks base method.
 func (m *MockIAddress) IdByAddress(ctx context.Context, address string, ids ...uint64) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -483,6 +606,45 @@ func (c *MockIAddressListWithBalanceCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// ListWithBalanceAfter mocks base method.
+func (m *MockIAddress) ListWithBalanceAfter(ctx context.Context, cursor uint64, limit int) ([]storage.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithBalanceAfter", ctx, cursor, limit)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithBalanceAfter indicates an expected call of ListWithBalanceAfter.
+func (mr *MockIAddressMockRecorder) ListWithBalanceAfter(ctx, cursor, limit any) *MockIAddressListWithBalanceAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithBalanceAfter", reflect.TypeOf((*MockIAddress)(nil).ListWithBalanceAfter), ctx, cursor, limit)
+	return &MockIAddressListWithBalanceAfterCall{Call: call}
+}
+
+// MockIAddressListWithBalanceAfterCall wrap *gomock.Call
+type MockIAddressListWithBalanceAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressListWithBalanceAfterCall) Return(arg0 []storage.Address, arg1 error) *MockIAddressListWithBalanceAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressListWithBalanceAfterCall) Do(f func(context.Context, uint64, int) ([]storage.Address, error)) *MockIAddressListWithBalanceAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressListWithBalanceAfterCall) DoAndReturn(f func(context.Context, uint64, int) ([]storage.Address, error)) *MockIAddressListWithBalanceAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIAddress) Save(ctx context.Context, m *storage.Address) error {
 	m_2.ctrl.T.Helper()
@@ -0,0 +1,481 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: address_label.go
+//
+// Generated by this command:
+//
+//	mockgen -source=address_label.go -destination=mock/address_label.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAddressLabel is a mock of IAddressLabel interface.
+type MockIAddressLabel struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAddressLabelMockRecorder
+	isgomock struct{}
+}
+
+// MockIAddressLabelMockRecorder is the mock recorder for MockIAddressLabel.
+type MockIAddressLabelMockRecorder struct {
+	mock *MockIAddressLabel
+}
+
+// NewMockIAddressLabel creates a new mock instance.
+func NewMockIAddressLabel(ctrl *gomock.Controller) *MockIAddressLabel {
+	mock := &MockIAddressLabel{ctrl: ctrl}
+	mock.recorder = &MockIAddressLabelMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAddressLabel) EXPECT() *MockIAddressLabelMockRecorder {
+	return m.recorder
+}
+
+// ByAddressId mocks base method.
+func (m *MockIAddressLabel) ByAddressId(ctx context.Context, addressId uint64) (storage.AddressLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByAddressId", ctx, addressId)
+	ret0, _ := ret[0].(storage.AddressLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAddressId indicates an expected call of ByAddressId.
+func (mr *MockIAddressLabelMockRecorder) ByAddressId(ctx, addressId any) *MockIAddressLabelByAddressIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAddressId", reflect.TypeOf((*MockIAddressLabel)(nil).ByAddressId), ctx, addressId)
+	return &MockIAddressLabelByAddressIdCall{Call: call}
+}
+
+// MockIAddressLabelByAddressIdCall wrap *gomock.Call
+type MockIAddressLabelByAddressIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelByAddressIdCall) Return(arg0 storage.AddressLabel, arg1 error) *MockIAddressLabelByAddressIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelByAddressIdCall) Do(f func(context.Context, uint64) (storage.AddressLabel, error)) *MockIAddressLabelByAddressIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelByAddressIdCall) DoAndReturn(f func(context.Context, uint64) (storage.AddressLabel, error)) *MockIAddressLabelByAddressIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByAddressIds mocks base method.
+func (m *MockIAddressLabel) ByAddressIds(ctx context.Context, addressIds ...uint64) ([]storage.AddressLabel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addressIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByAddressIds", varargs...)
+	ret0, _ := ret[0].([]storage.AddressLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAddressIds indicates an expected call of ByAddressIds.
+func (mr *MockIAddressLabelMockRecorder) ByAddressIds(ctx any, addressIds ...any) *MockIAddressLabelByAddressIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addressIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAddressIds", reflect.TypeOf((*MockIAddressLabel)(nil).ByAddressIds), varargs...)
+	return &MockIAddressLabelByAddressIdsCall{Call: call}
+}
+
+// MockIAddressLabelByAddressIdsCall wrap *gomock.Call
+type MockIAddressLabelByAddressIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelByAddressIdsCall) Return(arg0 []storage.AddressLabel, arg1 error) *MockIAddressLabelByAddressIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelByAddressIdsCall) Do(f func(context.Context, ...uint64) ([]storage.AddressLabel, error)) *MockIAddressLabelByAddressIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelByAddressIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.AddressLabel, error)) *MockIAddressLabelByAddressIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByMessages mocks base method.
+func (m *MockIAddressLabel) ByMessages(ctx context.Context, msgIds ...uint64) ([]storage.MessageLabel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range msgIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByMessages", varargs...)
+	ret0, _ := ret[0].([]storage.MessageLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByMessages indicates an expected call of ByMessages.
+func (mr *MockIAddressLabelMockRecorder) ByMessages(ctx any, msgIds ...any) *MockIAddressLabelByMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, msgIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByMessages", reflect.TypeOf((*MockIAddressLabel)(nil).ByMessages), varargs...)
+	return &MockIAddressLabelByMessagesCall{Call: call}
+}
+
+// MockIAddressLabelByMessagesCall wrap *gomock.Call
+type MockIAddressLabelByMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelByMessagesCall) Return(arg0 []storage.MessageLabel, arg1 error) *MockIAddressLabelByMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelByMessagesCall) Do(f func(context.Context, ...uint64) ([]storage.MessageLabel, error)) *MockIAddressLabelByMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelByMessagesCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.MessageLabel, error)) *MockIAddressLabelByMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIAddressLabel) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.AddressLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.AddressLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIAddressLabelMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIAddressLabelCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIAddressLabel)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIAddressLabelCursorListCall{Call: call}
+}
+
+// MockIAddressLabelCursorListCall wrap *gomock.Call
+type MockIAddressLabelCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelCursorListCall) Return(arg0 []*storage.AddressLabel, arg1 error) *MockIAddressLabelCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.AddressLabel, error)) *MockIAddressLabelCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.AddressLabel, error)) *MockIAddressLabelCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockIAddressLabel) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIAddressLabelMockRecorder) Delete(ctx, id any) *MockIAddressLabelDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIAddressLabel)(nil).Delete), ctx, id)
+	return &MockIAddressLabelDeleteCall{Call: call}
+}
+
+// MockIAddressLabelDeleteCall wrap *gomock.Call
+type MockIAddressLabelDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelDeleteCall) Return(arg0 error) *MockIAddressLabelDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelDeleteCall) Do(f func(context.Context, uint64) error) *MockIAddressLabelDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelDeleteCall) DoAndReturn(f func(context.Context, uint64) error) *MockIAddressLabelDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIAddressLabel) GetByID(ctx context.Context, id uint64) (*storage.AddressLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.AddressLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIAddressLabelMockRecorder) GetByID(ctx, id any) *MockIAddressLabelGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIAddressLabel)(nil).GetByID), ctx, id)
+	return &MockIAddressLabelGetByIDCall{Call: call}
+}
+
+// MockIAddressLabelGetByIDCall wrap *gomock.Call
+type MockIAddressLabelGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelGetByIDCall) Return(arg0 *storage.AddressLabel, arg1 error) *MockIAddressLabelGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelGetByIDCall) Do(f func(context.Context, uint64) (*storage.AddressLabel, error)) *MockIAddressLabelGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.AddressLabel, error)) *MockIAddressLabelGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIAddressLabel) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIAddressLabelMockRecorder) IsNoRows(err any) *MockIAddressLabelIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIAddressLabel)(nil).IsNoRows), err)
+	return &MockIAddressLabelIsNoRowsCall{Call: call}
+}
+
+// MockIAddressLabelIsNoRowsCall wrap *gomock.Call
+type MockIAddressLabelIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelIsNoRowsCall) Return(arg0 bool) *MockIAddressLabelIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelIsNoRowsCall) Do(f func(error) bool) *MockIAddressLabelIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIAddressLabelIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIAddressLabel) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIAddressLabelMockRecorder) LastID(ctx any) *MockIAddressLabelLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIAddressLabel)(nil).LastID), ctx)
+	return &MockIAddressLabelLastIDCall{Call: call}
+}
+
+// MockIAddressLabelLastIDCall wrap *gomock.Call
+type MockIAddressLabelLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelLastIDCall) Return(arg0 uint64, arg1 error) *MockIAddressLabelLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIAddressLabelLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIAddressLabelLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIAddressLabel) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.AddressLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.AddressLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIAddressLabelMockRecorder) List(ctx, limit, offset, order any) *MockIAddressLabelListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIAddressLabel)(nil).List), ctx, limit, offset, order)
+	return &MockIAddressLabelListCall{Call: call}
+}
+
+// MockIAddressLabelListCall wrap *gomock.Call
+type MockIAddressLabelListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelListCall) Return(arg0 []*storage.AddressLabel, arg1 error) *MockIAddressLabelListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.AddressLabel, error)) *MockIAddressLabelListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.AddressLabel, error)) *MockIAddressLabelListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIAddressLabel) Save(ctx context.Context, m *storage.AddressLabel) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIAddressLabelMockRecorder) Save(ctx, m any) *MockIAddressLabelSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIAddressLabel)(nil).Save), ctx, m)
+	return &MockIAddressLabelSaveCall{Call: call}
+}
+
+// MockIAddressLabelSaveCall wrap *gomock.Call
+type MockIAddressLabelSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelSaveCall) Return(arg0 error) *MockIAddressLabelSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelSaveCall) Do(f func(context.Context, *storage.AddressLabel) error) *MockIAddressLabelSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelSaveCall) DoAndReturn(f func(context.Context, *storage.AddressLabel) error) *MockIAddressLabelSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIAddressLabel) Update(ctx context.Context, m *storage.AddressLabel) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAddressLabelMockRecorder) Update(ctx, m any) *MockIAddressLabelUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAddressLabel)(nil).Update), ctx, m)
+	return &MockIAddressLabelUpdateCall{Call: call}
+}
+
+// MockIAddressLabelUpdateCall wrap *gomock.Call
+type MockIAddressLabelUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressLabelUpdateCall) Return(arg0 error) *MockIAddressLabelUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressLabelUpdateCall) Do(f func(context.Context, *storage.AddressLabel) error) *MockIAddressLabelUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressLabelUpdateCall) DoAndReturn(f func(context.Context, *storage.AddressLabel) error) *MockIAddressLabelUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,1001 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: alert.go
+//
+// Generated by this command:
+//
+//	mockgen -source=alert.go -destination=mock/alert.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAlertRule is a mock of IAlertRule interface.
+type MockIAlertRule struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAlertRuleMockRecorder
+	isgomock struct{}
+}
+
+// MockIAlertRuleMockRecorder is the mock recorder for MockIAlertRule.
+type MockIAlertRuleMockRecorder struct {
+	mock *MockIAlertRule
+}
+
+// NewMockIAlertRule creates a new mock instance.
+func NewMockIAlertRule(ctrl *gomock.Controller) *MockIAlertRule {
+	mock := &MockIAlertRule{ctrl: ctrl}
+	mock.recorder = &MockIAlertRuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAlertRule) EXPECT() *MockIAlertRuleMockRecorder {
+	return m.recorder
+}
+
+// Active mocks base method.
+func (m *MockIAlertRule) Active(ctx context.Context) ([]storage.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Active", ctx)
+	ret0, _ := ret[0].([]storage.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Active indicates an expected call of Active.
+func (mr *MockIAlertRuleMockRecorder) Active(ctx any) *MockIAlertRuleActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockIAlertRule)(nil).Active), ctx)
+	return &MockIAlertRuleActiveCall{Call: call}
+}
+
+// MockIAlertRuleActiveCall wrap *gomock.Call
+type MockIAlertRuleActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleActiveCall) Return(arg0 []storage.AlertRule, arg1 error) *MockIAlertRuleActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleActiveCall) Do(f func(context.Context) ([]storage.AlertRule, error)) *MockIAlertRuleActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleActiveCall) DoAndReturn(f func(context.Context) ([]storage.AlertRule, error)) *MockIAlertRuleActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByApiKey mocks base method.
+func (m *MockIAlertRule) ByApiKey(ctx context.Context, key string, limit, offset int) ([]storage.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByApiKey", ctx, key, limit, offset)
+	ret0, _ := ret[0].([]storage.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByApiKey indicates an expected call of ByApiKey.
+func (mr *MockIAlertRuleMockRecorder) ByApiKey(ctx, key, limit, offset any) *MockIAlertRuleByApiKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByApiKey", reflect.TypeOf((*MockIAlertRule)(nil).ByApiKey), ctx, key, limit, offset)
+	return &MockIAlertRuleByApiKeyCall{Call: call}
+}
+
+// MockIAlertRuleByApiKeyCall wrap *gomock.Call
+type MockIAlertRuleByApiKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleByApiKeyCall) Return(arg0 []storage.AlertRule, arg1 error) *MockIAlertRuleByApiKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleByApiKeyCall) Do(f func(context.Context, string, int, int) ([]storage.AlertRule, error)) *MockIAlertRuleByApiKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleByApiKeyCall) DoAndReturn(f func(context.Context, string, int, int) ([]storage.AlertRule, error)) *MockIAlertRuleByApiKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIAlertRule) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIAlertRuleMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIAlertRuleCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIAlertRule)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIAlertRuleCursorListCall{Call: call}
+}
+
+// MockIAlertRuleCursorListCall wrap *gomock.Call
+type MockIAlertRuleCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleCursorListCall) Return(arg0 []*storage.AlertRule, arg1 error) *MockIAlertRuleCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.AlertRule, error)) *MockIAlertRuleCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.AlertRule, error)) *MockIAlertRuleCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockIAlertRule) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIAlertRuleMockRecorder) Delete(ctx, id any) *MockIAlertRuleDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIAlertRule)(nil).Delete), ctx, id)
+	return &MockIAlertRuleDeleteCall{Call: call}
+}
+
+// MockIAlertRuleDeleteCall wrap *gomock.Call
+type MockIAlertRuleDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleDeleteCall) Return(arg0 error) *MockIAlertRuleDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleDeleteCall) Do(f func(context.Context, uint64) error) *MockIAlertRuleDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleDeleteCall) DoAndReturn(f func(context.Context, uint64) error) *MockIAlertRuleDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIAlertRule) GetByID(ctx context.Context, id uint64) (*storage.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIAlertRuleMockRecorder) GetByID(ctx, id any) *MockIAlertRuleGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIAlertRule)(nil).GetByID), ctx, id)
+	return &MockIAlertRuleGetByIDCall{Call: call}
+}
+
+// MockIAlertRuleGetByIDCall wrap *gomock.Call
+type MockIAlertRuleGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleGetByIDCall) Return(arg0 *storage.AlertRule, arg1 error) *MockIAlertRuleGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleGetByIDCall) Do(f func(context.Context, uint64) (*storage.AlertRule, error)) *MockIAlertRuleGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.AlertRule, error)) *MockIAlertRuleGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIAlertRule) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIAlertRuleMockRecorder) IsNoRows(err any) *MockIAlertRuleIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIAlertRule)(nil).IsNoRows), err)
+	return &MockIAlertRuleIsNoRowsCall{Call: call}
+}
+
+// MockIAlertRuleIsNoRowsCall wrap *gomock.Call
+type MockIAlertRuleIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleIsNoRowsCall) Return(arg0 bool) *MockIAlertRuleIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleIsNoRowsCall) Do(f func(error) bool) *MockIAlertRuleIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIAlertRuleIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIAlertRule) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIAlertRuleMockRecorder) LastID(ctx any) *MockIAlertRuleLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIAlertRule)(nil).LastID), ctx)
+	return &MockIAlertRuleLastIDCall{Call: call}
+}
+
+// MockIAlertRuleLastIDCall wrap *gomock.Call
+type MockIAlertRuleLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleLastIDCall) Return(arg0 uint64, arg1 error) *MockIAlertRuleLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIAlertRuleLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIAlertRuleLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIAlertRule) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIAlertRuleMockRecorder) List(ctx, limit, offset, order any) *MockIAlertRuleListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIAlertRule)(nil).List), ctx, limit, offset, order)
+	return &MockIAlertRuleListCall{Call: call}
+}
+
+// MockIAlertRuleListCall wrap *gomock.Call
+type MockIAlertRuleListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleListCall) Return(arg0 []*storage.AlertRule, arg1 error) *MockIAlertRuleListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.AlertRule, error)) *MockIAlertRuleListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.AlertRule, error)) *MockIAlertRuleListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIAlertRule) Save(ctx context.Context, m *storage.AlertRule) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIAlertRuleMockRecorder) Save(ctx, m any) *MockIAlertRuleSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIAlertRule)(nil).Save), ctx, m)
+	return &MockIAlertRuleSaveCall{Call: call}
+}
+
+// MockIAlertRuleSaveCall wrap *gomock.Call
+type MockIAlertRuleSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleSaveCall) Return(arg0 error) *MockIAlertRuleSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleSaveCall) Do(f func(context.Context, *storage.AlertRule) error) *MockIAlertRuleSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleSaveCall) DoAndReturn(f func(context.Context, *storage.AlertRule) error) *MockIAlertRuleSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIAlertRule) Update(ctx context.Context, m *storage.AlertRule) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAlertRuleMockRecorder) Update(ctx, m any) *MockIAlertRuleUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAlertRule)(nil).Update), ctx, m)
+	return &MockIAlertRuleUpdateCall{Call: call}
+}
+
+// MockIAlertRuleUpdateCall wrap *gomock.Call
+type MockIAlertRuleUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertRuleUpdateCall) Return(arg0 error) *MockIAlertRuleUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertRuleUpdateCall) Do(f func(context.Context, *storage.AlertRule) error) *MockIAlertRuleUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertRuleUpdateCall) DoAndReturn(f func(context.Context, *storage.AlertRule) error) *MockIAlertRuleUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockIAlert is a mock of IAlert interface.
+type MockIAlert struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAlertMockRecorder
+	isgomock struct{}
+}
+
+// MockIAlertMockRecorder is the mock recorder for MockIAlert.
+type MockIAlertMockRecorder struct {
+	mock *MockIAlert
+}
+
+// NewMockIAlert creates a new mock instance.
+func NewMockIAlert(ctrl *gomock.Controller) *MockIAlert {
+	mock := &MockIAlert{ctrl: ctrl}
+	mock.recorder = &MockIAlertMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAlert) EXPECT() *MockIAlertMockRecorder {
+	return m.recorder
+}
+
+// ActiveList mocks base method.
+func (m *MockIAlert) ActiveList(ctx context.Context, fltrs storage.AlertFilters) ([]storage.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveList", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveList indicates an expected call of ActiveList.
+func (mr *MockIAlertMockRecorder) ActiveList(ctx, fltrs any) *MockIAlertActiveListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveList", reflect.TypeOf((*MockIAlert)(nil).ActiveList), ctx, fltrs)
+	return &MockIAlertActiveListCall{Call: call}
+}
+
+// MockIAlertActiveListCall wrap *gomock.Call
+type MockIAlertActiveListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertActiveListCall) Return(arg0 []storage.Alert, arg1 error) *MockIAlertActiveListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertActiveListCall) Do(f func(context.Context, storage.AlertFilters) ([]storage.Alert, error)) *MockIAlertActiveListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertActiveListCall) DoAndReturn(f func(context.Context, storage.AlertFilters) ([]storage.Alert, error)) *MockIAlertActiveListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BlobSizeP99 mocks base method.
+func (m *MockIAlert) BlobSizeP99(ctx context.Context, rule storage.AlertRule, from time.Time) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobSizeP99", ctx, rule, from)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlobSizeP99 indicates an expected call of BlobSizeP99.
+func (mr *MockIAlertMockRecorder) BlobSizeP99(ctx, rule, from any) *MockIAlertBlobSizeP99Call {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobSizeP99", reflect.TypeOf((*MockIAlert)(nil).BlobSizeP99), ctx, rule, from)
+	return &MockIAlertBlobSizeP99Call{Call: call}
+}
+
+// MockIAlertBlobSizeP99Call wrap *gomock.Call
+type MockIAlertBlobSizeP99Call struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertBlobSizeP99Call) Return(arg0 float64, arg1 error) *MockIAlertBlobSizeP99Call {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertBlobSizeP99Call) Do(f func(context.Context, storage.AlertRule, time.Time) (float64, error)) *MockIAlertBlobSizeP99Call {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertBlobSizeP99Call) DoAndReturn(f func(context.Context, storage.AlertRule, time.Time) (float64, error)) *MockIAlertBlobSizeP99Call {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIAlert) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIAlertMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIAlertCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIAlert)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIAlertCursorListCall{Call: call}
+}
+
+// MockIAlertCursorListCall wrap *gomock.Call
+type MockIAlertCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertCursorListCall) Return(arg0 []*storage.Alert, arg1 error) *MockIAlertCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Alert, error)) *MockIAlertCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Alert, error)) *MockIAlertCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIAlert) GetByID(ctx context.Context, id uint64) (*storage.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIAlertMockRecorder) GetByID(ctx, id any) *MockIAlertGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIAlert)(nil).GetByID), ctx, id)
+	return &MockIAlertGetByIDCall{Call: call}
+}
+
+// MockIAlertGetByIDCall wrap *gomock.Call
+type MockIAlertGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertGetByIDCall) Return(arg0 *storage.Alert, arg1 error) *MockIAlertGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertGetByIDCall) Do(f func(context.Context, uint64) (*storage.Alert, error)) *MockIAlertGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Alert, error)) *MockIAlertGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HourlyFee mocks base method.
+func (m *MockIAlert) HourlyFee(ctx context.Context, rule storage.AlertRule, hour time.Time) (types.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HourlyFee", ctx, rule, hour)
+	ret0, _ := ret[0].(types.Numeric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HourlyFee indicates an expected call of HourlyFee.
+func (mr *MockIAlertMockRecorder) HourlyFee(ctx, rule, hour any) *MockIAlertHourlyFeeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HourlyFee", reflect.TypeOf((*MockIAlert)(nil).HourlyFee), ctx, rule, hour)
+	return &MockIAlertHourlyFeeCall{Call: call}
+}
+
+// MockIAlertHourlyFeeCall wrap *gomock.Call
+type MockIAlertHourlyFeeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertHourlyFeeCall) Return(arg0 types.Numeric, arg1 error) *MockIAlertHourlyFeeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertHourlyFeeCall) Do(f func(context.Context, storage.AlertRule, time.Time) (types.Numeric, error)) *MockIAlertHourlyFeeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertHourlyFeeCall) DoAndReturn(f func(context.Context, storage.AlertRule, time.Time) (types.Numeric, error)) *MockIAlertHourlyFeeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HourlySize mocks base method.
+func (m *MockIAlert) HourlySize(ctx context.Context, rule storage.AlertRule, hour time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HourlySize", ctx, rule, hour)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HourlySize indicates an expected call of HourlySize.
+func (mr *MockIAlertMockRecorder) HourlySize(ctx, rule, hour any) *MockIAlertHourlySizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HourlySize", reflect.TypeOf((*MockIAlert)(nil).HourlySize), ctx, rule, hour)
+	return &MockIAlertHourlySizeCall{Call: call}
+}
+
+// MockIAlertHourlySizeCall wrap *gomock.Call
+type MockIAlertHourlySizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertHourlySizeCall) Return(arg0 int64, arg1 error) *MockIAlertHourlySizeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertHourlySizeCall) Do(f func(context.Context, storage.AlertRule, time.Time) (int64, error)) *MockIAlertHourlySizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertHourlySizeCall) DoAndReturn(f func(context.Context, storage.AlertRule, time.Time) (int64, error)) *MockIAlertHourlySizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIAlert) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIAlertMockRecorder) IsNoRows(err any) *MockIAlertIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIAlert)(nil).IsNoRows), err)
+	return &MockIAlertIsNoRowsCall{Call: call}
+}
+
+// MockIAlertIsNoRowsCall wrap *gomock.Call
+type MockIAlertIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertIsNoRowsCall) Return(arg0 bool) *MockIAlertIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertIsNoRowsCall) Do(f func(error) bool) *MockIAlertIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIAlertIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastBlobTime mocks base method.
+func (m *MockIAlert) LastBlobTime(ctx context.Context, rule storage.AlertRule) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastBlobTime", ctx, rule)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastBlobTime indicates an expected call of LastBlobTime.
+func (mr *MockIAlertMockRecorder) LastBlobTime(ctx, rule any) *MockIAlertLastBlobTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastBlobTime", reflect.TypeOf((*MockIAlert)(nil).LastBlobTime), ctx, rule)
+	return &MockIAlertLastBlobTimeCall{Call: call}
+}
+
+// MockIAlertLastBlobTimeCall wrap *gomock.Call
+type MockIAlertLastBlobTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertLastBlobTimeCall) Return(arg0 time.Time, arg1 error) *MockIAlertLastBlobTimeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertLastBlobTimeCall) Do(f func(context.Context, storage.AlertRule) (time.Time, error)) *MockIAlertLastBlobTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertLastBlobTimeCall) DoAndReturn(f func(context.Context, storage.AlertRule) (time.Time, error)) *MockIAlertLastBlobTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIAlert) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIAlertMockRecorder) LastID(ctx any) *MockIAlertLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIAlert)(nil).LastID), ctx)
+	return &MockIAlertLastIDCall{Call: call}
+}
+
+// MockIAlertLastIDCall wrap *gomock.Call
+type MockIAlertLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertLastIDCall) Return(arg0 uint64, arg1 error) *MockIAlertLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIAlertLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIAlertLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIAlert) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIAlertMockRecorder) List(ctx, limit, offset, order any) *MockIAlertListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIAlert)(nil).List), ctx, limit, offset, order)
+	return &MockIAlertListCall{Call: call}
+}
+
+// MockIAlertListCall wrap *gomock.Call
+type MockIAlertListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertListCall) Return(arg0 []*storage.Alert, arg1 error) *MockIAlertListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Alert, error)) *MockIAlertListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Alert, error)) *MockIAlertListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Open mocks base method.
+func (m *MockIAlert) Open(ctx context.Context, alert *storage.Alert) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, alert)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockIAlertMockRecorder) Open(ctx, alert any) *MockIAlertOpenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockIAlert)(nil).Open), ctx, alert)
+	return &MockIAlertOpenCall{Call: call}
+}
+
+// MockIAlertOpenCall wrap *gomock.Call
+type MockIAlertOpenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertOpenCall) Return(arg0 bool, arg1 error) *MockIAlertOpenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertOpenCall) Do(f func(context.Context, *storage.Alert) (bool, error)) *MockIAlertOpenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertOpenCall) DoAndReturn(f func(context.Context, *storage.Alert) (bool, error)) *MockIAlertOpenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Resolve mocks base method.
+func (m *MockIAlert) Resolve(ctx context.Context, ruleId uint64, resolvedAt time.Time) ([]storage.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, ruleId, resolvedAt)
+	ret0, _ := ret[0].([]storage.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockIAlertMockRecorder) Resolve(ctx, ruleId, resolvedAt any) *MockIAlertResolveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockIAlert)(nil).Resolve), ctx, ruleId, resolvedAt)
+	return &MockIAlertResolveCall{Call: call}
+}
+
+// MockIAlertResolveCall wrap *gomock.Call
+type MockIAlertResolveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertResolveCall) Return(arg0 []storage.Alert, arg1 error) *MockIAlertResolveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertResolveCall) Do(f func(context.Context, uint64, time.Time) ([]storage.Alert, error)) *MockIAlertResolveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertResolveCall) DoAndReturn(f func(context.Context, uint64, time.Time) ([]storage.Alert, error)) *MockIAlertResolveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIAlert) Save(ctx context.Context, m *storage.Alert) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIAlertMockRecorder) Save(ctx, m any) *MockIAlertSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIAlert)(nil).Save), ctx, m)
+	return &MockIAlertSaveCall{Call: call}
+}
+
+// MockIAlertSaveCall wrap *gomock.Call
+type MockIAlertSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertSaveCall) Return(arg0 error) *MockIAlertSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertSaveCall) Do(f func(context.Context, *storage.Alert) error) *MockIAlertSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertSaveCall) DoAndReturn(f func(context.Context, *storage.Alert) error) *MockIAlertSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIAlert) Update(ctx context.Context, m *storage.Alert) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAlertMockRecorder) Update(ctx, m any) *MockIAlertUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAlert)(nil).Update), ctx, m)
+	return &MockIAlertUpdateCall{Call: call}
+}
+
+// MockIAlertUpdateCall wrap *gomock.Call
+type MockIAlertUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAlertUpdateCall) Return(arg0 error) *MockIAlertUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAlertUpdateCall) Do(f func(context.Context, *storage.Alert) error) *MockIAlertUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAlertUpdateCall) DoAndReturn(f func(context.Context, *storage.Alert) error) *MockIAlertUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
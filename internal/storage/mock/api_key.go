@@ -15,6 +15,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	storage "github.com/celenium-io/celestia-indexer/internal/storage"
 	gomock "go.uber.org/mock/gomock"
@@ -44,6 +45,45 @@ func (m *MockIApiKey) EXPECT() *MockIApiKeyMockRecorder {
 	return m.recorder
 }
 
+// BySecret mocks base method.
+func (m *MockIApiKey) BySecret(ctx context.Context, secret string) (storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BySecret", ctx, secret)
+	ret0, _ := ret[0].(storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BySecret indicates an expected call of BySecret.
+func (mr *MockIApiKeyMockRecorder) BySecret(ctx, secret any) *MockIApiKeyBySecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BySecret", reflect.TypeOf((*MockIApiKey)(nil).BySecret), ctx, secret)
+	return &MockIApiKeyBySecretCall{Call: call}
+}
+
+// MockIApiKeyBySecretCall wrap *gomock.Call
+type MockIApiKeyBySecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyBySecretCall) Return(arg0 storage.ApiKey, arg1 error) *MockIApiKeyBySecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyBySecretCall) Do(f func(context.Context, string) (storage.ApiKey, error)) *MockIApiKeyBySecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyBySecretCall) DoAndReturn(f func(context.Context, string) (storage.ApiKey, error)) *MockIApiKeyBySecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Get mocks base method.
 func (m *MockIApiKey) Get(ctx context.Context, key string) (storage.ApiKey, error) {
 	m.ctrl.T.Helper()
@@ -82,3 +122,194 @@ func (c *MockIApiKeyGetCall) DoAndReturn(f func(context.Context, string) (storag
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// IsNoRows mocks base method.
+func (m *MockIApiKey) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIApiKeyMockRecorder) IsNoRows(err any) *MockIApiKeyIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIApiKey)(nil).IsNoRows), err)
+	return &MockIApiKeyIsNoRowsCall{Call: call}
+}
+
+// MockIApiKeyIsNoRowsCall wrap *gomock.Call
+type MockIApiKeyIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyIsNoRowsCall) Return(arg0 bool) *MockIApiKeyIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyIsNoRowsCall) Do(f func(error) bool) *MockIApiKeyIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIApiKeyIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIApiKey) List(ctx context.Context, limit, offset int) ([]storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIApiKeyMockRecorder) List(ctx, limit, offset any) *MockIApiKeyListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIApiKey)(nil).List), ctx, limit, offset)
+	return &MockIApiKeyListCall{Call: call}
+}
+
+// MockIApiKeyListCall wrap *gomock.Call
+type MockIApiKeyListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyListCall) Return(arg0 []storage.ApiKey, arg1 error) *MockIApiKeyListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyListCall) Do(f func(context.Context, int, int) ([]storage.ApiKey, error)) *MockIApiKeyListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyListCall) DoAndReturn(f func(context.Context, int, int) ([]storage.ApiKey, error)) *MockIApiKeyListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m *MockIApiKey) Save(ctx context.Context, key *storage.ApiKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIApiKeyMockRecorder) Save(ctx, key any) *MockIApiKeySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIApiKey)(nil).Save), ctx, key)
+	return &MockIApiKeySaveCall{Call: call}
+}
+
+// MockIApiKeySaveCall wrap *gomock.Call
+type MockIApiKeySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeySaveCall) Return(arg0 error) *MockIApiKeySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeySaveCall) Do(f func(context.Context, *storage.ApiKey) error) *MockIApiKeySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeySaveCall) DoAndReturn(f func(context.Context, *storage.ApiKey) error) *MockIApiKeySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetLastUsed mocks base method.
+func (m *MockIApiKey) SetLastUsed(ctx context.Context, key string, ts time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastUsed", ctx, key, ts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastUsed indicates an expected call of SetLastUsed.
+func (mr *MockIApiKeyMockRecorder) SetLastUsed(ctx, key, ts any) *MockIApiKeySetLastUsedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastUsed", reflect.TypeOf((*MockIApiKey)(nil).SetLastUsed), ctx, key, ts)
+	return &MockIApiKeySetLastUsedCall{Call: call}
+}
+
+// MockIApiKeySetLastUsedCall wrap *gomock.Call
+type MockIApiKeySetLastUsedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeySetLastUsedCall) Return(arg0 error) *MockIApiKeySetLastUsedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeySetLastUsedCall) Do(f func(context.Context, string, time.Time) error) *MockIApiKeySetLastUsedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeySetLastUsedCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockIApiKeySetLastUsedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockIApiKey) Update(ctx context.Context, key *storage.ApiKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIApiKeyMockRecorder) Update(ctx, key any) *MockIApiKeyUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIApiKey)(nil).Update), ctx, key)
+	return &MockIApiKeyUpdateCall{Call: call}
+}
+
+// MockIApiKeyUpdateCall wrap *gomock.Call
+type MockIApiKeyUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyUpdateCall) Return(arg0 error) *MockIApiKeyUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyUpdateCall) Do(f func(context.Context, *storage.ApiKey) error) *MockIApiKeyUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyUpdateCall) DoAndReturn(f func(context.Context, *storage.ApiKey) error) *MockIApiKeyUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -242,6 +242,45 @@ func (c *MockIBlockByProposerCall) DoAndReturn(f func(context.Context, uint64, i
 	return c
 }
 
+// ByProposerAfter mocks base method.
+func (m *MockIBlock) ByProposerAfter(ctx context.Context, proposerId, cursor uint64, limit int) ([]storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByProposerAfter", ctx, proposerId, cursor, limit)
+	ret0, _ := ret[0].([]storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByProposerAfter indicates an expected call of ByProposerAfter.
+func (mr *MockIBlockMockRecorder) ByProposerAfter(ctx, proposerId, cursor, limit any) *MockIBlockByProposerAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByProposerAfter", reflect.TypeOf((*MockIBlock)(nil).ByProposerAfter), ctx, proposerId, cursor, limit)
+	return &MockIBlockByProposerAfterCall{Call: call}
+}
+
+// MockIBlockByProposerAfterCall wrap *gomock.Call
+type MockIBlockByProposerAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlockByProposerAfterCall) Return(arg0 []storage.Block, arg1 error) *MockIBlockByProposerAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlockByProposerAfterCall) Do(f func(context.Context, uint64, uint64, int) ([]storage.Block, error)) *MockIBlockByProposerAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlockByProposerAfterCall) DoAndReturn(f func(context.Context, uint64, uint64, int) ([]storage.Block, error)) *MockIBlockByProposerAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIBlock) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Block, error) {
 	m.ctrl.T.Helper()
@@ -514,6 +553,45 @@ func (c *MockIBlockListWithStatsCall) DoAndReturn(f func(context.Context, uint64
 	return c
 }
 
+// ListWithStatsAfter mocks base method.
+func (m *MockIBlock) ListWithStatsAfter(ctx context.Context, cursor uint64, limit int) ([]*storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithStatsAfter", ctx, cursor, limit)
+	ret0, _ := ret[0].([]*storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithStatsAfter indicates an expected call of ListWithStatsAfter.
+func (mr *MockIBlockMockRecorder) ListWithStatsAfter(ctx, cursor, limit any) *MockIBlockListWithStatsAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithStatsAfter", reflect.TypeOf((*MockIBlock)(nil).ListWithStatsAfter), ctx, cursor, limit)
+	return &MockIBlockListWithStatsAfterCall{Call: call}
+}
+
+// MockIBlockListWithStatsAfterCall wrap *gomock.Call
+type MockIBlockListWithStatsAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlockListWithStatsAfterCall) Return(arg0 []*storage.Block, arg1 error) *MockIBlockListWithStatsAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlockListWithStatsAfterCall) Do(f func(context.Context, uint64, int) ([]*storage.Block, error)) *MockIBlockListWithStatsAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlockListWithStatsAfterCall) DoAndReturn(f func(context.Context, uint64, int) ([]*storage.Block, error)) *MockIBlockListWithStatsAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIBlock) Save(ctx context.Context, m *storage.Block) error {
 	m_2.ctrl.T.Helper()
@@ -1263,6 +1263,45 @@ func (c *MockTransactionLastBlockCall) DoAndReturn(f func(context.Context) (stor
 	return c
 }
 
+// LastCommunityPool mocks base method.
+func (m *MockTransaction) LastCommunityPool(ctx context.Context) (types.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastCommunityPool", ctx)
+	ret0, _ := ret[0].(types.Numeric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastCommunityPool indicates an expected call of LastCommunityPool.
+func (mr *MockTransactionMockRecorder) LastCommunityPool(ctx any) *MockTransactionLastCommunityPoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastCommunityPool", reflect.TypeOf((*MockTransaction)(nil).LastCommunityPool), ctx)
+	return &MockTransactionLastCommunityPoolCall{Call: call}
+}
+
+// MockTransactionLastCommunityPoolCall wrap *gomock.Call
+type MockTransactionLastCommunityPoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionLastCommunityPoolCall) Return(arg0 types.Numeric, arg1 error) *MockTransactionLastCommunityPoolCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionLastCommunityPoolCall) Do(f func(context.Context) (types.Numeric, error)) *MockTransactionLastCommunityPoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionLastCommunityPoolCall) DoAndReturn(f func(context.Context) (types.Numeric, error)) *MockTransactionLastCommunityPoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // LastNamespaceMessage mocks base method.
 func (m *MockTransaction) LastNamespaceMessage(ctx context.Context, nsId uint64) (storage.NamespaceMessage, error) {
 	m.ctrl.T.Helper()
@@ -1302,6 +1341,128 @@ func (c *MockTransactionLastNamespaceMessageCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// LastProposalTally mocks base method.
+func (m *MockTransaction) LastProposalTally(ctx context.Context, proposalId uint64) (storage.ProposalTally, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastProposalTally", ctx, proposalId)
+	ret0, _ := ret[0].(storage.ProposalTally)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastProposalTally indicates an expected call of LastProposalTally.
+func (mr *MockTransactionMockRecorder) LastProposalTally(ctx, proposalId any) *MockTransactionLastProposalTallyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastProposalTally", reflect.TypeOf((*MockTransaction)(nil).LastProposalTally), ctx, proposalId)
+	return &MockTransactionLastProposalTallyCall{Call: call}
+}
+
+// MockTransactionLastProposalTallyCall wrap *gomock.Call
+type MockTransactionLastProposalTallyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionLastProposalTallyCall) Return(arg0 storage.ProposalTally, arg1 error) *MockTransactionLastProposalTallyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionLastProposalTallyCall) Do(f func(context.Context, uint64) (storage.ProposalTally, error)) *MockTransactionLastProposalTallyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionLastProposalTallyCall) DoAndReturn(f func(context.Context, uint64) (storage.ProposalTally, error)) *MockTransactionLastProposalTallyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LockedVesting mocks base method.
+func (m *MockTransaction) LockedVesting(ctx context.Context, t time.Time) (types.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockedVesting", ctx, t)
+	ret0, _ := ret[0].(types.Numeric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockedVesting indicates an expected call of LockedVesting.
+func (mr *MockTransactionMockRecorder) LockedVesting(ctx, t any) *MockTransactionLockedVestingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedVesting", reflect.TypeOf((*MockTransaction)(nil).LockedVesting), ctx, t)
+	return &MockTransactionLockedVestingCall{Call: call}
+}
+
+// MockTransactionLockedVestingCall wrap *gomock.Call
+type MockTransactionLockedVestingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionLockedVestingCall) Return(arg0 types.Numeric, arg1 error) *MockTransactionLockedVestingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionLockedVestingCall) Do(f func(context.Context, time.Time) (types.Numeric, error)) *MockTransactionLockedVestingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionLockedVestingCall) DoAndReturn(f func(context.Context, time.Time) (types.Numeric, error)) *MockTransactionLockedVestingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MergeAddresses mocks base method.
+func (m *MockTransaction) MergeAddresses(ctx context.Context, addresses ...*storage.Address) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeAddresses", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeAddresses indicates an expected call of MergeAddresses.
+func (mr *MockTransactionMockRecorder) MergeAddresses(ctx any, addresses ...any) *MockTransactionMergeAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeAddresses", reflect.TypeOf((*MockTransaction)(nil).MergeAddresses), varargs...)
+	return &MockTransactionMergeAddressesCall{Call: call}
+}
+
+// MockTransactionMergeAddressesCall wrap *gomock.Call
+type MockTransactionMergeAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionMergeAddressesCall) Return(arg0 int64, arg1 error) *MockTransactionMergeAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionMergeAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionMergeAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionMergeAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionMergeAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Namespace mocks base method.
 func (m *MockTransaction) Namespace(ctx context.Context, id uint64) (storage.Namespace, error) {
 	m.ctrl.T.Helper()
@@ -1418,6 +1579,45 @@ func (c *MockTransactionProposalCall) DoAndReturn(f func(context.Context, uint64
 	return c
 }
 
+// ProposalValidatorVotes mocks base method.
+func (m *MockTransaction) ProposalValidatorVotes(ctx context.Context, proposalId uint64) ([]storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalValidatorVotes", ctx, proposalId)
+	ret0, _ := ret[0].([]storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalValidatorVotes indicates an expected call of ProposalValidatorVotes.
+func (mr *MockTransactionMockRecorder) ProposalValidatorVotes(ctx, proposalId any) *MockTransactionProposalValidatorVotesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalValidatorVotes", reflect.TypeOf((*MockTransaction)(nil).ProposalValidatorVotes), ctx, proposalId)
+	return &MockTransactionProposalValidatorVotesCall{Call: call}
+}
+
+// MockTransactionProposalValidatorVotesCall wrap *gomock.Call
+type MockTransactionProposalValidatorVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionProposalValidatorVotesCall) Return(arg0 []storage.Vote, arg1 error) *MockTransactionProposalValidatorVotesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionProposalValidatorVotesCall) Do(f func(context.Context, uint64) ([]storage.Vote, error)) *MockTransactionProposalValidatorVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionProposalValidatorVotesCall) DoAndReturn(f func(context.Context, uint64) ([]storage.Vote, error)) *MockTransactionProposalValidatorVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ProposalVotes mocks base method.
 func (m *MockTransaction) ProposalVotes(ctx context.Context, proposalId uint64, limit, offset int) ([]storage.Vote, error) {
 	m.ctrl.T.Helper()
@@ -1495,6 +1695,45 @@ func (c *MockTransactionRefreshLeaderboardCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// ResolveIbcTransfer mocks base method.
+func (m *MockTransaction) ResolveIbcTransfer(ctx context.Context, transfer *storage.IbcTransfer) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveIbcTransfer", ctx, transfer)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveIbcTransfer indicates an expected call of ResolveIbcTransfer.
+func (mr *MockTransactionMockRecorder) ResolveIbcTransfer(ctx, transfer any) *MockTransactionResolveIbcTransferCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveIbcTransfer", reflect.TypeOf((*MockTransaction)(nil).ResolveIbcTransfer), ctx, transfer)
+	return &MockTransactionResolveIbcTransferCall{Call: call}
+}
+
+// MockTransactionResolveIbcTransferCall wrap *gomock.Call
+type MockTransactionResolveIbcTransferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionResolveIbcTransferCall) Return(arg0 bool, arg1 error) *MockTransactionResolveIbcTransferCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionResolveIbcTransferCall) Do(f func(context.Context, *storage.IbcTransfer) (bool, error)) *MockTransactionResolveIbcTransferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionResolveIbcTransferCall) DoAndReturn(f func(context.Context, *storage.IbcTransfer) (bool, error)) *MockTransactionResolveIbcTransferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RetentionBlockSignatures mocks base method.
 func (m *MockTransaction) RetentionBlockSignatures(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
@@ -1686,12 +1925,52 @@ func (c *MockTransactionRollbackAddressesCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// RollbackBalanceUpdates mocks base method.
+func (m *MockTransaction) RollbackBalanceUpdates(ctx context.Context, height types0.Level) ([]storage.BalanceUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackBalanceUpdates", ctx, height)
+	ret0, _ := ret[0].([]storage.BalanceUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackBalanceUpdates indicates an expected call of RollbackBalanceUpdates.
+func (mr *MockTransactionMockRecorder) RollbackBalanceUpdates(ctx, height any) *MockTransactionRollbackBalanceUpdatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBalanceUpdates", reflect.TypeOf((*MockTransaction)(nil).RollbackBalanceUpdates), ctx, height)
+	return &MockTransactionRollbackBalanceUpdatesCall{Call: call}
+}
+
+// MockTransactionRollbackBalanceUpdatesCall wrap *gomock.Call
+type MockTransactionRollbackBalanceUpdatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackBalanceUpdatesCall) Return(arg0 []storage.BalanceUpdate, arg1 error) *MockTransactionRollbackBalanceUpdatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackBalanceUpdatesCall) Do(f func(context.Context, types0.Level) ([]storage.BalanceUpdate, error)) *MockTransactionRollbackBalanceUpdatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackBalanceUpdatesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.BalanceUpdate, error)) *MockTransactionRollbackBalanceUpdatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackBlobLog mocks base method.
-func (m *MockTransaction) RollbackBlobLog(ctx context.Context, height types0.Level) error {
+func (m *MockTransaction) RollbackBlobLog(ctx context.Context, height types0.Level) ([]storage.BlobLog, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RollbackBlobLog", ctx, height)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]storage.BlobLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RollbackBlobLog indicates an expected call of RollbackBlobLog.
@@ -1707,19 +1986,19 @@ type MockTransactionRollbackBlobLogCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackBlobLogCall) Return(arg0 error) *MockTransactionRollbackBlobLogCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockTransactionRollbackBlobLogCall) Return(arg0 []storage.BlobLog, arg1 error) *MockTransactionRollbackBlobLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackBlobLogCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackBlobLogCall {
+func (c *MockTransactionRollbackBlobLogCall) Do(f func(context.Context, types0.Level) ([]storage.BlobLog, error)) *MockTransactionRollbackBlobLogCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackBlobLogCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackBlobLogCall {
+func (c *MockTransactionRollbackBlobLogCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.BlobLog, error)) *MockTransactionRollbackBlobLogCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1839,6 +2118,44 @@ func (c *MockTransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// RollbackEffectiveVotes mocks base method.
+func (m *MockTransaction) RollbackEffectiveVotes(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackEffectiveVotes", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackEffectiveVotes indicates an expected call of RollbackEffectiveVotes.
+func (mr *MockTransactionMockRecorder) RollbackEffectiveVotes(ctx, height any) *MockTransactionRollbackEffectiveVotesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackEffectiveVotes", reflect.TypeOf((*MockTransaction)(nil).RollbackEffectiveVotes), ctx, height)
+	return &MockTransactionRollbackEffectiveVotesCall{Call: call}
+}
+
+// MockTransactionRollbackEffectiveVotesCall wrap *gomock.Call
+type MockTransactionRollbackEffectiveVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackEffectiveVotesCall) Return(arg0 error) *MockTransactionRollbackEffectiveVotesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackEffectiveVotesCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackEffectiveVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackEffectiveVotesCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackEffectiveVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackEvents mocks base method.
 func (m *MockTransaction) RollbackEvents(ctx context.Context, height types0.Level) ([]storage.Event, error) {
 	m.ctrl.T.Helper()
@@ -2566,6 +2883,44 @@ func (c *MockTransactionRollbackNamespacesCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// RollbackProposalTallies mocks base method.
+func (m *MockTransaction) RollbackProposalTallies(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackProposalTallies", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackProposalTallies indicates an expected call of RollbackProposalTallies.
+func (mr *MockTransactionMockRecorder) RollbackProposalTallies(ctx, height any) *MockTransactionRollbackProposalTalliesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackProposalTallies", reflect.TypeOf((*MockTransaction)(nil).RollbackProposalTallies), ctx, height)
+	return &MockTransactionRollbackProposalTalliesCall{Call: call}
+}
+
+// MockTransactionRollbackProposalTalliesCall wrap *gomock.Call
+type MockTransactionRollbackProposalTalliesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackProposalTalliesCall) Return(arg0 error) *MockTransactionRollbackProposalTalliesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackProposalTalliesCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackProposalTalliesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackProposalTalliesCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackProposalTalliesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackProposals mocks base method.
 func (m *MockTransaction) RollbackProposals(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
@@ -2642,6 +2997,44 @@ func (c *MockTransactionRollbackRedelegationsCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// RollbackShareRanges mocks base method.
+func (m *MockTransaction) RollbackShareRanges(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackShareRanges", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackShareRanges indicates an expected call of RollbackShareRanges.
+func (mr *MockTransactionMockRecorder) RollbackShareRanges(ctx, height any) *MockTransactionRollbackShareRangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackShareRanges", reflect.TypeOf((*MockTransaction)(nil).RollbackShareRanges), ctx, height)
+	return &MockTransactionRollbackShareRangesCall{Call: call}
+}
+
+// MockTransactionRollbackShareRangesCall wrap *gomock.Call
+type MockTransactionRollbackShareRangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackShareRangesCall) Return(arg0 error) *MockTransactionRollbackShareRangesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackShareRangesCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackShareRangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackShareRangesCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackShareRangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackSignals mocks base method.
 func (m *MockTransaction) RollbackSignals(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
@@ -2757,6 +3150,44 @@ func (c *MockTransactionRollbackStakingLogsCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// RollbackSupply mocks base method.
+func (m *MockTransaction) RollbackSupply(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSupply", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackSupply indicates an expected call of RollbackSupply.
+func (mr *MockTransactionMockRecorder) RollbackSupply(ctx, height any) *MockTransactionRollbackSupplyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSupply", reflect.TypeOf((*MockTransaction)(nil).RollbackSupply), ctx, height)
+	return &MockTransactionRollbackSupplyCall{Call: call}
+}
+
+// MockTransactionRollbackSupplyCall wrap *gomock.Call
+type MockTransactionRollbackSupplyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackSupplyCall) Return(arg0 error) *MockTransactionRollbackSupplyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackSupplyCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackSupplyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackSupplyCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackSupplyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackTxs mocks base method.
 func (m *MockTransaction) RollbackTxs(ctx context.Context, height types0.Level) ([]storage.Tx, error) {
 	m.ctrl.T.Helper()
@@ -2872,6 +3303,44 @@ func (c *MockTransactionRollbackUpgradesCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// RollbackValidatorRates mocks base method.
+func (m *MockTransaction) RollbackValidatorRates(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValidatorRates", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackValidatorRates indicates an expected call of RollbackValidatorRates.
+func (mr *MockTransactionMockRecorder) RollbackValidatorRates(ctx, height any) *MockTransactionRollbackValidatorRatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidatorRates", reflect.TypeOf((*MockTransaction)(nil).RollbackValidatorRates), ctx, height)
+	return &MockTransactionRollbackValidatorRatesCall{Call: call}
+}
+
+// MockTransactionRollbackValidatorRatesCall wrap *gomock.Call
+type MockTransactionRollbackValidatorRatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackValidatorRatesCall) Return(arg0 error) *MockTransactionRollbackValidatorRatesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackValidatorRatesCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackValidatorRatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackValidatorRatesCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackValidatorRatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackValidators mocks base method.
 func (m *MockTransaction) RollbackValidators(ctx context.Context, height types0.Level) ([]storage.Validator, error) {
 	m.ctrl.T.Helper()
@@ -3152,33 +3621,76 @@ func (m *MockTransaction) SaveAddresses(ctx context.Context, addresses ...*stora
 	return ret0, ret1
 }
 
-// SaveAddresses indicates an expected call of SaveAddresses.
-func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *MockTransactionSaveAddressesCall {
+// SaveAddresses indicates an expected call of SaveAddresses.
+func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *MockTransactionSaveAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
+	return &MockTransactionSaveAddressesCall{Call: call}
+}
+
+// MockTransactionSaveAddressesCall wrap *gomock.Call
+type MockTransactionSaveAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *MockTransactionSaveAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBalanceUpdates mocks base method.
+func (m *MockTransaction) SaveBalanceUpdates(ctx context.Context, updates ...storage.BalanceUpdate) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range updates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBalanceUpdates", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBalanceUpdates indicates an expected call of SaveBalanceUpdates.
+func (mr *MockTransactionMockRecorder) SaveBalanceUpdates(ctx any, updates ...any) *MockTransactionSaveBalanceUpdatesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
-	return &MockTransactionSaveAddressesCall{Call: call}
+	varargs := append([]any{ctx}, updates...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalanceUpdates", reflect.TypeOf((*MockTransaction)(nil).SaveBalanceUpdates), varargs...)
+	return &MockTransactionSaveBalanceUpdatesCall{Call: call}
 }
 
-// MockTransactionSaveAddressesCall wrap *gomock.Call
-type MockTransactionSaveAddressesCall struct {
+// MockTransactionSaveBalanceUpdatesCall wrap *gomock.Call
+type MockTransactionSaveBalanceUpdatesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *MockTransactionSaveAddressesCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionSaveBalanceUpdatesCall) Return(arg0 error) *MockTransactionSaveBalanceUpdatesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+func (c *MockTransactionSaveBalanceUpdatesCall) Do(f func(context.Context, ...storage.BalanceUpdate) error) *MockTransactionSaveBalanceUpdatesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+func (c *MockTransactionSaveBalanceUpdatesCall) DoAndReturn(f func(context.Context, ...storage.BalanceUpdate) error) *MockTransactionSaveBalanceUpdatesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -3398,6 +3910,44 @@ func (c *MockTransactionSaveDelegationsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SaveEffectiveVotes mocks base method.
+func (m *MockTransaction) SaveEffectiveVotes(ctx context.Context, proposalId uint64, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEffectiveVotes", ctx, proposalId, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEffectiveVotes indicates an expected call of SaveEffectiveVotes.
+func (mr *MockTransactionMockRecorder) SaveEffectiveVotes(ctx, proposalId, height any) *MockTransactionSaveEffectiveVotesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEffectiveVotes", reflect.TypeOf((*MockTransaction)(nil).SaveEffectiveVotes), ctx, proposalId, height)
+	return &MockTransactionSaveEffectiveVotesCall{Call: call}
+}
+
+// MockTransactionSaveEffectiveVotesCall wrap *gomock.Call
+type MockTransactionSaveEffectiveVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveEffectiveVotesCall) Return(arg0 error) *MockTransactionSaveEffectiveVotesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveEffectiveVotesCall) Do(f func(context.Context, uint64, types0.Level) error) *MockTransactionSaveEffectiveVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveEffectiveVotesCall) DoAndReturn(f func(context.Context, uint64, types0.Level) error) *MockTransactionSaveEffectiveVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveEvents mocks base method.
 func (m *MockTransaction) SaveEvents(ctx context.Context, events ...storage.Event) error {
 	m.ctrl.T.Helper()
@@ -4217,6 +4767,49 @@ func (c *MockTransactionSaveNamespacesCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SaveProposalTallies mocks base method.
+func (m *MockTransaction) SaveProposalTallies(ctx context.Context, tallies ...storage.ProposalTally) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range tallies {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveProposalTallies", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProposalTallies indicates an expected call of SaveProposalTallies.
+func (mr *MockTransactionMockRecorder) SaveProposalTallies(ctx any, tallies ...any) *MockTransactionSaveProposalTalliesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, tallies...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProposalTallies", reflect.TypeOf((*MockTransaction)(nil).SaveProposalTallies), varargs...)
+	return &MockTransactionSaveProposalTalliesCall{Call: call}
+}
+
+// MockTransactionSaveProposalTalliesCall wrap *gomock.Call
+type MockTransactionSaveProposalTalliesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveProposalTalliesCall) Return(arg0 error) *MockTransactionSaveProposalTalliesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveProposalTalliesCall) Do(f func(context.Context, ...storage.ProposalTally) error) *MockTransactionSaveProposalTalliesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveProposalTalliesCall) DoAndReturn(f func(context.Context, ...storage.ProposalTally) error) *MockTransactionSaveProposalTalliesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveProposals mocks base method.
 func (m *MockTransaction) SaveProposals(ctx context.Context, proposals ...*storage.Proposal) (int64, error) {
 	m.ctrl.T.Helper()
@@ -4385,6 +4978,49 @@ func (c *MockTransactionSaveRollupCall) DoAndReturn(f func(context.Context, *sto
 	return c
 }
 
+// SaveShareRanges mocks base method.
+func (m *MockTransaction) SaveShareRanges(ctx context.Context, ranges ...storage.ShareRange) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ranges {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveShareRanges", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveShareRanges indicates an expected call of SaveShareRanges.
+func (mr *MockTransactionMockRecorder) SaveShareRanges(ctx any, ranges ...any) *MockTransactionSaveShareRangesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ranges...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveShareRanges", reflect.TypeOf((*MockTransaction)(nil).SaveShareRanges), varargs...)
+	return &MockTransactionSaveShareRangesCall{Call: call}
+}
+
+// MockTransactionSaveShareRangesCall wrap *gomock.Call
+type MockTransactionSaveShareRangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveShareRangesCall) Return(arg0 error) *MockTransactionSaveShareRangesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveShareRangesCall) Do(f func(context.Context, ...storage.ShareRange) error) *MockTransactionSaveShareRangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveShareRangesCall) DoAndReturn(f func(context.Context, ...storage.ShareRange) error) *MockTransactionSaveShareRangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveSignals mocks base method.
 func (m *MockTransaction) SaveSignals(ctx context.Context, signals ...*storage.SignalVersion) error {
 	m.ctrl.T.Helper()
@@ -4643,6 +5279,49 @@ func (c *MockTransactionSaveUpgradesCall) DoAndReturn(f func(context.Context, ..
 	return c
 }
 
+// SaveValidatorRates mocks base method.
+func (m *MockTransaction) SaveValidatorRates(ctx context.Context, rates ...storage.ValidatorRate) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range rates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveValidatorRates", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveValidatorRates indicates an expected call of SaveValidatorRates.
+func (mr *MockTransactionMockRecorder) SaveValidatorRates(ctx any, rates ...any) *MockTransactionSaveValidatorRatesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, rates...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidatorRates", reflect.TypeOf((*MockTransaction)(nil).SaveValidatorRates), varargs...)
+	return &MockTransactionSaveValidatorRatesCall{Call: call}
+}
+
+// MockTransactionSaveValidatorRatesCall wrap *gomock.Call
+type MockTransactionSaveValidatorRatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveValidatorRatesCall) Return(arg0 error) *MockTransactionSaveValidatorRatesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveValidatorRatesCall) Do(f func(context.Context, ...storage.ValidatorRate) error) *MockTransactionSaveValidatorRatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveValidatorRatesCall) DoAndReturn(f func(context.Context, ...storage.ValidatorRate) error) *MockTransactionSaveValidatorRatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveValidators mocks base method.
 func (m *MockTransaction) SaveValidators(ctx context.Context, validators ...*storage.Validator) (int, error) {
 	m.ctrl.T.Helper()
@@ -4946,6 +5625,84 @@ func (c *MockTransactionSaveZkISMsCall) DoAndReturn(f func(context.Context, ...*
 	return c
 }
 
+// ShiftSupply mocks base method.
+func (m *MockTransaction) ShiftSupply(ctx context.Context, height types0.Level, total types.Numeric) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShiftSupply", ctx, height, total)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShiftSupply indicates an expected call of ShiftSupply.
+func (mr *MockTransactionMockRecorder) ShiftSupply(ctx, height, total any) *MockTransactionShiftSupplyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShiftSupply", reflect.TypeOf((*MockTransaction)(nil).ShiftSupply), ctx, height, total)
+	return &MockTransactionShiftSupplyCall{Call: call}
+}
+
+// MockTransactionShiftSupplyCall wrap *gomock.Call
+type MockTransactionShiftSupplyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionShiftSupplyCall) Return(arg0 error) *MockTransactionShiftSupplyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionShiftSupplyCall) Do(f func(context.Context, types0.Level, types.Numeric) error) *MockTransactionShiftSupplyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionShiftSupplyCall) DoAndReturn(f func(context.Context, types0.Level, types.Numeric) error) *MockTransactionShiftSupplyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StakingTotals mocks base method.
+func (m *MockTransaction) StakingTotals(ctx context.Context, t time.Time) (types.Numeric, types.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StakingTotals", ctx, t)
+	ret0, _ := ret[0].(types.Numeric)
+	ret1, _ := ret[1].(types.Numeric)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StakingTotals indicates an expected call of StakingTotals.
+func (mr *MockTransactionMockRecorder) StakingTotals(ctx, t any) *MockTransactionStakingTotalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StakingTotals", reflect.TypeOf((*MockTransaction)(nil).StakingTotals), ctx, t)
+	return &MockTransactionStakingTotalsCall{Call: call}
+}
+
+// MockTransactionStakingTotalsCall wrap *gomock.Call
+type MockTransactionStakingTotalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionStakingTotalsCall) Return(staked, unbonding types.Numeric, err error) *MockTransactionStakingTotalsCall {
+	c.Call = c.Call.Return(staked, unbonding, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionStakingTotalsCall) Do(f func(context.Context, time.Time) (types.Numeric, types.Numeric, error)) *MockTransactionStakingTotalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionStakingTotalsCall) DoAndReturn(f func(context.Context, time.Time) (types.Numeric, types.Numeric, error)) *MockTransactionStakingTotalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // State mocks base method.
 func (m *MockTransaction) State(ctx context.Context, name string) (storage.State, error) {
 	m.ctrl.T.Helper()
@@ -5061,6 +5818,44 @@ func (c *MockTransactionUpdateCall) DoAndReturn(f func(context.Context, any) err
 	return c
 }
 
+// UpdateCommunityPool mocks base method.
+func (m *MockTransaction) UpdateCommunityPool(ctx context.Context, height types0.Level, t time.Time, amount types.Numeric) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCommunityPool", ctx, height, t, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCommunityPool indicates an expected call of UpdateCommunityPool.
+func (mr *MockTransactionMockRecorder) UpdateCommunityPool(ctx, height, t, amount any) *MockTransactionUpdateCommunityPoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommunityPool", reflect.TypeOf((*MockTransaction)(nil).UpdateCommunityPool), ctx, height, t, amount)
+	return &MockTransactionUpdateCommunityPoolCall{Call: call}
+}
+
+// MockTransactionUpdateCommunityPoolCall wrap *gomock.Call
+type MockTransactionUpdateCommunityPoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionUpdateCommunityPoolCall) Return(arg0 error) *MockTransactionUpdateCommunityPoolCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionUpdateCommunityPoolCall) Do(f func(context.Context, types0.Level, time.Time, types.Numeric) error) *MockTransactionUpdateCommunityPoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionUpdateCommunityPoolCall) DoAndReturn(f func(context.Context, types0.Level, time.Time, types.Numeric) error) *MockTransactionUpdateCommunityPoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateRollup mocks base method.
 func (m *MockTransaction) UpdateRollup(ctx context.Context, rollup *storage.Rollup) error {
 	m.ctrl.T.Helper()
@@ -5322,6 +6117,83 @@ func (m *MockISearch) EXPECT() *MockISearchMockRecorder {
 	return m.recorder
 }
 
+// FullText mocks base method.
+func (m *MockISearch) FullText(ctx context.Context, fltrs storage.FullTextFilters) ([]storage.FullTextResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullText", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.FullTextResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FullText indicates an expected call of FullText.
+func (mr *MockISearchMockRecorder) FullText(ctx, fltrs any) *MockISearchFullTextCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullText", reflect.TypeOf((*MockISearch)(nil).FullText), ctx, fltrs)
+	return &MockISearchFullTextCall{Call: call}
+}
+
+// MockISearchFullTextCall wrap *gomock.Call
+type MockISearchFullTextCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISearchFullTextCall) Return(arg0 []storage.FullTextResult, arg1 error) *MockISearchFullTextCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISearchFullTextCall) Do(f func(context.Context, storage.FullTextFilters) ([]storage.FullTextResult, error)) *MockISearchFullTextCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISearchFullTextCall) DoAndReturn(f func(context.Context, storage.FullTextFilters) ([]storage.FullTextResult, error)) *MockISearchFullTextCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockISearch) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockISearchMockRecorder) IsNoRows(err any) *MockISearchIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockISearch)(nil).IsNoRows), err)
+	return &MockISearchIsNoRowsCall{Call: call}
+}
+
+// MockISearchIsNoRowsCall wrap *gomock.Call
+type MockISearchIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISearchIsNoRowsCall) Return(arg0 bool) *MockISearchIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISearchIsNoRowsCall) Do(f func(error) bool) *MockISearchIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISearchIsNoRowsCall) DoAndReturn(f func(error) bool) *MockISearchIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Search mocks base method.
 func (m *MockISearch) Search(ctx context.Context, query []byte) ([]storage.SearchResult, error) {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	storage "github.com/celenium-io/celestia-indexer/internal/storage"
 	gomock "go.uber.org/mock/gomock"
@@ -83,6 +84,88 @@ func (c *MockIHLTransferByIdCall) DoAndReturn(f func(context.Context, uint64) (s
 	return c
 }
 
+// IncDeliveryAttempts mocks base method.
+func (m *MockIHLTransfer) IncDeliveryAttempts(ctx context.Context, transfers ...storage.HLTransfer) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range transfers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IncDeliveryAttempts", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncDeliveryAttempts indicates an expected call of IncDeliveryAttempts.
+func (mr *MockIHLTransferMockRecorder) IncDeliveryAttempts(ctx any, transfers ...any) *MockIHLTransferIncDeliveryAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, transfers...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncDeliveryAttempts", reflect.TypeOf((*MockIHLTransfer)(nil).IncDeliveryAttempts), varargs...)
+	return &MockIHLTransferIncDeliveryAttemptsCall{Call: call}
+}
+
+// MockIHLTransferIncDeliveryAttemptsCall wrap *gomock.Call
+type MockIHLTransferIncDeliveryAttemptsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIHLTransferIncDeliveryAttemptsCall) Return(arg0 error) *MockIHLTransferIncDeliveryAttemptsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIHLTransferIncDeliveryAttemptsCall) Do(f func(context.Context, ...storage.HLTransfer) error) *MockIHLTransferIncDeliveryAttemptsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIHLTransferIncDeliveryAttemptsCall) DoAndReturn(f func(context.Context, ...storage.HLTransfer) error) *MockIHLTransferIncDeliveryAttemptsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatencyByDomain mocks base method.
+func (m *MockIHLTransfer) LatencyByDomain(ctx context.Context, from time.Time) ([]storage.DomainLatency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatencyByDomain", ctx, from)
+	ret0, _ := ret[0].([]storage.DomainLatency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatencyByDomain indicates an expected call of LatencyByDomain.
+func (mr *MockIHLTransferMockRecorder) LatencyByDomain(ctx, from any) *MockIHLTransferLatencyByDomainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatencyByDomain", reflect.TypeOf((*MockIHLTransfer)(nil).LatencyByDomain), ctx, from)
+	return &MockIHLTransferLatencyByDomainCall{Call: call}
+}
+
+// MockIHLTransferLatencyByDomainCall wrap *gomock.Call
+type MockIHLTransferLatencyByDomainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIHLTransferLatencyByDomainCall) Return(arg0 []storage.DomainLatency, arg1 error) *MockIHLTransferLatencyByDomainCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIHLTransferLatencyByDomainCall) Do(f func(context.Context, time.Time) ([]storage.DomainLatency, error)) *MockIHLTransferLatencyByDomainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIHLTransferLatencyByDomainCall) DoAndReturn(f func(context.Context, time.Time) ([]storage.DomainLatency, error)) *MockIHLTransferLatencyByDomainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // List mocks base method.
 func (m *MockIHLTransfer) List(ctx context.Context, filters storage.ListHyperlaneTransferFilters) ([]storage.HLTransfer, error) {
 	m.ctrl.T.Helper()
@@ -238,3 +321,85 @@ func (c *MockIHLTransferTotalSeriesCall) DoAndReturn(f func(context.Context, sto
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Undelivered mocks base method.
+func (m *MockIHLTransfer) Undelivered(ctx context.Context, since time.Time, maxAttempts int, fromId uint64, limit int) ([]storage.HLTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undelivered", ctx, since, maxAttempts, fromId, limit)
+	ret0, _ := ret[0].([]storage.HLTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Undelivered indicates an expected call of Undelivered.
+func (mr *MockIHLTransferMockRecorder) Undelivered(ctx, since, maxAttempts, fromId, limit any) *MockIHLTransferUndeliveredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undelivered", reflect.TypeOf((*MockIHLTransfer)(nil).Undelivered), ctx, since, maxAttempts, fromId, limit)
+	return &MockIHLTransferUndeliveredCall{Call: call}
+}
+
+// MockIHLTransferUndeliveredCall wrap *gomock.Call
+type MockIHLTransferUndeliveredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIHLTransferUndeliveredCall) Return(arg0 []storage.HLTransfer, arg1 error) *MockIHLTransferUndeliveredCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIHLTransferUndeliveredCall) Do(f func(context.Context, time.Time, int, uint64, int) ([]storage.HLTransfer, error)) *MockIHLTransferUndeliveredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIHLTransferUndeliveredCall) DoAndReturn(f func(context.Context, time.Time, int, uint64, int) ([]storage.HLTransfer, error)) *MockIHLTransferUndeliveredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockIHLTransfer) UpdateDelivery(ctx context.Context, transfers ...storage.HLTransfer) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range transfers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDelivery", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockIHLTransferMockRecorder) UpdateDelivery(ctx any, transfers ...any) *MockIHLTransferUpdateDeliveryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, transfers...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockIHLTransfer)(nil).UpdateDelivery), varargs...)
+	return &MockIHLTransferUpdateDeliveryCall{Call: call}
+}
+
+// MockIHLTransferUpdateDeliveryCall wrap *gomock.Call
+type MockIHLTransferUpdateDeliveryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIHLTransferUpdateDeliveryCall) Return(arg0 error) *MockIHLTransferUpdateDeliveryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIHLTransferUpdateDeliveryCall) Do(f func(context.Context, ...storage.HLTransfer) error) *MockIHLTransferUpdateDeliveryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIHLTransferUpdateDeliveryCall) DoAndReturn(f func(context.Context, ...storage.HLTransfer) error) *MockIHLTransferUpdateDeliveryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
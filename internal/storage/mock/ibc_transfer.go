@@ -83,6 +83,50 @@ func (c *MockIIbcTransferByIdCall) DoAndReturn(f func(context.Context, uint64) (
 	return c
 }
 
+// ByIds mocks base method.
+func (m *MockIIbcTransfer) ByIds(ctx context.Context, ids ...uint64) ([]storage.IbcTransferWithSigner, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByIds", varargs...)
+	ret0, _ := ret[0].([]storage.IbcTransferWithSigner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByIds indicates an expected call of ByIds.
+func (mr *MockIIbcTransferMockRecorder) ByIds(ctx any, ids ...any) *MockIIbcTransferByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByIds", reflect.TypeOf((*MockIIbcTransfer)(nil).ByIds), varargs...)
+	return &MockIIbcTransferByIdsCall{Call: call}
+}
+
+// MockIIbcTransferByIdsCall wrap *gomock.Call
+type MockIIbcTransferByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIIbcTransferByIdsCall) Return(arg0 []storage.IbcTransferWithSigner, arg1 error) *MockIIbcTransferByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIIbcTransferByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.IbcTransferWithSigner, error)) *MockIIbcTransferByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIIbcTransferByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.IbcTransferWithSigner, error)) *MockIIbcTransferByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // LargestTransfer24h mocks base method.
 func (m *MockIIbcTransfer) LargestTransfer24h(ctx context.Context) (storage.IbcTransfer, error) {
 	m.ctrl.T.Helper()
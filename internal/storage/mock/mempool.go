@@ -0,0 +1,516 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mempool.go
+//
+// Generated by this command:
+//
+//	mockgen -source=mempool.go -destination=mock/mempool.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMempoolTx is a mock of IMempoolTx interface.
+type MockIMempoolTx struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMempoolTxMockRecorder
+	isgomock struct{}
+}
+
+// MockIMempoolTxMockRecorder is the mock recorder for MockIMempoolTx.
+type MockIMempoolTxMockRecorder struct {
+	mock *MockIMempoolTx
+}
+
+// NewMockIMempoolTx creates a new mock instance.
+func NewMockIMempoolTx(ctrl *gomock.Controller) *MockIMempoolTx {
+	mock := &MockIMempoolTx{ctrl: ctrl}
+	mock.recorder = &MockIMempoolTxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMempoolTx) EXPECT() *MockIMempoolTxMockRecorder {
+	return m.recorder
+}
+
+// ByHash mocks base method.
+func (m *MockIMempoolTx) ByHash(ctx context.Context, hash []byte) (storage.MempoolTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHash", ctx, hash)
+	ret0, _ := ret[0].(storage.MempoolTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHash indicates an expected call of ByHash.
+func (mr *MockIMempoolTxMockRecorder) ByHash(ctx, hash any) *MockIMempoolTxByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHash", reflect.TypeOf((*MockIMempoolTx)(nil).ByHash), ctx, hash)
+	return &MockIMempoolTxByHashCall{Call: call}
+}
+
+// MockIMempoolTxByHashCall wrap *gomock.Call
+type MockIMempoolTxByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxByHashCall) Return(arg0 storage.MempoolTx, arg1 error) *MockIMempoolTxByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxByHashCall) Do(f func(context.Context, []byte) (storage.MempoolTx, error)) *MockIMempoolTxByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxByHashCall) DoAndReturn(f func(context.Context, []byte) (storage.MempoolTx, error)) *MockIMempoolTxByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIMempoolTx) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.MempoolTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.MempoolTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIMempoolTxMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIMempoolTxCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIMempoolTx)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIMempoolTxCursorListCall{Call: call}
+}
+
+// MockIMempoolTxCursorListCall wrap *gomock.Call
+type MockIMempoolTxCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxCursorListCall) Return(arg0 []*storage.MempoolTx, arg1 error) *MockIMempoolTxCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.MempoolTx, error)) *MockIMempoolTxCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.MempoolTx, error)) *MockIMempoolTxCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteFinished mocks base method.
+func (m *MockIMempoolTx) DeleteFinished(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinished", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinished indicates an expected call of DeleteFinished.
+func (mr *MockIMempoolTxMockRecorder) DeleteFinished(ctx, before any) *MockIMempoolTxDeleteFinishedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinished", reflect.TypeOf((*MockIMempoolTx)(nil).DeleteFinished), ctx, before)
+	return &MockIMempoolTxDeleteFinishedCall{Call: call}
+}
+
+// MockIMempoolTxDeleteFinishedCall wrap *gomock.Call
+type MockIMempoolTxDeleteFinishedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxDeleteFinishedCall) Return(arg0 int, arg1 error) *MockIMempoolTxDeleteFinishedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxDeleteFinishedCall) Do(f func(context.Context, time.Time) (int, error)) *MockIMempoolTxDeleteFinishedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxDeleteFinishedCall) DoAndReturn(f func(context.Context, time.Time) (int, error)) *MockIMempoolTxDeleteFinishedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIMempoolTx) GetByID(ctx context.Context, id uint64) (*storage.MempoolTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.MempoolTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIMempoolTxMockRecorder) GetByID(ctx, id any) *MockIMempoolTxGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIMempoolTx)(nil).GetByID), ctx, id)
+	return &MockIMempoolTxGetByIDCall{Call: call}
+}
+
+// MockIMempoolTxGetByIDCall wrap *gomock.Call
+type MockIMempoolTxGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxGetByIDCall) Return(arg0 *storage.MempoolTx, arg1 error) *MockIMempoolTxGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxGetByIDCall) Do(f func(context.Context, uint64) (*storage.MempoolTx, error)) *MockIMempoolTxGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.MempoolTx, error)) *MockIMempoolTxGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Insert mocks base method.
+func (m *MockIMempoolTx) Insert(ctx context.Context, txs ...*storage.MempoolTx) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range txs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Insert", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockIMempoolTxMockRecorder) Insert(ctx any, txs ...any) *MockIMempoolTxInsertCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, txs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockIMempoolTx)(nil).Insert), varargs...)
+	return &MockIMempoolTxInsertCall{Call: call}
+}
+
+// MockIMempoolTxInsertCall wrap *gomock.Call
+type MockIMempoolTxInsertCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxInsertCall) Return(arg0 error) *MockIMempoolTxInsertCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxInsertCall) Do(f func(context.Context, ...*storage.MempoolTx) error) *MockIMempoolTxInsertCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxInsertCall) DoAndReturn(f func(context.Context, ...*storage.MempoolTx) error) *MockIMempoolTxInsertCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIMempoolTx) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIMempoolTxMockRecorder) IsNoRows(err any) *MockIMempoolTxIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIMempoolTx)(nil).IsNoRows), err)
+	return &MockIMempoolTxIsNoRowsCall{Call: call}
+}
+
+// MockIMempoolTxIsNoRowsCall wrap *gomock.Call
+type MockIMempoolTxIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxIsNoRowsCall) Return(arg0 bool) *MockIMempoolTxIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxIsNoRowsCall) Do(f func(error) bool) *MockIMempoolTxIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIMempoolTxIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIMempoolTx) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIMempoolTxMockRecorder) LastID(ctx any) *MockIMempoolTxLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIMempoolTx)(nil).LastID), ctx)
+	return &MockIMempoolTxLastIDCall{Call: call}
+}
+
+// MockIMempoolTxLastIDCall wrap *gomock.Call
+type MockIMempoolTxLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxLastIDCall) Return(arg0 uint64, arg1 error) *MockIMempoolTxLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIMempoolTxLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIMempoolTxLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastIncluded mocks base method.
+func (m *MockIMempoolTx) LastIncluded(ctx context.Context, limit int) ([]storage.MempoolTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastIncluded", ctx, limit)
+	ret0, _ := ret[0].([]storage.MempoolTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastIncluded indicates an expected call of LastIncluded.
+func (mr *MockIMempoolTxMockRecorder) LastIncluded(ctx, limit any) *MockIMempoolTxLastIncludedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastIncluded", reflect.TypeOf((*MockIMempoolTx)(nil).LastIncluded), ctx, limit)
+	return &MockIMempoolTxLastIncludedCall{Call: call}
+}
+
+// MockIMempoolTxLastIncludedCall wrap *gomock.Call
+type MockIMempoolTxLastIncludedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxLastIncludedCall) Return(arg0 []storage.MempoolTx, arg1 error) *MockIMempoolTxLastIncludedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxLastIncludedCall) Do(f func(context.Context, int) ([]storage.MempoolTx, error)) *MockIMempoolTxLastIncludedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxLastIncludedCall) DoAndReturn(f func(context.Context, int) ([]storage.MempoolTx, error)) *MockIMempoolTxLastIncludedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIMempoolTx) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.MempoolTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.MempoolTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIMempoolTxMockRecorder) List(ctx, limit, offset, order any) *MockIMempoolTxListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIMempoolTx)(nil).List), ctx, limit, offset, order)
+	return &MockIMempoolTxListCall{Call: call}
+}
+
+// MockIMempoolTxListCall wrap *gomock.Call
+type MockIMempoolTxListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxListCall) Return(arg0 []*storage.MempoolTx, arg1 error) *MockIMempoolTxListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.MempoolTx, error)) *MockIMempoolTxListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.MempoolTx, error)) *MockIMempoolTxListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Pending mocks base method.
+func (m *MockIMempoolTx) Pending(ctx context.Context) ([]storage.MempoolTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx)
+	ret0, _ := ret[0].([]storage.MempoolTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockIMempoolTxMockRecorder) Pending(ctx any) *MockIMempoolTxPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockIMempoolTx)(nil).Pending), ctx)
+	return &MockIMempoolTxPendingCall{Call: call}
+}
+
+// MockIMempoolTxPendingCall wrap *gomock.Call
+type MockIMempoolTxPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxPendingCall) Return(arg0 []storage.MempoolTx, arg1 error) *MockIMempoolTxPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxPendingCall) Do(f func(context.Context) ([]storage.MempoolTx, error)) *MockIMempoolTxPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxPendingCall) DoAndReturn(f func(context.Context) ([]storage.MempoolTx, error)) *MockIMempoolTxPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIMempoolTx) Save(ctx context.Context, m *storage.MempoolTx) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIMempoolTxMockRecorder) Save(ctx, m any) *MockIMempoolTxSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIMempoolTx)(nil).Save), ctx, m)
+	return &MockIMempoolTxSaveCall{Call: call}
+}
+
+// MockIMempoolTxSaveCall wrap *gomock.Call
+type MockIMempoolTxSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxSaveCall) Return(arg0 error) *MockIMempoolTxSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxSaveCall) Do(f func(context.Context, *storage.MempoolTx) error) *MockIMempoolTxSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxSaveCall) DoAndReturn(f func(context.Context, *storage.MempoolTx) error) *MockIMempoolTxSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIMempoolTx) Update(ctx context.Context, m *storage.MempoolTx) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIMempoolTxMockRecorder) Update(ctx, m any) *MockIMempoolTxUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIMempoolTx)(nil).Update), ctx, m)
+	return &MockIMempoolTxUpdateCall{Call: call}
+}
+
+// MockIMempoolTxUpdateCall wrap *gomock.Call
+type MockIMempoolTxUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMempoolTxUpdateCall) Return(arg0 error) *MockIMempoolTxUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMempoolTxUpdateCall) Do(f func(context.Context, *storage.MempoolTx) error) *MockIMempoolTxUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMempoolTxUpdateCall) DoAndReturn(f func(context.Context, *storage.MempoolTx) error) *MockIMempoolTxUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -123,6 +123,45 @@ func (c *MockIMessageByTxIdCall) DoAndReturn(f func(context.Context, uint64, int
 	return c
 }
 
+// ByTxIdAfter mocks base method.
+func (m *MockIMessage) ByTxIdAfter(ctx context.Context, txId, cursor uint64, limit int) ([]storage.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByTxIdAfter", ctx, txId, cursor, limit)
+	ret0, _ := ret[0].([]storage.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByTxIdAfter indicates an expected call of ByTxIdAfter.
+func (mr *MockIMessageMockRecorder) ByTxIdAfter(ctx, txId, cursor, limit any) *MockIMessageByTxIdAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByTxIdAfter", reflect.TypeOf((*MockIMessage)(nil).ByTxIdAfter), ctx, txId, cursor, limit)
+	return &MockIMessageByTxIdAfterCall{Call: call}
+}
+
+// MockIMessageByTxIdAfterCall wrap *gomock.Call
+type MockIMessageByTxIdAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIMessageByTxIdAfterCall) Return(arg0 []storage.Message, arg1 error) *MockIMessageByTxIdAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIMessageByTxIdAfterCall) Do(f func(context.Context, uint64, uint64, int) ([]storage.Message, error)) *MockIMessageByTxIdAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIMessageByTxIdAfterCall) DoAndReturn(f func(context.Context, uint64, uint64, int) ([]storage.Message, error)) *MockIMessageByTxIdAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIMessage) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Message, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +361,45 @@ func (c *MockINamespaceListCall) DoAndReturn(f func(context.Context, uint64, uin
 	return c
 }
 
+// ListAfter mocks base method.
+func (m *MockINamespace) ListAfter(ctx context.Context, cursor uint64, limit int) ([]storage.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, cursor, limit)
+	ret0, _ := ret[0].([]storage.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockINamespaceMockRecorder) ListAfter(ctx, cursor, limit any) *MockINamespaceListAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockINamespace)(nil).ListAfter), ctx, cursor, limit)
+	return &MockINamespaceListAfterCall{Call: call}
+}
+
+// MockINamespaceListAfterCall wrap *gomock.Call
+type MockINamespaceListAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockINamespaceListAfterCall) Return(ns []storage.Namespace, err error) *MockINamespaceListAfterCall {
+	c.Call = c.Call.Return(ns, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockINamespaceListAfterCall) Do(f func(context.Context, uint64, int) ([]storage.Namespace, error)) *MockINamespaceListAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockINamespaceListAfterCall) DoAndReturn(f func(context.Context, uint64, int) ([]storage.Namespace, error)) *MockINamespaceListAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListWithSort mocks base method.
 func (m *MockINamespace) ListWithSort(ctx context.Context, sortField string, sort storage0.SortOrder, limit, offset int) ([]storage.Namespace, error) {
 	m.ctrl.T.Helper()
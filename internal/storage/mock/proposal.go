@@ -83,6 +83,50 @@ func (c *MockIProposalByIdCall) DoAndReturn(f func(context.Context, uint64) (sto
 	return c
 }
 
+// ByIds mocks base method.
+func (m *MockIProposal) ByIds(ctx context.Context, ids ...uint64) ([]storage.Proposal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByIds indicates an expected call of ByIds.
+func (mr *MockIProposalMockRecorder) ByIds(ctx any, ids ...any) *MockIProposalByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByIds", reflect.TypeOf((*MockIProposal)(nil).ByIds), varargs...)
+	return &MockIProposalByIdsCall{Call: call}
+}
+
+// MockIProposalByIdsCall wrap *gomock.Call
+type MockIProposalByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProposalByIdsCall) Return(arg0 []storage.Proposal, arg1 error) *MockIProposalByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProposalByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Proposal, error)) *MockIProposalByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProposalByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Proposal, error)) *MockIProposalByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListWithFilters mocks base method.
 func (m *MockIProposal) ListWithFilters(ctx context.Context, filters storage.ListProposalFilters) ([]storage.Proposal, error) {
 	m.ctrl.T.Helper()
@@ -121,3 +165,42 @@ func (c *MockIProposalListWithFiltersCall) DoAndReturn(f func(context.Context, s
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Timeline mocks base method.
+func (m *MockIProposal) Timeline(ctx context.Context, id uint64) ([]storage.ProposalTally, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", ctx, id)
+	ret0, _ := ret[0].([]storage.ProposalTally)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockIProposalMockRecorder) Timeline(ctx, id any) *MockIProposalTimelineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockIProposal)(nil).Timeline), ctx, id)
+	return &MockIProposalTimelineCall{Call: call}
+}
+
+// MockIProposalTimelineCall wrap *gomock.Call
+type MockIProposalTimelineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProposalTimelineCall) Return(arg0 []storage.ProposalTally, arg1 error) *MockIProposalTimelineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProposalTimelineCall) Do(f func(context.Context, uint64) ([]storage.ProposalTally, error)) *MockIProposalTimelineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProposalTimelineCall) DoAndReturn(f func(context.Context, uint64) ([]storage.ProposalTally, error)) *MockIProposalTimelineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
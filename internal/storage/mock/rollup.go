@@ -318,6 +318,50 @@ func (c *MockIRollupGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*s
 	return c
 }
 
+// GetByIds mocks base method.
+func (m *MockIRollup) GetByIds(ctx context.Context, ids ...uint64) ([]storage.Rollup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Rollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockIRollupMockRecorder) GetByIds(ctx any, ids ...any) *MockIRollupGetByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockIRollup)(nil).GetByIds), varargs...)
+	return &MockIRollupGetByIdsCall{Call: call}
+}
+
+// MockIRollupGetByIdsCall wrap *gomock.Call
+type MockIRollupGetByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupGetByIdsCall) Return(arg0 []storage.Rollup, arg1 error) *MockIRollupGetByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupGetByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Rollup, error)) *MockIRollupGetByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupGetByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Rollup, error)) *MockIRollupGetByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsNoRows mocks base method.
 func (m *MockIRollup) IsNoRows(err error) bool {
 	m.ctrl.T.Helper()
@@ -434,6 +478,45 @@ func (c *MockIRollupLeaderboardCall) DoAndReturn(f func(context.Context, storage
 	return c
 }
 
+// LeaderboardAfter mocks base method.
+func (m *MockIRollup) LeaderboardAfter(ctx context.Context, cursor uint64, limit int) ([]storage.RollupWithStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaderboardAfter", ctx, cursor, limit)
+	ret0, _ := ret[0].([]storage.RollupWithStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaderboardAfter indicates an expected call of LeaderboardAfter.
+func (mr *MockIRollupMockRecorder) LeaderboardAfter(ctx, cursor, limit any) *MockIRollupLeaderboardAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaderboardAfter", reflect.TypeOf((*MockIRollup)(nil).LeaderboardAfter), ctx, cursor, limit)
+	return &MockIRollupLeaderboardAfterCall{Call: call}
+}
+
+// MockIRollupLeaderboardAfterCall wrap *gomock.Call
+type MockIRollupLeaderboardAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupLeaderboardAfterCall) Return(arg0 []storage.RollupWithStats, arg1 error) *MockIRollupLeaderboardAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupLeaderboardAfterCall) Do(f func(context.Context, uint64, int) ([]storage.RollupWithStats, error)) *MockIRollupLeaderboardAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupLeaderboardAfterCall) DoAndReturn(f func(context.Context, uint64, int) ([]storage.RollupWithStats, error)) *MockIRollupLeaderboardAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // LeaderboardDay mocks base method.
 func (m *MockIRollup) LeaderboardDay(ctx context.Context, fltrs storage.LeaderboardFilters) ([]storage.RollupWithDayStats, error) {
 	m.ctrl.T.Helper()
@@ -551,6 +634,45 @@ func (c *MockIRollupNamespacesCall) DoAndReturn(f func(context.Context, uint64,
 	return c
 }
 
+// NamespacesAfter mocks base method.
+func (m *MockIRollup) NamespacesAfter(ctx context.Context, rollupId, cursor uint64, limit int) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespacesAfter", ctx, rollupId, cursor, limit)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NamespacesAfter indicates an expected call of NamespacesAfter.
+func (mr *MockIRollupMockRecorder) NamespacesAfter(ctx, rollupId, cursor, limit any) *MockIRollupNamespacesAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespacesAfter", reflect.TypeOf((*MockIRollup)(nil).NamespacesAfter), ctx, rollupId, cursor, limit)
+	return &MockIRollupNamespacesAfterCall{Call: call}
+}
+
+// MockIRollupNamespacesAfterCall wrap *gomock.Call
+type MockIRollupNamespacesAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupNamespacesAfterCall) Return(namespaceIds []uint64, err error) *MockIRollupNamespacesAfterCall {
+	c.Call = c.Call.Return(namespaceIds, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupNamespacesAfterCall) Do(f func(context.Context, uint64, uint64, int) ([]uint64, error)) *MockIRollupNamespacesAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupNamespacesAfterCall) DoAndReturn(f func(context.Context, uint64, uint64, int) ([]uint64, error)) *MockIRollupNamespacesAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Providers mocks base method.
 func (m *MockIRollup) Providers(ctx context.Context, rollupId uint64) ([]storage.RollupProvider, error) {
 	m.ctrl.T.Helper()
@@ -668,6 +790,45 @@ func (c *MockIRollupRollupsByNamespaceCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// RollupsByNamespaceAfter mocks base method.
+func (m *MockIRollup) RollupsByNamespaceAfter(ctx context.Context, namespaceId, cursor uint64, limit int) ([]storage.Rollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupsByNamespaceAfter", ctx, namespaceId, cursor, limit)
+	ret0, _ := ret[0].([]storage.Rollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollupsByNamespaceAfter indicates an expected call of RollupsByNamespaceAfter.
+func (mr *MockIRollupMockRecorder) RollupsByNamespaceAfter(ctx, namespaceId, cursor, limit any) *MockIRollupRollupsByNamespaceAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupsByNamespaceAfter", reflect.TypeOf((*MockIRollup)(nil).RollupsByNamespaceAfter), ctx, namespaceId, cursor, limit)
+	return &MockIRollupRollupsByNamespaceAfterCall{Call: call}
+}
+
+// MockIRollupRollupsByNamespaceAfterCall wrap *gomock.Call
+type MockIRollupRollupsByNamespaceAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupRollupsByNamespaceAfterCall) Return(rollups []storage.Rollup, err error) *MockIRollupRollupsByNamespaceAfterCall {
+	c.Call = c.Call.Return(rollups, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupRollupsByNamespaceAfterCall) Do(f func(context.Context, uint64, uint64, int) ([]storage.Rollup, error)) *MockIRollupRollupsByNamespaceAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupRollupsByNamespaceAfterCall) DoAndReturn(f func(context.Context, uint64, uint64, int) ([]storage.Rollup, error)) *MockIRollupRollupsByNamespaceAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIRollup) Save(ctx context.Context, m *storage.Rollup) error {
 	m_2.ctrl.T.Helper()
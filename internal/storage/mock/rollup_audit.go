@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rollup_audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rollup_audit.go -destination=mock/rollup_audit.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRollupAudit is a mock of IRollupAudit interface.
+type MockIRollupAudit struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRollupAuditMockRecorder
+	isgomock struct{}
+}
+
+// MockIRollupAuditMockRecorder is the mock recorder for MockIRollupAudit.
+type MockIRollupAuditMockRecorder struct {
+	mock *MockIRollupAudit
+}
+
+// NewMockIRollupAudit creates a new mock instance.
+func NewMockIRollupAudit(ctrl *gomock.Controller) *MockIRollupAudit {
+	mock := &MockIRollupAudit{ctrl: ctrl}
+	mock.recorder = &MockIRollupAuditMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRollupAudit) EXPECT() *MockIRollupAuditMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIRollupAudit) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.RollupAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.RollupAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIRollupAuditMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIRollupAuditCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIRollupAudit)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIRollupAuditCursorListCall{Call: call}
+}
+
+// MockIRollupAuditCursorListCall wrap *gomock.Call
+type MockIRollupAuditCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupAuditCursorListCall) Return(arg0 []*storage.RollupAudit, arg1 error) *MockIRollupAuditCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupAuditCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollupAudit, error)) *MockIRollupAuditCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupAuditCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollupAudit, error)) *MockIRollupAuditCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIRollupAudit) GetByID(ctx context.Context, id uint64) (*storage.RollupAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.RollupAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIRollupAuditMockRecorder) GetByID(ctx, id any) *MockIRollupAuditGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIRollupAudit)(nil).GetByID), ctx, id)
+	return &MockIRollupAuditGetByIDCall{Call: call}
+}
+
+// MockIRollupAuditGetByIDCall wrap *gomock.Call
+type MockIRollupAuditGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupAuditGetByIDCall) Return(arg0 *storage.RollupAudit, arg1 error) *MockIRollupAuditGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupAuditGetByIDCall) Do(f func(context.Context, uint64) (*storage.RollupAudit, error)) *MockIRollupAuditGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupAuditGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.RollupAudit, error)) *MockIRollupAuditGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIRollupAudit) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIRollupAuditMockRecorder) IsNoRows(err any) *MockIRollupAuditIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIRollupAudit)(nil).IsNoRows), err)
+	return &MockIRollupAuditIsNoRowsCall{Call: call}
+}
+
+// MockIRollupAuditIsNoRowsCall wrap *gomock.Call
+type MockIRollupAuditIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupAuditIsNoRowsCall) Return(arg0 bool) *MockIRollupAuditIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupAuditIsNoRowsCall) Do(f func(error) bool) *MockIRollupAuditIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupAuditIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIRollupAuditIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIRollupAudit) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIRollupAuditMockRecorder) LastID(ctx any) *MockIRollupAuditLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIRollupAudit)(nil).LastID), ctx)
+	return &MockIRollupAuditLastIDCall{Call: call}
+}
+
+// MockIRollupAuditLastIDCall wrap *gomock.Call
+type MockIRollupAuditLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupAuditLastIDCall) Return(arg0 uint64, arg1 error) *MockIRollupAuditLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupAuditLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIRollupAuditLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupAuditLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIRollupAuditLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIRollupAudit) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.RollupAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.RollupAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRollupAuditMockRecorder) List(ctx, limit, offset, order any) *MockIRollupAuditListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRollupAudit)(nil).List), ctx, limit, offset, order)
+	return &MockIRollupAuditListCall{Call: call}
+}
+
+// MockIRollupAuditListCall wrap *gomock.Call
+type MockIRollupAuditListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupAuditListCall) Return(arg0 []*storage.RollupAudit, arg1 error) *MockIRollupAuditListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupAuditListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollupAudit, error)) *MockIRollupAuditListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupAuditListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollupAudit, error)) *MockIRollupAuditListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIRollupAudit) Save(ctx context.Context, m *storage.RollupAudit) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRollupAuditMockRecorder) Save(ctx, m any) *MockIRollupAuditSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRollupAudit)(nil).Save), ctx, m)
+	return &MockIRollupAuditSaveCall{Call: call}
+}
+
+// MockIRollupAuditSaveCall wrap *gomock.Call
+type MockIRollupAuditSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupAuditSaveCall) Return(arg0 error) *MockIRollupAuditSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupAuditSaveCall) Do(f func(context.Context, *storage.RollupAudit) error) *MockIRollupAuditSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupAuditSaveCall) DoAndReturn(f func(context.Context, *storage.RollupAudit) error) *MockIRollupAuditSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIRollupAudit) Update(ctx context.Context, m *storage.RollupAudit) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIRollupAuditMockRecorder) Update(ctx, m any) *MockIRollupAuditUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIRollupAudit)(nil).Update), ctx, m)
+	return &MockIRollupAuditUpdateCall{Call: call}
+}
+
+// MockIRollupAuditUpdateCall wrap *gomock.Call
+type MockIRollupAuditUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupAuditUpdateCall) Return(arg0 error) *MockIRollupAuditUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupAuditUpdateCall) Do(f func(context.Context, *storage.RollupAudit) error) *MockIRollupAuditUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupAuditUpdateCall) DoAndReturn(f func(context.Context, *storage.RollupAudit) error) *MockIRollupAuditUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
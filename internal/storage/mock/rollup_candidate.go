@@ -0,0 +1,400 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rollup_candidate.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rollup_candidate.go -destination=mock/rollup_candidate.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRollupCandidate is a mock of IRollupCandidate interface.
+type MockIRollupCandidate struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRollupCandidateMockRecorder
+	isgomock struct{}
+}
+
+// MockIRollupCandidateMockRecorder is the mock recorder for MockIRollupCandidate.
+type MockIRollupCandidateMockRecorder struct {
+	mock *MockIRollupCandidate
+}
+
+// NewMockIRollupCandidate creates a new mock instance.
+func NewMockIRollupCandidate(ctrl *gomock.Controller) *MockIRollupCandidate {
+	mock := &MockIRollupCandidate{ctrl: ctrl}
+	mock.recorder = &MockIRollupCandidateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRollupCandidate) EXPECT() *MockIRollupCandidateMockRecorder {
+	return m.recorder
+}
+
+// Activity mocks base method.
+func (m *MockIRollupCandidate) Activity(ctx context.Context, since time.Time, minBlobs int) ([]storage.NamespaceActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activity", ctx, since, minBlobs)
+	ret0, _ := ret[0].([]storage.NamespaceActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Activity indicates an expected call of Activity.
+func (mr *MockIRollupCandidateMockRecorder) Activity(ctx, since, minBlobs any) *MockIRollupCandidateActivityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activity", reflect.TypeOf((*MockIRollupCandidate)(nil).Activity), ctx, since, minBlobs)
+	return &MockIRollupCandidateActivityCall{Call: call}
+}
+
+// MockIRollupCandidateActivityCall wrap *gomock.Call
+type MockIRollupCandidateActivityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateActivityCall) Return(arg0 []storage.NamespaceActivity, arg1 error) *MockIRollupCandidateActivityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateActivityCall) Do(f func(context.Context, time.Time, int) ([]storage.NamespaceActivity, error)) *MockIRollupCandidateActivityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateActivityCall) DoAndReturn(f func(context.Context, time.Time, int) ([]storage.NamespaceActivity, error)) *MockIRollupCandidateActivityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByRollupIds mocks base method.
+func (m *MockIRollupCandidate) ByRollupIds(ctx context.Context, rollupIds ...uint64) ([]storage.RollupCandidate, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range rollupIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByRollupIds", varargs...)
+	ret0, _ := ret[0].([]storage.RollupCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByRollupIds indicates an expected call of ByRollupIds.
+func (mr *MockIRollupCandidateMockRecorder) ByRollupIds(ctx any, rollupIds ...any) *MockIRollupCandidateByRollupIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, rollupIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByRollupIds", reflect.TypeOf((*MockIRollupCandidate)(nil).ByRollupIds), varargs...)
+	return &MockIRollupCandidateByRollupIdsCall{Call: call}
+}
+
+// MockIRollupCandidateByRollupIdsCall wrap *gomock.Call
+type MockIRollupCandidateByRollupIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateByRollupIdsCall) Return(arg0 []storage.RollupCandidate, arg1 error) *MockIRollupCandidateByRollupIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateByRollupIdsCall) Do(f func(context.Context, ...uint64) ([]storage.RollupCandidate, error)) *MockIRollupCandidateByRollupIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateByRollupIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.RollupCandidate, error)) *MockIRollupCandidateByRollupIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIRollupCandidate) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.RollupCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.RollupCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIRollupCandidateMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIRollupCandidateCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIRollupCandidate)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIRollupCandidateCursorListCall{Call: call}
+}
+
+// MockIRollupCandidateCursorListCall wrap *gomock.Call
+type MockIRollupCandidateCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateCursorListCall) Return(arg0 []*storage.RollupCandidate, arg1 error) *MockIRollupCandidateCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollupCandidate, error)) *MockIRollupCandidateCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollupCandidate, error)) *MockIRollupCandidateCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIRollupCandidate) GetByID(ctx context.Context, id uint64) (*storage.RollupCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.RollupCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIRollupCandidateMockRecorder) GetByID(ctx, id any) *MockIRollupCandidateGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIRollupCandidate)(nil).GetByID), ctx, id)
+	return &MockIRollupCandidateGetByIDCall{Call: call}
+}
+
+// MockIRollupCandidateGetByIDCall wrap *gomock.Call
+type MockIRollupCandidateGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateGetByIDCall) Return(arg0 *storage.RollupCandidate, arg1 error) *MockIRollupCandidateGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateGetByIDCall) Do(f func(context.Context, uint64) (*storage.RollupCandidate, error)) *MockIRollupCandidateGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.RollupCandidate, error)) *MockIRollupCandidateGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIRollupCandidate) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIRollupCandidateMockRecorder) IsNoRows(err any) *MockIRollupCandidateIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIRollupCandidate)(nil).IsNoRows), err)
+	return &MockIRollupCandidateIsNoRowsCall{Call: call}
+}
+
+// MockIRollupCandidateIsNoRowsCall wrap *gomock.Call
+type MockIRollupCandidateIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateIsNoRowsCall) Return(arg0 bool) *MockIRollupCandidateIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateIsNoRowsCall) Do(f func(error) bool) *MockIRollupCandidateIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIRollupCandidateIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIRollupCandidate) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIRollupCandidateMockRecorder) LastID(ctx any) *MockIRollupCandidateLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIRollupCandidate)(nil).LastID), ctx)
+	return &MockIRollupCandidateLastIDCall{Call: call}
+}
+
+// MockIRollupCandidateLastIDCall wrap *gomock.Call
+type MockIRollupCandidateLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateLastIDCall) Return(arg0 uint64, arg1 error) *MockIRollupCandidateLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIRollupCandidateLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIRollupCandidateLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIRollupCandidate) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.RollupCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.RollupCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRollupCandidateMockRecorder) List(ctx, limit, offset, order any) *MockIRollupCandidateListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRollupCandidate)(nil).List), ctx, limit, offset, order)
+	return &MockIRollupCandidateListCall{Call: call}
+}
+
+// MockIRollupCandidateListCall wrap *gomock.Call
+type MockIRollupCandidateListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateListCall) Return(arg0 []*storage.RollupCandidate, arg1 error) *MockIRollupCandidateListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollupCandidate, error)) *MockIRollupCandidateListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollupCandidate, error)) *MockIRollupCandidateListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIRollupCandidate) Save(ctx context.Context, m *storage.RollupCandidate) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRollupCandidateMockRecorder) Save(ctx, m any) *MockIRollupCandidateSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRollupCandidate)(nil).Save), ctx, m)
+	return &MockIRollupCandidateSaveCall{Call: call}
+}
+
+// MockIRollupCandidateSaveCall wrap *gomock.Call
+type MockIRollupCandidateSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateSaveCall) Return(arg0 error) *MockIRollupCandidateSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateSaveCall) Do(f func(context.Context, *storage.RollupCandidate) error) *MockIRollupCandidateSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateSaveCall) DoAndReturn(f func(context.Context, *storage.RollupCandidate) error) *MockIRollupCandidateSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIRollupCandidate) Update(ctx context.Context, m *storage.RollupCandidate) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIRollupCandidateMockRecorder) Update(ctx, m any) *MockIRollupCandidateUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIRollupCandidate)(nil).Update), ctx, m)
+	return &MockIRollupCandidateUpdateCall{Call: call}
+}
+
+// MockIRollupCandidateUpdateCall wrap *gomock.Call
+type MockIRollupCandidateUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupCandidateUpdateCall) Return(arg0 error) *MockIRollupCandidateUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupCandidateUpdateCall) Do(f func(context.Context, *storage.RollupCandidate) error) *MockIRollupCandidateUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupCandidateUpdateCall) DoAndReturn(f func(context.Context, *storage.RollupCandidate) error) *MockIRollupCandidateUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,356 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: share_range.go
+//
+// Generated by this command:
+//
+//	mockgen -source=share_range.go -destination=mock/share_range.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIShareRange is a mock of IShareRange interface.
+type MockIShareRange struct {
+	ctrl     *gomock.Controller
+	recorder *MockIShareRangeMockRecorder
+	isgomock struct{}
+}
+
+// MockIShareRangeMockRecorder is the mock recorder for MockIShareRange.
+type MockIShareRangeMockRecorder struct {
+	mock *MockIShareRange
+}
+
+// NewMockIShareRange creates a new mock instance.
+func NewMockIShareRange(ctrl *gomock.Controller) *MockIShareRange {
+	mock := &MockIShareRange{ctrl: ctrl}
+	mock.recorder = &MockIShareRangeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIShareRange) EXPECT() *MockIShareRangeMockRecorder {
+	return m.recorder
+}
+
+// ByHeight mocks base method.
+func (m *MockIShareRange) ByHeight(ctx context.Context, height types.Level, fltrs storage.ShareRangeFilters) ([]storage.ShareRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height, fltrs)
+	ret0, _ := ret[0].([]storage.ShareRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIShareRangeMockRecorder) ByHeight(ctx, height, fltrs any) *MockIShareRangeByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIShareRange)(nil).ByHeight), ctx, height, fltrs)
+	return &MockIShareRangeByHeightCall{Call: call}
+}
+
+// MockIShareRangeByHeightCall wrap *gomock.Call
+type MockIShareRangeByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeByHeightCall) Return(arg0 []storage.ShareRange, arg1 error) *MockIShareRangeByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeByHeightCall) Do(f func(context.Context, types.Level, storage.ShareRangeFilters) ([]storage.ShareRange, error)) *MockIShareRangeByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeByHeightCall) DoAndReturn(f func(context.Context, types.Level, storage.ShareRangeFilters) ([]storage.ShareRange, error)) *MockIShareRangeByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIShareRange) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ShareRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ShareRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIShareRangeMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIShareRangeCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIShareRange)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIShareRangeCursorListCall{Call: call}
+}
+
+// MockIShareRangeCursorListCall wrap *gomock.Call
+type MockIShareRangeCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeCursorListCall) Return(arg0 []*storage.ShareRange, arg1 error) *MockIShareRangeCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ShareRange, error)) *MockIShareRangeCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ShareRange, error)) *MockIShareRangeCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIShareRange) GetByID(ctx context.Context, id uint64) (*storage.ShareRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ShareRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIShareRangeMockRecorder) GetByID(ctx, id any) *MockIShareRangeGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIShareRange)(nil).GetByID), ctx, id)
+	return &MockIShareRangeGetByIDCall{Call: call}
+}
+
+// MockIShareRangeGetByIDCall wrap *gomock.Call
+type MockIShareRangeGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeGetByIDCall) Return(arg0 *storage.ShareRange, arg1 error) *MockIShareRangeGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeGetByIDCall) Do(f func(context.Context, uint64) (*storage.ShareRange, error)) *MockIShareRangeGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ShareRange, error)) *MockIShareRangeGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIShareRange) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIShareRangeMockRecorder) IsNoRows(err any) *MockIShareRangeIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIShareRange)(nil).IsNoRows), err)
+	return &MockIShareRangeIsNoRowsCall{Call: call}
+}
+
+// MockIShareRangeIsNoRowsCall wrap *gomock.Call
+type MockIShareRangeIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeIsNoRowsCall) Return(arg0 bool) *MockIShareRangeIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeIsNoRowsCall) Do(f func(error) bool) *MockIShareRangeIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIShareRangeIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIShareRange) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIShareRangeMockRecorder) LastID(ctx any) *MockIShareRangeLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIShareRange)(nil).LastID), ctx)
+	return &MockIShareRangeLastIDCall{Call: call}
+}
+
+// MockIShareRangeLastIDCall wrap *gomock.Call
+type MockIShareRangeLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeLastIDCall) Return(arg0 uint64, arg1 error) *MockIShareRangeLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIShareRangeLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIShareRangeLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIShareRange) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ShareRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ShareRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIShareRangeMockRecorder) List(ctx, limit, offset, order any) *MockIShareRangeListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIShareRange)(nil).List), ctx, limit, offset, order)
+	return &MockIShareRangeListCall{Call: call}
+}
+
+// MockIShareRangeListCall wrap *gomock.Call
+type MockIShareRangeListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeListCall) Return(arg0 []*storage.ShareRange, arg1 error) *MockIShareRangeListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ShareRange, error)) *MockIShareRangeListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ShareRange, error)) *MockIShareRangeListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIShareRange) Save(ctx context.Context, m *storage.ShareRange) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIShareRangeMockRecorder) Save(ctx, m any) *MockIShareRangeSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIShareRange)(nil).Save), ctx, m)
+	return &MockIShareRangeSaveCall{Call: call}
+}
+
+// MockIShareRangeSaveCall wrap *gomock.Call
+type MockIShareRangeSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeSaveCall) Return(arg0 error) *MockIShareRangeSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeSaveCall) Do(f func(context.Context, *storage.ShareRange) error) *MockIShareRangeSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeSaveCall) DoAndReturn(f func(context.Context, *storage.ShareRange) error) *MockIShareRangeSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIShareRange) Update(ctx context.Context, m *storage.ShareRange) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIShareRangeMockRecorder) Update(ctx, m any) *MockIShareRangeUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIShareRange)(nil).Update), ctx, m)
+	return &MockIShareRangeUpdateCall{Call: call}
+}
+
+// MockIShareRangeUpdateCall wrap *gomock.Call
+type MockIShareRangeUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIShareRangeUpdateCall) Return(arg0 error) *MockIShareRangeUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIShareRangeUpdateCall) Do(f func(context.Context, *storage.ShareRange) error) *MockIShareRangeUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIShareRangeUpdateCall) DoAndReturn(f func(context.Context, *storage.ShareRange) error) *MockIShareRangeUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
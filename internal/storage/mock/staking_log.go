@@ -239,6 +239,84 @@ func (c *MockIStakingLogListCall) DoAndReturn(f func(context.Context, uint64, ui
 	return c
 }
 
+// RewardsLedger mocks base method.
+func (m *MockIStakingLog) RewardsLedger(ctx context.Context, fltrs storage.RewardsLedgerFilters) ([]storage.RewardsLedgerItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewardsLedger", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.RewardsLedgerItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RewardsLedger indicates an expected call of RewardsLedger.
+func (mr *MockIStakingLogMockRecorder) RewardsLedger(ctx, fltrs any) *MockIStakingLogRewardsLedgerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardsLedger", reflect.TypeOf((*MockIStakingLog)(nil).RewardsLedger), ctx, fltrs)
+	return &MockIStakingLogRewardsLedgerCall{Call: call}
+}
+
+// MockIStakingLogRewardsLedgerCall wrap *gomock.Call
+type MockIStakingLogRewardsLedgerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStakingLogRewardsLedgerCall) Return(arg0 []storage.RewardsLedgerItem, arg1 error) *MockIStakingLogRewardsLedgerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStakingLogRewardsLedgerCall) Do(f func(context.Context, storage.RewardsLedgerFilters) ([]storage.RewardsLedgerItem, error)) *MockIStakingLogRewardsLedgerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStakingLogRewardsLedgerCall) DoAndReturn(f func(context.Context, storage.RewardsLedgerFilters) ([]storage.RewardsLedgerItem, error)) *MockIStakingLogRewardsLedgerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RewardsSeries mocks base method.
+func (m *MockIStakingLog) RewardsSeries(ctx context.Context, fltrs storage.RewardsSeriesFilters) ([]storage.RewardsSeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewardsSeries", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.RewardsSeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RewardsSeries indicates an expected call of RewardsSeries.
+func (mr *MockIStakingLogMockRecorder) RewardsSeries(ctx, fltrs any) *MockIStakingLogRewardsSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardsSeries", reflect.TypeOf((*MockIStakingLog)(nil).RewardsSeries), ctx, fltrs)
+	return &MockIStakingLogRewardsSeriesCall{Call: call}
+}
+
+// MockIStakingLogRewardsSeriesCall wrap *gomock.Call
+type MockIStakingLogRewardsSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStakingLogRewardsSeriesCall) Return(arg0 []storage.RewardsSeriesItem, arg1 error) *MockIStakingLogRewardsSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStakingLogRewardsSeriesCall) Do(f func(context.Context, storage.RewardsSeriesFilters) ([]storage.RewardsSeriesItem, error)) *MockIStakingLogRewardsSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStakingLogRewardsSeriesCall) DoAndReturn(f func(context.Context, storage.RewardsSeriesFilters) ([]storage.RewardsSeriesItem, error)) *MockIStakingLogRewardsSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIStakingLog) Save(ctx context.Context, m *storage.StakingLog) error {
 	m_2.ctrl.T.Helper()
@@ -0,0 +1,394 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: supply.go
+//
+// Generated by this command:
+//
+//	mockgen -source=supply.go -destination=mock/supply.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISupply is a mock of ISupply interface.
+type MockISupply struct {
+	ctrl     *gomock.Controller
+	recorder *MockISupplyMockRecorder
+	isgomock struct{}
+}
+
+// MockISupplyMockRecorder is the mock recorder for MockISupply.
+type MockISupplyMockRecorder struct {
+	mock *MockISupply
+}
+
+// NewMockISupply creates a new mock instance.
+func NewMockISupply(ctrl *gomock.Controller) *MockISupply {
+	mock := &MockISupply{ctrl: ctrl}
+	mock.recorder = &MockISupplyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISupply) EXPECT() *MockISupplyMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockISupply) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Supply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Supply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockISupplyMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockISupplyCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockISupply)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockISupplyCursorListCall{Call: call}
+}
+
+// MockISupplyCursorListCall wrap *gomock.Call
+type MockISupplyCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplyCursorListCall) Return(arg0 []*storage.Supply, arg1 error) *MockISupplyCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplyCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Supply, error)) *MockISupplyCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplyCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Supply, error)) *MockISupplyCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockISupply) GetByID(ctx context.Context, id uint64) (*storage.Supply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Supply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockISupplyMockRecorder) GetByID(ctx, id any) *MockISupplyGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockISupply)(nil).GetByID), ctx, id)
+	return &MockISupplyGetByIDCall{Call: call}
+}
+
+// MockISupplyGetByIDCall wrap *gomock.Call
+type MockISupplyGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplyGetByIDCall) Return(arg0 *storage.Supply, arg1 error) *MockISupplyGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplyGetByIDCall) Do(f func(context.Context, uint64) (*storage.Supply, error)) *MockISupplyGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplyGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Supply, error)) *MockISupplyGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockISupply) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockISupplyMockRecorder) IsNoRows(err any) *MockISupplyIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockISupply)(nil).IsNoRows), err)
+	return &MockISupplyIsNoRowsCall{Call: call}
+}
+
+// MockISupplyIsNoRowsCall wrap *gomock.Call
+type MockISupplyIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplyIsNoRowsCall) Return(arg0 bool) *MockISupplyIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplyIsNoRowsCall) Do(f func(error) bool) *MockISupplyIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplyIsNoRowsCall) DoAndReturn(f func(error) bool) *MockISupplyIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Last mocks base method.
+func (m *MockISupply) Last(ctx context.Context) (storage.Supply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Last", ctx)
+	ret0, _ := ret[0].(storage.Supply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Last indicates an expected call of Last.
+func (mr *MockISupplyMockRecorder) Last(ctx any) *MockISupplyLastCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Last", reflect.TypeOf((*MockISupply)(nil).Last), ctx)
+	return &MockISupplyLastCall{Call: call}
+}
+
+// MockISupplyLastCall wrap *gomock.Call
+type MockISupplyLastCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplyLastCall) Return(arg0 storage.Supply, arg1 error) *MockISupplyLastCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplyLastCall) Do(f func(context.Context) (storage.Supply, error)) *MockISupplyLastCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplyLastCall) DoAndReturn(f func(context.Context) (storage.Supply, error)) *MockISupplyLastCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockISupply) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockISupplyMockRecorder) LastID(ctx any) *MockISupplyLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockISupply)(nil).LastID), ctx)
+	return &MockISupplyLastIDCall{Call: call}
+}
+
+// MockISupplyLastIDCall wrap *gomock.Call
+type MockISupplyLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplyLastIDCall) Return(arg0 uint64, arg1 error) *MockISupplyLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplyLastIDCall) Do(f func(context.Context) (uint64, error)) *MockISupplyLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplyLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockISupplyLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockISupply) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Supply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Supply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockISupplyMockRecorder) List(ctx, limit, offset, order any) *MockISupplyListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockISupply)(nil).List), ctx, limit, offset, order)
+	return &MockISupplyListCall{Call: call}
+}
+
+// MockISupplyListCall wrap *gomock.Call
+type MockISupplyListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplyListCall) Return(arg0 []*storage.Supply, arg1 error) *MockISupplyListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplyListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Supply, error)) *MockISupplyListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplyListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Supply, error)) *MockISupplyListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockISupply) Save(ctx context.Context, m *storage.Supply) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockISupplyMockRecorder) Save(ctx, m any) *MockISupplySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockISupply)(nil).Save), ctx, m)
+	return &MockISupplySaveCall{Call: call}
+}
+
+// MockISupplySaveCall wrap *gomock.Call
+type MockISupplySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplySaveCall) Return(arg0 error) *MockISupplySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplySaveCall) Do(f func(context.Context, *storage.Supply) error) *MockISupplySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplySaveCall) DoAndReturn(f func(context.Context, *storage.Supply) error) *MockISupplySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Series mocks base method.
+func (m *MockISupply) Series(ctx context.Context, timeframe storage.Timeframe, req storage.SeriesRequest) ([]storage.Supply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Series", ctx, timeframe, req)
+	ret0, _ := ret[0].([]storage.Supply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Series indicates an expected call of Series.
+func (mr *MockISupplyMockRecorder) Series(ctx, timeframe, req any) *MockISupplySeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Series", reflect.TypeOf((*MockISupply)(nil).Series), ctx, timeframe, req)
+	return &MockISupplySeriesCall{Call: call}
+}
+
+// MockISupplySeriesCall wrap *gomock.Call
+type MockISupplySeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplySeriesCall) Return(arg0 []storage.Supply, arg1 error) *MockISupplySeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplySeriesCall) Do(f func(context.Context, storage.Timeframe, storage.SeriesRequest) ([]storage.Supply, error)) *MockISupplySeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplySeriesCall) DoAndReturn(f func(context.Context, storage.Timeframe, storage.SeriesRequest) ([]storage.Supply, error)) *MockISupplySeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockISupply) Update(ctx context.Context, m *storage.Supply) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockISupplyMockRecorder) Update(ctx, m any) *MockISupplyUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockISupply)(nil).Update), ctx, m)
+	return &MockISupplyUpdateCall{Call: call}
+}
+
+// MockISupplyUpdateCall wrap *gomock.Call
+type MockISupplyUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISupplyUpdateCall) Return(arg0 error) *MockISupplyUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISupplyUpdateCall) Do(f func(context.Context, *storage.Supply) error) *MockISupplyUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISupplyUpdateCall) DoAndReturn(f func(context.Context, *storage.Supply) error) *MockISupplyUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
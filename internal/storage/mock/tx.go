@@ -359,6 +359,50 @@ func (c *MockITxGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*stora
 	return c
 }
 
+// GetByIds mocks base method.
+func (m *MockITx) GetByIds(ctx context.Context, ids ...uint64) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockITxMockRecorder) GetByIds(ctx any, ids ...any) *MockITxGetByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockITx)(nil).GetByIds), varargs...)
+	return &MockITxGetByIdsCall{Call: call}
+}
+
+// MockITxGetByIdsCall wrap *gomock.Call
+type MockITxGetByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxGetByIdsCall) Return(arg0 []storage.Tx, arg1 error) *MockITxGetByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxGetByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Tx, error)) *MockITxGetByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxGetByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Tx, error)) *MockITxGetByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IdAndTimeByHash mocks base method.
 func (m *MockITx) IdAndTimeByHash(ctx context.Context, hash []byte) (uint64, time.Time, error) {
 	m.ctrl.T.Helper()
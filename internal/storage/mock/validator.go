@@ -163,6 +163,89 @@ func (c *MockIValidatorGetByIDCall) DoAndReturn(f func(context.Context, uint64)
 	return c
 }
 
+// GetByIds mocks base method.
+func (m *MockIValidator) GetByIds(ctx context.Context, ids ...uint64) ([]storage.Validator, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockIValidatorMockRecorder) GetByIds(ctx any, ids ...any) *MockIValidatorGetByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockIValidator)(nil).GetByIds), varargs...)
+	return &MockIValidatorGetByIdsCall{Call: call}
+}
+
+// MockIValidatorGetByIdsCall wrap *gomock.Call
+type MockIValidatorGetByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIValidatorGetByIdsCall) Return(arg0 []storage.Validator, arg1 error) *MockIValidatorGetByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIValidatorGetByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Validator, error)) *MockIValidatorGetByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIValidatorGetByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Validator, error)) *MockIValidatorGetByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// History mocks base method.
+func (m *MockIValidator) History(ctx context.Context, id uint64, fltrs storage.ValidatorHistoryFilters) ([]storage.ValidatorHistoryItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, id, fltrs)
+	ret0, _ := ret[0].([]storage.ValidatorHistoryItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockIValidatorMockRecorder) History(ctx, id, fltrs any) *MockIValidatorHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockIValidator)(nil).History), ctx, id, fltrs)
+	return &MockIValidatorHistoryCall{Call: call}
+}
+
+// MockIValidatorHistoryCall wrap *gomock.Call
+type MockIValidatorHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIValidatorHistoryCall) Return(arg0 []storage.ValidatorHistoryItem, arg1 error) *MockIValidatorHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIValidatorHistoryCall) Do(f func(context.Context, uint64, storage.ValidatorHistoryFilters) ([]storage.ValidatorHistoryItem, error)) *MockIValidatorHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIValidatorHistoryCall) DoAndReturn(f func(context.Context, uint64, storage.ValidatorHistoryFilters) ([]storage.ValidatorHistoryItem, error)) *MockIValidatorHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsNoRows mocks base method.
 func (m *MockIValidator) IsNoRows(err error) bool {
 	m.ctrl.T.Helper()
@@ -357,6 +440,45 @@ func (c *MockIValidatorListByPowerCall) DoAndReturn(f func(context.Context, stor
 	return c
 }
 
+// ListByPowerAfter mocks base method.
+func (m *MockIValidator) ListByPowerAfter(ctx context.Context, cursor uint64, limit int) ([]storage.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPowerAfter", ctx, cursor, limit)
+	ret0, _ := ret[0].([]storage.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPowerAfter indicates an expected call of ListByPowerAfter.
+func (mr *MockIValidatorMockRecorder) ListByPowerAfter(ctx, cursor, limit any) *MockIValidatorListByPowerAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPowerAfter", reflect.TypeOf((*MockIValidator)(nil).ListByPowerAfter), ctx, cursor, limit)
+	return &MockIValidatorListByPowerAfterCall{Call: call}
+}
+
+// MockIValidatorListByPowerAfterCall wrap *gomock.Call
+type MockIValidatorListByPowerAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIValidatorListByPowerAfterCall) Return(arg0 []storage.Validator, arg1 error) *MockIValidatorListByPowerAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIValidatorListByPowerAfterCall) Do(f func(context.Context, uint64, int) ([]storage.Validator, error)) *MockIValidatorListByPowerAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIValidatorListByPowerAfterCall) DoAndReturn(f func(context.Context, uint64, int) ([]storage.Validator, error)) *MockIValidatorListByPowerAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Messages mocks base method.
 func (m *MockIValidator) Messages(ctx context.Context, id uint64, fltrs storage.ValidatorMessagesFilters) ([]storage.MsgValidator, error) {
 	m.ctrl.T.Helper()
@@ -15,8 +15,10 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -278,6 +280,45 @@ func (c *MockIVestingAccountListCall) DoAndReturn(f func(context.Context, uint64
 	return c
 }
 
+// Locked mocks base method.
+func (m *MockIVestingAccount) Locked(ctx context.Context, t time.Time) (types.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locked", ctx, t)
+	ret0, _ := ret[0].(types.Numeric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Locked indicates an expected call of Locked.
+func (mr *MockIVestingAccountMockRecorder) Locked(ctx, t any) *MockIVestingAccountLockedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locked", reflect.TypeOf((*MockIVestingAccount)(nil).Locked), ctx, t)
+	return &MockIVestingAccountLockedCall{Call: call}
+}
+
+// MockIVestingAccountLockedCall wrap *gomock.Call
+type MockIVestingAccountLockedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIVestingAccountLockedCall) Return(arg0 types.Numeric, arg1 error) *MockIVestingAccountLockedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIVestingAccountLockedCall) Do(f func(context.Context, time.Time) (types.Numeric, error)) *MockIVestingAccountLockedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIVestingAccountLockedCall) DoAndReturn(f func(context.Context, time.Time) (types.Numeric, error)) *MockIVestingAccountLockedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIVestingAccount) Save(ctx context.Context, m *storage.VestingAccount) error {
 	m_2.ctrl.T.Helper()
@@ -316,6 +357,45 @@ func (c *MockIVestingAccountSaveCall) DoAndReturn(f func(context.Context, *stora
 	return c
 }
 
+// UnlockSeries mocks base method.
+func (m *MockIVestingAccount) UnlockSeries(ctx context.Context, timeframe storage.Timeframe, req storage.SeriesRequest) ([]storage.VestingUnlockItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockSeries", ctx, timeframe, req)
+	ret0, _ := ret[0].([]storage.VestingUnlockItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockSeries indicates an expected call of UnlockSeries.
+func (mr *MockIVestingAccountMockRecorder) UnlockSeries(ctx, timeframe, req any) *MockIVestingAccountUnlockSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockSeries", reflect.TypeOf((*MockIVestingAccount)(nil).UnlockSeries), ctx, timeframe, req)
+	return &MockIVestingAccountUnlockSeriesCall{Call: call}
+}
+
+// MockIVestingAccountUnlockSeriesCall wrap *gomock.Call
+type MockIVestingAccountUnlockSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIVestingAccountUnlockSeriesCall) Return(arg0 []storage.VestingUnlockItem, arg1 error) *MockIVestingAccountUnlockSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIVestingAccountUnlockSeriesCall) Do(f func(context.Context, storage.Timeframe, storage.SeriesRequest) ([]storage.VestingUnlockItem, error)) *MockIVestingAccountUnlockSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIVestingAccountUnlockSeriesCall) DoAndReturn(f func(context.Context, storage.Timeframe, storage.SeriesRequest) ([]storage.VestingUnlockItem, error)) *MockIVestingAccountUnlockSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockIVestingAccount) Update(ctx context.Context, m *storage.VestingAccount) error {
 	m_2.ctrl.T.Helper()
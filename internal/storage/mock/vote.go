@@ -162,6 +162,45 @@ func (c *MockIVoteByVoterIdCall) DoAndReturn(f func(context.Context, uint64, sto
 	return c
 }
 
+// ComputeEffectiveVotes mocks base method.
+func (m *MockIVote) ComputeEffectiveVotes(ctx context.Context, proposalId uint64, fltrs storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeEffectiveVotes", ctx, proposalId, fltrs)
+	ret0, _ := ret[0].([]storage.EffectiveVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComputeEffectiveVotes indicates an expected call of ComputeEffectiveVotes.
+func (mr *MockIVoteMockRecorder) ComputeEffectiveVotes(ctx, proposalId, fltrs any) *MockIVoteComputeEffectiveVotesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeEffectiveVotes", reflect.TypeOf((*MockIVote)(nil).ComputeEffectiveVotes), ctx, proposalId, fltrs)
+	return &MockIVoteComputeEffectiveVotesCall{Call: call}
+}
+
+// MockIVoteComputeEffectiveVotesCall wrap *gomock.Call
+type MockIVoteComputeEffectiveVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIVoteComputeEffectiveVotesCall) Return(arg0 []storage.EffectiveVote, arg1 error) *MockIVoteComputeEffectiveVotesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIVoteComputeEffectiveVotesCall) Do(f func(context.Context, uint64, storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error)) *MockIVoteComputeEffectiveVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIVoteComputeEffectiveVotesCall) DoAndReturn(f func(context.Context, uint64, storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error)) *MockIVoteComputeEffectiveVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIVote) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Vote, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +240,84 @@ func (c *MockIVoteCursorListCall) DoAndReturn(f func(context.Context, uint64, ui
 	return c
 }
 
+// EffectiveVotes mocks base method.
+func (m *MockIVote) EffectiveVotes(ctx context.Context, proposalId uint64, fltrs storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EffectiveVotes", ctx, proposalId, fltrs)
+	ret0, _ := ret[0].([]storage.EffectiveVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EffectiveVotes indicates an expected call of EffectiveVotes.
+func (mr *MockIVoteMockRecorder) EffectiveVotes(ctx, proposalId, fltrs any) *MockIVoteEffectiveVotesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EffectiveVotes", reflect.TypeOf((*MockIVote)(nil).EffectiveVotes), ctx, proposalId, fltrs)
+	return &MockIVoteEffectiveVotesCall{Call: call}
+}
+
+// MockIVoteEffectiveVotesCall wrap *gomock.Call
+type MockIVoteEffectiveVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIVoteEffectiveVotesCall) Return(arg0 []storage.EffectiveVote, arg1 error) *MockIVoteEffectiveVotesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIVoteEffectiveVotesCall) Do(f func(context.Context, uint64, storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error)) *MockIVoteEffectiveVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIVoteEffectiveVotesCall) DoAndReturn(f func(context.Context, uint64, storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error)) *MockIVoteEffectiveVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EffectiveVotesByAddress mocks base method.
+func (m *MockIVote) EffectiveVotesByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]storage.EffectiveVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EffectiveVotesByAddress", ctx, addressId, limit, offset)
+	ret0, _ := ret[0].([]storage.EffectiveVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EffectiveVotesByAddress indicates an expected call of EffectiveVotesByAddress.
+func (mr *MockIVoteMockRecorder) EffectiveVotesByAddress(ctx, addressId, limit, offset any) *MockIVoteEffectiveVotesByAddressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EffectiveVotesByAddress", reflect.TypeOf((*MockIVote)(nil).EffectiveVotesByAddress), ctx, addressId, limit, offset)
+	return &MockIVoteEffectiveVotesByAddressCall{Call: call}
+}
+
+// MockIVoteEffectiveVotesByAddressCall wrap *gomock.Call
+type MockIVoteEffectiveVotesByAddressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIVoteEffectiveVotesByAddressCall) Return(arg0 []storage.EffectiveVote, arg1 error) *MockIVoteEffectiveVotesByAddressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIVoteEffectiveVotesByAddressCall) Do(f func(context.Context, uint64, int, int) ([]storage.EffectiveVote, error)) *MockIVoteEffectiveVotesByAddressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIVoteEffectiveVotesByAddressCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.EffectiveVote, error)) *MockIVoteEffectiveVotesByAddressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetByID mocks base method.
 func (m *MockIVote) GetByID(ctx context.Context, id uint64) (*storage.Vote, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,1218 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook.go -destination=mock/webhook.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWebhook is a mock of IWebhook interface.
+type MockIWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookMockRecorder
+	isgomock struct{}
+}
+
+// MockIWebhookMockRecorder is the mock recorder for MockIWebhook.
+type MockIWebhookMockRecorder struct {
+	mock *MockIWebhook
+}
+
+// NewMockIWebhook creates a new mock instance.
+func NewMockIWebhook(ctrl *gomock.Controller) *MockIWebhook {
+	mock := &MockIWebhook{ctrl: ctrl}
+	mock.recorder = &MockIWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhook) EXPECT() *MockIWebhookMockRecorder {
+	return m.recorder
+}
+
+// Active mocks base method.
+func (m *MockIWebhook) Active(ctx context.Context) ([]storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Active", ctx)
+	ret0, _ := ret[0].([]storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Active indicates an expected call of Active.
+func (mr *MockIWebhookMockRecorder) Active(ctx any) *MockIWebhookActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockIWebhook)(nil).Active), ctx)
+	return &MockIWebhookActiveCall{Call: call}
+}
+
+// MockIWebhookActiveCall wrap *gomock.Call
+type MockIWebhookActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookActiveCall) Return(arg0 []storage.Webhook, arg1 error) *MockIWebhookActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookActiveCall) Do(f func(context.Context) ([]storage.Webhook, error)) *MockIWebhookActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookActiveCall) DoAndReturn(f func(context.Context) ([]storage.Webhook, error)) *MockIWebhookActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByApiKey mocks base method.
+func (m *MockIWebhook) ByApiKey(ctx context.Context, key string, limit, offset int) ([]storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByApiKey", ctx, key, limit, offset)
+	ret0, _ := ret[0].([]storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByApiKey indicates an expected call of ByApiKey.
+func (mr *MockIWebhookMockRecorder) ByApiKey(ctx, key, limit, offset any) *MockIWebhookByApiKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByApiKey", reflect.TypeOf((*MockIWebhook)(nil).ByApiKey), ctx, key, limit, offset)
+	return &MockIWebhookByApiKeyCall{Call: call}
+}
+
+// MockIWebhookByApiKeyCall wrap *gomock.Call
+type MockIWebhookByApiKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookByApiKeyCall) Return(arg0 []storage.Webhook, arg1 error) *MockIWebhookByApiKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookByApiKeyCall) Do(f func(context.Context, string, int, int) ([]storage.Webhook, error)) *MockIWebhookByApiKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookByApiKeyCall) DoAndReturn(f func(context.Context, string, int, int) ([]storage.Webhook, error)) *MockIWebhookByApiKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIWebhook) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIWebhookMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIWebhookCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIWebhook)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIWebhookCursorListCall{Call: call}
+}
+
+// MockIWebhookCursorListCall wrap *gomock.Call
+type MockIWebhookCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookCursorListCall) Return(arg0 []*storage.Webhook, arg1 error) *MockIWebhookCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Webhook, error)) *MockIWebhookCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Webhook, error)) *MockIWebhookCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockIWebhook) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIWebhookMockRecorder) Delete(ctx, id any) *MockIWebhookDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIWebhook)(nil).Delete), ctx, id)
+	return &MockIWebhookDeleteCall{Call: call}
+}
+
+// MockIWebhookDeleteCall wrap *gomock.Call
+type MockIWebhookDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeleteCall) Return(arg0 error) *MockIWebhookDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeleteCall) Do(f func(context.Context, uint64) error) *MockIWebhookDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeleteCall) DoAndReturn(f func(context.Context, uint64) error) *MockIWebhookDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIWebhook) GetByID(ctx context.Context, id uint64) (*storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIWebhookMockRecorder) GetByID(ctx, id any) *MockIWebhookGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIWebhook)(nil).GetByID), ctx, id)
+	return &MockIWebhookGetByIDCall{Call: call}
+}
+
+// MockIWebhookGetByIDCall wrap *gomock.Call
+type MockIWebhookGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookGetByIDCall) Return(arg0 *storage.Webhook, arg1 error) *MockIWebhookGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookGetByIDCall) Do(f func(context.Context, uint64) (*storage.Webhook, error)) *MockIWebhookGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Webhook, error)) *MockIWebhookGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIWebhook) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIWebhookMockRecorder) IsNoRows(err any) *MockIWebhookIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIWebhook)(nil).IsNoRows), err)
+	return &MockIWebhookIsNoRowsCall{Call: call}
+}
+
+// MockIWebhookIsNoRowsCall wrap *gomock.Call
+type MockIWebhookIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookIsNoRowsCall) Return(arg0 bool) *MockIWebhookIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookIsNoRowsCall) Do(f func(error) bool) *MockIWebhookIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIWebhookIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIWebhook) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIWebhookMockRecorder) LastID(ctx any) *MockIWebhookLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIWebhook)(nil).LastID), ctx)
+	return &MockIWebhookLastIDCall{Call: call}
+}
+
+// MockIWebhookLastIDCall wrap *gomock.Call
+type MockIWebhookLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookLastIDCall) Return(arg0 uint64, arg1 error) *MockIWebhookLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIWebhookLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIWebhookLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIWebhook) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIWebhookMockRecorder) List(ctx, limit, offset, order any) *MockIWebhookListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIWebhook)(nil).List), ctx, limit, offset, order)
+	return &MockIWebhookListCall{Call: call}
+}
+
+// MockIWebhookListCall wrap *gomock.Call
+type MockIWebhookListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookListCall) Return(arg0 []*storage.Webhook, arg1 error) *MockIWebhookListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Webhook, error)) *MockIWebhookListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Webhook, error)) *MockIWebhookListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIWebhook) Save(ctx context.Context, m *storage.Webhook) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIWebhookMockRecorder) Save(ctx, m any) *MockIWebhookSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIWebhook)(nil).Save), ctx, m)
+	return &MockIWebhookSaveCall{Call: call}
+}
+
+// MockIWebhookSaveCall wrap *gomock.Call
+type MockIWebhookSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookSaveCall) Return(arg0 error) *MockIWebhookSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookSaveCall) Do(f func(context.Context, *storage.Webhook) error) *MockIWebhookSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookSaveCall) DoAndReturn(f func(context.Context, *storage.Webhook) error) *MockIWebhookSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIWebhook) Update(ctx context.Context, m *storage.Webhook) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIWebhookMockRecorder) Update(ctx, m any) *MockIWebhookUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIWebhook)(nil).Update), ctx, m)
+	return &MockIWebhookUpdateCall{Call: call}
+}
+
+// MockIWebhookUpdateCall wrap *gomock.Call
+type MockIWebhookUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookUpdateCall) Return(arg0 error) *MockIWebhookUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookUpdateCall) Do(f func(context.Context, *storage.Webhook) error) *MockIWebhookUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookUpdateCall) DoAndReturn(f func(context.Context, *storage.Webhook) error) *MockIWebhookUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockIWebhookDelivery is a mock of IWebhookDelivery interface.
+type MockIWebhookDelivery struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookDeliveryMockRecorder
+	isgomock struct{}
+}
+
+// MockIWebhookDeliveryMockRecorder is the mock recorder for MockIWebhookDelivery.
+type MockIWebhookDeliveryMockRecorder struct {
+	mock *MockIWebhookDelivery
+}
+
+// NewMockIWebhookDelivery creates a new mock instance.
+func NewMockIWebhookDelivery(ctrl *gomock.Controller) *MockIWebhookDelivery {
+	mock := &MockIWebhookDelivery{ctrl: ctrl}
+	mock.recorder = &MockIWebhookDeliveryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookDelivery) EXPECT() *MockIWebhookDeliveryMockRecorder {
+	return m.recorder
+}
+
+// ByWebhookId mocks base method.
+func (m *MockIWebhookDelivery) ByWebhookId(ctx context.Context, webhookId uint64, limit, offset int) ([]storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByWebhookId", ctx, webhookId, limit, offset)
+	ret0, _ := ret[0].([]storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByWebhookId indicates an expected call of ByWebhookId.
+func (mr *MockIWebhookDeliveryMockRecorder) ByWebhookId(ctx, webhookId, limit, offset any) *MockIWebhookDeliveryByWebhookIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByWebhookId", reflect.TypeOf((*MockIWebhookDelivery)(nil).ByWebhookId), ctx, webhookId, limit, offset)
+	return &MockIWebhookDeliveryByWebhookIdCall{Call: call}
+}
+
+// MockIWebhookDeliveryByWebhookIdCall wrap *gomock.Call
+type MockIWebhookDeliveryByWebhookIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryByWebhookIdCall) Return(arg0 []storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryByWebhookIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryByWebhookIdCall) Do(f func(context.Context, uint64, int, int) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryByWebhookIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryByWebhookIdCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryByWebhookIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIWebhookDelivery) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIWebhookDeliveryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIWebhookDeliveryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIWebhookDelivery)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIWebhookDeliveryCursorListCall{Call: call}
+}
+
+// MockIWebhookDeliveryCursorListCall wrap *gomock.Call
+type MockIWebhookDeliveryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryCursorListCall) Return(arg0 []*storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIWebhookDelivery) GetByID(ctx context.Context, id uint64) (*storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIWebhookDeliveryMockRecorder) GetByID(ctx, id any) *MockIWebhookDeliveryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIWebhookDelivery)(nil).GetByID), ctx, id)
+	return &MockIWebhookDeliveryGetByIDCall{Call: call}
+}
+
+// MockIWebhookDeliveryGetByIDCall wrap *gomock.Call
+type MockIWebhookDeliveryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryGetByIDCall) Return(arg0 *storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryGetByIDCall) Do(f func(context.Context, uint64) (*storage.WebhookDelivery, error)) *MockIWebhookDeliveryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.WebhookDelivery, error)) *MockIWebhookDeliveryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIWebhookDelivery) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIWebhookDeliveryMockRecorder) IsNoRows(err any) *MockIWebhookDeliveryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIWebhookDelivery)(nil).IsNoRows), err)
+	return &MockIWebhookDeliveryIsNoRowsCall{Call: call}
+}
+
+// MockIWebhookDeliveryIsNoRowsCall wrap *gomock.Call
+type MockIWebhookDeliveryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryIsNoRowsCall) Return(arg0 bool) *MockIWebhookDeliveryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryIsNoRowsCall) Do(f func(error) bool) *MockIWebhookDeliveryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIWebhookDeliveryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIWebhookDelivery) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIWebhookDeliveryMockRecorder) LastID(ctx any) *MockIWebhookDeliveryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIWebhookDelivery)(nil).LastID), ctx)
+	return &MockIWebhookDeliveryLastIDCall{Call: call}
+}
+
+// MockIWebhookDeliveryLastIDCall wrap *gomock.Call
+type MockIWebhookDeliveryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryLastIDCall) Return(arg0 uint64, arg1 error) *MockIWebhookDeliveryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIWebhookDeliveryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIWebhookDeliveryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIWebhookDelivery) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIWebhookDeliveryMockRecorder) List(ctx, limit, offset, order any) *MockIWebhookDeliveryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIWebhookDelivery)(nil).List), ctx, limit, offset, order)
+	return &MockIWebhookDeliveryListCall{Call: call}
+}
+
+// MockIWebhookDeliveryListCall wrap *gomock.Call
+type MockIWebhookDeliveryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryListCall) Return(arg0 []*storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIWebhookDelivery) Save(ctx context.Context, m *storage.WebhookDelivery) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIWebhookDeliveryMockRecorder) Save(ctx, m any) *MockIWebhookDeliverySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIWebhookDelivery)(nil).Save), ctx, m)
+	return &MockIWebhookDeliverySaveCall{Call: call}
+}
+
+// MockIWebhookDeliverySaveCall wrap *gomock.Call
+type MockIWebhookDeliverySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliverySaveCall) Return(arg0 error) *MockIWebhookDeliverySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliverySaveCall) Do(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliverySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliverySaveCall) DoAndReturn(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliverySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIWebhookDelivery) Update(ctx context.Context, m *storage.WebhookDelivery) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIWebhookDeliveryMockRecorder) Update(ctx, m any) *MockIWebhookDeliveryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIWebhookDelivery)(nil).Update), ctx, m)
+	return &MockIWebhookDeliveryUpdateCall{Call: call}
+}
+
+// MockIWebhookDeliveryUpdateCall wrap *gomock.Call
+type MockIWebhookDeliveryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryUpdateCall) Return(arg0 error) *MockIWebhookDeliveryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryUpdateCall) Do(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliveryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryUpdateCall) DoAndReturn(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliveryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockIWebhookTask is a mock of IWebhookTask interface.
+type MockIWebhookTask struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookTaskMockRecorder
+	isgomock struct{}
+}
+
+// MockIWebhookTaskMockRecorder is the mock recorder for MockIWebhookTask.
+type MockIWebhookTaskMockRecorder struct {
+	mock *MockIWebhookTask
+}
+
+// NewMockIWebhookTask creates a new mock instance.
+func NewMockIWebhookTask(ctrl *gomock.Controller) *MockIWebhookTask {
+	mock := &MockIWebhookTask{ctrl: ctrl}
+	mock.recorder = &MockIWebhookTaskMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookTask) EXPECT() *MockIWebhookTaskMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockIWebhookTask) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]storage.WebhookTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]storage.WebhookTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockIWebhookTaskMockRecorder) Claim(ctx, now, lease, limit any) *MockIWebhookTaskClaimCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockIWebhookTask)(nil).Claim), ctx, now, lease, limit)
+	return &MockIWebhookTaskClaimCall{Call: call}
+}
+
+// MockIWebhookTaskClaimCall wrap *gomock.Call
+type MockIWebhookTaskClaimCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskClaimCall) Return(arg0 []storage.WebhookTask, arg1 error) *MockIWebhookTaskClaimCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskClaimCall) Do(f func(context.Context, time.Time, time.Duration, int) ([]storage.WebhookTask, error)) *MockIWebhookTaskClaimCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskClaimCall) DoAndReturn(f func(context.Context, time.Time, time.Duration, int) ([]storage.WebhookTask, error)) *MockIWebhookTaskClaimCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIWebhookTask) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.WebhookTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.WebhookTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIWebhookTaskMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIWebhookTaskCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIWebhookTask)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIWebhookTaskCursorListCall{Call: call}
+}
+
+// MockIWebhookTaskCursorListCall wrap *gomock.Call
+type MockIWebhookTaskCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskCursorListCall) Return(arg0 []*storage.WebhookTask, arg1 error) *MockIWebhookTaskCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookTask, error)) *MockIWebhookTaskCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookTask, error)) *MockIWebhookTaskCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockIWebhookTask) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIWebhookTaskMockRecorder) Delete(ctx, id any) *MockIWebhookTaskDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIWebhookTask)(nil).Delete), ctx, id)
+	return &MockIWebhookTaskDeleteCall{Call: call}
+}
+
+// MockIWebhookTaskDeleteCall wrap *gomock.Call
+type MockIWebhookTaskDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskDeleteCall) Return(arg0 error) *MockIWebhookTaskDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskDeleteCall) Do(f func(context.Context, uint64) error) *MockIWebhookTaskDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskDeleteCall) DoAndReturn(f func(context.Context, uint64) error) *MockIWebhookTaskDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Enqueue mocks base method.
+func (m *MockIWebhookTask) Enqueue(ctx context.Context, tasks ...storage.WebhookTask) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range tasks {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Enqueue", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockIWebhookTaskMockRecorder) Enqueue(ctx any, tasks ...any) *MockIWebhookTaskEnqueueCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, tasks...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockIWebhookTask)(nil).Enqueue), varargs...)
+	return &MockIWebhookTaskEnqueueCall{Call: call}
+}
+
+// MockIWebhookTaskEnqueueCall wrap *gomock.Call
+type MockIWebhookTaskEnqueueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskEnqueueCall) Return(arg0 error) *MockIWebhookTaskEnqueueCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskEnqueueCall) Do(f func(context.Context, ...storage.WebhookTask) error) *MockIWebhookTaskEnqueueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskEnqueueCall) DoAndReturn(f func(context.Context, ...storage.WebhookTask) error) *MockIWebhookTaskEnqueueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIWebhookTask) GetByID(ctx context.Context, id uint64) (*storage.WebhookTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.WebhookTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIWebhookTaskMockRecorder) GetByID(ctx, id any) *MockIWebhookTaskGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIWebhookTask)(nil).GetByID), ctx, id)
+	return &MockIWebhookTaskGetByIDCall{Call: call}
+}
+
+// MockIWebhookTaskGetByIDCall wrap *gomock.Call
+type MockIWebhookTaskGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskGetByIDCall) Return(arg0 *storage.WebhookTask, arg1 error) *MockIWebhookTaskGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskGetByIDCall) Do(f func(context.Context, uint64) (*storage.WebhookTask, error)) *MockIWebhookTaskGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.WebhookTask, error)) *MockIWebhookTaskGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIWebhookTask) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIWebhookTaskMockRecorder) IsNoRows(err any) *MockIWebhookTaskIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIWebhookTask)(nil).IsNoRows), err)
+	return &MockIWebhookTaskIsNoRowsCall{Call: call}
+}
+
+// MockIWebhookTaskIsNoRowsCall wrap *gomock.Call
+type MockIWebhookTaskIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskIsNoRowsCall) Return(arg0 bool) *MockIWebhookTaskIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskIsNoRowsCall) Do(f func(error) bool) *MockIWebhookTaskIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIWebhookTaskIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIWebhookTask) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIWebhookTaskMockRecorder) LastID(ctx any) *MockIWebhookTaskLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIWebhookTask)(nil).LastID), ctx)
+	return &MockIWebhookTaskLastIDCall{Call: call}
+}
+
+// MockIWebhookTaskLastIDCall wrap *gomock.Call
+type MockIWebhookTaskLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskLastIDCall) Return(arg0 uint64, arg1 error) *MockIWebhookTaskLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIWebhookTaskLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIWebhookTaskLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIWebhookTask) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.WebhookTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.WebhookTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIWebhookTaskMockRecorder) List(ctx, limit, offset, order any) *MockIWebhookTaskListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIWebhookTask)(nil).List), ctx, limit, offset, order)
+	return &MockIWebhookTaskListCall{Call: call}
+}
+
+// MockIWebhookTaskListCall wrap *gomock.Call
+type MockIWebhookTaskListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskListCall) Return(arg0 []*storage.WebhookTask, arg1 error) *MockIWebhookTaskListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookTask, error)) *MockIWebhookTaskListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookTask, error)) *MockIWebhookTaskListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Retry mocks base method.
+func (m *MockIWebhookTask) Retry(ctx context.Context, id uint64, attempt int, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, id, attempt, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockIWebhookTaskMockRecorder) Retry(ctx, id, attempt, nextAttemptAt any) *MockIWebhookTaskRetryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockIWebhookTask)(nil).Retry), ctx, id, attempt, nextAttemptAt)
+	return &MockIWebhookTaskRetryCall{Call: call}
+}
+
+// MockIWebhookTaskRetryCall wrap *gomock.Call
+type MockIWebhookTaskRetryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskRetryCall) Return(arg0 error) *MockIWebhookTaskRetryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskRetryCall) Do(f func(context.Context, uint64, int, time.Time) error) *MockIWebhookTaskRetryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskRetryCall) DoAndReturn(f func(context.Context, uint64, int, time.Time) error) *MockIWebhookTaskRetryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIWebhookTask) Save(ctx context.Context, m *storage.WebhookTask) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIWebhookTaskMockRecorder) Save(ctx, m any) *MockIWebhookTaskSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIWebhookTask)(nil).Save), ctx, m)
+	return &MockIWebhookTaskSaveCall{Call: call}
+}
+
+// MockIWebhookTaskSaveCall wrap *gomock.Call
+type MockIWebhookTaskSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskSaveCall) Return(arg0 error) *MockIWebhookTaskSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskSaveCall) Do(f func(context.Context, *storage.WebhookTask) error) *MockIWebhookTaskSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskSaveCall) DoAndReturn(f func(context.Context, *storage.WebhookTask) error) *MockIWebhookTaskSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIWebhookTask) Update(ctx context.Context, m *storage.WebhookTask) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIWebhookTaskMockRecorder) Update(ctx, m any) *MockIWebhookTaskUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIWebhookTask)(nil).Update), ctx, m)
+	return &MockIWebhookTaskUpdateCall{Call: call}
+}
+
+// MockIWebhookTaskUpdateCall wrap *gomock.Call
+type MockIWebhookTaskUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookTaskUpdateCall) Return(arg0 error) *MockIWebhookTaskUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookTaskUpdateCall) Do(f func(context.Context, *storage.WebhookTask) error) *MockIWebhookTaskUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookTaskUpdateCall) DoAndReturn(f func(context.Context, *storage.WebhookTask) error) *MockIWebhookTaskUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
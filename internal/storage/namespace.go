@@ -23,6 +23,7 @@ type INamespace interface {
 	ByNamespaceIdAndVersion(ctx context.Context, namespaceId []byte, version byte) (Namespace, error)
 	Messages(ctx context.Context, id uint64, limit, offset int) ([]NamespaceMessage, error)
 	ListWithSort(ctx context.Context, sortField string, sort sdk.SortOrder, limit, offset int) (ns []Namespace, err error)
+	ListAfter(ctx context.Context, cursor uint64, limit int) (ns []Namespace, err error)
 	GetByIds(ctx context.Context, ids ...uint64) (ns []Namespace, err error)
 }
 
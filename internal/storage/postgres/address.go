@@ -8,6 +8,7 @@ import (
 
 	"github.com/celenium-io/celestia-indexer/internal/currency"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-io/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/pkg/errors"
@@ -35,13 +36,24 @@ func (a *Address) ByHash(ctx context.Context, hash []byte) (address storage.Addr
 	err = a.DB().NewSelect().TableExpr("(?) as address", addressQuery).
 		ColumnExpr("address.*").
 		ColumnExpr("celestial.id as celestials__id, celestial.image_url as celestials__image_url").
+		ColumnExpr("address_label.name as label__name, address_label.category as label__category, address_label.source as label__source").
 		ColumnExpr("balance.currency as default_balance__currency, balance.spendable as default_balance__spendable, balance.delegated as default_balance__delegated, balance.unbonding as default_balance__unbonding").
 		Join("left join balance on balance.id = address.id and balance.currency = ?", currency.DefaultCurrency).
 		Join("left join celestial on celestial.address_id = address.id and celestial.status = 'PRIMARY'").
+		Join("left join address_label on address_label.address_id = address.id").
 		Scan(ctx, &address)
 	return
 }
 
+func (a *Address) GetByIds(ctx context.Context, ids ...uint64) (addresses []storage.Address, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	err = a.DB().NewSelect().Model(&addresses).Where("id IN (?)", bun.In(ids)).Scan(ctx)
+	return
+}
+
 func (a *Address) ListWithBalance(ctx context.Context, filters storage.AddressListFilter) (result []storage.Address, err error) {
 	if filters.SortField == "last_height" || filters.SortField == "first_height" {
 		addressQuery := a.DB().NewSelect().
@@ -80,6 +92,35 @@ func (a *Address) ListWithBalance(ctx context.Context, filters storage.AddressLi
 	return
 }
 
+// ListWithBalanceAfter - returns addresses ordered by spendable balance and identity in descending order. Cursor is the identity
+// of the last received address, only addresses following it are returned. It's used by keyset pagination of GraphQL.
+func (a *Address) ListWithBalanceAfter(ctx context.Context, cursor uint64, limit int) (result []storage.Address, err error) {
+	balanceQuery := a.DB().NewSelect().
+		Model((*storage.Balance)(nil)).
+		Where("currency = ?", currency.DefaultCurrency).
+		OrderExpr("spendable desc, id desc")
+	if cursor > 0 {
+		cursorRow := a.DB().NewSelect().
+			Model((*storage.Balance)(nil)).
+			Column("spendable", "id").
+			Where("currency = ?", currency.DefaultCurrency).
+			Where("id = ?", cursor)
+		balanceQuery = balanceQuery.Where("(spendable, id) < (?)", cursorRow)
+	}
+	balanceQuery = limitScope(balanceQuery, limit)
+
+	err = a.DB().NewSelect().
+		TableExpr("(?) as balance", balanceQuery).
+		ColumnExpr("address.*").
+		ColumnExpr("celestial.id as celestials__id, celestial.image_url as celestials__image_url").
+		ColumnExpr("balance.currency as default_balance__currency, balance.spendable as default_balance__spendable, balance.delegated as default_balance__delegated, balance.unbonding as default_balance__unbonding").
+		Join("left join address on balance.id = address.id and balance.currency = ?", currency.DefaultCurrency).
+		Join("left join celestial on celestial.address_id = address.id and celestial.status = 'PRIMARY'").
+		OrderExpr("balance.spendable desc, balance.id desc").
+		Scan(ctx, &result)
+	return
+}
+
 func (a *Address) Series(ctx context.Context, addressId uint64, timeframe storage.Timeframe, column string, req storage.SeriesRequest) (items []storage.HistogramItem, err error) {
 	query := a.DB().NewSelect().
 		Where("address_id = ?", addressId).
@@ -168,3 +209,71 @@ func (a *Address) Balances(ctx context.Context, addressId uint64, limit, offset
 	err = query.Scan(ctx, &balances)
 	return
 }
+
+// BalancesAt - returns address balances at the end of the block with given height. It's computed as sum of balance updates.
+func (a *Address) BalancesAt(ctx context.Context, addressId uint64, height types.Level, limit, offset int) (balances []storage.Balance, err error) {
+	query := a.DB().NewSelect().
+		Model((*storage.BalanceUpdate)(nil)).
+		ColumnExpr("address_id as id, currency").
+		ColumnExpr("sum(spendable) as spendable, sum(delegated) as delegated, sum(unbonding) as unbonding").
+		Where("address_id = ?", addressId).
+		Where("height <= ?", height).
+		Group("address_id", "currency").
+		Order("currency")
+
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	query = limitScope(query, limit)
+
+	err = query.Scan(ctx, &balances)
+	return
+}
+
+// BalanceSeries - returns address balance in given currency at the end of each time bucket
+func (a *Address) BalanceSeries(ctx context.Context, addressId uint64, denom string, timeframe storage.Timeframe, req storage.SeriesRequest) (items []storage.BalanceSeriesItem, err error) {
+	var interval string
+	switch timeframe {
+	case storage.TimeframeHour:
+		interval = "1 hour"
+	case storage.TimeframeDay:
+		interval = "1 day"
+	case storage.TimeframeWeek:
+		interval = "1 week"
+	case storage.TimeframeMonth:
+		interval = "1 month"
+	default:
+		return nil, errors.Errorf("invalid timeframe: %s", timeframe)
+	}
+
+	inner := a.DB().NewSelect().
+		Model((*storage.BalanceUpdate)(nil)).
+		ColumnExpr("time_bucket(?, time) as bucket", interval).
+		ColumnExpr("sum(spendable) as spendable, sum(delegated) as delegated, sum(unbonding) as unbonding").
+		Where("address_id = ?", addressId).
+		Where("currency = ?", denom).
+		Group("bucket")
+
+	if !req.To.IsZero() {
+		inner = inner.Where("time < ?", req.To)
+	}
+
+	cumulative := a.DB().NewSelect().
+		TableExpr("(?) as updates", inner).
+		ColumnExpr("bucket").
+		ColumnExpr("sum(spendable) OVER(ORDER BY bucket) as spendable").
+		ColumnExpr("sum(delegated) OVER(ORDER BY bucket) as delegated").
+		ColumnExpr("sum(unbonding) OVER(ORDER BY bucket) as unbonding")
+
+	query := a.DB().NewSelect().
+		TableExpr("(?) as series", cumulative).
+		ColumnExpr("series.*").
+		Order("bucket desc")
+
+	if !req.From.IsZero() {
+		query = query.Where("bucket >= ?", req.From)
+	}
+
+	err = query.Scan(ctx, &items)
+	return
+}
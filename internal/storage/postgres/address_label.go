@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// AddressLabel -
+type AddressLabel struct {
+	*postgres.Table[*storage.AddressLabel]
+}
+
+// NewAddressLabel -
+func NewAddressLabel(db *database.Bun) *AddressLabel {
+	return &AddressLabel{
+		Table: postgres.NewTable[*storage.AddressLabel](db),
+	}
+}
+
+func (al *AddressLabel) List(ctx context.Context, fltrs storage.AddressLabelFilters) (labels []storage.AddressLabel, err error) {
+	query := al.DB().NewSelect().
+		Model((*storage.AddressLabel)(nil))
+
+	if len(fltrs.Category) > 0 {
+		query = query.Where("category IN (?)", bun.In(fltrs.Category))
+	}
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = query.Order("id desc")
+
+	err = al.DB().NewSelect().
+		TableExpr("(?) as address_label", query).
+		ColumnExpr("address_label.*").
+		ColumnExpr("address.address as address__address").
+		Join("left join address on address.id = address_label.address_id").
+		Order("address_label.id desc").
+		Scan(ctx, &labels)
+	return
+}
+
+func (al *AddressLabel) ByAddressId(ctx context.Context, addressId uint64) (label storage.AddressLabel, err error) {
+	err = al.DB().NewSelect().
+		Model(&label).
+		Where("address_id = ?", addressId).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+func (al *AddressLabel) ByAddressIds(ctx context.Context, addressIds ...uint64) (labels []storage.AddressLabel, err error) {
+	if len(addressIds) == 0 {
+		return nil, nil
+	}
+
+	err = al.DB().NewSelect().
+		Model(&labels).
+		Where("address_id IN (?)", bun.In(addressIds)).
+		Scan(ctx)
+	return
+}
+
+// ByMessages - returns labels of the addresses linked to the messages
+func (al *AddressLabel) ByMessages(ctx context.Context, msgIds ...uint64) (labels []storage.MessageLabel, err error) {
+	if len(msgIds) == 0 {
+		return
+	}
+
+	err = al.DB().NewSelect().
+		TableExpr("msg_address").
+		ColumnExpr("msg_address.msg_id, address.address, address_label.name, address_label.category, address_label.source").
+		Join("inner join address_label on address_label.address_id = msg_address.address_id").
+		Join("left join address on address.id = msg_address.address_id").
+		Where("msg_address.msg_id = ANY(?)", pgdialect.Array(msgIds)).
+		Scan(ctx, &labels)
+	return
+}
+
+func (al *AddressLabel) Delete(ctx context.Context, id uint64) error {
+	_, err := al.DB().NewDelete().
+		Model((*storage.AddressLabel)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
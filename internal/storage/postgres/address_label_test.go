@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+)
+
+func (s *StorageTestSuite) TestAddressLabelList() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	labels, err := s.storage.AddressLabels.List(ctx, storage.AddressLabelFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(labels, 2)
+
+	label := labels[0]
+	s.Require().EqualValues(2, label.Id)
+	s.Require().EqualValues(3, label.AddressId)
+	s.Require().Equal("Bridge relayer", label.Name)
+	s.Require().Equal(types.AddressLabelCategoryBridge, label.Category)
+	s.Require().NotNil(label.Address)
+	s.Require().Equal("celestia1cr2t0y5zu9sya67t9sp0vt9cxum408yuphkhex", label.Address.Address)
+}
+
+func (s *StorageTestSuite) TestAddressLabelListByCategory() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	labels, err := s.storage.AddressLabels.List(ctx, storage.AddressLabelFilters{
+		Limit:    10,
+		Category: []types.AddressLabelCategory{types.AddressLabelCategoryExchange},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(labels, 1)
+
+	label := labels[0]
+	s.Require().EqualValues(1, label.Id)
+	s.Require().Equal("Binance hot wallet", label.Name)
+	s.Require().Equal("https://example.com/labels", label.Source)
+	s.Require().Equal("test_key", label.ApiKey)
+	s.Require().NotNil(label.Address)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", label.Address.Address)
+}
+
+func (s *StorageTestSuite) TestAddressLabelByAddressId() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	label, err := s.storage.AddressLabels.ByAddressId(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, label.Id)
+	s.Require().Equal(types.AddressLabelCategoryExchange, label.Category)
+
+	_, err = s.storage.AddressLabels.ByAddressId(ctx, 1)
+	s.Require().Error(err)
+	s.Require().True(s.storage.AddressLabels.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestAddressLabelByMessages() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	labels, err := s.storage.AddressLabels.ByMessages(ctx, 1, 2)
+	s.Require().NoError(err)
+	s.Require().Len(labels, 1)
+
+	label := labels[0]
+	s.Require().EqualValues(1, label.MsgId)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", label.Address)
+	s.Require().Equal("Binance hot wallet", label.Name)
+	s.Require().Equal(types.AddressLabelCategoryExchange, label.Category)
+
+	labels, err = s.storage.AddressLabels.ByMessages(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(labels, 0)
+}
@@ -24,6 +24,7 @@ func (s *StorageTestSuite) TestAddressByHash() {
 	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", address.Address)
 	s.Require().NotNil(address.Celestials)
 	s.Require().EqualValues("name 1", address.Celestials.Id)
+	s.Require().Nil(address.Label)
 	s.Require().NotNil(address.DefaultBalance)
 	s.Require().EqualValues("432", address.DefaultBalance.Spendable.String())
 }
@@ -68,6 +69,9 @@ func (s *StorageTestSuite) TestAddressByHashWithoutCelestials() {
 	s.Require().EqualValues(102, address.Height)
 	s.Require().Equal("celestia1cr2t0y5zu9sya67t9sp0vt9cxum408yuphkhex", address.Address)
 	s.Require().Nil(address.Celestials)
+	s.Require().NotNil(address.Label)
+	s.Require().Equal("Bridge relayer", address.Label.Name)
+	s.Require().Equal(types.AddressLabelCategoryBridge, address.Label.Category)
 }
 
 func (s *StorageTestSuite) TestAddressList() {
@@ -208,6 +212,17 @@ func (s *StorageTestSuite) TestAddressListWithSortDesc() {
 	}
 }
 
+func (s *StorageTestSuite) TestAddressListWithBalanceAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	addresses, err := s.storage.Address.ListWithBalanceAfter(ctx, 1, 2)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+	s.Require().EqualValues(2, addresses[0].Id)
+	s.Require().EqualValues(4, addresses[1].Id)
+}
+
 func (s *StorageTestSuite) TestAddressListWithSortAsc() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -349,3 +364,55 @@ func (s *StorageTestSuite) TestBalances() {
 	s.Require().Equal("1", balances[0].Spendable.String())
 	s.Require().Equal("ibc/testtoken", balances[0].Currency)
 }
+
+func (s *StorageTestSuite) TestBalancesAt() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	balances, err := s.storage.Address.BalancesAt(ctx, 1, 200, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+
+	s.Require().EqualValues(1, balances[0].Id)
+	s.Require().Equal("utia", balances[0].Currency)
+	s.Require().Equal("422", balances[0].Spendable.String())
+	s.Require().Equal("10", balances[0].Delegated.String())
+	s.Require().Equal("0", balances[0].Unbonding.String())
+
+	balances, err = s.storage.Address.BalancesAt(ctx, 1, 300, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+	s.Require().Equal("432", balances[0].Spendable.String())
+	s.Require().Equal("10", balances[0].Delegated.String())
+	s.Require().Equal("10", balances[0].Unbonding.String())
+
+	balances, err = s.storage.Address.BalancesAt(ctx, 1, 99, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 0)
+}
+
+func (s *StorageTestSuite) TestBalanceSeries() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.Address.BalanceSeries(ctx, 1, "utia", storage.TimeframeDay, storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().Equal("432", items[0].Spendable.String())
+	s.Require().Equal("10", items[0].Delegated.String())
+	s.Require().Equal("10", items[0].Unbonding.String())
+
+	s.Require().Equal("500", items[1].Spendable.String())
+	s.Require().Equal("0", items[1].Delegated.String())
+	s.Require().Equal("0", items[1].Unbonding.String())
+
+	items, err = s.storage.Address.BalanceSeries(ctx, 1, "utia", storage.TimeframeHour, storage.NewSeriesRequest(1688526000, 0))
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Require().Equal("432", items[0].Spendable.String())
+	s.Require().Equal("422", items[1].Spendable.String())
+
+	_, err = s.storage.Address.BalanceSeries(ctx, 1, "utia", storage.TimeframeYear, storage.SeriesRequest{})
+	s.Require().Error(err)
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+)
+
+// AlertRule -
+type AlertRule struct {
+	*postgres.Table[*storage.AlertRule]
+}
+
+// NewAlertRule -
+func NewAlertRule(db *database.Bun) *AlertRule {
+	return &AlertRule{
+		Table: postgres.NewTable[*storage.AlertRule](db),
+	}
+}
+
+func (ar *AlertRule) ByApiKey(ctx context.Context, key string, limit, offset int) (rules []storage.AlertRule, err error) {
+	query := ar.DB().NewSelect().
+		Model(&rules).
+		Where("api_key = ?", key).
+		Order("id desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+func (ar *AlertRule) Active(ctx context.Context) (rules []storage.AlertRule, err error) {
+	err = ar.DB().NewSelect().
+		Model(&rules).
+		Where("active = true").
+		Scan(ctx)
+	return
+}
+
+func (ar *AlertRule) Delete(ctx context.Context, id uint64) error {
+	_, err := ar.DB().NewDelete().
+		Model((*storage.AlertRule)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+// Alert -
+type Alert struct {
+	*postgres.Table[*storage.Alert]
+}
+
+// NewAlert -
+func NewAlert(db *database.Bun) *Alert {
+	return &Alert{
+		Table: postgres.NewTable[*storage.Alert](db),
+	}
+}
+
+func (a *Alert) ActiveList(ctx context.Context, fltrs storage.AlertFilters) (alerts []storage.Alert, err error) {
+	query := a.DB().NewSelect().
+		Model(&alerts).
+		Relation("Rule").
+		Where("alert.resolved_at IS NULL").
+		Order("alert.started_at desc")
+
+	if fltrs.RollupId > 0 {
+		query = query.Where("alert.rollup_id = ?", fltrs.RollupId)
+	}
+	if fltrs.NamespaceId > 0 {
+		query = query.Where("alert.namespace_id = ?", fltrs.NamespaceId)
+	}
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+// Open - saves alert if the rule has no active alert yet. Returns true if alert was saved.
+func (a *Alert) Open(ctx context.Context, alert *storage.Alert) (bool, error) {
+	_, err := a.DB().NewInsert().
+		Model(alert).
+		On("CONFLICT (rule_id) WHERE resolved_at IS NULL DO NOTHING").
+		Returning("id").
+		Exec(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return alert.Id > 0, nil
+}
+
+// Resolve - resolves active alert of the rule. Returns resolved alerts.
+func (a *Alert) Resolve(ctx context.Context, ruleId uint64, resolvedAt time.Time) (alerts []storage.Alert, err error) {
+	_, err = a.DB().NewUpdate().
+		Model((*storage.Alert)(nil)).
+		Set("resolved_at = ?", resolvedAt).
+		Where("rule_id = ?", ruleId).
+		Where("resolved_at IS NULL").
+		Returning("*").
+		Exec(ctx, &alerts)
+	return
+}
+
+func (a *Alert) LastBlobTime(ctx context.Context, rule storage.AlertRule) (ts time.Time, err error) {
+	query := a.DB().NewSelect().
+		TableExpr("? as stats", bun.Safe(storage.ViewRollupStatsByHour)).
+		ColumnExpr("max(stats.last_time)")
+	query = alertRuleScope(query, rule)
+	err = query.Scan(ctx, &ts)
+	return
+}
+
+func (a *Alert) HourlyFee(ctx context.Context, rule storage.AlertRule, hour time.Time) (fee types.Numeric, err error) {
+	query := a.DB().NewSelect().
+		TableExpr("? as stats", bun.Safe(storage.ViewRollupStatsByHour)).
+		ColumnExpr("coalesce(sum(stats.fee), 0)").
+		Where("stats.time = ?", hour)
+	query = alertRuleScope(query, rule)
+	err = query.Scan(ctx, &fee)
+	return
+}
+
+func (a *Alert) HourlySize(ctx context.Context, rule storage.AlertRule, hour time.Time) (size int64, err error) {
+	var query *bun.SelectQuery
+	if rule.RollupId > 0 {
+		query = a.DB().NewSelect().
+			TableExpr("? as stats", bun.Safe(storage.ViewRollupStatsByHour)).
+			ColumnExpr("coalesce(sum(stats.size), 0)").
+			Where("stats.time = ?", hour)
+		query = alertRuleScope(query, rule)
+	} else {
+		query = a.DB().NewSelect().
+			TableExpr("? as stats", bun.Safe(storage.ViewNamespaceStatsByHour)).
+			ColumnExpr("coalesce(sum(stats.size), 0)").
+			Where("stats.ts = ?", hour).
+			Where("stats.namespace_id = ?", rule.NamespaceId)
+	}
+	err = query.Scan(ctx, &size)
+	return
+}
+
+func (a *Alert) BlobSizeP99(ctx context.Context, rule storage.AlertRule, from time.Time) (size float64, err error) {
+	query := a.DB().NewSelect().
+		TableExpr("blob_log as stats").
+		ColumnExpr("coalesce(percentile_cont(0.99) within group (order by stats.size), 0)").
+		Where("stats.time >= ?", from)
+	query = alertRuleScope(query, rule)
+	err = query.Scan(ctx, &size)
+	return
+}
+
+// alertRuleScope - filters rows of blob statistics aliased as `stats` by rollup providers or namespace of the rule
+func alertRuleScope(query *bun.SelectQuery, rule storage.AlertRule) *bun.SelectQuery {
+	if rule.RollupId > 0 {
+		return query.Where(`EXISTS (
+			SELECT 1 FROM rollup_provider AS rp
+			WHERE rp.rollup_id = ?
+				AND (rp.namespace_id = 0 OR rp.namespace_id = stats.namespace_id)
+				AND (rp.address_id = 0 OR rp.address_id = stats.signer_id)
+		)`, rule.RollupId)
+	}
+	return query.Where("stats.namespace_id = ?", rule.NamespaceId)
+}
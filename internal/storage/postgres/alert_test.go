@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+)
+
+func (s *StorageTestSuite) TestAlertRuleByApiKey() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	rules, err := s.storage.AlertRules.ByApiKey(ctx, "test_key", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(rules, 2)
+	s.Require().EqualValues(2, rules[0].Id)
+	s.Require().Equal(types.AlertRuleKindHourlyFee, rules[0].Kind)
+	s.Require().EqualValues(2, rules[0].NamespaceId)
+	s.Require().Equal("1000000", rules[0].Threshold.String())
+	s.Require().False(rules[0].Active)
+
+	rules, err = s.storage.AlertRules.ByApiKey(ctx, "unknown", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(rules, 0)
+}
+
+func (s *StorageTestSuite) TestAlertRuleActive() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	rules, err := s.storage.AlertRules.Active(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(rules, 1)
+	s.Require().EqualValues(1, rules[0].Id)
+	s.Require().Equal(types.AlertRuleKindNoBlob, rules[0].Kind)
+	s.Require().EqualValues(1, rules[0].RollupId)
+}
+
+func (s *StorageTestSuite) TestAlertActiveList() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	alerts, err := s.storage.Alerts.ActiveList(ctx, storage.AlertFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(alerts, 1)
+	s.Require().EqualValues(1, alerts[0].Id)
+	s.Require().EqualValues(1, alerts[0].RollupId)
+	s.Require().Nil(alerts[0].ResolvedAt)
+	s.Require().NotNil(alerts[0].Rule)
+	s.Require().Equal("no blobs for 10 minutes", alerts[0].Rule.Name)
+
+	alerts, err = s.storage.Alerts.ActiveList(ctx, storage.AlertFilters{
+		Limit:       10,
+		NamespaceId: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(alerts, 0)
+}
+
+func (s *StorageTestSuite) TestAlertOpenAlreadyActive() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	opened, err := s.storage.Alerts.Open(ctx, &storage.Alert{
+		RuleId:    1,
+		Kind:      types.AlertRuleKindNoBlob,
+		RollupId:  1,
+		Value:     types.NumericFromInt64(1800),
+		Threshold: types.NumericFromInt64(600),
+		StartedAt: time.Now().UTC(),
+	})
+	s.Require().NoError(err)
+	s.Require().False(opened)
+}
+
+func (s *StorageTestSuite) TestAlertLastBlobTime() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	ts, err := s.storage.Alerts.LastBlobTime(ctx, storage.AlertRule{
+		RollupId: 1,
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(1688440257, ts.Unix())
+}
+
+func (s *StorageTestSuite) TestAlertBlobSizeP99() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	size, err := s.storage.Alerts.BlobSizeP99(ctx, storage.AlertRule{
+		RollupId: 1,
+	}, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().InDelta(19.9, size, 0.001)
+
+	size, err = s.storage.Alerts.BlobSizeP99(ctx, storage.AlertRule{
+		NamespaceId: 1,
+	}, time.Now().UTC())
+	s.Require().NoError(err)
+	s.Require().Zero(size)
+}
@@ -5,9 +5,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/go-lib/database"
+	"github.com/pkg/errors"
 )
 
 // ApiKey -
@@ -27,3 +30,54 @@ func (ak *ApiKey) Get(ctx context.Context, key string) (apikey storage.ApiKey, e
 	err = ak.db.DB().NewSelect().Model(&apikey).WherePK().Scan(ctx)
 	return
 }
+
+// BySecret - returns the key by the secret passed in request. Only hash of the secret is compared.
+func (ak *ApiKey) BySecret(ctx context.Context, secret string) (apikey storage.ApiKey, err error) {
+	err = ak.db.DB().NewSelect().
+		Model(&apikey).
+		Where("secret_hash = ?", storage.HashApiKeySecret(secret)).
+		Scan(ctx)
+	return
+}
+
+func (ak *ApiKey) List(ctx context.Context, limit, offset int) (keys []storage.ApiKey, err error) {
+	query := ak.db.DB().NewSelect().
+		Model(&keys).
+		Order("created_at desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+func (ak *ApiKey) Save(ctx context.Context, key *storage.ApiKey) error {
+	_, err := ak.db.DB().NewInsert().Model(key).Exec(ctx)
+	return err
+}
+
+// Update - updates all fields of the key except last usage time which is tracked by SetLastUsed
+func (ak *ApiKey) Update(ctx context.Context, key *storage.ApiKey) error {
+	_, err := ak.db.DB().NewUpdate().
+		Model(key).
+		ExcludeColumn("last_used_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+func (ak *ApiKey) SetLastUsed(ctx context.Context, key string, ts time.Time) error {
+	_, err := ak.db.DB().NewUpdate().
+		Model((*storage.ApiKey)(nil)).
+		Set("last_used_at = ?", ts).
+		Where("key = ?", key).
+		Exec(ctx)
+	return err
+}
+
+// IsNoRows - checks whether error is returned because nothing was found
+func (ak *ApiKey) IsNoRows(err error) bool {
+	return errors.Is(err, sql.ErrNoRows)
+}
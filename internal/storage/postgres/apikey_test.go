@@ -6,6 +6,9 @@ package postgres
 import (
 	"context"
 	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 )
 
 func (s *StorageTestSuite) TestApiKeyValid() {
@@ -16,6 +19,20 @@ func (s *StorageTestSuite) TestApiKeyValid() {
 	s.Require().NoError(err)
 	s.Require().EqualValues("test_key", key.Key)
 	s.Require().EqualValues("valid key", key.Description)
+	s.Require().EqualValues(types.ApiKeyTierFree, key.Tier)
+	s.Require().EqualValues(0, key.RateLimit)
+	s.Require().EqualValues(0, key.DailyQuota)
+}
+
+func (s *StorageTestSuite) TestApiKeyTier() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	key, err := s.storage.ApiKeys.Get(ctx, "pro_key")
+	s.Require().NoError(err)
+	s.Require().EqualValues(types.ApiKeyTierPro, key.Tier)
+	s.Require().EqualValues(0, key.RateLimit)
+	s.Require().EqualValues(5000000, key.DailyQuota)
 }
 
 func (s *StorageTestSuite) TestApiKeyInvalid() {
@@ -25,3 +42,49 @@ func (s *StorageTestSuite) TestApiKeyInvalid() {
 	_, err := s.storage.ApiKeys.Get(ctx, "invalid")
 	s.Require().Error(err)
 }
+
+func (s *StorageTestSuite) TestApiKeyBySecret() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	key, err := s.storage.ApiKeys.BySecret(ctx, "pro_secret")
+	s.Require().NoError(err)
+	s.Require().EqualValues("pro_key", key.Key)
+	s.Require().EqualValues(storage.HashApiKeySecret("pro_secret"), key.SecretHash)
+	s.Require().EqualValues(types.ApiKeyScopeRollupAuth, key.Scope)
+	s.Require().False(key.ExpiresAt.IsZero())
+	s.Require().True(key.RevokedAt.IsZero())
+	s.Require().True(key.IsActive(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	_, err = s.storage.ApiKeys.BySecret(ctx, "pro_key")
+	s.Require().Error(err)
+}
+
+func (s *StorageTestSuite) TestApiKeyRevoked() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	key, err := s.storage.ApiKeys.BySecret(ctx, "admin_secret")
+	s.Require().NoError(err)
+	s.Require().EqualValues("admin_key", key.Key)
+	s.Require().True(key.IsAdmin())
+	s.Require().False(key.RevokedAt.IsZero())
+	s.Require().False(key.IsActive(time.Now()))
+}
+
+func (s *StorageTestSuite) TestApiKeyList() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	keys, err := s.storage.ApiKeys.List(ctx, 2, 0)
+	s.Require().NoError(err)
+	s.Require().Len(keys, 2)
+	s.Require().EqualValues("admin_key", keys[0].Key)
+	s.Require().EqualValues("pro_key", keys[1].Key)
+
+	keys, err = s.storage.ApiKeys.List(ctx, 10, 2)
+	s.Require().NoError(err)
+	s.Require().Len(keys, 1)
+	s.Require().EqualValues("test_key", keys[0].Key)
+	s.Require().EqualValues(types.ApiKeyScopeStandard, keys[0].Scope)
+}
@@ -101,7 +101,7 @@ func (bl *BlobLog) ByProviders(ctx context.Context, providers []storage.RollupPr
 
 	query = blobLogSort(query, fltrs.SortBy, fltrs.Sort)
 	query = limitScope(query, fltrs.Limit)
-	if fltrs.Offset > 0 {
+	if fltrs.Cursor == 0 && fltrs.Offset > 0 {
 		query = query.Offset(fltrs.Offset)
 	}
 	err = query.Scan(ctx, &logs)
@@ -122,7 +122,53 @@ func (b *Blocks) ListWithStats(ctx context.Context, limit, offset uint64, order
 
 	subQuery = sortScope(subQuery, "time", order)
 
-	query := b.DB().NewSelect().
+	query := b.withStats(subQuery)
+	query = sortScope(query, "block.id", order)
+
+	err = query.Scan(ctx, &blocks)
+	return
+}
+
+// ListWithStatsAfter - returns blocks with stats from the newest one. Cursor is the identity of the last received block,
+// only blocks preceding it are returned. It's used by keyset pagination of GraphQL.
+func (b *Blocks) ListWithStatsAfter(ctx context.Context, cursor uint64, limit int) (blocks []*storage.Block, err error) {
+	subQuery := b.DB().NewSelect().
+		Model(&blocks)
+	if cursor > 0 {
+		subQuery = subQuery.Where("id < ?", cursor)
+	}
+	subQuery = limitScope(subQuery, limit)
+	subQuery = sortScope(subQuery, "id", sdk.SortOrderDesc)
+
+	query := b.withStats(subQuery)
+	query = sortScope(query, "block.id", sdk.SortOrderDesc)
+
+	err = query.Scan(ctx, &blocks)
+	return
+}
+
+// ByProposerAfter - returns blocks proposed by the validator from the newest one. Cursor is the identity of the last received block,
+// only blocks preceding it are returned. It's used by keyset pagination of GraphQL.
+func (b *Blocks) ByProposerAfter(ctx context.Context, proposerId, cursor uint64, limit int) (blocks []storage.Block, err error) {
+	subQuery := b.DB().NewSelect().
+		Model((*storage.Block)(nil)).
+		Where("proposer_id = ?", proposerId)
+	if cursor > 0 {
+		subQuery = subQuery.Where("id < ?", cursor)
+	}
+	subQuery = limitScope(subQuery, limit)
+	subQuery = sortScope(subQuery, "id", sdk.SortOrderDesc)
+
+	query := b.withStats(subQuery)
+	query = sortScope(query, "block.id", sdk.SortOrderDesc)
+
+	err = query.Scan(ctx, &blocks)
+	return
+}
+
+// withStats - joins stats and proposer to blocks selected by the subquery
+func (b *Blocks) withStats(subQuery *bun.SelectQuery) *bun.SelectQuery {
+	return b.DB().NewSelect().
 		ColumnExpr("block.*").
 		ColumnExpr("v.id AS proposer__id, v.cons_address as proposer__cons_address, v.moniker as proposer__moniker").
 		ColumnExpr("stats.id AS stats__id, stats.height AS stats__height, stats.time AS stats__time, stats.tx_count AS stats__tx_count, stats.events_count AS stats__events_count, stats.blobs_count as stats__blobs_count").
@@ -131,10 +177,6 @@ func (b *Blocks) ListWithStats(ctx context.Context, limit, offset uint64, order
 		TableExpr("(?) as block", subQuery).
 		Join("LEFT JOIN block_stats as stats ON (stats.height = block.height) AND (stats.time = block.time)").
 		Join("LEFT JOIN validator as v ON v.id = block.proposer_id")
-	query = sortScope(query, "block.id", order)
-
-	err = query.Scan(ctx, &blocks)
-	return
 }
 
 func (b *Blocks) ByProposer(ctx context.Context, proposerId uint64, limit, offset int) (blocks []storage.Block, err error) {
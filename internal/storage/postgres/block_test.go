@@ -196,6 +196,34 @@ func (s *StorageTestSuite) TestBlockByProposer() {
 	s.Require().EqualValues(4, block.Stats.BlobsCount)
 }
 
+func (s *StorageTestSuite) TestBlockListWithStatsAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	blocks, err := s.storage.Blocks.ListWithStatsAfter(ctx, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 2)
+	s.Require().EqualValues(2, blocks[0].Id)
+	s.Require().NotNil(blocks[0].Proposer)
+	s.Require().EqualValues(1, blocks[1].Id)
+
+	blocks, err = s.storage.Blocks.ListWithStatsAfter(ctx, 2, 10)
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 1)
+	s.Require().EqualValues(999, blocks[0].Height)
+}
+
+func (s *StorageTestSuite) TestBlockByProposerAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	blocks, err := s.storage.Blocks.ByProposerAfter(ctx, 1, 2, 10)
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 1)
+	s.Require().EqualValues(999, blocks[0].Height)
+	s.Require().EqualValues(1, blocks[0].ProposerId)
+}
+
 func (s *StorageTestSuite) TestBlockTime() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -30,8 +30,10 @@ type Storage struct {
 
 	Blocks          models.IBlock
 	BlockStats      models.IBlockStats
+	Supply          models.ISupply
 	BlockSignatures models.IBlockSignature
 	BlobLogs        models.IBlobLog
+	ShareRanges     models.IShareRange
 	Constants       models.IConstant
 	DenomMetadata   models.IDenomMetadata
 	Tx              models.ITx
@@ -52,8 +54,17 @@ type Storage struct {
 	Jails           models.IJail
 	Rollup          models.IRollup
 	RollupProvider  models.IRollupProvider
+	RollupCandidate models.IRollupCandidate
+	RollupAudit     models.IRollupAudit
 	Grants          models.IGrant
 	ApiKeys         models.IApiKey
+	Webhooks        models.IWebhook
+	WebhookDelivery models.IWebhookDelivery
+	WebhookTasks    models.IWebhookTask
+	AlertRules      models.IAlertRule
+	Alerts          models.IAlert
+	AddressLabels   models.IAddressLabel
+	MempoolTxs      models.IMempoolTx
 	Proposals       models.IProposal
 	Votes           models.IVote
 	IbcClients      models.IIbcClient
@@ -96,8 +107,10 @@ func Create(ctx context.Context, cfg config.Database, scriptsDir string, withMig
 		Storage:         strg,
 		Blocks:          NewBlocks(strg.Connection()),
 		BlockStats:      NewBlockStats(strg.Connection()),
+		Supply:          NewSupply(strg.Connection()),
 		BlockSignatures: NewBlockSignature(strg.Connection()),
 		BlobLogs:        NewBlobLog(strg.Connection(), export),
+		ShareRanges:     NewShareRange(strg.Connection()),
 		Constants:       NewConstant(strg.Connection()),
 		DenomMetadata:   NewDenomMetadata(strg.Connection()),
 		Message:         NewMessage(strg.Connection()),
@@ -118,8 +131,17 @@ func Create(ctx context.Context, cfg config.Database, scriptsDir string, withMig
 		Jails:           NewJail(strg.Connection()),
 		Rollup:          NewRollup(strg.Connection()),
 		RollupProvider:  NewRollupProvider(strg.Connection()),
+		RollupCandidate: NewRollupCandidate(strg.Connection()),
+		RollupAudit:     NewRollupAudit(strg.Connection()),
 		Grants:          NewGrant(strg.Connection()),
 		ApiKeys:         NewApiKey(strg.Connection()),
+		Webhooks:        NewWebhook(strg.Connection()),
+		WebhookDelivery: NewWebhookDelivery(strg.Connection()),
+		WebhookTasks:    NewWebhookTask(strg.Connection()),
+		AlertRules:      NewAlertRule(strg.Connection()),
+		Alerts:          NewAlert(strg.Connection()),
+		AddressLabels:   NewAddressLabel(strg.Connection()),
+		MempoolTxs:      NewMempoolTx(strg.Connection()),
 		Proposals:       NewProposal(strg.Connection()),
 		Votes:           NewVote(strg.Connection()),
 		IbcClients:      NewIbcClient(strg.Connection()),
@@ -202,9 +224,17 @@ func initDatabaseWithMigrations(ctx context.Context, conn *database.Bun) error {
 		if err := migrateDatabase(ctx, conn); err != nil {
 			return errors.Wrap(err, "migrate database")
 		}
+		return initDatabase(ctx, conn)
 	}
 
-	return initDatabase(ctx, conn)
+	if err := initDatabase(ctx, conn); err != nil {
+		return err
+	}
+	// schema of the new database is created from current models, so migrations are marked as applied without running
+	if err := migrateDatabase(ctx, conn, migrate.WithNopMigration()); err != nil {
+		return errors.Wrap(err, "mark migrations as applied")
+	}
+	return nil
 }
 
 func (s Storage) CreateListener() models.Listener {
@@ -216,20 +246,25 @@ func createHypertables(ctx context.Context, conn *database.Bun) error {
 		for _, model := range []storage.Model{
 			&models.Block{},
 			&models.BlockStats{},
+			&models.Supply{},
 			&models.Tx{},
 			&models.Message{},
 			&models.Event{},
 			&models.NamespaceMessage{},
 			&models.BlobLog{},
+			&models.ShareRange{},
 			&models.Jail{},
 			&models.StakingLog{},
+			&models.ValidatorRate{},
 			&models.Vote{},
+			&models.ProposalTally{},
 			&models.IbcTransfer{},
 			&models.HLTransfer{},
 			&models.SignalVersion{},
 			&models.Forwarding{},
 			&models.ZkISMUpdate{},
 			&models.ZkISMMessage{},
+			&models.BalanceUpdate{},
 		} {
 			if _, err := tx.ExecContext(ctx,
 				`SELECT create_hypertable(?, 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE);`,
@@ -257,7 +292,7 @@ func createExtensions(ctx context.Context, conn *database.Bun) error {
 	})
 }
 
-func migrateDatabase(ctx context.Context, db *database.Bun) error {
+func migrateDatabase(ctx context.Context, db *database.Bun, opts ...migrate.MigrationOption) error {
 	migrator := migrate.NewMigrator(db.DB(), migrations.Migrations)
 	if err := migrator.Init(ctx); err != nil {
 		return err
@@ -279,7 +314,7 @@ func migrateDatabase(ctx context.Context, db *database.Bun) error {
 		return nil
 	}
 
-	if _, err := migrator.Migrate(ctx); err != nil {
+	if _, err := migrator.Migrate(ctx, opts...); err != nil {
 		return errors.Wrap(err, "migrator.Migrate")
 	}
 	return nil
@@ -160,6 +160,16 @@ func createTypes(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"ibc_transfer_status",
+			bun.Safe("ibc_transfer_status"),
+			bun.Tuple(types.IbcTransferStatusValues()),
+		); err != nil {
+			return err
+		}
+
 		if _, err := tx.ExecContext(
 			ctx,
 			createTypeQuery,
@@ -170,6 +180,16 @@ func createTypes(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"hyperlane_delivery_status",
+			bun.Safe("hyperlane_delivery_status"),
+			bun.Tuple(types.HLDeliveryStatusValues()),
+		); err != nil {
+			return err
+		}
+
 		if _, err := tx.ExecContext(
 			ctx,
 			createTypeQuery,
@@ -189,6 +209,86 @@ func createTypes(ctx context.Context, conn *database.Bun) error {
 		); err != nil {
 			return err
 		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"webhook_event",
+			bun.Safe("webhook_event"),
+			bun.Tuple(types.WebhookEventValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"alert_rule_kind",
+			bun.Safe("alert_rule_kind"),
+			bun.Tuple(types.AlertRuleKindValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"share_range_kind",
+			bun.Safe("share_range_kind"),
+			bun.Tuple(types.ShareRangeKindValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"address_label_category",
+			bun.Safe("address_label_category"),
+			bun.Tuple(types.AddressLabelCategoryValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"mempool_tx_status",
+			bun.Safe("mempool_tx_status"),
+			bun.Tuple(types.MempoolTxStatusValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"api_key_tier",
+			bun.Safe("api_key_tier"),
+			bun.Tuple(types.ApiKeyTierValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"api_key_scope",
+			bun.Safe("api_key_scope"),
+			bun.Tuple(types.ApiKeyScopeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"rollup_audit_action",
+			bun.Safe("rollup_audit_action"),
+			bun.Tuple(types.RollupAuditActionValues()),
+		); err != nil {
+			return err
+		}
 		return nil
 	})
 }
@@ -34,9 +34,7 @@ func (e *Event) ByTxId(ctx context.Context, txId uint64, fltrs storage.EventFilt
 	query = limitScope(query, fltrs.Limit)
 	query = sortScope(query, "id", sdk.SortOrderAsc)
 
-	if fltrs.Offset > 0 {
-		query = query.Offset(fltrs.Offset)
-	}
+	query = cursorScope(query, "id", fltrs.Cursor, fltrs.Offset, sdk.SortOrderAsc)
 	if !fltrs.Time.IsZero() {
 		query = query.
 			Where("time >= ?", fltrs.Time).
@@ -56,9 +54,7 @@ func (e *Event) ByBlock(ctx context.Context, height pkgTypes.Level, fltrs storag
 	query = limitScope(query, fltrs.Limit)
 	query = sortScope(query, "id", sdk.SortOrderAsc)
 
-	if fltrs.Offset > 0 {
-		query = query.Offset(fltrs.Offset)
-	}
+	query = cursorScope(query, "id", fltrs.Cursor, fltrs.Offset, sdk.SortOrderAsc)
 	if !fltrs.Time.IsZero() {
 		query = query.
 			Where("time >= ?", fltrs.Time).
@@ -5,8 +5,10 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/go-lib/database"
 	"github.com/pkg/errors"
 	"github.com/uptrace/bun"
@@ -56,6 +58,9 @@ func (t *HLTransfer) List(ctx context.Context, filters storage.ListHyperlaneTran
 	if len(filters.Type) > 0 {
 		query = query.Where("type IN ?", bun.Tuple(filters.Type))
 	}
+	if filters.DeliveryStatus != "" {
+		query = query.Where("delivery_status = ?", filters.DeliveryStatus)
+	}
 
 	err = t.DB().NewSelect().
 		TableExpr("(?) as transfer", query).
@@ -67,8 +72,10 @@ func (t *HLTransfer) List(ctx context.Context, filters storage.ListHyperlaneTran
 		ColumnExpr("tx.hash as tx__hash").
 		ColumnExpr("address.address as address__address").
 		ColumnExpr("celestial.id as address__celestials__id, celestial.image_url as address__celestials__image_url").
+		ColumnExpr("address_label.name as address__label__name, address_label.category as address__label__category, address_label.source as address__label__source").
 		ColumnExpr("relayer.address as relayer__address").
 		ColumnExpr("relayer_celestials.id as relayer__celestials__id, relayer_celestials.image_url as relayer__celestials__image_url").
+		ColumnExpr("relayer_label.name as relayer__label__name, relayer_label.category as relayer__label__category, relayer_label.source as relayer__label__source").
 		Join("left join hl_mailbox on mailbox_id = hl_mailbox.id").
 		Join("left join hl_token on transfer.token_id = hl_token.id").
 		Join("left join hl_gas_payment on transfer.id = hl_gas_payment.transfer_id").
@@ -76,8 +83,10 @@ func (t *HLTransfer) List(ctx context.Context, filters storage.ListHyperlaneTran
 		Join("left join tx on transfer.tx_id = tx.id").
 		Join("left join address on address.id = transfer.address_id").
 		Join("left join celestial on celestial.address_id = transfer.address_id and celestial.status = 'PRIMARY'").
+		Join("left join address_label on address_label.address_id = transfer.address_id").
 		Join("left join address as relayer on relayer.id = transfer.relayer_id").
 		Join("left join celestial as relayer_celestials on relayer_celestials.address_id = transfer.relayer_id and relayer_celestials.status = 'PRIMARY'").
+		Join("left join address_label as relayer_label on relayer_label.address_id = transfer.relayer_id").
 		OrderExpr("time ?0, id ?0", bun.Safe(filters.Sort)).
 		Scan(ctx, &transfers)
 	return
@@ -98,8 +107,10 @@ func (t *HLTransfer) ById(ctx context.Context, id uint64) (transfer storage.HLTr
 		ColumnExpr("tx.hash as tx__hash").
 		ColumnExpr("address.address as address__address").
 		ColumnExpr("celestial.id as address__celestials__id, celestial.image_url as address__celestials__image_url").
+		ColumnExpr("address_label.name as address__label__name, address_label.category as address__label__category, address_label.source as address__label__source").
 		ColumnExpr("relayer.address as relayer__address").
 		ColumnExpr("relayer_celestials.id as relayer__celestials__id, relayer_celestials.image_url as relayer__celestials__image_url").
+		ColumnExpr("relayer_label.name as relayer__label__name, relayer_label.category as relayer__label__category, relayer_label.source as relayer__label__source").
 		Join("left join hl_mailbox on mailbox_id = hl_mailbox.id").
 		Join("left join hl_token on transfer.token_id = hl_token.id").
 		Join("left join hl_gas_payment on transfer.id = hl_gas_payment.transfer_id").
@@ -107,8 +118,10 @@ func (t *HLTransfer) ById(ctx context.Context, id uint64) (transfer storage.HLTr
 		Join("left join tx on transfer.tx_id = tx.id").
 		Join("left join address on address.id = transfer.address_id").
 		Join("left join celestial on celestial.address_id = transfer.address_id and celestial.status = 'PRIMARY'").
+		Join("left join address_label on address_label.address_id = transfer.address_id").
 		Join("left join address as relayer on relayer.id = transfer.relayer_id").
 		Join("left join celestial as relayer_celestials on relayer_celestials.address_id = transfer.relayer_id and relayer_celestials.status = 'PRIMARY'").
+		Join("left join address_label as relayer_label on relayer_label.address_id = transfer.relayer_id").
 		Scan(ctx, &transfer)
 	return
 }
@@ -198,3 +211,93 @@ func (t *HLTransfer) StatsByDomain(ctx context.Context, limit, offset int) (stat
 	err = query.Scan(ctx, &stats)
 	return
 }
+
+// LatencyByDomain - delivery latency percentiles of messages grouped by counterparty domain and direction.
+// Only messages with known dispatch and delivery time are taken into account.
+func (t *HLTransfer) LatencyByDomain(ctx context.Context, from time.Time) (stats []storage.DomainLatency, err error) {
+	query := t.DB().NewSelect().
+		Model((*storage.HLTransfer)(nil)).
+		ColumnExpr("counterparty as domain_id, type").
+		ColumnExpr("count(*) filter (where delivery_status = 'delivered') as delivered").
+		ColumnExpr("count(*) filter (where delivery_status = 'pending') as undelivered").
+		ColumnExpr("coalesce(percentile_cont(0.5) within group (order by extract(epoch from delivered_at - dispatched_at)), 0) as p50").
+		ColumnExpr("coalesce(percentile_cont(0.9) within group (order by extract(epoch from delivered_at - dispatched_at)), 0) as p90").
+		ColumnExpr("coalesce(percentile_cont(0.99) within group (order by extract(epoch from delivered_at - dispatched_at)), 0) as p99").
+		Where("delivery_status IS NOT NULL").
+		Group("counterparty", "type").
+		Order("domain_id", "type")
+
+	if !from.IsZero() {
+		query = query.Where("time >= ?", from)
+	}
+
+	err = query.Scan(ctx, &stats)
+	return
+}
+
+// Undelivered - returns messages dispatched after `since` which delivery info is not complete yet: pending sent messages
+// and received ones with unknown dispatch time. Only messages requested from hyperlane explorer less than `maxAttempts` times
+// are returned in id order starting after fromId.
+func (t *HLTransfer) Undelivered(ctx context.Context, since time.Time, maxAttempts int, fromId uint64, limit int) (transfers []storage.HLTransfer, err error) {
+	query := t.DB().NewSelect().
+		Model(&transfers).
+		Where("id > ?", fromId).
+		Where("time > ?", since).
+		Where("delivery_attempts < ?", maxAttempts).
+		Where("message_id IS NOT NULL").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("delivery_status = ?", types.HLDeliveryStatusPending).
+				WhereOr("dispatched_at IS NULL")
+		}).
+		Order("id asc")
+
+	query = limitScope(query, limit)
+	err = query.Scan(ctx)
+	return
+}
+
+// UpdateDelivery - saves delivery info of the messages received from hyperlane explorer
+func (t *HLTransfer) UpdateDelivery(ctx context.Context, transfers ...storage.HLTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	return t.DB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for i := range transfers {
+			if _, err := tx.NewUpdate().
+				Model((*storage.HLTransfer)(nil)).
+				Set("delivery_status = ?", transfers[i].DeliveryStatus).
+				Set("dispatched_at = ?", transfers[i].DispatchedAt).
+				Set("delivered_at = ?", transfers[i].DeliveredAt).
+				Set("counterparty_tx_hash = ?", transfers[i].CounterpartyTxHash).
+				Where("id = ?", transfers[i].Id).
+				Where("time = ?", transfers[i].Time).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// IncDeliveryAttempts - increments count of delivery info requests of the messages
+func (t *HLTransfer) IncDeliveryAttempts(ctx context.Context, transfers ...storage.HLTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	return t.DB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for i := range transfers {
+			if _, err := tx.NewUpdate().
+				Model((*storage.HLTransfer)(nil)).
+				Set("delivery_attempts = delivery_attempts + 1").
+				Where("id = ?", transfers[i].Id).
+				Where("time = ?", transfers[i].Time).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
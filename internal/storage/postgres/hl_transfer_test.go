@@ -55,6 +55,12 @@ func (s *StorageTestSuite) TestHyperlaneTransferList() {
 			Sort:   sdk.SortOrderDesc,
 			Domain: 1234,
 		},
+		{
+			Limit:          1,
+			Offset:         0,
+			Sort:           sdk.SortOrderAsc,
+			DeliveryStatus: types.HLDeliveryStatusDelivered,
+		},
 	} {
 
 		transfers, err := s.storage.HLTransfer.List(ctx, fltrs)
@@ -77,6 +83,9 @@ func (s *StorageTestSuite) TestHyperlaneTransferList() {
 		s.Require().EqualValues(types.HLTransferTypeSend, transfer.Type)
 		s.Require().NotNil(transfer.Body)
 		s.Require().NotNil(transfer.Metadata)
+		s.Require().EqualValues("dcdb3f985ecd20c313c58c0f6b2a0d7ea980349134ee4813f6bd53cfe5bf0a1e", hex.EncodeToString(transfer.MessageId))
+		s.Require().EqualValues(types.HLDeliveryStatusDelivered, transfer.DeliveryStatus)
+		s.Require().EqualValues(2*time.Minute, transfer.Latency())
 
 		s.Require().NotNil(transfer.Tx)
 		txHash, err := hex.DecodeString("652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF")
@@ -127,6 +136,80 @@ func (s *StorageTestSuite) TestHyperlaneTransferById() {
 	s.Require().Equal([]byte("igp_1"), transfer.GasPayment.Igp.IgpId)
 }
 
+func (s *StorageTestSuite) TestHyperlaneTransferListPending() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.HLTransfer.List(ctx, storage.ListHyperlaneTransferFilters{
+		Limit:          10,
+		Sort:           sdk.SortOrderAsc,
+		DeliveryStatus: types.HLDeliveryStatusPending,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+
+	transfer := transfers[0]
+	s.Require().EqualValues(2, transfer.Id)
+	s.Require().EqualValues(types.HLDeliveryStatusPending, transfer.DeliveryStatus)
+	s.Require().NotNil(transfer.DispatchedAt)
+	s.Require().Nil(transfer.DeliveredAt)
+	s.Require().Zero(transfer.Latency())
+}
+
+func (s *StorageTestSuite) TestHyperlaneTransferLatencyByDomain() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	stats, err := s.storage.HLTransfer.LatencyByDomain(ctx, time.Time{})
+	s.Require().NoError(err)
+	s.Require().Len(stats, 3)
+
+	s.Require().EqualValues(1234, stats[0].Domain)
+	s.Require().EqualValues(types.HLTransferTypeSend, stats[0].Type)
+	s.Require().EqualValues(1, stats[0].Delivered)
+	s.Require().EqualValues(0, stats[0].Undelivered)
+	s.Require().EqualValues(120, stats[0].P50)
+	s.Require().EqualValues(120, stats[0].P90)
+	s.Require().EqualValues(120, stats[0].P99)
+
+	s.Require().EqualValues(12345, stats[1].Domain)
+	s.Require().EqualValues(types.HLTransferTypeSend, stats[1].Type)
+	s.Require().EqualValues(0, stats[1].Delivered)
+	s.Require().EqualValues(1, stats[1].Undelivered)
+	s.Require().EqualValues(0, stats[1].P50)
+
+	s.Require().EqualValues(123450, stats[2].Domain)
+	s.Require().EqualValues(types.HLTransferTypeReceive, stats[2].Type)
+	s.Require().EqualValues(1, stats[2].Delivered)
+	s.Require().EqualValues(0, stats[2].Undelivered)
+	s.Require().EqualValues(0, stats[2].P50)
+
+	stats, err = s.storage.HLTransfer.LatencyByDomain(ctx, time.Date(2023, 7, 4, 4, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Len(stats, 2)
+}
+
+func (s *StorageTestSuite) TestHyperlaneTransferUndelivered() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.HLTransfer.Undelivered(ctx, time.Time{}, 10, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 2)
+	s.Require().EqualValues(2, transfers[0].Id)
+	s.Require().EqualValues(3, transfers[1].Id)
+
+	transfers, err = s.storage.HLTransfer.Undelivered(ctx, time.Time{}, 10, 2, 10)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(3, transfers[0].Id)
+	s.Require().Nil(transfers[0].DispatchedAt)
+
+	transfers, err = s.storage.HLTransfer.Undelivered(ctx, time.Date(2023, 7, 4, 5, 0, 0, 0, time.UTC), 10, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 0, "messages out of window")
+}
+
 func (s *StorageTestSuite) TestHyperlaneTransferByIdNotFound() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/go-lib/database"
 	"github.com/pkg/errors"
 	"github.com/uptrace/bun"
@@ -52,24 +53,33 @@ func (c *IbcTransfer) List(ctx context.Context, fltrs storage.ListIbcTransferFil
 	if len(fltrs.ConnectionIds) > 0 {
 		query = query.Where("connection_id IN ?", bun.Tuple(fltrs.ConnectionIds))
 	}
+	if fltrs.Status != "" {
+		query = query.Where("status = ?", fltrs.Status)
+	}
 
 	err = c.DB().NewSelect().
 		TableExpr("(?) as ibc_transfer", query).
 		ColumnExpr("ibc_transfer.*").
 		ColumnExpr("tx.hash as tx__hash").
+		ColumnExpr("resolve_tx.hash as resolve_tx__hash").
 		ColumnExpr("signer.address_id as signer_id").
 		ColumnExpr("receiver.address as receiver__address").
 		ColumnExpr("cel_receiver.id as receiver__celestials__id, cel_receiver.image_url as receiver__celestials__image_url").
+		ColumnExpr("label_receiver.name as receiver__label__name, label_receiver.category as receiver__label__category, label_receiver.source as receiver__label__source").
 		ColumnExpr("sender.address as sender__address").
 		ColumnExpr("cel_sender.id as sender__celestials__id, cel_sender.image_url as sender__celestials__image_url").
+		ColumnExpr("label_sender.name as sender__label__name, label_sender.category as sender__label__category, label_sender.source as sender__label__source").
 		ColumnExpr("ibc_client.chain_id as connection__client__chain_id").
 		ColumnExpr("ibc_client.creator_id as connection__client__creator_id").
 		Join("left join tx on tx_id = tx.id").
-		Join("left join signer as signer on signer.tx_id = tx.id").
+		Join("left join tx as resolve_tx on resolve_tx_id = resolve_tx.id").
+		Join("left join signer as signer on signer.tx_id = coalesce(ibc_transfer.resolve_tx_id, ibc_transfer.tx_id)").
 		Join("left join address as receiver on receiver.id = receiver_id").
 		Join("left join celestial as cel_receiver on cel_receiver.address_id = receiver_id and cel_receiver.status = 'PRIMARY'").
+		Join("left join address_label as label_receiver on label_receiver.address_id = receiver_id").
 		Join("left join address as sender on sender.id = sender_id").
 		Join("left join celestial as cel_sender on cel_sender.address_id = sender_id and cel_sender.status = 'PRIMARY'").
+		Join("left join address_label as label_sender on label_sender.address_id = sender_id").
 		Join("left join ibc_connection on ibc_connection.connection_id = ibc_transfer.connection_id").
 		Join("left join ibc_client on ibc_connection.client_id = ibc_client.id").
 		OrderExpr("time ?0, id ?0", bun.Safe(fltrs.Sort)).
@@ -117,6 +127,7 @@ func (c *IbcTransfer) LargestTransfer24h(ctx context.Context) (transfer storage.
 	query := c.DB().NewSelect().
 		Model((*storage.IbcTransfer)(nil)).
 		Where("time >= NOW() - INTERVAL '24 hours'").
+		Where("status IN (?)", bun.In([]types.IbcTransferStatus{types.IbcTransferStatusReceived, types.IbcTransferStatusAcknowledged})).
 		Order("amount DESC").
 		Limit(1)
 
@@ -126,14 +137,18 @@ func (c *IbcTransfer) LargestTransfer24h(ctx context.Context) (transfer storage.
 		ColumnExpr("tx.hash as tx__hash").
 		ColumnExpr("receiver.address as receiver__address").
 		ColumnExpr("cel_receiver.id as receiver__celestials__id, cel_receiver.image_url as receiver__celestials__image_url").
+		ColumnExpr("label_receiver.name as receiver__label__name, label_receiver.category as receiver__label__category, label_receiver.source as receiver__label__source").
 		ColumnExpr("sender.address as sender__address").
 		ColumnExpr("cel_sender.id as sender__celestials__id, cel_sender.image_url as sender__celestials__image_url").
+		ColumnExpr("label_sender.name as sender__label__name, label_sender.category as sender__label__category, label_sender.source as sender__label__source").
 		ColumnExpr("ibc_client.chain_id as connection__client__chain_id").
 		Join("left join tx on tx_id = tx.id").
 		Join("left join address as receiver on receiver.id = receiver_id").
 		Join("left join celestial as cel_receiver on cel_receiver.address_id = receiver_id and cel_receiver.status = 'PRIMARY'").
+		Join("left join address_label as label_receiver on label_receiver.address_id = receiver_id").
 		Join("left join address as sender on sender.id = sender_id").
 		Join("left join celestial as cel_sender on cel_sender.address_id = sender_id and cel_sender.status = 'PRIMARY'").
+		Join("left join address_label as label_sender on label_sender.address_id = sender_id").
 		Join("left join ibc_connection on ibc_connection.connection_id = ibc_transfer.connection_id").
 		Join("left join ibc_client on ibc_connection.client_id = ibc_client.id").
 		Scan(ctx, &transfer)
@@ -145,25 +160,47 @@ func (c *IbcTransfer) ById(ctx context.Context, id uint64) (transfer storage.Ibc
 		Model((*storage.IbcTransfer)(nil)).
 		Where("id = ?", id)
 
-	err = c.DB().NewSelect().
+	err = c.withRelations(query).Scan(ctx, &transfer)
+	return
+}
+
+func (c *IbcTransfer) ByIds(ctx context.Context, ids ...uint64) (transfers []storage.IbcTransferWithSigner, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := c.DB().NewSelect().
+		Model((*storage.IbcTransfer)(nil)).
+		Where("id IN (?)", bun.In(ids))
+
+	err = c.withRelations(query).Scan(ctx, &transfers)
+	return
+}
+
+func (c *IbcTransfer) withRelations(query *bun.SelectQuery) *bun.SelectQuery {
+	return c.DB().NewSelect().
 		TableExpr("(?) as ibc_transfer", query).
 		ColumnExpr("ibc_transfer.*").
 		ColumnExpr("tx.hash as tx__hash").
+		ColumnExpr("resolve_tx.hash as resolve_tx__hash").
 		ColumnExpr("signer.address_id as signer_id").
 		ColumnExpr("receiver.address as receiver__address").
 		ColumnExpr("cel_receiver.id as receiver__celestials__id, cel_receiver.image_url as receiver__celestials__image_url").
+		ColumnExpr("label_receiver.name as receiver__label__name, label_receiver.category as receiver__label__category, label_receiver.source as receiver__label__source").
 		ColumnExpr("sender.address as sender__address").
 		ColumnExpr("cel_sender.id as sender__celestials__id, cel_sender.image_url as sender__celestials__image_url").
+		ColumnExpr("label_sender.name as sender__label__name, label_sender.category as sender__label__category, label_sender.source as sender__label__source").
 		ColumnExpr("ibc_client.chain_id as connection__client__chain_id").
 		ColumnExpr("ibc_client.creator_id as connection__client__creator_id").
 		Join("left join tx on tx_id = tx.id").
-		Join("left join signer as signer on signer.tx_id = tx.id").
+		Join("left join tx as resolve_tx on resolve_tx_id = resolve_tx.id").
+		Join("left join signer as signer on signer.tx_id = coalesce(ibc_transfer.resolve_tx_id, ibc_transfer.tx_id)").
 		Join("left join address as receiver on receiver.id = receiver_id").
 		Join("left join celestial as cel_receiver on cel_receiver.address_id = receiver_id and cel_receiver.status = 'PRIMARY'").
+		Join("left join address_label as label_receiver on label_receiver.address_id = receiver_id").
 		Join("left join address as sender on sender.id = sender_id").
 		Join("left join celestial as cel_sender on cel_sender.address_id = sender_id and cel_sender.status = 'PRIMARY'").
+		Join("left join address_label as label_sender on label_sender.address_id = sender_id").
 		Join("left join ibc_connection on ibc_connection.connection_id = ibc_transfer.connection_id").
-		Join("left join ibc_client on ibc_connection.client_id = ibc_client.id").
-		Scan(ctx, &transfer)
-	return
+		Join("left join ibc_client on ibc_connection.client_id = ibc_client.id")
 }
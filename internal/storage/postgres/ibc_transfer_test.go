@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
@@ -79,6 +80,34 @@ func (s *StorageTestSuite) TestIbcTransferList() {
 	}
 }
 
+func (s *StorageTestSuite) TestIbcTransferListByStatus() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.IbcTransfers.List(ctx, storage.ListIbcTransferFilters{
+		Limit:  10,
+		Sort:   sdk.SortOrderDesc,
+		Status: types.IbcTransferStatusPending,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+
+	transfer := transfers[0]
+	s.Require().EqualValues(4, transfer.Id)
+	s.Require().Equal(types.IbcTransferStatusPending, transfer.Status)
+	s.Require().EqualValues(321657, transfer.Sequence)
+	s.Require().Nil(transfer.ResolveTxId)
+	s.Require().Zero(transfer.ResolveHeight)
+
+	transfers, err = s.storage.IbcTransfers.List(ctx, storage.ListIbcTransferFilters{
+		Limit:  10,
+		Sort:   sdk.SortOrderDesc,
+		Status: types.IbcTransferStatusAcknowledged,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 3)
+}
+
 func (s *StorageTestSuite) TestIbcTransferSeries() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -35,6 +35,15 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Address)(nil)).
+			Index("address_name_idx").
+			Column("name").
+			Where("name IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Block
 		if _, err := tx.NewCreateIndex().
@@ -93,6 +102,17 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// Supply
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Supply)(nil)).
+			Index("supply_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Tx
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -392,6 +412,16 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// Share range
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ShareRange)(nil)).
+			Index("share_range_height_idx").
+			Column("height", "namespace").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Rollup
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -491,6 +521,71 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// ValidatorRate
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ValidatorRate)(nil)).
+			Index("validator_rate_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ValidatorRate)(nil)).
+			Index("validator_rate_validator_id_idx").
+			Column("validator_id", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// ProposalTally
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ProposalTally)(nil)).
+			Index("proposal_tally_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ProposalTally)(nil)).
+			Index("proposal_tally_proposal_id_idx").
+			Column("proposal_id", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Effective vote
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.EffectiveVote)(nil)).
+			Index("effective_vote_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.EffectiveVote)(nil)).
+			Index("effective_vote_proposal_id_idx").
+			Column("proposal_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.EffectiveVote)(nil)).
+			Index("effective_vote_address_id_idx").
+			Column("address_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Delegation
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -870,6 +965,15 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_pending_packet_idx").
+			Column("port", "channel_id", "sequence").
+			Where("status = 'pending'").
+			Exec(ctx); err != nil {
+			return err
+		}
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
 			Model((*storage.IbcTransfer)(nil)).
@@ -1271,6 +1375,201 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// Balance update
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceUpdate)(nil)).
+			Index("balance_update_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceUpdate)(nil)).
+			Index("balance_update_address_id_idx").
+			Column("address_id", "currency", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Webhook
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Webhook)(nil)).
+			Index("webhook_api_key_idx").
+			Column("api_key").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.WebhookDelivery)(nil)).
+			Index("webhook_delivery_webhook_id_idx").
+			Column("webhook_id", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.WebhookTask)(nil)).
+			Index("webhook_task_delivery_id_idx").
+			Column("delivery_id").
+			Unique().
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.WebhookTask)(nil)).
+			Index("webhook_task_next_attempt_at_idx").
+			Column("next_attempt_at").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Alert
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.AlertRule)(nil)).
+			Index("alert_rule_api_key_idx").
+			Column("api_key").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Alert)(nil)).
+			Index("alert_rule_id_active_idx").
+			Column("rule_id").
+			Unique().
+			Where("resolved_at IS NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// AddressLabel
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.AddressLabel)(nil)).
+			Index("address_label_name_idx").
+			ColumnExpr("name gin_trgm_ops").
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.AddressLabel)(nil)).
+			Index("address_label_category_idx").
+			Column("category").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// RollupCandidate
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.RollupCandidate)(nil)).
+			Index("rollup_candidate_namespace_ids_idx").
+			Column("namespace_ids").
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// RollupAudit
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.RollupAudit)(nil)).
+			Index("rollup_audit_rollup_id_idx").
+			Column("rollup_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.RollupAudit)(nil)).
+			Index("rollup_audit_api_key_idx").
+			Column("api_key").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// MempoolTx
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.MempoolTx)(nil)).
+			Index("mempool_tx_status_idx").
+			Column("status").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.MempoolTx)(nil)).
+			Index("mempool_tx_signers_idx").
+			Column("signers").
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Full-text search
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Proposal)(nil)).
+			Index("proposal_fts_idx").
+			ColumnExpr("to_tsvector(?, ?)", ftsConfigEnglish, bun.Safe(proposalDocument)).
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Validator)(nil)).
+			Index("validator_fts_idx").
+			ColumnExpr("to_tsvector(?, ?)", ftsConfigEnglish, bun.Safe(validatorDocument)).
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		// array_to_string is stable, so immutable wrapper is required to use it in the index expression
+		if _, err := tx.ExecContext(ctx, `CREATE OR REPLACE FUNCTION fts_array_to_string(anyarray, text) RETURNS text
+			LANGUAGE sql IMMUTABLE PARALLEL SAFE AS 'SELECT array_to_string($1, $2)'`); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Rollup)(nil)).
+			Index("rollup_fts_idx").
+			ColumnExpr("to_tsvector(?, ?)", ftsConfigEnglish, bun.Safe(rollupDocument)).
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Tx)(nil)).
+			Index("tx_memo_fts_idx").
+			ColumnExpr("to_tsvector(?, ?)", ftsConfigSimple, bun.Safe(memoDocument)).
+			Using("GIN").
+			Where("memo <> ''").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_memo_fts_idx").
+			ColumnExpr("to_tsvector(?, ?)", ftsConfigSimple, bun.Safe(memoDocument)).
+			Using("GIN").
+			Where("memo <> ''").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
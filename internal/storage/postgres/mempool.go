@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// MempoolTx -
+type MempoolTx struct {
+	*postgres.Table[*storage.MempoolTx]
+}
+
+// NewMempoolTx -
+func NewMempoolTx(db *database.Bun) *MempoolTx {
+	return &MempoolTx{
+		Table: postgres.NewTable[*storage.MempoolTx](db),
+	}
+}
+
+// Insert - saves new observed transactions. Transactions which are already tracked are skipped.
+func (m *MempoolTx) Insert(ctx context.Context, txs ...*storage.MempoolTx) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	_, err := m.DB().NewInsert().
+		Model(&txs).
+		On("CONFLICT (hash) DO NOTHING").
+		Returning("id").
+		Exec(ctx)
+	return err
+}
+
+func (m *MempoolTx) ByHash(ctx context.Context, hash []byte) (tx storage.MempoolTx, err error) {
+	err = m.DB().NewSelect().
+		Model(&tx).
+		Where("hash = ?", hash).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+func (m *MempoolTx) Pending(ctx context.Context) (txs []storage.MempoolTx, err error) {
+	err = m.DB().NewSelect().
+		Model(&txs).
+		Where("status = ?", types.MempoolTxStatusPending).
+		Scan(ctx)
+	return
+}
+
+func (m *MempoolTx) List(ctx context.Context, fltrs storage.MempoolFilters) (txs []storage.MempoolTx, err error) {
+	query := m.DB().NewSelect().
+		Model(&txs)
+
+	if len(fltrs.Status) > 0 {
+		query = query.Where("status IN (?)", bun.In(fltrs.Status))
+	}
+	if fltrs.Signer != "" {
+		query = query.Where("? = ANY(signers)", fltrs.Signer)
+	}
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+	err = query.Scan(ctx)
+	return
+}
+
+// LastIncluded - returns the latest included transactions with known time of inclusion
+func (m *MempoolTx) LastIncluded(ctx context.Context, limit int) (txs []storage.MempoolTx, err error) {
+	query := m.DB().NewSelect().
+		Model(&txs).
+		Where("status = ?", types.MempoolTxStatusIncluded).
+		Order("finished_at desc")
+	query = limitScope(query, limit)
+	err = query.Scan(ctx)
+	return
+}
+
+// DeleteFinished - removes included and dropped transactions finished before the time
+func (m *MempoolTx) DeleteFinished(ctx context.Context, before time.Time) (int, error) {
+	result, err := m.DB().NewDelete().
+		Model((*storage.MempoolTx)(nil)).
+		Where("status != ?", types.MempoolTxStatusPending).
+		Where("finished_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := result.RowsAffected()
+	return int(count), err
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestMempoolTxByHash() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	hash, err := hex.DecodeString("0A5B1F32F52C61F5A9B7F1CD7A2C1BBC59E4F3E6D1B6E7C0A2A4B1C2D3E4F501")
+	s.Require().NoError(err)
+
+	tx, err := s.storage.MempoolTxs.ByHash(ctx, hash)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, tx.Id)
+	s.Require().Equal(types.MempoolTxStatusIncluded, tx.Status)
+	s.Require().EqualValues(1000, tx.Height)
+	s.Require().EqualValues(7000, tx.InclusionTime)
+	s.Require().EqualValues(1, tx.InclusionBlocks())
+	s.Require().Equal([]string{"MsgPayForBlobs"}, tx.MessageTypes)
+	s.Require().Equal([]string{"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"}, tx.Signers)
+	s.Require().NotNil(tx.FinishedAt)
+}
+
+func (s *StorageTestSuite) TestMempoolTxPending() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.storage.MempoolTxs.Pending(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().EqualValues(2, txs[0].Id)
+	s.Require().Equal(types.MempoolTxStatusPending, txs[0].Status)
+	s.Require().Nil(txs[0].FinishedAt)
+}
+
+func (s *StorageTestSuite) TestMempoolTxList() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.storage.MempoolTxs.List(ctx, storage.MempoolFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 3)
+	s.Require().EqualValues(3, txs[0].Id)
+
+	txs, err = s.storage.MempoolTxs.List(ctx, storage.MempoolFilters{
+		Limit:  10,
+		Status: []string{types.MempoolTxStatusPending.String(), types.MempoolTxStatusDropped.String()},
+		Signer: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().EqualValues(2, txs[0].Id)
+}
+
+func (s *StorageTestSuite) TestMempoolTxLastIncluded() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.storage.MempoolTxs.LastIncluded(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().EqualValues(1, txs[0].Id)
+	s.Require().Equal("1", txs[0].GasPrice.String())
+}
+
+func (s *StorageTestSuite) TestMempoolTxDeleteFinished() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	count, err := s.storage.MempoolTxs.DeleteFinished(ctx, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Equal(0, count)
+}
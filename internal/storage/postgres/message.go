@@ -39,6 +39,21 @@ func (m *Message) ByTxId(ctx context.Context, txId uint64, limit, offset int) (m
 	return
 }
 
+// ByTxIdAfter - returns messages of the transaction in execution order. Cursor is the identity of the last received message,
+// only messages following it are returned. It's used by keyset pagination of GraphQL.
+func (m *Message) ByTxIdAfter(ctx context.Context, txId, cursor uint64, limit int) (messages []storage.Message, err error) {
+	query := m.DB().NewSelect().Model(&messages).
+		Where("tx_id = ?", txId).
+		Order("id asc")
+	if cursor > 0 {
+		query = query.Where("id > ?", cursor)
+	}
+	query = limitScope(query, limit)
+
+	err = query.Scan(ctx)
+	return
+}
+
 func (m *Message) ListWithTx(ctx context.Context, filters storage.MessageListWithTxFilters) (msgs []storage.MessageWithTx, err error) {
 	query := m.DB().NewSelect().Model(&msgs).Offset(filters.Offset)
 	query = messagesFilter(query, filters)
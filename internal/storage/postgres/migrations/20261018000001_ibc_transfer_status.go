@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upIbcTransferStatus, downIbcTransferStatus)
+}
+
+// upIbcTransferStatus - adds packet lifecycle columns to transfers. Transfers indexed before were saved only after successful
+// acknowledgement (sent from Celestia) or successful receiving, so their status can be restored from the celestia side of the transfer.
+// Aggregates of transfers are dropped to be recreated on start with status filter.
+func upIbcTransferStatus(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'ibc_transfer_status') THEN
+				CREATE TYPE ibc_transfer_status AS ENUM ('pending', 'received', 'failed', 'acknowledged', 'refunded', 'timed_out');
+			END IF;
+		END$$;`); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			ALTER TABLE ibc_transfer
+				ADD COLUMN IF NOT EXISTS status         ibc_transfer_status,
+				ADD COLUMN IF NOT EXISTS resolve_tx_id  bigint,
+				ADD COLUMN IF NOT EXISTS resolve_height bigint
+		`); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE ibc_transfer SET
+				status         = 'acknowledged',
+				resolve_tx_id  = tx_id,
+				resolve_height = height
+			WHERE status IS NULL AND sender_id IS NOT NULL
+		`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE ibc_transfer SET status = 'received' WHERE status IS NULL`); err != nil {
+			return err
+		}
+
+		for _, view := range []string{
+			"ibc_transfers_by_month",
+			"ibc_transfers_by_day",
+			"ibc_transfers_by_hour",
+		} {
+			if _, err := tx.ExecContext(ctx, `DROP MATERIALIZED VIEW IF EXISTS ?`, bun.Ident(view)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func downIbcTransferStatus(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		ALTER TABLE ibc_transfer
+			DROP COLUMN IF EXISTS status,
+			DROP COLUMN IF EXISTS resolve_tx_id,
+			DROP COLUMN IF EXISTS resolve_height
+	`)
+	return err
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upHlDeliveryStatus, downHlDeliveryStatus)
+}
+
+// upHlDeliveryStatus - adds delivery tracking columns to hyperlane transfers. Message id of transfers indexed before can't be restored,
+// so only their side of the delivery known on Celestia is filled: dispatch time for sent messages and delivery time for received ones.
+// Delivery status of sent messages stays unknown (NULL) because it can't be requested without message id.
+func upHlDeliveryStatus(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'hyperlane_delivery_status') THEN
+				CREATE TYPE hyperlane_delivery_status AS ENUM ('pending', 'delivered');
+			END IF;
+		END$$;`); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			ALTER TABLE hl_transfer
+				ADD COLUMN IF NOT EXISTS message_id           bytea,
+				ADD COLUMN IF NOT EXISTS delivery_status      hyperlane_delivery_status,
+				ADD COLUMN IF NOT EXISTS dispatched_at        timestamptz,
+				ADD COLUMN IF NOT EXISTS delivered_at         timestamptz,
+				ADD COLUMN IF NOT EXISTS counterparty_tx_hash text
+		`); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE hl_transfer SET dispatched_at = time
+			WHERE dispatched_at IS NULL AND type = 'send'
+		`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+			UPDATE hl_transfer SET delivery_status = 'delivered', delivered_at = time
+			WHERE delivery_status IS NULL AND type = 'receive'
+		`)
+		return err
+	})
+}
+
+func downHlDeliveryStatus(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		ALTER TABLE hl_transfer
+			DROP COLUMN IF EXISTS message_id,
+			DROP COLUMN IF EXISTS delivery_status,
+			DROP COLUMN IF EXISTS dispatched_at,
+			DROP COLUMN IF EXISTS delivered_at,
+			DROP COLUMN IF EXISTS counterparty_tx_hash
+	`)
+	return err
+}
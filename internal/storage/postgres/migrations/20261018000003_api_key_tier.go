@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upApiKeyTier, downApiKeyTier)
+}
+
+// upApiKeyTier - adds tier and limits of public API to api keys. Existing keys get the free tier.
+func upApiKeyTier(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'api_key_tier') THEN
+				CREATE TYPE api_key_tier AS ENUM ('free', 'basic', 'pro', 'enterprise');
+			END IF;
+		END$$;`); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			ALTER TABLE apikey
+				ADD COLUMN IF NOT EXISTS tier        api_key_tier NOT NULL DEFAULT 'free',
+				ADD COLUMN IF NOT EXISTS rate_limit  bigint       NOT NULL DEFAULT 0,
+				ADD COLUMN IF NOT EXISTS daily_quota bigint       NOT NULL DEFAULT 0
+		`)
+		return err
+	})
+}
+
+func downApiKeyTier(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		ALTER TABLE apikey
+			DROP COLUMN IF EXISTS tier,
+			DROP COLUMN IF EXISTS rate_limit,
+			DROP COLUMN IF EXISTS daily_quota
+	`)
+	return err
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upApiKeyLifecycle, downApiKeyLifecycle)
+}
+
+// apiKeyOwnerTables - tables which reference api keys as owners of records
+var apiKeyOwnerTables = []string{"webhook", "alert_rule", "address_label"}
+
+// upApiKeyLifecycle - adds scope, secret hash and lifecycle timestamps to api keys.
+// Previously the secret itself was the primary key. It's replaced with its hash and the public identity of the key
+// becomes the prefix of the hash, so owners of webhooks, alerts and labels are renamed too. Admin flag is replaced with admin scope.
+func upApiKeyLifecycle(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'api_key_scope') THEN
+				CREATE TYPE api_key_scope AS ENUM ('standard', 'rollup_auth', 'read_only', 'admin');
+			END IF;
+		END$$;`); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			ALTER TABLE apikey
+				ADD COLUMN IF NOT EXISTS secret_hash  text,
+				ADD COLUMN IF NOT EXISTS scope        api_key_scope NOT NULL DEFAULT 'standard',
+				ADD COLUMN IF NOT EXISTS created_at   timestamptz   NOT NULL DEFAULT current_timestamp,
+				ADD COLUMN IF NOT EXISTS rotated_at   timestamptz,
+				ADD COLUMN IF NOT EXISTS expires_at   timestamptz,
+				ADD COLUMN IF NOT EXISTS revoked_at   timestamptz,
+				ADD COLUMN IF NOT EXISTS last_used_at timestamptz
+		`); err != nil {
+			return err
+		}
+
+		var legacy bool
+		if err := tx.NewRaw(`SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns WHERE table_name = 'apikey' AND column_name = 'admin'
+		)`).Scan(ctx, &legacy); err != nil {
+			return err
+		}
+		if !legacy {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE apikey SET
+				secret_hash = encode(sha256(convert_to(key, 'UTF8')), 'hex'),
+				scope = CASE WHEN admin THEN 'admin'::api_key_scope ELSE 'standard'::api_key_scope END
+		`); err != nil {
+			return err
+		}
+
+		for _, table := range apiKeyOwnerTables {
+			var exists bool
+			if err := tx.NewRaw(`SELECT to_regclass(?) IS NOT NULL`, table).Scan(ctx, &exists); err != nil {
+				return err
+			}
+			if !exists {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE ? AS t SET api_key = left(k.secret_hash, 16)
+				FROM apikey AS k
+				WHERE t.api_key = k.key
+			`, bun.Ident(table)); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE apikey SET key = left(secret_hash, 16)`); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			ALTER TABLE apikey
+				DROP COLUMN admin,
+				ALTER COLUMN secret_hash SET NOT NULL,
+				ADD CONSTRAINT apikey_secret_hash UNIQUE (secret_hash)
+		`)
+		return err
+	})
+}
+
+// downApiKeyLifecycle - restores admin flag. Secrets can't be restored from hashes, so keys keep their new identities.
+func downApiKeyLifecycle(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, `ALTER TABLE apikey ADD COLUMN IF NOT EXISTS admin boolean`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE apikey SET admin = (scope = 'admin')`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+			ALTER TABLE apikey
+				DROP CONSTRAINT IF EXISTS apikey_secret_hash,
+				DROP COLUMN IF EXISTS secret_hash,
+				DROP COLUMN IF EXISTS scope,
+				DROP COLUMN IF EXISTS created_at,
+				DROP COLUMN IF EXISTS rotated_at,
+				DROP COLUMN IF EXISTS expires_at,
+				DROP COLUMN IF EXISTS revoked_at,
+				DROP COLUMN IF EXISTS last_used_at
+		`)
+		return err
+	})
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upBalanceHistorySeed, downBalanceHistorySeed)
+}
+
+// upBalanceHistorySeed - seeds opening balances for databases which were indexed before balance changes were tracked.
+// Opening row of every address and currency is the current balance minus all tracked changes. It's saved at the height
+// preceding the first tracked block, and this height is stored in the state as the start of the balance history.
+func upBalanceHistorySeed(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, `ALTER TABLE state ADD COLUMN IF NOT EXISTS balance_history_height bigint DEFAULT 0`); err != nil {
+			return err
+		}
+
+		var state struct {
+			LastHeight int64     `bun:"last_height"`
+			LastTime   time.Time `bun:"last_time"`
+		}
+		if err := tx.NewRaw(`SELECT last_height, last_time FROM state ORDER BY id LIMIT 1`).Scan(ctx, &state); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS balance_update (
+				id         bigserial   NOT NULL,
+				height     bigint      NOT NULL,
+				time       timestamptz NOT NULL,
+				address_id bigint      NOT NULL,
+				currency   text        NOT NULL,
+				spendable  numeric,
+				delegated  numeric,
+				unbonding  numeric,
+				PRIMARY KEY (id, time)
+			)
+		`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx,
+			`SELECT create_hypertable('balance_update', 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE)`,
+		); err != nil {
+			return err
+		}
+
+		var firstHeight sql.NullInt64
+		if err := tx.NewRaw(`SELECT min(height) FROM balance_update`).Scan(ctx, &firstHeight); err != nil {
+			return err
+		}
+
+		openingHeight := state.LastHeight
+		if firstHeight.Valid {
+			var fromGenesis bool
+			if err := tx.NewRaw(`SELECT NOT EXISTS (SELECT 1 FROM block WHERE height < ?)`, firstHeight.Int64).Scan(ctx, &fromGenesis); err != nil {
+				return err
+			}
+			if fromGenesis {
+				return nil
+			}
+			openingHeight = firstHeight.Int64 - 1
+		}
+
+		openingTime := state.LastTime
+		if err := tx.NewRaw(`SELECT time FROM block WHERE height = ?`, openingHeight).Scan(ctx, &openingTime); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO balance_update (height, time, address_id, currency, spendable, delegated, unbonding)
+			SELECT ?, ?, opening.id, opening.currency, opening.spendable, opening.delegated, opening.unbonding
+			FROM (
+				SELECT
+					b.id,
+					b.currency,
+					coalesce(b.spendable, 0) - coalesce(u.spendable, 0) AS spendable,
+					coalesce(b.delegated, 0) - coalesce(u.delegated, 0) AS delegated,
+					coalesce(b.unbonding, 0) - coalesce(u.unbonding, 0) AS unbonding
+				FROM balance AS b
+				LEFT JOIN (
+					SELECT address_id, currency, sum(spendable) AS spendable, sum(delegated) AS delegated, sum(unbonding) AS unbonding
+					FROM balance_update
+					GROUP BY address_id, currency
+				) AS u ON u.address_id = b.id AND u.currency = b.currency
+			) AS opening
+			WHERE opening.spendable <> 0 OR opening.delegated <> 0 OR opening.unbonding <> 0
+		`, openingHeight, openingTime); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `UPDATE state SET balance_history_height = ?`, openingHeight)
+		return err
+	})
+}
+
+// downBalanceHistorySeed - removes the start of balance history. Opening rows are kept because they can't be distinguished from tracked changes.
+func downBalanceHistorySeed(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `ALTER TABLE state DROP COLUMN IF EXISTS balance_history_height`)
+	return err
+}
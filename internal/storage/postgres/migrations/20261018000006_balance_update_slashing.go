@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upBalanceUpdateSlashing, downBalanceUpdateSlashing)
+}
+
+// upBalanceUpdateSlashing - marks balance updates caused by slashing of validators. Such updates are not emitted by block parsing,
+// so reindex has to keep them. Existing rows are matched by shape: slashing only decreases delegated amount of delegators
+// of the validator jailed with burned tokens in the same block.
+func upBalanceUpdateSlashing(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var exists bool
+		if err := tx.NewRaw(`SELECT to_regclass('balance_update') IS NOT NULL`).Scan(ctx, &exists); err != nil {
+			return err
+		}
+		if !exists {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `ALTER TABLE balance_update ADD COLUMN IF NOT EXISTS slashing boolean DEFAULT false`); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			UPDATE balance_update AS bu SET slashing = true
+			FROM jail
+			JOIN delegation ON delegation.validator_id = jail.validator_id
+			WHERE jail.burned > 0
+				AND bu.height = jail.height
+				AND bu.address_id = delegation.address_id
+				AND bu.currency = 'utia'
+				AND bu.delegated < 0
+				AND coalesce(bu.spendable, 0) = 0
+				AND coalesce(bu.unbonding, 0) = 0
+		`)
+		return err
+	})
+}
+
+func downBalanceUpdateSlashing(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `ALTER TABLE balance_update DROP COLUMN IF EXISTS slashing`)
+	return err
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/uptrace/bun"
+)
+
+const jailsBatchSize = 10000
+
+func init() {
+	Migrations.MustRegister(upJailWithoutBurned, downJailWithoutBurned)
+}
+
+// slashJail - jail decoded from slash event
+type slashJail struct {
+	EventId     uint64    `bun:"event_id"`
+	Time        time.Time `bun:"time"`
+	Height      int64     `bun:"height"`
+	ConsAddress string    `bun:"cons_address"`
+	Reason      string    `bun:"reason"`
+}
+
+// upJailWithoutBurned - restores jails without burned coins (e.g. for downtime) from slash events. They were not saved before,
+// so jail history was incomplete and validator set at height could include jailed validators. Events are decoded by batches
+// and every batch is inserted by one statement. Restored rows keep the event id, so down migration removes only them.
+func upJailWithoutBurned(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var exists bool
+		if err := tx.NewRaw(`SELECT to_regclass('jail') IS NOT NULL AND to_regclass('event') IS NOT NULL`).Scan(ctx, &exists); err != nil {
+			return err
+		}
+		if !exists {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `ALTER TABLE jail ADD COLUMN IF NOT EXISTS event_id bigint`); err != nil {
+			return err
+		}
+
+		var lastId uint64
+		for {
+			var events []storage.Event
+			if err := tx.NewSelect().
+				Model(&events).
+				Column("id", "height", "time", "data").
+				Where("type = ?", types.EventTypeSlash).
+				Where("id > ?", lastId).
+				Order("id").
+				Limit(jailsBatchSize).
+				Scan(ctx); err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				return nil
+			}
+			lastId = events[len(events)-1].Id
+
+			jails := make([]slashJail, 0, len(events))
+			for i := range events {
+				address := events[i].Data["address"]
+				reason := events[i].Data["reason"]
+				if address == "" || reason == "" {
+					continue
+				}
+
+				_, hash, err := pkgTypes.Address(address).Decode()
+				if err != nil {
+					return err
+				}
+				jails = append(jails, slashJail{
+					EventId:     events[i].Id,
+					Time:        events[i].Time,
+					Height:      int64(events[i].Height),
+					ConsAddress: strings.ToUpper(hex.EncodeToString(hash)),
+					Reason:      reason,
+				})
+			}
+
+			if len(jails) > 0 {
+				if _, err := tx.NewRaw(`
+					WITH _data (event_id, time, height, cons_address, reason) AS (?)
+					INSERT INTO jail (time, height, validator_id, reason, burned, event_id)
+					SELECT _data.time::timestamptz, _data.height::bigint, validator.id, _data.reason, 0, _data.event_id::bigint
+					FROM _data
+					JOIN validator ON validator.cons_address = _data.cons_address
+					WHERE NOT EXISTS (
+						SELECT 1 FROM jail WHERE jail.height = _data.height::bigint AND jail.validator_id = validator.id
+					)
+				`, tx.NewValues(&jails)).Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			if len(events) < jailsBatchSize {
+				return nil
+			}
+		}
+	})
+}
+
+// downJailWithoutBurned - removes restored jails only. Jails written by the parser don't have event id.
+func downJailWithoutBurned(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var exists bool
+		if err := tx.NewRaw(`SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns WHERE table_name = 'jail' AND column_name = 'event_id'
+		)`).Scan(ctx, &exists); err != nil {
+			return err
+		}
+		if !exists {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM jail WHERE event_id IS NOT NULL`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `ALTER TABLE jail DROP COLUMN event_id`)
+		return err
+	})
+}
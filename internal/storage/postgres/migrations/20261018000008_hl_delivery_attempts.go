@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upHlDeliveryAttempts, downHlDeliveryAttempts)
+}
+
+// upHlDeliveryAttempts - adds count of delivery info requests to hyperlane transfers. Delivery tracker stops requesting
+// messages which reached the limit of attempts.
+func upHlDeliveryAttempts(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		DO $$
+		BEGIN
+			IF to_regclass('hl_transfer') IS NOT NULL THEN
+				ALTER TABLE hl_transfer ADD COLUMN IF NOT EXISTS delivery_attempts integer DEFAULT 0;
+			END IF;
+		END$$;
+	`)
+	return err
+}
+
+func downHlDeliveryAttempts(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `ALTER TABLE hl_transfer DROP COLUMN IF EXISTS delivery_attempts`)
+	return err
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/uptrace/bun"
+)
+
+const rewardsWithdrawalsBatchSize = 10000
+
+func init() {
+	Migrations.MustRegister(upRewardsWithdrawals, downRewardsWithdrawals)
+}
+
+// rewardsWithdrawal - delegator's withdrawal decoded from withdraw_rewards event
+type rewardsWithdrawal struct {
+	EventId   uint64    `bun:"event_id"`
+	Time      time.Time `bun:"time"`
+	Height    int64     `bun:"height"`
+	Delegator string    `bun:"delegator"`
+	Validator string    `bun:"validator"`
+	Amount    string    `bun:"amount"`
+}
+
+// upRewardsWithdrawals - restores rewards withdrawals of delegators in the staking log from withdraw_rewards events.
+// They were not recorded before, so the rewards ledger was empty for the history indexed earlier. Event data is stored
+// in msgpack, so events are decoded by batches and every batch is inserted by one statement joined to addresses and validators.
+// Restored rows keep the event id: it deduplicates them and separates them from rows written by the parser.
+func upRewardsWithdrawals(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var exists bool
+		if err := tx.NewRaw(`SELECT to_regclass('staking_log') IS NOT NULL AND to_regclass('event') IS NOT NULL`).Scan(ctx, &exists); err != nil {
+			return err
+		}
+		if !exists {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `ALTER TABLE staking_log ADD COLUMN IF NOT EXISTS event_id bigint`); err != nil {
+			return err
+		}
+
+		var lastId uint64
+		for {
+			var events []storage.Event
+			if err := tx.NewSelect().
+				Model(&events).
+				Column("id", "height", "time", "data").
+				Where("type = ?", types.EventTypeWithdrawRewards).
+				Where("id > ?", lastId).
+				Order("id").
+				Limit(rewardsWithdrawalsBatchSize).
+				Scan(ctx); err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				return nil
+			}
+			lastId = events[len(events)-1].Id
+
+			withdrawals := make([]rewardsWithdrawal, 0, len(events))
+			for i := range events {
+				delegator := events[i].Data["delegator"]
+				validator := events[i].Data["validator"]
+				amount, ok := utiaAmount(events[i].Data["amount"])
+				if delegator == "" || validator == "" || !ok {
+					continue
+				}
+				withdrawals = append(withdrawals, rewardsWithdrawal{
+					EventId:   events[i].Id,
+					Time:      events[i].Time,
+					Height:    int64(events[i].Height),
+					Delegator: delegator,
+					Validator: validator,
+					Amount:    amount,
+				})
+			}
+
+			if len(withdrawals) > 0 {
+				if _, err := tx.NewRaw(`
+					WITH _data (event_id, time, height, delegator, validator, amount) AS (?)
+					INSERT INTO staking_log (time, height, address_id, validator_id, change, type, event_id)
+					SELECT _data.time::timestamptz, _data.height::bigint, address.id, validator.id, -(_data.amount::numeric), 'rewards', _data.event_id::bigint
+					FROM _data
+					JOIN address ON address.address = _data.delegator
+					JOIN validator ON validator.address = _data.validator
+					WHERE NOT EXISTS (
+						SELECT 1 FROM staking_log WHERE staking_log.event_id = _data.event_id::bigint
+					)
+				`, tx.NewValues(&withdrawals)).Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			if len(events) < rewardsWithdrawalsBatchSize {
+				return nil
+			}
+		}
+	})
+}
+
+// utiaAmount - returns amount of utia from coins string, e.g. `1000utia,5ibc/...`
+func utiaAmount(coins string) (string, bool) {
+	for coin := range strings.SplitSeq(coins, ",") {
+		amount, ok := strings.CutSuffix(strings.TrimSpace(coin), "utia")
+		if ok && amount != "" && amount != "0" {
+			return amount, true
+		}
+	}
+	return "", false
+}
+
+// downRewardsWithdrawals - removes restored withdrawals only. Withdrawals written by the parser don't have event id.
+func downRewardsWithdrawals(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var exists bool
+		if err := tx.NewRaw(`SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns WHERE table_name = 'staking_log' AND column_name = 'event_id'
+		)`).Scan(ctx, &exists); err != nil {
+			return err
+		}
+		if !exists {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM staking_log WHERE event_id IS NOT NULL`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `ALTER TABLE staking_log DROP COLUMN event_id`)
+		return err
+	})
+}
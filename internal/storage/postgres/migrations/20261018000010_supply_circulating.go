@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upSupplyCirculating, downSupplyCirculating)
+}
+
+// upSupplyCirculating - recomputes circulating supply of stored breakdowns as the total supply without tokens locked in vesting accounts.
+// The community pool isn't subtracted anymore, so breakdowns match the supply forecast.
+func upSupplyCirculating(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		DO $$
+		BEGIN
+			IF to_regclass('supply') IS NOT NULL THEN
+				UPDATE supply SET circulating = greatest(total - vesting_locked, 0);
+			END IF;
+		END$$;
+	`)
+	return err
+}
+
+func downSupplyCirculating(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		DO $$
+		BEGIN
+			IF to_regclass('supply') IS NOT NULL THEN
+				UPDATE supply SET circulating = greatest(total - vesting_locked - community_pool, 0);
+			END IF;
+		END$$;
+	`)
+	return err
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upWebhookDeliveryId, downWebhookDeliveryId)
+}
+
+// upWebhookDeliveryId - adds delivery identity to the log of webhook delivery attempts. Attempts of the same delivery share the identity.
+func upWebhookDeliveryId(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		DO $$
+		BEGIN
+			IF to_regclass('webhook_delivery') IS NOT NULL THEN
+				ALTER TABLE webhook_delivery ADD COLUMN IF NOT EXISTS delivery_id text;
+			END IF;
+		END$$;
+	`)
+	return err
+}
+
+func downWebhookDeliveryId(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `ALTER TABLE webhook_delivery DROP COLUMN IF EXISTS delivery_id`)
+	return err
+}
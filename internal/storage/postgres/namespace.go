@@ -93,6 +93,20 @@ func (n *Namespace) ListWithSort(ctx context.Context, sortField string, sort sdk
 	return
 }
 
+// ListAfter - returns namespaces from the newest one. Cursor is the identity of the last received namespace,
+// only namespaces preceding it are returned. It's used by keyset pagination of GraphQL.
+func (n *Namespace) ListAfter(ctx context.Context, cursor uint64, limit int) (ns []storage.Namespace, err error) {
+	query := n.DB().NewSelect().Model(&ns).
+		Order("id desc")
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+	query = limitScope(query, limit)
+
+	err = query.Scan(ctx)
+	return
+}
+
 func (n *Namespace) GetByIds(ctx context.Context, ids ...uint64) (ns []storage.Namespace, err error) {
 	if len(ids) == 0 {
 		return nil, nil
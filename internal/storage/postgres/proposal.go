@@ -55,14 +55,37 @@ func (p *Proposal) ListWithFilters(ctx context.Context, filters storage.ListProp
 }
 
 func (p *Proposal) ById(ctx context.Context, id uint64) (proposal storage.Proposal, err error) {
-	err = p.DB().NewSelect().
-		Model(&proposal).
+	err = p.withProposer(p.DB().NewSelect().Model(&proposal)).
+		Where("proposal.id = ?", id).
+		Scan(ctx)
+	return
+}
+
+func (p *Proposal) ByIds(ctx context.Context, ids ...uint64) (proposals []storage.Proposal, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	err = p.withProposer(p.DB().NewSelect().Model(&proposals)).
+		Where("proposal.id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	return
+}
+
+func (p *Proposal) withProposer(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.
 		ColumnExpr("proposal.*").
 		ColumnExpr("proposer.address as proposer__address").
 		ColumnExpr("celestial.id as proposer__celestials__id, celestial.image_url as proposer__celestials__image_url").
-		Where("proposal.id = ?", id).
 		Join("left join address as proposer ON proposal.proposer_id = proposer.id").
-		Join("left join celestial on celestial.address_id = proposal.proposer_id and celestial.status = 'PRIMARY'").
+		Join("left join celestial on celestial.address_id = proposal.proposer_id and celestial.status = 'PRIMARY'")
+}
+
+func (p *Proposal) Timeline(ctx context.Context, id uint64) (tallies []storage.ProposalTally, err error) {
+	err = p.DB().NewSelect().
+		Model(&tallies).
+		Where("proposal_id = ?", id).
+		OrderExpr("time asc, id asc").
 		Scan(ctx)
 	return
 }
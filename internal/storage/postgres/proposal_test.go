@@ -92,3 +92,43 @@ func (s *StorageTestSuite) TestProposalById() {
 	s.Require().NotNil(proposal.Proposer)
 	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", proposal.Proposer.String())
 }
+
+func (s *StorageTestSuite) TestProposalByIds() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	proposals, err := s.storage.Proposals.ByIds(ctx, 1, 2)
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 2)
+
+	for i := range proposals {
+		s.Require().Contains([]uint64{1, 2}, proposals[i].Id)
+		s.Require().NotNil(proposals[i].Proposer)
+	}
+}
+
+func (s *StorageTestSuite) TestProposalTimeline() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tallies, err := s.storage.Proposals.Timeline(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(tallies, 2)
+
+	s.Require().EqualValues(1, tallies[0].Id)
+	s.Require().EqualValues(1000, tallies[0].Height)
+	s.Require().EqualValues(1, tallies[0].VotesCount)
+	s.Require().EqualValues("100", tallies[0].YesVotingPower.String())
+
+	s.Require().EqualValues(2, tallies[1].Id)
+	s.Require().EqualValues(1001, tallies[1].Height)
+	s.Require().EqualValues(3, tallies[1].VotesCount)
+	s.Require().EqualValues(1, tallies[1].Abstain)
+	s.Require().EqualValues("500", tallies[1].VotingPower.String())
+	s.Require().EqualValues("200", tallies[1].AbstainVotingPower.String())
+	s.Require().EqualValues("1000", tallies[1].TotalVotingPower.String())
+
+	tallies, err = s.storage.Proposals.Timeline(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Empty(tallies)
+}
@@ -80,6 +80,28 @@ func (r *Rollup) Leaderboard(ctx context.Context, fltrs storage.LeaderboardFilte
 	return
 }
 
+// LeaderboardAfter - returns rollups ordered by total blobs size and identity in descending order. Rollups without stats
+// have null size in the view, so it's coalesced to zero both in order and in cursor comparison. Cursor is the identity of
+// the last received rollup, only rollups following it are returned. It's used by keyset pagination of GraphQL.
+func (r *Rollup) LeaderboardAfter(ctx context.Context, cursor uint64, limit int) (rollups []storage.RollupWithStats, err error) {
+	query := r.DB().NewSelect().
+		Table(storage.ViewLeaderboard).
+		ColumnExpr("leaderboard.*").
+		ColumnExpr("da_change.da_pct as da_pct").
+		Join("left join da_change on da_change.rollup_id = leaderboard.id").
+		OrderExpr("coalesce(leaderboard.size, 0) desc, leaderboard.id desc")
+	if cursor > 0 {
+		cursorRow := r.DB().NewSelect().
+			Table(storage.ViewLeaderboard).
+			ColumnExpr("coalesce(size, 0), id").
+			Where("id = ?", cursor)
+		query = query.Where("(coalesce(leaderboard.size, 0), leaderboard.id) < (?)", cursorRow)
+	}
+	query = limitScope(query, limit)
+	err = query.Scan(ctx, &rollups)
+	return
+}
+
 func (r *Rollup) LeaderboardDay(ctx context.Context, fltrs storage.LeaderboardFilters) (rollups []storage.RollupWithDayStats, err error) {
 	switch fltrs.SortField {
 	case "avg_size", blobsCountColumn, "total_size", "total_fee", "throughput", "namespace_count", "pfb_count", "mb_price":
@@ -143,6 +165,23 @@ func (r *Rollup) Namespaces(ctx context.Context, rollupId uint64, limit, offset
 	return
 }
 
+// NamespacesAfter - returns identities of namespaces used by the rollup in ascending order. Cursor is the last received identity,
+// only namespaces following it are returned. It's used by keyset pagination of GraphQL.
+func (r *Rollup) NamespacesAfter(ctx context.Context, rollupId, cursor uint64, limit int) (namespaceIds []uint64, err error) {
+	query := r.DB().NewSelect().
+		TableExpr("rollup_stats_by_month as r").
+		ColumnExpr("distinct r.namespace_id").
+		Join("inner join rollup_provider as rp on (rp.address_id = r.signer_id OR rp.address_id = 0) AND (rp.namespace_id = r.namespace_id OR rp.namespace_id = 0)").
+		Where("rollup_id = ?", rollupId).
+		Order("r.namespace_id asc")
+	if cursor > 0 {
+		query = query.Where("r.namespace_id > ?", cursor)
+	}
+	query = limitScope(query, limit)
+	err = query.Scan(ctx, &namespaceIds)
+	return
+}
+
 func (r *Rollup) Providers(ctx context.Context, rollupId uint64) (providers []storage.RollupProvider, err error) {
 	err = r.DB().NewSelect().Model(&providers).
 		Where("rollup_id = ?", rollupId).
@@ -169,6 +208,30 @@ func (r *Rollup) RollupsByNamespace(ctx context.Context, namespaceId uint64, lim
 	return
 }
 
+// RollupsByNamespaceAfter - returns rollups which use the namespace in ascending order of identity. Cursor is the identity
+// of the last received rollup, only rollups following it are returned. It's used by keyset pagination of GraphQL.
+func (r *Rollup) RollupsByNamespaceAfter(ctx context.Context, namespaceId, cursor uint64, limit int) (rollups []storage.Rollup, err error) {
+	subQuery := r.DB().NewSelect().
+		Model((*storage.RollupProvider)(nil)).
+		Column("rollup_id").
+		Where("namespace_id = ?", namespaceId).
+		Group("rollup_id").
+		Order("rollup_id asc")
+	if cursor > 0 {
+		subQuery = subQuery.Where("rollup_id > ?", cursor)
+	}
+	subQuery = limitScope(subQuery, limit)
+
+	err = r.DB().NewSelect().
+		With("rollups", subQuery).
+		Table("rollups").
+		ColumnExpr("rollup.*").
+		Join("left join rollup on rollup.id = rollups.rollup_id and rollup.verified = true").
+		Order("rollups.rollup_id asc").
+		Scan(ctx, &rollups)
+	return
+}
+
 func (r *Rollup) Series(ctx context.Context, rollupId uint64, timeframe storage.Timeframe, column string, req storage.SeriesRequest) (items []storage.HistogramItem, err error) {
 	providers, err := r.Providers(ctx, rollupId)
 	if err != nil {
@@ -269,6 +332,15 @@ func (r *Rollup) ById(ctx context.Context, rollupId uint64) (rollup storage.Roll
 	return
 }
 
+func (r *Rollup) GetByIds(ctx context.Context, ids ...uint64) (rollups []storage.Rollup, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	err = r.DB().NewSelect().Model(&rollups).Where("id IN (?)", bun.In(ids)).Scan(ctx)
+	return
+}
+
 func (r *Rollup) Distribution(ctx context.Context, rollupId uint64, series string, groupBy storage.Timeframe) (items []storage.DistributionItem, err error) {
 	providers, err := r.Providers(ctx, rollupId)
 	if err != nil {
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// RollupAudit -
+type RollupAudit struct {
+	*postgres.Table[*storage.RollupAudit]
+}
+
+// NewRollupAudit -
+func NewRollupAudit(db *database.Bun) *RollupAudit {
+	return &RollupAudit{
+		Table: postgres.NewTable[*storage.RollupAudit](db),
+	}
+}
+
+func (ra *RollupAudit) List(ctx context.Context, fltrs storage.RollupAuditFilters) (changes []storage.RollupAudit, err error) {
+	query := ra.DB().NewSelect().
+		Model(&changes).
+		Order("id desc")
+
+	if fltrs.RollupId > 0 {
+		query = query.Where("rollup_id = ?", fltrs.RollupId)
+	}
+	if fltrs.ApiKey != "" {
+		query = query.Where("api_key = ?", fltrs.ApiKey)
+	}
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
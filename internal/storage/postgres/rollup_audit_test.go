@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+)
+
+func (s *StorageTestSuite) TestRollupAuditListByRollup() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	changes, err := s.storage.RollupAudit.List(ctx, storage.RollupAuditFilters{
+		RollupId: 1,
+		Limit:    10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(changes, 2)
+
+	change := changes[0]
+	s.Require().EqualValues(2, change.Id)
+	s.Require().EqualValues(1, change.RollupId)
+	s.Require().Equal("pro_key", change.ApiKey)
+	s.Require().Equal(types.RollupAuditActionUpdate, change.Action)
+	s.Require().Contains(change.Changes, "website")
+	s.Require().Equal("https://website.com", change.Changes["website"].Old)
+	s.Require().Equal("https://rollup.io", change.Changes["website"].New)
+
+	s.Require().EqualValues(1, changes[1].Id)
+	s.Require().Equal(types.RollupAuditActionCreate, changes[1].Action)
+	s.Require().Len(changes[1].Changes, 2)
+}
+
+func (s *StorageTestSuite) TestRollupAuditListByApiKey() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	changes, err := s.storage.RollupAudit.List(ctx, storage.RollupAuditFilters{
+		ApiKey: "test_key",
+		Limit:  10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(changes, 1)
+	s.Require().EqualValues(3, changes[0].Id)
+	s.Require().Equal(types.RollupAuditActionVerify, changes[0].Action)
+	s.Require().Equal(true, changes[0].Changes["verified"].New)
+}
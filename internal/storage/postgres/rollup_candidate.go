@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// RollupCandidate -
+type RollupCandidate struct {
+	*postgres.Table[*storage.RollupCandidate]
+}
+
+// NewRollupCandidate -
+func NewRollupCandidate(db *database.Bun) *RollupCandidate {
+	return &RollupCandidate{
+		Table: postgres.NewTable[*storage.RollupCandidate](db),
+	}
+}
+
+func (rc *RollupCandidate) ByRollupIds(ctx context.Context, rollupIds ...uint64) (candidates []storage.RollupCandidate, err error) {
+	if len(rollupIds) == 0 {
+		return
+	}
+
+	err = rc.DB().NewSelect().
+		Model(&candidates).
+		Where("rollup_id = ANY(?)", pgdialect.Array(rollupIds)).
+		Scan(ctx)
+	return
+}
+
+// Activity - returns blob activity profiles of namespaces which were active since the time, are not linked to any rollup and were not proposed by discovery before.
+// Cadence is the average interval between blocks containing blobs of the namespace.
+func (rc *RollupCandidate) Activity(ctx context.Context, since time.Time, minBlobs int) (activity []storage.NamespaceActivity, err error) {
+	query := rc.DB().NewSelect().
+		TableExpr("blob_log").
+		ColumnExpr("namespace.id, namespace.version, namespace.namespace_id").
+		ColumnExpr("count(*) as blobs_count").
+		ColumnExpr("avg(blob_log.size) as avg_size").
+		ColumnExpr("coalesce(stddev_samp(blob_log.size), 0) as size_stddev").
+		ColumnExpr("extract(epoch from max(blob_log.time) - min(blob_log.time)) / greatest(count(distinct blob_log.height) - 1, 1) as cadence").
+		ColumnExpr("array_agg(distinct blob_log.signer_id) as signer_ids").
+		ColumnExpr("max(blob_log.height) as last_height").
+		ColumnExpr("max(blob_log.time) as last_time").
+		Join("inner join namespace on namespace.id = blob_log.namespace_id").
+		Where("blob_log.time >= ?", since).
+		Where("not exists (select 1 from rollup_provider where rollup_provider.namespace_id = blob_log.namespace_id)").
+		Where("not exists (select 1 from rollup_provider where rollup_provider.namespace_id = 0 and rollup_provider.address_id = blob_log.signer_id)").
+		Where("not exists (select 1 from rollup_candidate where rollup_candidate.namespace_ids @> array[blob_log.namespace_id]::bigint[])").
+		Group("namespace.id", "namespace.version", "namespace.namespace_id")
+
+	if minBlobs > 0 {
+		query = query.Having("count(*) >= ?", minBlobs)
+	}
+
+	err = query.
+		Order("namespace.id").
+		Scan(ctx, &activity)
+	return
+}
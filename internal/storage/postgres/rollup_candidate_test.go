@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+func (s *StorageTestSuite) TestRollupCandidateByRollupIds() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	candidates, err := s.storage.RollupCandidate.ByRollupIds(ctx, 1, 4)
+	s.Require().NoError(err)
+	s.Require().Len(candidates, 1)
+
+	candidate := candidates[0]
+	s.Require().EqualValues(1, candidate.Id)
+	s.Require().EqualValues(4, candidate.RollupId)
+	s.Require().Equal([]uint64{3}, candidate.NamespaceIds)
+	s.Require().Equal([]uint64{2}, candidate.SignerIds)
+	s.Require().Equal("OP Stack", candidate.Stack)
+	s.Require().EqualValues(120, candidate.BlobsCount)
+	s.Require().EqualValues(12, candidate.Cadence)
+	s.Require().EqualValues(0.9, candidate.Score)
+	s.Require().Len(candidate.Evidence, 2)
+}
+
+func (s *StorageTestSuite) TestRollupCandidateByRollupIdsEmpty() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	candidates, err := s.storage.RollupCandidate.ByRollupIds(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(candidates, 0)
+}
+
+func (s *StorageTestSuite) TestRollupCandidateActivity() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	// every namespace with blobs is linked to rollup or already proposed
+	activity, err := s.storage.RollupCandidate.Activity(ctx, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), 1)
+	s.Require().NoError(err)
+	s.Require().Len(activity, 0)
+}
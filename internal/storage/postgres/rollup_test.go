@@ -12,6 +12,30 @@ import (
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
+func (s *StorageTestSuite) TestRollupLeaderboardAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Connection().Exec(ctx, "REFRESH MATERIALIZED VIEW leaderboard;")
+	s.Require().NoError(err)
+	_, err = s.storage.Connection().Exec(ctx, "REFRESH MATERIALIZED VIEW da_change;")
+	s.Require().NoError(err)
+
+	all, err := s.storage.Rollup.LeaderboardAfter(ctx, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(all, 3)
+	s.Require().EqualValues("Rollup 3", all[0].Name)
+
+	for i := range all {
+		rollups, err := s.storage.Rollup.LeaderboardAfter(ctx, all[i].Id, 10)
+		s.Require().NoError(err)
+		s.Require().Len(rollups, len(all)-i-1)
+		for j := range rollups {
+			s.Require().Equal(all[i+j+1].Id, rollups[j].Id)
+		}
+	}
+}
+
 func (s *StorageTestSuite) TestRollupLeaderboard() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -263,6 +287,20 @@ func (s *StorageTestSuite) TestRollupLeaderboardDay() {
 	}
 }
 
+func (s *StorageTestSuite) TestRollupNamespacesAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	nsIds, err := s.storage.Rollup.NamespacesAfter(ctx, 1, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(nsIds, 2)
+	s.Require().Less(nsIds[0], nsIds[1])
+
+	nsIds, err = s.storage.Rollup.NamespacesAfter(ctx, 1, nsIds[0], 10)
+	s.Require().NoError(err)
+	s.Require().Len(nsIds, 1)
+}
+
 func (s *StorageTestSuite) TestRollupNamespaces() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -351,6 +389,20 @@ func (s *StorageTestSuite) TestRollupById() {
 	s.Require().EqualValues(0, rollup.DAPct)
 }
 
+func (s *StorageTestSuite) TestRollupsByNamespaceAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	rollups, err := s.storage.Rollup.RollupsByNamespaceAfter(ctx, 2, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 2)
+	s.Require().Less(rollups[0].Id, rollups[1].Id)
+
+	rollups, err = s.storage.Rollup.RollupsByNamespaceAfter(ctx, 2, rollups[0].Id, 10)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 1)
+}
+
 func (s *StorageTestSuite) TestRollupsByNamespace() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -31,6 +31,20 @@ func sortScope(q *bun.SelectQuery, field string, sort sdk.SortOrder) *bun.Select
 	return q.OrderExpr("? ?", bun.Ident(field), bun.Safe(sort))
 }
 
+// cursorScope - keyset pagination by the column: rows following the cursor in sort order are returned. Offset is applied only if cursor is not set.
+func cursorScope(q *bun.SelectQuery, column string, cursor uint64, offset int, sort sdk.SortOrder) *bun.SelectQuery {
+	if cursor > 0 {
+		if sort == sdk.SortOrderDesc {
+			return q.Where("? < ?", bun.Ident(column), cursor)
+		}
+		return q.Where("? > ?", bun.Ident(column), cursor)
+	}
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+	return q
+}
+
 func txFilter(query *bun.SelectQuery, fltrs storage.TxFilter) *bun.SelectQuery {
 	query = limitScope(query, fltrs.Limit)
 	query = txFilterWithoutLimit(query, fltrs)
@@ -148,6 +162,15 @@ func blobByProviderFilters(query *bun.SelectQuery, fltrs storage.BlobLogFilters)
 	if fltrs.Height > 0 {
 		query = query.Where("height = ?", fltrs.Height)
 	}
+	if fltrs.Cursor > 0 {
+		switch fltrs.Sort {
+		case sdk.SortOrderAsc:
+			query = query.Where("id > ?", fltrs.Cursor)
+		case sdk.SortOrderDesc:
+			query = query.Where("id < ?", fltrs.Cursor)
+		}
+		fltrs.Offset = 0
+	}
 	if fltrs.Limit+fltrs.Offset > 0 {
 		query = query.Limit(fltrs.Limit + fltrs.Offset)
 	} else {
@@ -233,3 +256,13 @@ func blobLogSort(query *bun.SelectQuery, sortBy string, sort sdk.SortOrder) *bun
 	}
 	return query
 }
+
+func timeRangeScope(query *bun.SelectQuery, req storage.SeriesRequest) *bun.SelectQuery {
+	if !req.From.IsZero() {
+		query = query.Where("time >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("time < ?", req.To)
+	}
+	return query
+}
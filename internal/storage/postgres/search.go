@@ -5,12 +5,15 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"encoding/hex"
 	"strings"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	celestials "github.com/celenium-io/celestial-module/pkg/storage"
 	"github.com/dipdup-io/go-lib/database"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
 )
 
 // Search -
@@ -25,6 +28,11 @@ func NewSearch(db *database.Bun) *Search {
 	}
 }
 
+// IsNoRows - checks whether error is returned because nothing was found
+func (s *Search) IsNoRows(err error) bool {
+	return errors.Is(err, sql.ErrNoRows)
+}
+
 func (s *Search) Search(ctx context.Context, query []byte) (results []storage.SearchResult, err error) {
 	blockQuery := s.db.DB().NewSelect().
 		Model((*storage.Block)(nil)).
@@ -66,11 +74,16 @@ func (s *Search) SearchText(ctx context.Context, text string) (results []storage
 		Model((*celestials.Celestial)(nil)).
 		ColumnExpr("address_id as id, id as value, 'celestial' as type").
 		Where("id ILIKE ?", text)
+	labelQuery := s.db.DB().NewSelect().
+		Model((*storage.AddressLabel)(nil)).
+		ColumnExpr("address_id as id, name as value, 'label' as type").
+		Where("name ILIKE ?", text)
 
 	union := rollupQuery.
 		UnionAll(namespaceQuery).
 		UnionAll(validatorQuery).
-		UnionAll(celestialsQuery)
+		UnionAll(celestialsQuery).
+		UnionAll(labelQuery)
 
 	err = s.db.DB().NewSelect().
 		TableExpr("(?) as search", union).
@@ -80,3 +93,104 @@ func (s *Search) SearchText(ctx context.Context, text string) (results []storage
 
 	return
 }
+
+// Documents of full-text search. Expressions are shared with GIN indexes, so they should not be changed without reindexing.
+const (
+	proposalDocument  = "coalesce(title, '') || ' ' || coalesce(description, '')"
+	validatorDocument = "coalesce(moniker, '') || ' ' || coalesce(details, '')"
+	rollupDocument    = "coalesce(name, '') || ' ' || coalesce(description, '') || ' ' || coalesce(fts_array_to_string(tags, ' '), '')"
+	memoDocument      = "memo"
+
+	// proposals, validators and rollups are described in english, memos are indexed without stemming
+	ftsConfigEnglish = "english"
+	ftsConfigSimple  = "simple"
+
+	ftsHeadlineOptions = "MaxWords=35, MinWords=15, MaxFragments=2, StartSel=<b>, StopSel=</b>"
+
+	// memos are searched only among the first matched documents, so rank isn't computed for the whole table
+	memoCandidatesLimit = 1000
+)
+
+// FullText - ranked full-text search over proposals, rollups, validators and memos of transactions and IBC transfers.
+// Returns snippets of matched documents with highlighted words. Memos are searched only if their types are requested explicitly.
+func (s *Search) FullText(ctx context.Context, fltrs storage.FullTextFilters) (results []storage.FullTextResult, err error) {
+	types := fltrs.Types
+	if len(types) == 0 {
+		types = []string{
+			storage.SearchTypeProposal,
+			storage.SearchTypeRollup,
+			storage.SearchTypeValidator,
+		}
+	}
+
+	var union *bun.SelectQuery
+	for _, typ := range types {
+		var query *bun.SelectQuery
+		switch typ {
+		case storage.SearchTypeProposal:
+			query = s.fullTextQuery((*storage.Proposal)(nil), typ, ftsConfigEnglish, proposalDocument, fltrs.Query)
+		case storage.SearchTypeRollup:
+			query = s.fullTextQuery((*storage.Rollup)(nil), typ, ftsConfigEnglish, rollupDocument, fltrs.Query)
+		case storage.SearchTypeValidator:
+			query = s.fullTextQuery((*storage.Validator)(nil), typ, ftsConfigEnglish, validatorDocument, fltrs.Query)
+		case storage.SearchTypeTx:
+			query = s.memoQuery((*storage.Tx)(nil), typ, fltrs.Query)
+		case storage.SearchTypeIbcTransfer:
+			query = s.memoQuery((*storage.IbcTransfer)(nil), typ, fltrs.Query)
+		default:
+			continue
+		}
+
+		if union == nil {
+			union = query
+		} else {
+			union = union.UnionAll(query)
+		}
+	}
+	if union == nil {
+		return
+	}
+
+	ranked := s.db.DB().NewSelect().
+		TableExpr("(?) as search", union).
+		OrderExpr("rank desc, type, id desc")
+	ranked = limitScope(ranked, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		ranked = ranked.Offset(fltrs.Offset)
+	}
+
+	// headlines are expensive, so they are computed only for the requested page
+	err = s.db.DB().NewSelect().
+		TableExpr("(?) as ranked", ranked).
+		ColumnExpr("ranked.id, ranked.type, ranked.rank").
+		ColumnExpr("ts_headline(ranked.config, ranked.document, websearch_to_tsquery(ranked.config, ?), ?) as snippet", fltrs.Query, ftsHeadlineOptions).
+		OrderExpr("ranked.rank desc, ranked.type, ranked.id desc").
+		Scan(ctx, &results)
+	return
+}
+
+func (s *Search) fullTextQuery(model any, typ, config, document, text string) *bun.SelectQuery {
+	return s.db.DB().NewSelect().
+		Model(model).
+		ColumnExpr("id, ? as type, ?::regconfig as config", typ, config).
+		ColumnExpr("? as document", bun.Safe(document)).
+		ColumnExpr("ts_rank(to_tsvector(?::regconfig, ?), websearch_to_tsquery(?::regconfig, ?)) as rank", config, bun.Safe(document), config, text).
+		Where("to_tsvector(?::regconfig, ?) @@ websearch_to_tsquery(?::regconfig, ?)", config, bun.Safe(document), config, text)
+}
+
+// memoQuery - limits matched memos before ranking. Tables with memos are large, so rank of all matched rows is too expensive.
+func (s *Search) memoQuery(model any, typ, text string) *bun.SelectQuery {
+	matched := s.db.DB().NewSelect().
+		Model(model).
+		Column("id").
+		ColumnExpr("? as document", bun.Safe(memoDocument)).
+		Where("memo <> ''").
+		Where("to_tsvector(?::regconfig, ?) @@ websearch_to_tsquery(?::regconfig, ?)", ftsConfigSimple, bun.Safe(memoDocument), ftsConfigSimple, text).
+		Limit(memoCandidatesLimit)
+
+	return s.db.DB().NewSelect().
+		TableExpr("(?) as matched", matched).
+		ColumnExpr("id, ? as type, ?::regconfig as config", typ, ftsConfigSimple).
+		ColumnExpr("document").
+		ColumnExpr("ts_rank(to_tsvector(?::regconfig, document), websearch_to_tsquery(?::regconfig, ?)) as rank", ftsConfigSimple, ftsConfigSimple, text)
+}
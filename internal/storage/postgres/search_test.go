@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/hex"
 	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 )
 
 func (s *StorageTestSuite) TestSearchText() {
@@ -84,3 +86,111 @@ func (s *StorageTestSuite) TestSearchByTextByCelestials() {
 	s.Require().EqualValues("celestial", result.Type)
 	s.Require().EqualValues("name 1", result.Value)
 }
+
+func (s *StorageTestSuite) TestSearchByTextByLabel() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.SearchText(ctx, "hot wallet")
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues(2, result.Id)
+	s.Require().EqualValues("label", result.Type)
+	s.Require().EqualValues("Binance hot wallet", result.Value)
+}
+
+func (s *StorageTestSuite) TestFullTextValidator() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.FullText(ctx, storage.FullTextFilters{
+		Query: "validating production networks",
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues(2, result.Id)
+	s.Require().Equal(storage.SearchTypeValidator, result.Type)
+	s.Require().Greater(result.Rank, 0.0)
+	s.Require().Contains(result.Snippet, "<b>production</b> <b>networks</b>")
+}
+
+func (s *StorageTestSuite) TestFullTextProposals() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.FullText(ctx, storage.FullTextFilters{
+		Query: "description",
+		Types: []string{storage.SearchTypeProposal},
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.Require().EqualValues(2, results[0].Id)
+	s.Require().EqualValues(1, results[1].Id)
+
+	for i := range results {
+		s.Require().Equal(storage.SearchTypeProposal, results[i].Type)
+		s.Require().Contains(results[i].Snippet, "<b>Description</b>")
+	}
+}
+
+func (s *StorageTestSuite) TestFullTextRollup() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.FullText(ctx, storage.FullTextFilters{
+		Query: "best rollup",
+		Types: []string{storage.SearchTypeRollup, storage.SearchTypeTx},
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().EqualValues(1, results[0].Id)
+	s.Require().Equal(storage.SearchTypeRollup, results[0].Type)
+}
+
+func (s *StorageTestSuite) TestFullTextTxMemo() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.FullText(ctx, storage.FullTextFilters{
+		Query: "memo2",
+		Types: []string{storage.SearchTypeTx},
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().EqualValues(2, results[0].Id)
+	s.Require().Equal(storage.SearchTypeTx, results[0].Type)
+	s.Require().Contains(results[0].Snippet, "<b>memo2</b>")
+}
+
+func (s *StorageTestSuite) TestFullTextSkipsMemoByDefault() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.FullText(ctx, storage.FullTextFilters{
+		Query: "memo2",
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(results, 0)
+}
+
+func (s *StorageTestSuite) TestFullTextUnknownType() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.FullText(ctx, storage.FullTextFilters{
+		Query: "memo2",
+		Types: []string{"block"},
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(results, 0)
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// ShareRange -
+type ShareRange struct {
+	*postgres.Table[*storage.ShareRange]
+}
+
+// NewShareRange -
+func NewShareRange(db *database.Bun) *ShareRange {
+	return &ShareRange{
+		Table: postgres.NewTable[*storage.ShareRange](db),
+	}
+}
+
+func (sr *ShareRange) ByHeight(ctx context.Context, height types.Level, fltrs storage.ShareRangeFilters) (ranges []storage.ShareRange, err error) {
+	query := sr.DB().NewSelect().Model(&ranges).
+		Where("share_range.height = ?", height).
+		Relation("Tx", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Column("hash")
+		}).
+		Order("share_range.start asc")
+
+	if len(fltrs.Namespace) > 0 {
+		query = query.Where("share_range.namespace = ?", fltrs.Namespace)
+	}
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
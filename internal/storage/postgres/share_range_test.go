@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+)
+
+func (s *StorageTestSuite) TestShareRangesByHeight() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	ranges, err := s.storage.ShareRanges.ByHeight(ctx, 1000, storage.ShareRangeFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 4)
+
+	for i := range ranges {
+		s.Require().EqualValues(1000, ranges[i].Height)
+		if i > 0 {
+			s.Require().Equal(ranges[i-1].End, ranges[i].Start)
+		}
+	}
+
+	blob := ranges[2]
+	s.Require().EqualValues(3, blob.Id)
+	s.Require().Equal(types.ShareRangeKindBlob, blob.Kind)
+	s.Require().EqualValues(2, blob.Start)
+	s.Require().EqualValues(5, blob.End)
+	s.Require().EqualValues(3, blob.Size())
+	s.Require().EqualValues(2, blob.TxId)
+	s.Require().NotNil(blob.Tx)
+	s.Require().Equal("652452a670011d629cc116e510ba88c1cabe061336661b1f3d206d248bd55811", hex.EncodeToString(blob.Tx.Hash))
+}
+
+func (s *StorageTestSuite) TestShareRangesByHeightWithNamespace() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	ns, err := hex.DecodeString("00000000000000000000000000000000000000005F7A8DDFE6136FE76B")
+	s.Require().NoError(err)
+
+	ranges, err := s.storage.ShareRanges.ByHeight(ctx, 1000, storage.ShareRangeFilters{
+		Limit:     10,
+		Namespace: ns,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 1)
+	s.Require().EqualValues(3, ranges[0].Id)
+	s.Require().Equal(ns, ranges[0].Namespace)
+}
+
+func (s *StorageTestSuite) TestShareRangesByHeightEmpty() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	ranges, err := s.storage.ShareRanges.ByHeight(ctx, 999, storage.ShareRangeFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 0)
+}
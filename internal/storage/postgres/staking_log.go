@@ -4,9 +4,14 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
 )
 
 // StakingLog -
@@ -20,3 +25,163 @@ func NewStakingLog(db *database.Bun) *StakingLog {
 		Table: postgres.NewTable[*storage.StakingLog](db),
 	}
 }
+
+// RewardsLedger - returns rewards withdrawals and delegation changes of (address, validator) pairs with running totals.
+// Running totals are computed over the whole history of the pair, so time filters don't affect them.
+func (sl *StakingLog) RewardsLedger(ctx context.Context, fltrs storage.RewardsLedgerFilters) (items []storage.RewardsLedgerItem, err error) {
+	inner := sl.DB().NewSelect().
+		Model((*storage.StakingLog)(nil)).
+		ColumnExpr("id, time, height, address_id, validator_id, type, change").
+		ColumnExpr(
+			"coalesce(sum(change) filter (where type IN (?, ?)) over (partition by address_id, validator_id order by time, id), 0) as delegated",
+			types.StakingLogTypeDelegation, types.StakingLogTypeUnbonding,
+		).
+		ColumnExpr(
+			"coalesce(-sum(change) filter (where type = ?) over (partition by address_id, validator_id order by time, id), 0) as withdrawn",
+			types.StakingLogTypeRewards,
+		).
+		Where("address_id is not null").
+		Where("type IN (?, ?, ?)", types.StakingLogTypeDelegation, types.StakingLogTypeUnbonding, types.StakingLogTypeRewards)
+
+	if fltrs.AddressId != nil {
+		inner = inner.Where("address_id = ?", *fltrs.AddressId)
+	}
+	if fltrs.ValidatorId != nil {
+		inner = inner.Where("validator_id = ?", *fltrs.ValidatorId)
+	}
+
+	query := sl.DB().NewSelect().
+		TableExpr("(?) as ledger", inner).
+		ColumnExpr("ledger.*").
+		ColumnExpr("address.address as address__address").
+		ColumnExpr("validator.id as validator__id, validator.moniker as validator__moniker, validator.cons_address as validator__cons_address").
+		Join("left join address on address.id = ledger.address_id").
+		Join("left join validator on validator.id = ledger.validator_id")
+
+	if !fltrs.From.IsZero() {
+		query = query.Where("ledger.time >= ?", fltrs.From)
+	}
+	if !fltrs.To.IsZero() {
+		query = query.Where("ledger.time < ?", fltrs.To)
+	}
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "ledger.time", fltrs.Sort)
+	query = sortScope(query, "ledger.id", fltrs.Sort)
+
+	err = query.Scan(ctx, &items)
+	return
+}
+
+// RewardsSeries - returns time series of accrued and withdrawn rewards and commissions
+func (sl *StakingLog) RewardsSeries(ctx context.Context, fltrs storage.RewardsSeriesFilters) (items []storage.RewardsSeriesItem, err error) {
+	var interval string
+	switch fltrs.Timeframe {
+	case storage.TimeframeHour:
+		interval = "1 hour"
+	case storage.TimeframeDay:
+		interval = "1 day"
+	case storage.TimeframeWeek:
+		interval = "1 week"
+	case storage.TimeframeMonth:
+		interval = "1 month"
+	default:
+		return nil, errors.Errorf("invalid timeframe: %s", fltrs.Timeframe)
+	}
+
+	if fltrs.AddressId != nil {
+		return sl.addressRewardsSeries(ctx, interval, fltrs)
+	}
+
+	query := sl.DB().NewSelect().
+		Model((*storage.StakingLog)(nil)).
+		ColumnExpr("time_bucket(?, time) as bucket", interval).
+		ColumnExpr("coalesce(sum(change) filter (where type = ? and address_id is null), 0) as rewards", types.StakingLogTypeRewards).
+		ColumnExpr("coalesce(-sum(change) filter (where type = ? and address_id is not null), 0) as withdrawn", types.StakingLogTypeRewards).
+		ColumnExpr("coalesce(sum(change) filter (where type = ? and change > 0), 0) as commissions", types.StakingLogTypeCommissions).
+		ColumnExpr("coalesce(-sum(change) filter (where type = ? and change < 0), 0) as commissions_withdrawn", types.StakingLogTypeCommissions).
+		Where("type IN (?, ?)", types.StakingLogTypeRewards, types.StakingLogTypeCommissions).
+		Group("bucket").
+		Order("bucket desc")
+
+	if fltrs.ValidatorId != nil {
+		query = query.Where("validator_id = ?", *fltrs.ValidatorId)
+	}
+	query = timeRangeScope(query, fltrs.SeriesRequest)
+
+	err = query.Scan(ctx, &items)
+	return
+}
+
+// addressRewardsSeries - returns time series of rewards accrued and withdrawn by the address. Accrued rewards are estimated per bucket:
+// rewards distributed to delegators of the validator (rewards without commission) multiplied by the share of the address
+// in the validator's stake at the end of the bucket.
+func (sl *StakingLog) addressRewardsSeries(ctx context.Context, interval string, fltrs storage.RewardsSeriesFilters) (items []storage.RewardsSeriesItem, err error) {
+	stakeLogs := []types.StakingLogType{types.StakingLogTypeDelegation, types.StakingLogTypeUnbonding}
+
+	validators := sl.DB().NewSelect().
+		Model((*storage.StakingLog)(nil)).
+		Distinct().
+		Column("validator_id").
+		Where("address_id = ?", *fltrs.AddressId).
+		Where("type IN (?)", bun.In(stakeLogs))
+	if fltrs.ValidatorId != nil {
+		validators = validators.Where("validator_id = ?", *fltrs.ValidatorId)
+	}
+
+	distributed := sl.DB().NewSelect().
+		Model((*storage.StakingLog)(nil)).
+		Column("validator_id").
+		ColumnExpr("time_bucket(?, time) as bucket", interval).
+		ColumnExpr("coalesce(sum(change) filter (where type = ? and address_id is null), 0) - coalesce(sum(change) filter (where type = ? and change > 0), 0) as amount", types.StakingLogTypeRewards, types.StakingLogTypeCommissions).
+		Where("validator_id IN (SELECT validator_id FROM validators)").
+		Where("type IN (?, ?)", types.StakingLogTypeRewards, types.StakingLogTypeCommissions).
+		Group("validator_id", "bucket")
+	distributed = timeRangeScope(distributed, fltrs.SeriesRequest)
+
+	accrued := sl.DB().NewSelect().
+		TableExpr("distributed").
+		ColumnExpr("distributed.bucket").
+		ColumnExpr("floor(sum(distributed.amount * delegated.amount / nullif(stake.amount, 0))) as rewards").
+		Join(`cross join lateral (
+			SELECT coalesce(sum(change), 0) as amount FROM staking_log
+			WHERE address_id = ? AND validator_id = distributed.validator_id AND type IN (?) AND time < distributed.bucket + ?::interval
+		) as delegated`, *fltrs.AddressId, bun.In(stakeLogs), interval).
+		Join(`cross join lateral (
+			SELECT validator.stake - coalesce((
+				SELECT sum(change) FROM staking_log
+				WHERE validator_id = distributed.validator_id AND type IN (?) AND time >= distributed.bucket + ?::interval
+			), 0) as amount FROM validator WHERE validator.id = distributed.validator_id
+		) as stake`, bun.In(stakeLogs), interval).
+		Group("distributed.bucket")
+
+	withdrawn := sl.DB().NewSelect().
+		Model((*storage.StakingLog)(nil)).
+		ColumnExpr("time_bucket(?, time) as bucket", interval).
+		ColumnExpr("-sum(change) as withdrawn").
+		Where("address_id = ?", *fltrs.AddressId).
+		Where("type = ?", types.StakingLogTypeRewards).
+		Group("bucket")
+	if fltrs.ValidatorId != nil {
+		withdrawn = withdrawn.Where("validator_id = ?", *fltrs.ValidatorId)
+	}
+	withdrawn = timeRangeScope(withdrawn, fltrs.SeriesRequest)
+
+	err = sl.DB().NewSelect().
+		With("validators", validators).
+		With("distributed", distributed).
+		With("accrued", accrued).
+		With("withdrawn", withdrawn).
+		TableExpr("accrued").
+		ColumnExpr("coalesce(accrued.bucket, withdrawn.bucket) as bucket").
+		ColumnExpr("coalesce(accrued.rewards, 0) as rewards").
+		ColumnExpr("coalesce(withdrawn.withdrawn, 0) as withdrawn").
+		ColumnExpr("0 as commissions, 0 as commissions_withdrawn").
+		Join("full outer join withdrawn on withdrawn.bucket = accrued.bucket").
+		OrderExpr("bucket desc").
+		Scan(ctx, &items)
+	return
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestRewardsLedgerByAddress() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.StakingLogs.RewardsLedger(ctx, storage.RewardsLedgerFilters{
+		AddressId: testsuite.Ptr(uint64(1)),
+		Limit:     10,
+		Sort:      sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 4)
+
+	s.Require().EqualValues(types.StakingLogTypeDelegation, items[0].Type)
+	s.Require().EqualValues("10000", items[0].Delegated.String())
+	s.Require().EqualValues("0", items[0].Withdrawn.String())
+
+	s.Require().EqualValues(types.StakingLogTypeRewards, items[1].Type)
+	s.Require().EqualValues("-1000", items[1].Change.String())
+	s.Require().EqualValues("10000", items[1].Delegated.String())
+	s.Require().EqualValues("1000", items[1].Withdrawn.String())
+
+	s.Require().EqualValues(types.StakingLogTypeRewards, items[2].Type)
+	s.Require().EqualValues(1001, items[2].Height)
+	s.Require().EqualValues("1300", items[2].Withdrawn.String())
+
+	s.Require().EqualValues(types.StakingLogTypeDelegation, items[3].Type)
+	s.Require().EqualValues("12000", items[3].Delegated.String())
+	s.Require().EqualValues("1300", items[3].Withdrawn.String())
+	s.Require().NotNil(items[3].Address)
+	s.Require().EqualValues("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", items[3].Address.Address)
+	s.Require().NotNil(items[3].Validator)
+	s.Require().EqualValues(1, items[3].Validator.Id)
+}
+
+func (s *StorageTestSuite) TestRewardsLedgerByValidatorWithTimeFilter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.StakingLogs.RewardsLedger(ctx, storage.RewardsLedgerFilters{
+		ValidatorId: testsuite.Ptr(uint64(1)),
+		From:        time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC),
+		Limit:       10,
+		Sort:        sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().EqualValues(1002, items[0].Height)
+	s.Require().EqualValues("12000", items[0].Delegated.String())
+	s.Require().EqualValues(1001, items[1].Height)
+	s.Require().EqualValues("1300", items[1].Withdrawn.String())
+}
+
+func (s *StorageTestSuite) TestRewardsSeriesByValidator() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.StakingLogs.RewardsSeries(ctx, storage.RewardsSeriesFilters{
+		ValidatorId: testsuite.Ptr(uint64(1)),
+		Timeframe:   storage.TimeframeDay,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 3)
+
+	s.Require().EqualValues("0", items[0].Rewards.String())
+	s.Require().EqualValues("200", items[0].CommissionsWithdrawn.String())
+
+	s.Require().EqualValues("5000", items[1].Rewards.String())
+	s.Require().EqualValues("300", items[1].Withdrawn.String())
+
+	s.Require().EqualValues("1000", items[2].Withdrawn.String())
+	s.Require().EqualValues("1000", items[2].Commissions.String())
+}
+
+func (s *StorageTestSuite) TestRewardsSeriesByAddress() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.StakingLogs.RewardsSeries(ctx, storage.RewardsSeriesFilters{
+		AddressId: testsuite.Ptr(uint64(1)),
+		Timeframe: storage.TimeframeMonth,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().EqualValues("1300", items[0].Withdrawn.String())
+	// (5000 rewards - 1000 commissions) * 12000 delegated / 1000100 stake
+	s.Require().EqualValues("47", items[0].Rewards.String())
+	s.Require().EqualValues("0", items[0].Commissions.String())
+}
+
+func (s *StorageTestSuite) TestRewardsSeriesInvalidTimeframe() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.StakingLogs.RewardsSeries(ctx, storage.RewardsSeriesFilters{
+		Timeframe: storage.TimeframeYear,
+	})
+	s.Require().Error(err)
+}
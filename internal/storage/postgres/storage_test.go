@@ -113,6 +113,19 @@ func (s *StorageTestSuite) TestMessageByTxId() {
 	s.Require().Equal(types.MsgWithdrawDelegatorReward, msgs[0].Type)
 }
 
+func (s *StorageTestSuite) TestMessageByTxIdAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	msgs, err := s.storage.Message.ByTxIdAfter(ctx, 1, 1, 10)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(msgs)
+	for i := range msgs {
+		s.Require().Greater(msgs[i].Id, uint64(1))
+		s.Require().EqualValues(1, msgs[i].TxId)
+	}
+}
+
 func (s *StorageTestSuite) TestMessageListWithTx() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -251,6 +264,22 @@ func (s *StorageTestSuite) TestNamespaceActiveBySize() {
 	s.Require().EqualValues(1255, namespace.Size)
 }
 
+func (s *StorageTestSuite) TestNamespaceListAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	ns, err := s.storage.Namespace.ListAfter(ctx, 0, 2)
+	s.Require().NoError(err)
+	s.Require().Len(ns, 2)
+	s.Require().EqualValues(3, ns[0].Id)
+	s.Require().EqualValues(2, ns[1].Id)
+
+	ns, err = s.storage.Namespace.ListAfter(ctx, 2, 2)
+	s.Require().NoError(err)
+	s.Require().Len(ns, 1)
+	s.Require().EqualValues(1, ns[0].Id)
+}
+
 func (s *StorageTestSuite) TestNamespaceGetByIds() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/pkg/errors"
+)
+
+// Supply -
+type Supply struct {
+	*postgres.Table[*storage.Supply]
+}
+
+// NewSupply -
+func NewSupply(db *database.Bun) *Supply {
+	return &Supply{
+		Table: postgres.NewTable[*storage.Supply](db),
+	}
+}
+
+// Last - returns supply breakdown of the last indexed block
+func (s *Supply) Last(ctx context.Context) (supply storage.Supply, err error) {
+	err = s.DB().NewSelect().
+		Model(&supply).
+		Order("time desc").
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// Series - returns supply breakdown at the end of each time bucket
+func (s *Supply) Series(ctx context.Context, timeframe storage.Timeframe, req storage.SeriesRequest) (items []storage.Supply, err error) {
+	var interval string
+	switch timeframe {
+	case storage.TimeframeHour:
+		interval = "1 hour"
+	case storage.TimeframeDay:
+		interval = "1 day"
+	case storage.TimeframeWeek:
+		interval = "1 week"
+	case storage.TimeframeMonth:
+		interval = "1 month"
+	default:
+		return nil, errors.Errorf("invalid timeframe: %s", timeframe)
+	}
+
+	query := s.DB().NewSelect().
+		Model((*storage.Supply)(nil)).
+		ColumnExpr("time_bucket(?, time) as time", interval).
+		ColumnExpr("last(height, time) as height").
+		ColumnExpr("last(total, time) as total").
+		ColumnExpr("last(circulating, time) as circulating").
+		ColumnExpr("last(staked, time) as staked").
+		ColumnExpr("last(unbonding, time) as unbonding").
+		ColumnExpr("last(vesting_locked, time) as vesting_locked").
+		ColumnExpr("last(community_pool, time) as community_pool").
+		GroupExpr("1").
+		OrderExpr("1 desc")
+
+	if !req.From.IsZero() {
+		query = query.Where("time >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("time < ?", req.To)
+	}
+
+	err = query.Scan(ctx, &items)
+	return
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+func (s *StorageTestSuite) TestSupplyLast() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	supply, err := s.storage.Supply.Last(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1000, supply.Height)
+	s.Require().Equal("1000200", supply.Total.String())
+	s.Require().Equal("900200", supply.Circulating.String())
+	s.Require().Equal("500200", supply.Staked.String())
+	s.Require().Equal("900", supply.Unbonding.String())
+	s.Require().Equal("100000", supply.VestingLocked.String())
+	s.Require().Equal("10050", supply.CommunityPool.String())
+}
+
+func (s *StorageTestSuite) TestSupplySeries() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.Supply.Series(ctx, storage.TimeframeDay, storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().Equal(time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC), items[0].Time.UTC())
+	s.Require().EqualValues(1000, items[0].Height)
+	s.Require().Equal("1000200", items[0].Total.String())
+	s.Require().Equal("900200", items[0].Circulating.String())
+
+	s.Require().Equal(time.Date(2023, 7, 2, 0, 0, 0, 0, time.UTC), items[1].Time.UTC())
+	s.Require().EqualValues(998, items[1].Height)
+	s.Require().Equal("110000", items[1].VestingLocked.String())
+}
+
+func (s *StorageTestSuite) TestSupplySeriesWithRange() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.Supply.Series(ctx, storage.TimeframeHour, storage.SeriesRequest{
+		From: time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2023, 7, 3, 11, 0, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().EqualValues(999, items[0].Height)
+}
@@ -5,6 +5,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	models "github.com/celenium-io/celestia-indexer/internal/storage"
@@ -141,6 +142,39 @@ func (tx Transaction) SaveAddresses(ctx context.Context, addresses ...*models.Ad
 	return count, err
 }
 
+// MergeAddresses - saves addresses like SaveAddresses but never moves last height back.
+// It's used to save addresses of blocks which were already indexed.
+func (tx Transaction) MergeAddresses(ctx context.Context, addresses ...*models.Address) (int64, error) {
+	if len(addresses) == 0 {
+		return 0, nil
+	}
+
+	addr := make([]addedAddress, len(addresses))
+	for i := range addresses {
+		addr[i].Address = addresses[i]
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&addr).
+		Column("address", "height", "last_height", "hash", "name", "is_forwarding").
+		On("CONFLICT ON CONSTRAINT address_idx DO UPDATE").
+		Set("last_height = GREATEST(EXCLUDED.last_height, added_address.last_height)").
+		Set("height = LEAST(EXCLUDED.height, added_address.height)").
+		Returning("xmax, id").
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for i := range addr {
+		if addr[i].Xmax == 0 {
+			count++
+		}
+	}
+
+	return count, err
+}
+
 func (tx Transaction) SaveBalances(ctx context.Context, balances ...models.Balance) error {
 	if len(balances) == 0 {
 		return nil
@@ -156,6 +190,15 @@ func (tx Transaction) SaveBalances(ctx context.Context, balances ...models.Balan
 	return err
 }
 
+func (tx Transaction) SaveBalanceUpdates(ctx context.Context, updates ...models.BalanceUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&updates).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveEvents(ctx context.Context, events ...models.Event) error {
 	return pg.SaveBulkWithCopy(ctx, tx, events, copyThreshold)
 }
@@ -177,6 +220,14 @@ func (tx Transaction) SaveBlobLogs(ctx context.Context, logs ...*models.BlobLog)
 	return pg.SaveBulkWithCopy(ctx, tx, logs, copyThreshold)
 }
 
+func (tx Transaction) SaveShareRanges(ctx context.Context, ranges ...models.ShareRange) error {
+	if len(ranges) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&ranges).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveMsgAddresses(ctx context.Context, addresses ...*models.MsgAddress) error {
 	return pg.SaveBulkWithCopy(ctx, tx, addresses, copyThreshold)
 }
@@ -404,6 +455,14 @@ func (tx Transaction) SaveValidators(ctx context.Context, validators ...*models.
 	return count, nil
 }
 
+func (tx Transaction) SaveValidatorRates(ctx context.Context, rates ...models.ValidatorRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&rates).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveUndelegations(ctx context.Context, undelegations ...models.Undelegation) error {
 	if len(undelegations) == 0 {
 		return nil
@@ -609,9 +668,11 @@ func (tx Transaction) SaveVotes(ctx context.Context, votes ...*models.Vote) (map
 				for _, vote := range existsVotes {
 					if vc, ok := votesCount[vote.ProposalId]; ok {
 						vc.Update(-1, vote)
+						vc.Removed = append(vc.Removed, vote)
 					} else {
 						var vc models.VotesCount
 						vc.Update(-1, vote)
+						vc.Removed = append(vc.Removed, vote)
 						votesCount[vote.ProposalId] = &vc
 					}
 				}
@@ -631,6 +692,32 @@ func (tx Transaction) SaveVotes(ctx context.Context, votes ...*models.Vote) (map
 	return votesCount, err
 }
 
+func (tx Transaction) SaveProposalTallies(ctx context.Context, tallies ...models.ProposalTally) error {
+	if len(tallies) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&tallies).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveEffectiveVotes(ctx context.Context, proposalId uint64, height types.Level) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.EffectiveVote)(nil)).
+		Where("proposal_id = ?", proposalId).
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "remove existing effective votes")
+	}
+
+	query := effectiveVotesQuery(tx.Tx(), func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("v.proposal_id = ?", proposalId)
+	})
+	_, err := tx.Tx().NewRaw(
+		"INSERT INTO effective_vote (height, proposal_id, address_id, validator_id, option, weight, voting_power, inherited) SELECT ?, ev.* FROM (?) AS ev",
+		height, query,
+	).Exec(ctx)
+	return err
+}
+
 type addedIbcClient struct {
 	bun.BaseModel `bun:"ibc_client"`
 	*models.IbcClient
@@ -792,6 +879,35 @@ func (tx Transaction) SaveIbcTransfers(ctx context.Context, transfers ...*models
 	return err
 }
 
+func (tx Transaction) ResolveIbcTransfer(ctx context.Context, transfer *models.IbcTransfer) (bool, error) {
+	if transfer == nil {
+		return false, nil
+	}
+
+	query := tx.Tx().NewUpdate().Model((*models.IbcTransfer)(nil)).
+		Set("status = ?", transfer.Status).
+		Set("resolve_tx_id = ?", transfer.ResolveTxId).
+		Set("resolve_height = ?", transfer.ResolveHeight).
+		Where("port = ?", transfer.Port).
+		Where("channel_id = ?", transfer.ChannelId).
+		Where("sequence = ?", transfer.Sequence).
+		Where("status = ?", storageTypes.IbcTransferStatusPending)
+
+	if transfer.ConnectionId != "" {
+		query = query.Set("connection_id = ?", transfer.ConnectionId)
+	}
+
+	result, err := query.Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
 func (tx Transaction) SaveHyperlaneMailbox(ctx context.Context, mailbox ...*models.HLMailbox) error {
 	if len(mailbox) == 0 {
 		return nil
@@ -898,6 +1014,72 @@ func (tx Transaction) LastBlock(ctx context.Context) (block models.Block, err er
 	return
 }
 
+// LockedVesting - returns amount of tokens which are still locked in vesting accounts at the moment t
+func (tx Transaction) LockedVesting(ctx context.Context, t time.Time) (locked storageTypes.Numeric, err error) {
+	err = lockedVestingQuery(tx.Tx(), t).Scan(ctx, &locked)
+	return
+}
+
+// StakingTotals - returns sum of validators stake and sum of undelegations which are not completed at the time
+func (tx Transaction) StakingTotals(ctx context.Context, t time.Time) (staked storageTypes.Numeric, unbonding storageTypes.Numeric, err error) {
+	stakeQuery := tx.Tx().NewSelect().
+		Model((*models.Validator)(nil)).
+		ColumnExpr("coalesce(sum(stake), 0)")
+	unbondingQuery := tx.Tx().NewSelect().
+		Model((*models.Undelegation)(nil)).
+		ColumnExpr("coalesce(sum(amount), 0)").
+		Where("completion_time >= ?", t)
+
+	err = tx.Tx().NewSelect().
+		ColumnExpr("(?) as staked", stakeQuery).
+		ColumnExpr("(?) as unbonding", unbondingQuery).
+		Scan(ctx, &staked, &unbonding)
+	return
+}
+
+// LastCommunityPool - returns community pool of the last supply breakdown or zero if there are no breakdowns
+func (tx Transaction) LastCommunityPool(ctx context.Context) (amount storageTypes.Numeric, err error) {
+	err = tx.Tx().NewSelect().
+		Model((*models.Supply)(nil)).
+		Column("community_pool").
+		Order("time desc").
+		Limit(1).
+		Scan(ctx, &amount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storageTypes.NumericZero(), nil
+	}
+	return
+}
+
+// UpdateCommunityPool - sets community pool of supply breakdowns since the height. Community pool is received from the node
+// asynchronously, so it's kept until the next update.
+func (tx Transaction) UpdateCommunityPool(ctx context.Context, height types.Level, t time.Time, amount storageTypes.Numeric) error {
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Supply)(nil)).
+		Set("community_pool = ?", amount.String()).
+		Where("time >= ?", t).
+		Where("height >= ?", height).
+		Exec(ctx)
+	return err
+}
+
+// ShiftSupply - adds the change of total supply to supply breakdowns since the height. It's used when changes of already indexed block
+// are replaced, so all following breakdowns are shifted equally. Staked, unbonding and community pool amounts don't depend on the supply,
+// so they are not shifted.
+func (tx Transaction) ShiftSupply(ctx context.Context, height types.Level, total storageTypes.Numeric) error {
+	if total.IsZero() {
+		return nil
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Supply)(nil)).
+		Set("total = total + ?", total.String()).
+		Set("circulating = greatest(circulating + ?, 0)", total.String()).
+		Where("height >= ?", height).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) State(ctx context.Context, name string) (state models.State, err error) {
 	err = tx.Tx().NewSelect().Model(&state).Where("name = ?", name).Scan(ctx)
 	return
@@ -956,8 +1138,13 @@ func (tx Transaction) RollbackValidators(ctx context.Context, height types.Level
 	return
 }
 
-func (tx Transaction) RollbackBlobLog(ctx context.Context, height types.Level) (err error) {
-	_, err = tx.Tx().NewDelete().Model((*models.BlobLog)(nil)).Where("height = ?", height).Exec(ctx)
+func (tx Transaction) RollbackBlobLog(ctx context.Context, height types.Level) (logs []models.BlobLog, err error) {
+	_, err = tx.Tx().NewDelete().Model(&logs).Where("height = ?", height).Returning("*").Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackShareRanges(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.ShareRange)(nil)).Where("height = ?", height).Exec(ctx)
 	return
 }
 
@@ -1034,6 +1221,13 @@ func (tx Transaction) RollbackJails(ctx context.Context, height types.Level) (ja
 	return
 }
 
+func (tx Transaction) RollbackValidatorRates(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.ValidatorRate)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
 func (tx Transaction) RollbackStakingLogs(ctx context.Context, height types.Level) (logs []models.StakingLog, err error) {
 	_, err = tx.Tx().NewDelete().Model(&logs).
 		Where("height = ?", height).
@@ -1056,6 +1250,27 @@ func (tx Transaction) RollbackVotes(ctx context.Context, height types.Level) (er
 	return
 }
 
+func (tx Transaction) RollbackProposalTallies(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.ProposalTally)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackEffectiveVotes(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.EffectiveVote)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackSupply(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.Supply)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
 func (tx Transaction) RollbackIbcClients(ctx context.Context, height types.Level) (err error) {
 	_, err = tx.Tx().NewDelete().Model((*models.IbcClient)(nil)).
 		Where("height = ?", height).
@@ -1078,8 +1293,17 @@ func (tx Transaction) RollbackIbcChannels(ctx context.Context, height types.Leve
 }
 
 func (tx Transaction) RollbackIbcTransfers(ctx context.Context, height types.Level) (err error) {
-	_, err = tx.Tx().NewDelete().Model((*models.IbcChannel)(nil)).
+	if _, err = tx.Tx().NewDelete().Model((*models.IbcTransfer)(nil)).
 		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = tx.Tx().NewUpdate().Model((*models.IbcTransfer)(nil)).
+		Set("status = ?", storageTypes.IbcTransferStatusPending).
+		Set("resolve_tx_id = NULL").
+		Set("resolve_height = NULL").
+		Where("resolve_height = ?", height).
 		Exec(ctx)
 	return
 }
@@ -1439,6 +1663,23 @@ func (tx Transaction) ProposalVotes(ctx context.Context, proposalId uint64, limi
 	return
 }
 
+func (tx Transaction) ProposalValidatorVotes(ctx context.Context, proposalId uint64) (votes []models.Vote, err error) {
+	err = tx.Tx().NewSelect().Model(&votes).
+		Where("proposal_id = ?", proposalId).
+		Where("validator_id IS NOT NULL").
+		Scan(ctx)
+	return
+}
+
+func (tx Transaction) LastProposalTally(ctx context.Context, proposalId uint64) (tally models.ProposalTally, err error) {
+	err = tx.Tx().NewSelect().Model(&tally).
+		Where("proposal_id = ?", proposalId).
+		Order("time desc").
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
 func (tx Transaction) AddressDelegations(ctx context.Context, addressId uint64) (val []models.Delegation, err error) {
 	err = tx.Tx().NewSelect().Model(&val).
 		Where("address_id = ?", addressId).
@@ -1566,6 +1807,14 @@ func (tx Transaction) RollbackZkISMMessages(ctx context.Context, height types.Le
 	return
 }
 
+func (tx Transaction) RollbackBalanceUpdates(ctx context.Context, height types.Level) (updates []models.BalanceUpdate, err error) {
+	_, err = tx.Tx().NewDelete().Model(&updates).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
 func (tx Transaction) ZkISMById(ctx context.Context, externalId []byte) (item models.ZkISM, err error) {
 	err = tx.Tx().NewSelect().Model(&item).
 		Where("external_id = ?", externalId).
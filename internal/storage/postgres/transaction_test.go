@@ -660,6 +660,12 @@ func (s *TransactionTestSuite) TestSaveVotesValidatorIdDuplicate() {
 
 	newCount, err := tx.SaveVotes(ctx, vote)
 	s.Require().NoError(err)
+	s.Require().Contains(newCount, uint64(2))
+	s.Require().Len(newCount[2].Removed, 1)
+	s.Require().EqualValues(3, newCount[2].Removed[0].Id)
+	s.Require().Equal(types.VoteOptionAbstain, newCount[2].Removed[0].Option)
+	newCount[2].Removed = nil
+
 	s.Require().EqualValues(map[uint64]*storage.VotesCount{
 		2: {
 			Yes:               1,
@@ -706,6 +712,12 @@ func (s *TransactionTestSuite) TestSaveVotesAddressIdDuplicate() {
 
 	newCount, err := tx.SaveVotes(ctx, vote)
 	s.Require().NoError(err)
+	s.Require().Contains(newCount, uint64(1))
+	s.Require().Len(newCount[1].Removed, 1)
+	s.Require().EqualValues(1, newCount[1].Removed[0].Id)
+	s.Require().Equal(types.VoteOptionYes, newCount[1].Removed[0].Option)
+	newCount[1].Removed = nil
+
 	s.Require().EqualValues(map[uint64]*storage.VotesCount{
 		1: {
 			Yes:            -1,
@@ -922,8 +934,9 @@ func (s *TransactionTestSuite) TestRollbackBlobLogs() {
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.RollbackBlobLog(ctx, 1000)
+	logs, err := tx.RollbackBlobLog(ctx, 1000)
 	s.Require().NoError(err)
+	s.Require().Len(logs, 4)
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
@@ -1079,6 +1092,225 @@ func (s *TransactionTestSuite) TestRollbackVotes() {
 	s.Require().Len(items, 0)
 }
 
+func (s *TransactionTestSuite) TestRollbackProposalTallies() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackProposalTallies(ctx, 1001)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	items, err := s.storage.Proposals.Timeline(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().EqualValues(1000, items[0].Height)
+}
+
+func (s *TransactionTestSuite) TestSaveProposalTallies() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveProposalTallies(ctx, storage.ProposalTally{
+		Height:           1002,
+		Time:             time.Date(2023, 7, 4, 5, 10, 57, 0, time.UTC),
+		ProposalId:       1,
+		VotesCount:       1,
+		No:               1,
+		VotingPower:      types.NumericFromInt64(10),
+		NoVotingPower:    types.NumericFromInt64(10),
+		TotalVotingPower: types.NumericFromInt64(1000),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	items, err := s.storage.Proposals.Timeline(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().EqualValues(1002, items[0].Height)
+	s.Require().EqualValues(1, items[0].No)
+	s.Require().EqualValues("10", items[0].NoVotingPower.String())
+}
+
+func (s *TransactionTestSuite) TestSaveEffectiveVotes() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveEffectiveVotes(ctx, 2, 1002)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	items, err := s.storage.Votes.EffectiveVotes(ctx, 2, storage.EffectiveVoteFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().EqualValues(1002, items[0].Height)
+	s.Require().EqualValues(2, items[0].AddressId)
+	s.Require().EqualValues(types.VoteOptionYes, items[0].Option)
+	s.Require().False(items[0].Inherited)
+
+	s.Require().EqualValues(1002, items[1].Height)
+	s.Require().EqualValues(1, items[1].AddressId)
+	s.Require().EqualValues(types.VoteOptionAbstain, items[1].Option)
+	s.Require().EqualValues("10000", items[1].VotingPower.String())
+	s.Require().True(items[1].Inherited)
+}
+
+func (s *TransactionTestSuite) TestRollbackEffectiveVotes() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackEffectiveVotes(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	items, err := s.storage.Votes.EffectiveVotes(ctx, 1, storage.EffectiveVoteFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 0)
+}
+
+func (s *TransactionTestSuite) TestRollbackSupply() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackSupply(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	supply, err := s.storage.Supply.Last(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(999, supply.Height)
+}
+
+func (s *TransactionTestSuite) TestLockedVesting() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	locked, err := tx.LockedVesting(ctx, time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().NoError(tx.Close(ctx))
+
+	s.Require().Equal("104000", locked.String())
+}
+
+func (s *TransactionTestSuite) TestShiftSupply() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.ShiftSupply(ctx, 999, types.NumericFromInt64(50))
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var items []storage.Supply
+	err = s.storage.Connection().DB().NewSelect().Model(&items).Order("height asc").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(items, 3)
+
+	s.Require().Equal("1000000", items[0].Total.String())
+	s.Require().Equal("500000", items[0].Staked.String())
+
+	s.Require().Equal("1000150", items[1].Total.String())
+	s.Require().Equal("900150", items[1].Circulating.String())
+	s.Require().Equal("500100", items[1].Staked.String())
+	s.Require().Equal("1000", items[1].Unbonding.String())
+
+	s.Require().Equal("1000250", items[2].Total.String())
+	s.Require().Equal("900250", items[2].Circulating.String())
+	s.Require().Equal("500200", items[2].Staked.String())
+}
+
+func (s *TransactionTestSuite) TestStakingTotals() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	staked, unbonding, err := tx.StakingTotals(ctx, time.Date(2023, 7, 5, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Equal("2000200", staked.String())
+	s.Require().Equal("1000", unbonding.String())
+
+	_, unbonding, err = tx.StakingTotals(ctx, time.Date(2023, 8, 5, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Equal("0", unbonding.String())
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestLastCommunityPool() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	pool, err := tx.LastCommunityPool(ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(tx.Close(ctx))
+
+	s.Require().Equal("10050", pool.String())
+}
+
+func (s *TransactionTestSuite) TestUpdateCommunityPool() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.UpdateCommunityPool(ctx, 999, time.Date(2023, 7, 3, 10, 0, 0, 0, time.UTC), types.NumericFromInt64(12345))
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var items []storage.Supply
+	err = s.storage.Connection().DB().NewSelect().Model(&items).Order("height asc").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(items, 3)
+
+	s.Require().Equal("10000", items[0].CommunityPool.String())
+	s.Require().Equal("12345", items[1].CommunityPool.String())
+	s.Require().Equal("12345", items[2].CommunityPool.String())
+}
+
 func (s *TransactionTestSuite) TestRollbackHyperlaneIgps() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -1692,6 +1924,48 @@ func (s *TransactionTestSuite) TestProposalVotes() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestProposalValidatorVotes() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	votes, err := tx.ProposalValidatorVotes(ctx, 2)
+	s.Require().NoError(err)
+
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(3, votes[0].Id)
+	s.Require().NotNil(votes[0].ValidatorId)
+	s.Require().EqualValues(1, *votes[0].ValidatorId)
+	s.Require().Equal(types.VoteOptionAbstain, votes[0].Option)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestLastProposalTally() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	tally, err := tx.LastProposalTally(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, tally.Id)
+	s.Require().EqualValues(1001, tally.Height)
+	s.Require().EqualValues(3, tally.VotesCount)
+	s.Require().Equal("500", tally.VotingPower.String())
+
+	_, err = tx.LastProposalTally(ctx, 1)
+	s.Require().Error(err)
+	s.Require().True(s.storage.Proposals.IsNoRows(err))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
 func (s *TransactionTestSuite) TestAddressDelegations() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -1913,6 +2187,61 @@ func (s *TransactionTestSuite) TestIbcTransfers() {
 	s.Require().NoError(tx2.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestResolveIbcTransfer() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	resolved, err := tx.ResolveIbcTransfer(ctx, &storage.IbcTransfer{
+		Port:          "transfer",
+		ChannelId:     "channel-2",
+		Sequence:      321657,
+		Status:        types.IbcTransferStatusAcknowledged,
+		ResolveTxId:   testsuite.Ptr(uint64(2)),
+		ResolveHeight: 1010,
+	})
+	s.Require().NoError(err)
+	s.Require().True(resolved)
+
+	resolved, err = tx.ResolveIbcTransfer(ctx, &storage.IbcTransfer{
+		Port:          "transfer",
+		ChannelId:     "channel-2",
+		Sequence:      321657,
+		Status:        types.IbcTransferStatusTimedOut,
+		ResolveTxId:   testsuite.Ptr(uint64(3)),
+		ResolveHeight: 1011,
+	})
+	s.Require().NoError(err)
+	s.Require().False(resolved, "transfer is already resolved")
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	transfer, err := s.storage.IbcTransfers.ById(ctx, 4)
+	s.Require().NoError(err)
+	s.Require().Equal(types.IbcTransferStatusAcknowledged, transfer.Status)
+	s.Require().EqualValues(testsuite.Ptr(uint64(2)), transfer.ResolveTxId)
+	s.Require().EqualValues(1010, transfer.ResolveHeight)
+	s.Require().NotNil(transfer.ResolveTx)
+
+	tx2, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx2.RollbackIbcTransfers(ctx, 1010)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx2.Flush(ctx))
+	s.Require().NoError(tx2.Close(ctx))
+
+	transfer, err = s.storage.IbcTransfers.ById(ctx, 4)
+	s.Require().NoError(err)
+	s.Require().Equal(types.IbcTransferStatusPending, transfer.Status)
+	s.Require().Nil(transfer.ResolveTxId)
+	s.Require().Zero(transfer.ResolveHeight)
+}
+
 func (s *TransactionTestSuite) TestIbcConnection() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -2393,3 +2722,65 @@ func (s *TransactionTestSuite) TestSaveHyperlaneGasPayments() {
 func TestSuiteTransaction_Run(t *testing.T) {
 	suite.Run(t, new(TransactionTestSuite))
 }
+
+func (s *TransactionTestSuite) TestHlTransferUpdateDelivery() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	dispatchedAt := time.Date(2023, 7, 4, 4, 11, 57, 0, time.UTC)
+	deliveredAt := dispatchedAt.Add(90 * time.Second)
+
+	err := s.storage.HLTransfer.UpdateDelivery(ctx, storage.HLTransfer{
+		Id:                 2,
+		Time:               dispatchedAt,
+		Type:               types.HLTransferTypeSend,
+		DeliveryStatus:     types.HLDeliveryStatusDelivered,
+		DispatchedAt:       &dispatchedAt,
+		DeliveredAt:        &deliveredAt,
+		CounterpartyTxHash: "0d5f3f4c1a2b",
+	})
+	s.Require().NoError(err)
+
+	transfer, err := s.storage.HLTransfer.ById(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(types.HLDeliveryStatusDelivered, transfer.DeliveryStatus)
+	s.Require().EqualValues("0d5f3f4c1a2b", transfer.CounterpartyTxHash)
+	s.Require().EqualValues(90*time.Second, transfer.Latency())
+
+	transfers, err := s.storage.HLTransfer.Undelivered(ctx, time.Time{}, 10, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(3, transfers[0].Id)
+}
+
+func (s *TransactionTestSuite) TestHlTransferIncDeliveryAttempts() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.HLTransfer.Undelivered(ctx, time.Time{}, 2, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 2)
+
+	for range 2 {
+		err = s.storage.HLTransfer.IncDeliveryAttempts(ctx, transfers[0])
+		s.Require().NoError(err)
+	}
+
+	transfer, err := s.storage.HLTransfer.ById(ctx, transfers[0].Id)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, transfer.DeliveryAttempts)
+
+	other := transfers[1]
+	other.Time = other.Time.Add(time.Hour)
+	err = s.storage.HLTransfer.IncDeliveryAttempts(ctx, other)
+	s.Require().NoError(err)
+
+	transfer, err = s.storage.HLTransfer.ById(ctx, transfers[1].Id)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, transfer.DeliveryAttempts, "transfer is matched by id and time")
+
+	transfers, err = s.storage.HLTransfer.Undelivered(ctx, time.Time{}, 2, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(3, transfers[0].Id)
+}
@@ -35,13 +35,24 @@ func (tx *Tx) getSigners(ctx context.Context, txId ...uint64) (signers []storage
 	err = tx.DB().NewSelect().TableExpr("(?) as signer", subQuery).
 		ColumnExpr("address.address as address__address").
 		ColumnExpr("celestial.id as address__celestials__id, celestial.image_url as address__celestials__image_url").
+		ColumnExpr("address_label.name as address__label__name, address_label.category as address__label__category, address_label.source as address__label__source").
 		ColumnExpr("signer.*").
 		Join("left join address on address.id = signer.address_id").
 		Join("left join celestial on celestial.address_id = signer.address_id and celestial.status = 'PRIMARY'").
+		Join("left join address_label on address_label.address_id = signer.address_id").
 		Scan(ctx, &signers)
 	return
 }
 
+func (tx *Tx) GetByIds(ctx context.Context, ids ...uint64) (txs []storage.Tx, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	err = tx.DB().NewSelect().Model(&txs).Where("id = ANY(?)", pgdialect.Array(ids)).Scan(ctx)
+	return
+}
+
 func (tx *Tx) setSigners(ctx context.Context, txs []storage.Tx) error {
 	ids := make([]uint64, len(txs))
 	for i := range ids {
@@ -138,7 +149,7 @@ func (tx *Tx) ByAddress(ctx context.Context, addressId uint64, fltrs storage.TxF
 
 	if fltrs.IsEmpty() {
 		signersQuery = limitScope(signersQuery, fltrs.Limit)
-		signersQuery = signersQuery.Offset(fltrs.Offset)
+		signersQuery = cursorScope(signersQuery, "tx_id", fltrs.Cursor, fltrs.Offset, fltrs.Sort)
 	}
 
 	signersQuery = sortScope(signersQuery, "tx_id", fltrs.Sort)
@@ -151,7 +162,7 @@ func (tx *Tx) ByAddress(ctx context.Context, addressId uint64, fltrs storage.TxF
 
 	if !fltrs.IsEmpty() {
 		query = limitScope(query, fltrs.Limit)
-		query = query.Offset(fltrs.Offset)
+		query = cursorScope(query, "tx.id", fltrs.Cursor, fltrs.Offset, fltrs.Sort)
 	}
 
 	query = txFilterWithoutLimit(query, fltrs)
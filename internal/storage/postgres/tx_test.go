@@ -256,6 +256,15 @@ func (s *StorageTestSuite) TestTxByIdWithRelations() {
 
 	s.Require().Len(tx.Messages, 2)
 	s.Require().Len(tx.Signers, 2)
+	for _, signer := range tx.Signers {
+		if signer.Address == "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" {
+			s.Require().NotNil(signer.Label)
+			s.Require().Equal("Binance hot wallet", signer.Label.Name)
+			s.Require().Equal(types.AddressLabelCategoryExchange, signer.Label.Category)
+		} else {
+			s.Require().Nil(signer.Label)
+		}
+	}
 }
 
 func (s *StorageTestSuite) TestTxGenesis() {
@@ -10,6 +10,7 @@ import (
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // Validator -
@@ -31,6 +32,15 @@ func (v *Validator) ByAddress(ctx context.Context, address string) (validator st
 	return
 }
 
+func (v *Validator) GetByIds(ctx context.Context, ids ...uint64) (validators []storage.Validator, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	err = v.DB().NewSelect().Model(&validators).Where("id IN (?)", bun.In(ids)).Scan(ctx)
+	return
+}
+
 func (v *Validator) TotalVotingPower(ctx context.Context, maxVals int) (storageTypes.Numeric, error) {
 	q := v.DB().NewSelect().
 		Model((*storage.Validator)(nil)).
@@ -49,6 +59,10 @@ func (v *Validator) TotalVotingPower(ctx context.Context, maxVals int) (storageT
 }
 
 func (v *Validator) ListByPower(ctx context.Context, fltrs storage.ValidatorFilters) (validators []storage.Validator, err error) {
+	if fltrs.Height > 0 {
+		return v.listByPowerAtHeight(ctx, fltrs)
+	}
+
 	query := v.DB().NewSelect().Model(&validators).
 		OrderExpr("(not jailed)::int * stake desc")
 
@@ -71,6 +85,124 @@ func (v *Validator) ListByPower(ctx context.Context, fltrs storage.ValidatorFilt
 	return
 }
 
+// ListByPowerAfter - returns validators ordered by voting power and identity. Cursor is the identity of the last received validator,
+// only validators following it are returned. It's used by keyset pagination of GraphQL.
+func (v *Validator) ListByPowerAfter(ctx context.Context, cursor uint64, limit int) (validators []storage.Validator, err error) {
+	query := v.DB().NewSelect().Model(&validators).
+		OrderExpr("(not jailed)::int * stake desc, id asc")
+	if cursor > 0 {
+		query = query.Where("((not jailed)::int * stake, -id) < (SELECT (not jailed)::int * stake, -id FROM validator WHERE id = ?)", cursor)
+	}
+	query = limitScope(query, limit)
+
+	err = query.Scan(ctx)
+	return
+}
+
+// stakeChangingLogs - staking log types which change stake of validator. Slashed stake is stored in jails.
+var stakeChangingLogs = []storageTypes.StakingLogType{
+	storageTypes.StakingLogTypeDelegation,
+	storageTypes.StakingLogTypeUnbonding,
+}
+
+// listByPowerAtHeight - reconstructs validator set at the height. Stake is computed by reverting
+// delegations, unbondings and slashings which happened after the height from the current stake.
+// Validators jailed at the height are skipped and the set is capped by the current max_validators constant.
+// Commission rate is the last one set at or before the height. It's null if validator has no rate history before the height.
+func (v *Validator) listByPowerAtHeight(ctx context.Context, fltrs storage.ValidatorFilters) (validators []storage.Validator, err error) {
+	changes := v.DB().NewSelect().
+		Model((*storage.StakingLog)(nil)).
+		Column("validator_id").
+		ColumnExpr("sum(change) as change").
+		Where("height > ?", fltrs.Height).
+		Where("type IN (?)", bun.In(stakeChangingLogs)).
+		Group("validator_id")
+
+	burned := v.DB().NewSelect().
+		Model((*storage.Jail)(nil)).
+		Column("validator_id").
+		ColumnExpr("sum(burned) as burned").
+		Where("height > ?", fltrs.Height).
+		Group("validator_id")
+
+	rates := v.DB().NewSelect().
+		Model((*storage.ValidatorRate)(nil)).
+		DistinctOn("validator_id").
+		Column("validator_id", "rate").
+		Where("height <= ?", fltrs.Height).
+		OrderExpr("validator_id, height desc")
+
+	jails := v.DB().NewSelect().
+		Model((*storage.Jail)(nil)).
+		Column("validator_id").
+		ColumnExpr("max(height) as height").
+		Where("height <= ?", fltrs.Height).
+		Group("validator_id")
+
+	unjails := v.DB().NewSelect().
+		Model((*storage.MsgValidator)(nil)).
+		Column("msg_validator.validator_id").
+		ColumnExpr("max(msg_validator.height) as height").
+		Join("join message on message.id = msg_validator.msg_id").
+		Join("join tx on tx.id = message.tx_id").
+		Where("msg_validator.height <= ?", fltrs.Height).
+		Where("message.type = ?", storageTypes.MsgUnjail).
+		Where("tx.status = ?", storageTypes.StatusSuccess).
+		Group("msg_validator.validator_id")
+
+	maxVals := v.DB().NewSelect().
+		Model((*storage.Constant)(nil)).
+		ColumnExpr("value::int").
+		Where("module = ?", storageTypes.ModuleNameStaking).
+		Where("name = ?", "max_validators")
+
+	set := v.DB().NewSelect().
+		Model((*storage.Validator)(nil)).
+		Column("id", "delegator", "address", "cons_address", "moniker", "website", "identity", "contacts", "details", "max_rate", "max_change_rate", "min_self_delegation", "rewards", "commissions", "height", "version", "messages_count", "creation_time").
+		ColumnExpr("validator.stake - coalesce(changes.change, 0) + coalesce(burned.burned, 0) as stake").
+		ColumnExpr("rates.rate as rate").
+		ColumnExpr("rates.rate is null as rate_unknown").
+		ColumnExpr("false as jailed").
+		Join("left join changes on changes.validator_id = validator.id").
+		Join("left join burned on burned.validator_id = validator.id").
+		Join("left join rates on rates.validator_id = validator.id").
+		Join("left join jails on jails.validator_id = validator.id").
+		Join("left join unjails on unjails.validator_id = validator.id").
+		Where("validator.height <= ?", fltrs.Height).
+		Where("jails.height is null or unjails.height > jails.height")
+
+	ranked := v.DB().NewSelect().
+		Table("validator_set").
+		Column("id").
+		ColumnExpr("row_number() over (order by stake desc, id asc) as position").
+		Where("stake > 0")
+
+	top := v.DB().NewSelect().
+		TableExpr("(?) as ranked", ranked).
+		Column("id").
+		Where("position <= coalesce((?), position)", maxVals)
+
+	query := v.DB().NewSelect().
+		With("changes", changes).
+		With("burned", burned).
+		With("rates", rates).
+		With("jails", jails).
+		With("unjails", unjails).
+		With("validator_set", set).
+		TableExpr("validator_set as validator").
+		ColumnExpr("validator.*").
+		Where("validator.id IN (?)", top).
+		OrderExpr("stake desc, id asc")
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+
+	err = query.Scan(ctx, &validators)
+	return
+}
+
 func (v *Validator) JailedCount(ctx context.Context) (int, error) {
 	return v.DB().NewSelect().
 		Model((*storage.Validator)(nil)).
@@ -130,3 +262,51 @@ func (v *Validator) TopNMetrics(ctx context.Context, n int) (metrics storage.Val
 		Scan(ctx, &metrics)
 	return
 }
+
+// History - returns validator's stake and commission rate after each block which changed them.
+// Rate is null if validator has no rate history at or before the block.
+func (v *Validator) History(ctx context.Context, id uint64, fltrs storage.ValidatorHistoryFilters) (items []storage.ValidatorHistoryItem, err error) {
+	stakeEvents := v.DB().NewSelect().
+		Model((*storage.StakingLog)(nil)).
+		Column("height", "time", "change").
+		Where("validator_id = ?", id).
+		Where("type IN (?)", bun.In(stakeChangingLogs))
+
+	slashEvents := v.DB().NewSelect().
+		Model((*storage.Jail)(nil)).
+		Column("height", "time").
+		ColumnExpr("-burned as change").
+		Where("validator_id = ?", id)
+
+	rateEvents := v.DB().NewSelect().
+		Model((*storage.ValidatorRate)(nil)).
+		Column("height", "time").
+		ColumnExpr("0 as change").
+		Where("validator_id = ?", id)
+
+	points := v.DB().NewSelect().
+		TableExpr("(?) as events", stakeEvents.UnionAll(slashEvents).UnionAll(rateEvents)).
+		Column("height").
+		ColumnExpr("max(time) as time").
+		ColumnExpr("sum(change) as change").
+		Group("height")
+
+	series := v.DB().NewSelect().
+		TableExpr("(?) as points", points).
+		ColumnExpr("points.height, points.time").
+		ColumnExpr("validator.stake - coalesce(sum(points.change) over (order by points.height desc rows between unbounded preceding and 1 preceding), 0) as stake").
+		ColumnExpr("rates.rate as rate").
+		ColumnExpr("rates.rate is null as rate_unknown").
+		Join("cross join validator").
+		Join("left join lateral (select rate from validator_rate where validator_id = validator.id and validator_rate.height <= points.height order by validator_rate.height desc limit 1) as rates on true").
+		Where("validator.id = ?", id)
+
+	query := v.DB().NewSelect().
+		TableExpr("(?) as series", series).
+		Offset(fltrs.Offset)
+	query = limitScope(query, fltrs.Limit)
+	query = sortScope(query, "height", fltrs.Sort)
+
+	err = query.Scan(ctx, &items)
+	return
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
 func (s *StorageTestSuite) TestValidatorByAddress() {
@@ -48,6 +49,20 @@ func (s *StorageTestSuite) TestListByPower() {
 	s.Require().Len(validators, 2)
 }
 
+func (s *StorageTestSuite) TestListByPowerAfter() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	all, err := s.storage.Validator.ListByPowerAfter(ctx, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(all, 2)
+
+	validators, err := s.storage.Validator.ListByPowerAfter(ctx, all[0].Id, 10)
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1)
+	s.Require().Equal(all[1].Id, validators[0].Id)
+}
+
 func (s *StorageTestSuite) TestListByPowerWithVersion() {
 	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
 	defer ctxCancel()
@@ -113,3 +128,57 @@ func (s *StorageTestSuite) TestTopNMetrics() {
 	s.Require().NotEmpty(metrics.CommissionMetric.String())
 	s.Require().NotEmpty(metrics.SelfDelegationMetric.String())
 }
+
+func (s *StorageTestSuite) TestListByPowerAtHeight() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	validators, err := s.storage.Validator.ListByPower(ctx, storage.ValidatorFilters{
+		Limit:  10,
+		Height: 999,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1)
+
+	s.Require().EqualValues(1, validators[0].Id)
+	s.Require().Equal("989100", validators[0].Stake.String())
+	s.Require().Equal("0.05", validators[0].Rate.String())
+	s.Require().False(validators[0].RateUnknown)
+	s.Require().Equal("Conqueror", validators[0].Moniker)
+
+	validators, err = s.storage.Validator.ListByPower(ctx, storage.ValidatorFilters{
+		Limit:  10,
+		Height: 1000,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1, "validator 1 is jailed at 1000")
+
+	s.Require().EqualValues(2, validators[0].Id)
+	s.Require().Equal("1000100", validators[0].Stake.String())
+	s.Require().True(validators[0].RateUnknown)
+	s.Require().NotNil(validators[0].Jailed)
+	s.Require().False(*validators[0].Jailed)
+}
+
+func (s *StorageTestSuite) TestValidatorHistory() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.Validator.History(ctx, 1, storage.ValidatorHistoryFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().EqualValues(1000, items[0].Height)
+	s.Require().Equal("1000100", items[0].Stake.String())
+	s.Require().Equal("0.07", items[0].Rate.String())
+	s.Require().False(items[0].RateUnknown)
+	s.Require().Equal("1", items[0].VotingPower().String())
+
+	s.Require().EqualValues(999, items[1].Height)
+	s.Require().Equal("989100", items[1].Stake.String())
+	s.Require().Equal("0.05", items[1].Rate.String())
+	s.Require().False(items[1].RateUnknown)
+}
@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
 )
 
 // VestingAccount -
@@ -46,3 +49,85 @@ func (v *VestingAccount) ByAddress(ctx context.Context, addressId uint64, limit,
 		Scan(ctx, &accs)
 	return
 }
+
+// UnlockSeries - returns amount of tokens unlocked by all vesting accounts in each time bucket. Delayed vestings
+// unlock at the end time, periodic ones at the time of each period and continuous ones linearly between start
+// and end times. Permanent locked accounts never unlock. Future buckets are included.
+func (v *VestingAccount) UnlockSeries(ctx context.Context, timeframe storage.Timeframe, req storage.SeriesRequest) (items []storage.VestingUnlockItem, err error) {
+	var interval string
+	switch timeframe {
+	case storage.TimeframeDay:
+		interval = "1 day"
+	case storage.TimeframeWeek:
+		interval = "1 week"
+	case storage.TimeframeMonth:
+		interval = "1 month"
+	default:
+		return nil, errors.Errorf("invalid timeframe: %s", timeframe)
+	}
+
+	delayed := v.DB().NewSelect().
+		Model((*storage.VestingAccount)(nil)).
+		ColumnExpr("time_bucket(?, end_time) as bucket, amount", interval).
+		Where("type = ?", types.VestingTypeDelayed).
+		Where("end_time is not null")
+
+	periodic := v.DB().NewSelect().
+		Model((*storage.VestingPeriod)(nil)).
+		ColumnExpr("time_bucket(?, time) as bucket, amount", interval)
+
+	continuous := v.DB().NewSelect().
+		TableExpr("vesting_account as va").
+		ColumnExpr("series.bucket").
+		ColumnExpr("va.amount * extract(epoch from least(series.bucket + ?::interval, va.end_time) - greatest(series.bucket, coalesce(va.start_time, va.time))) / extract(epoch from va.end_time - coalesce(va.start_time, va.time)) as amount", interval).
+		Join("cross join lateral generate_series(time_bucket(?, coalesce(va.start_time, va.time)), va.end_time, ?::interval) as series(bucket)", interval, interval).
+		Where("va.type = ?", types.VestingTypeContinuous).
+		Where("va.end_time > coalesce(va.start_time, va.time)").
+		Where("series.bucket < va.end_time")
+
+	query := v.DB().NewSelect().
+		TableExpr("(?) as unlocks", delayed.UnionAll(periodic).UnionAll(continuous)).
+		ColumnExpr("bucket, trunc(sum(amount)) as amount").
+		Group("bucket").
+		Order("bucket asc")
+
+	if !req.From.IsZero() {
+		query = query.Where("bucket >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("bucket < ?", req.To)
+	}
+
+	err = query.Scan(ctx, &items)
+	return
+}
+
+// Locked - returns amount of tokens which are still locked in vesting accounts at the moment t
+func (v *VestingAccount) Locked(ctx context.Context, t time.Time) (locked types.Numeric, err error) {
+	err = lockedVestingQuery(v.DB(), t).Scan(ctx, &locked)
+	return
+}
+
+func lockedVestingQuery(db bun.IDB, t time.Time) *bun.SelectQuery {
+	periods := db.NewSelect().
+		Model((*storage.VestingPeriod)(nil)).
+		ColumnExpr("coalesce(sum(vesting_period.amount), 0)").
+		Where("vesting_period.vesting_account_id = vesting_account.id").
+		Where("vesting_period.time > ?", t)
+
+	return db.NewSelect().
+		Model((*storage.VestingAccount)(nil)).
+		ColumnExpr(`coalesce(trunc(sum(case
+			when type = ? then (?)
+			when type = ? or end_time is null or end_time > ? then
+				case
+					when type = ? and end_time is not null and coalesce(start_time, time) < ? then amount * extract(epoch from end_time - ?) / extract(epoch from end_time - coalesce(start_time, time))
+					else amount
+				end
+			else 0
+		end)), 0) as locked`,
+			types.VestingTypePeriodic, periods,
+			types.VestingTypePermanent, t,
+			types.VestingTypeContinuous, t, t,
+		)
+}
@@ -6,6 +6,8 @@ package postgres
 import (
 	"context"
 	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 )
 
 func (s *StorageTestSuite) TestVestingAccountByAddress() {
@@ -20,3 +22,63 @@ func (s *StorageTestSuite) TestVestingAccountByAddress() {
 	s.Require().Equal("100000", vesting.Amount.String())
 	s.Require().Equal("delayed", vesting.Type.String())
 }
+
+func (s *StorageTestSuite) TestVestingAccountUnlockSeries() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.VestingAccounts.UnlockSeries(ctx, storage.TimeframeMonth, storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(items, 5)
+
+	s.Require().Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), items[0].Bucket.UTC())
+	s.Require().Equal("1000", items[0].Amount.String())
+	s.Require().Equal(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), items[1].Bucket.UTC())
+	s.Require().Equal("1000", items[1].Amount.String())
+	s.Require().Equal("1000", items[2].Amount.String())
+	s.Require().Equal("1000", items[3].Amount.String())
+	s.Require().Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), items[4].Bucket.UTC())
+	s.Require().Equal("100000", items[4].Amount.String())
+}
+
+func (s *StorageTestSuite) TestVestingAccountUnlockSeriesContinuous() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.VestingAccounts.UnlockSeries(ctx, storage.TimeframeDay, storage.SeriesRequest{
+		From: time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2023, 1, 8, 0, 0, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 3)
+
+	for i := range items {
+		s.Require().Equal("100", items[i].Amount.String())
+	}
+}
+
+func (s *StorageTestSuite) TestVestingAccountUnlockSeriesInvalidTimeframe() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.VestingAccounts.UnlockSeries(ctx, storage.TimeframeHour, storage.SeriesRequest{})
+	s.Require().Error(err)
+}
+
+func (s *StorageTestSuite) TestVestingAccountLocked() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tt := range []struct {
+		t    time.Time
+		want string
+	}{
+		{time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC), "104000"},
+		{time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC), "101500"},
+		{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "500"},
+	} {
+		locked, err := s.storage.VestingAccounts.Locked(ctx, tt.t)
+		s.Require().NoError(err)
+		s.Require().Equal(tt.want, locked.String(), tt.t.String())
+	}
+}
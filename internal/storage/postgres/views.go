@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// RefreshViews - materializes views over changed data. If `since` is nil continuous aggregates are refreshed entirely,
+// otherwise only buckets after `since`. Continuous aggregates are refreshed in order of creation because some of them
+// are built on top of others. Plain materialized views depend on aggregates, so they are the last.
+func RefreshViews(ctx context.Context, conn *pgx.Conn, since *time.Time) error {
+	if since != nil {
+		// the largest bucket of aggregates is month, so window is extended to cover bucket containing `since`
+		start := since.AddDate(0, -1, 0)
+		since = &start
+	}
+
+	rows, err := conn.Query(ctx, `
+		SELECT view_name
+		FROM timescaledb_information.continuous_aggregates
+		WHERE view_schema = current_schema()
+		ORDER BY regexp_replace(materialization_hypertable_name, '\D', '', 'g')::int`,
+	)
+	if err != nil {
+		return err
+	}
+	aggregates, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	for _, name := range aggregates {
+		if _, err := conn.Exec(ctx, `CALL refresh_continuous_aggregate($1::regclass, $2::timestamptz, NULL)`, name, since); err != nil {
+			return errors.Wrapf(err, "continuous aggregate %s", name)
+		}
+		log.Info().Str("view", name).Msg("continuous aggregate was refreshed")
+	}
+
+	rows, err = conn.Query(ctx, `
+		SELECT c.relname
+		FROM pg_class AS c
+		JOIN pg_namespace AS n ON n.oid = c.relnamespace
+		WHERE c.relkind = 'm' AND n.nspname = current_schema()
+		ORDER BY c.oid`,
+	)
+	if err != nil {
+		return err
+	}
+	views, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	for _, name := range views {
+		if _, err := conn.Exec(ctx, "REFRESH MATERIALIZED VIEW "+pgx.Identifier{name}.Sanitize()); err != nil {
+			return errors.Wrapf(err, "materialized view %s", name)
+		}
+		log.Info().Str("view", name).Msg("materialized view was refreshed")
+	}
+	return nil
+}
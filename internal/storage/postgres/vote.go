@@ -123,3 +123,116 @@ func (v *Vote) ByValidatorId(ctx context.Context, validatorId uint64, fltrs stor
 
 	return
 }
+
+// effectiveVotesQuery - computes effective votes of current delegations: the delegator's own vote
+// or the vote inherited from the validator if the delegator didn't vote in the proposal.
+// Scope is applied to both parts of the query to select proposals and delegators.
+func effectiveVotesQuery(db bun.IDB, scope func(q *bun.SelectQuery) *bun.SelectQuery) *bun.SelectQuery {
+	own := db.NewSelect().
+		TableExpr("vote as v").
+		ColumnExpr("v.proposal_id, d.address_id, d.validator_id, v.option, coalesce(v.weight, 1) as weight").
+		ColumnExpr("d.amount * coalesce(v.weight, 1) as voting_power, false as inherited").
+		Join("join delegation as d on d.address_id = v.voter_id").
+		Where("d.amount > 0")
+
+	inherited := db.NewSelect().
+		TableExpr("vote as v").
+		ColumnExpr("v.proposal_id, d.address_id, d.validator_id, v.option, coalesce(v.weight, 1) as weight").
+		ColumnExpr("d.amount * coalesce(v.weight, 1) as voting_power, true as inherited").
+		Join("join delegation as d on d.validator_id = v.validator_id").
+		Where("v.validator_id is not null").
+		Where("d.amount > 0").
+		Where("not exists (select 1 from vote as own where own.proposal_id = v.proposal_id and own.voter_id = d.address_id)")
+
+	return scope(own).UnionAll(scope(inherited))
+}
+
+func (v *Vote) effectiveVotes(ctx context.Context, subQuery *bun.SelectQuery, fltrs storage.EffectiveVoteFilters) (votes []storage.EffectiveVote, err error) {
+	query := v.DB().NewSelect().
+		TableExpr("(?) as effective_vote", subQuery).
+		ColumnExpr("effective_vote.*").
+		ColumnExpr("validator.id as validator__id").
+		ColumnExpr("validator.cons_address as validator__cons_address").
+		ColumnExpr("validator.moniker as validator__moniker").
+		ColumnExpr("address.address as address__address").
+		ColumnExpr("celestial.id as address__celestials__id").
+		ColumnExpr("celestial.image_url as address__celestials__image_url").
+		Join("left join validator on validator.id = effective_vote.validator_id").
+		Join("left join address on address.id = effective_vote.address_id").
+		Join("left join celestial on celestial.address_id = effective_vote.address_id and celestial.status = 'PRIMARY'").
+		OrderExpr("effective_vote.voting_power desc, effective_vote.address_id asc, effective_vote.validator_id asc")
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	if len(fltrs.Option) > 0 {
+		query = query.Where("effective_vote.option IN ?", bun.Tuple(fltrs.Option))
+	}
+	if fltrs.Inherited != nil {
+		query = query.Where("effective_vote.inherited = ?", *fltrs.Inherited)
+	}
+	if fltrs.AddressId != nil {
+		query = query.Where("effective_vote.address_id = ?", *fltrs.AddressId)
+	}
+	if fltrs.ValidatorId != nil {
+		query = query.Where("effective_vote.validator_id = ?", *fltrs.ValidatorId)
+	}
+
+	err = query.Scan(ctx, &votes)
+	return
+}
+
+// EffectiveVotes - returns effective votes recorded when the proposal was finished
+func (v *Vote) EffectiveVotes(ctx context.Context, proposalId uint64, fltrs storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error) {
+	subQuery := v.DB().NewSelect().
+		Model((*storage.EffectiveVote)(nil)).
+		Where("proposal_id = ?", proposalId)
+	return v.effectiveVotes(ctx, subQuery, fltrs)
+}
+
+// ComputeEffectiveVotes - computes effective votes of the proposal from the current delegations
+func (v *Vote) ComputeEffectiveVotes(ctx context.Context, proposalId uint64, fltrs storage.EffectiveVoteFilters) ([]storage.EffectiveVote, error) {
+	subQuery := effectiveVotesQuery(v.DB(), func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("v.proposal_id = ?", proposalId)
+	})
+	return v.effectiveVotes(ctx, subQuery, fltrs)
+}
+
+// EffectiveVotesByAddress - returns effective votes of the address: recorded ones for finished proposals and computed ones for active proposals
+func (v *Vote) EffectiveVotesByAddress(ctx context.Context, addressId uint64, limit, offset int) (votes []storage.EffectiveVote, err error) {
+	activeProposals := v.DB().NewSelect().
+		Model((*storage.Proposal)(nil)).
+		Column("id").
+		Where("status = ?", types.ProposalStatusActive)
+
+	computed := effectiveVotesQuery(v.DB(), func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("v.proposal_id IN (?)", activeProposals).
+			Where("d.address_id = ?", addressId)
+	})
+
+	recorded := v.DB().NewSelect().
+		Model((*storage.EffectiveVote)(nil)).
+		Column("proposal_id", "address_id", "validator_id", "option", "weight", "voting_power", "inherited").
+		Where("address_id = ?", addressId)
+
+	query := v.DB().NewSelect().
+		TableExpr("(?) as effective_vote", recorded.UnionAll(computed)).
+		ColumnExpr("effective_vote.*").
+		ColumnExpr("validator.id as validator__id").
+		ColumnExpr("validator.cons_address as validator__cons_address").
+		ColumnExpr("validator.moniker as validator__moniker").
+		ColumnExpr("proposal.id as proposal__id, proposal.status as proposal__status, proposal.title as proposal__title").
+		Join("left join validator on validator.id = effective_vote.validator_id").
+		Join("left join proposal on proposal.id = effective_vote.proposal_id").
+		OrderExpr("effective_vote.proposal_id desc, effective_vote.voting_power desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err = query.Scan(ctx, &votes)
+	return
+}
@@ -185,3 +185,97 @@ func (s *StorageTestSuite) TestVoteByValidatorIdNoResult() {
 	s.Require().NoError(err)
 	s.Require().Len(votes, 0)
 }
+
+func (s *StorageTestSuite) TestEffectiveVotes() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Votes.EffectiveVotes(ctx, 1, storage.EffectiveVoteFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votes, 2)
+
+	vote := votes[0]
+	s.Require().EqualValues(1, vote.ProposalId)
+	s.Require().EqualValues(1000, vote.Height)
+	s.Require().EqualValues(2, vote.AddressId)
+	s.Require().EqualValues(types.VoteOptionNo, vote.Option)
+	s.Require().EqualValues("100001", vote.VotingPower.String())
+	s.Require().False(vote.Inherited)
+	s.Require().NotNil(vote.Address)
+	s.Require().EqualValues("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", vote.Address.Address)
+	s.Require().NotNil(vote.Validator)
+	s.Require().EqualValues(1, vote.Validator.Id)
+
+	votes, err = s.storage.Votes.EffectiveVotes(ctx, 1, storage.EffectiveVoteFilters{
+		Limit:  10,
+		Option: []types.VoteOption{types.VoteOptionYes},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(1, votes[0].AddressId)
+
+	votes, err = s.storage.Votes.EffectiveVotes(ctx, 2, storage.EffectiveVoteFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(votes)
+}
+
+func (s *StorageTestSuite) TestComputeEffectiveVotes() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Votes.ComputeEffectiveVotes(ctx, 2, storage.EffectiveVoteFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votes, 2)
+
+	own := votes[0]
+	s.Require().EqualValues(2, own.ProposalId)
+	s.Require().EqualValues(2, own.AddressId)
+	s.Require().EqualValues(1, own.ValidatorId)
+	s.Require().EqualValues(types.VoteOptionYes, own.Option)
+	s.Require().EqualValues("100001", own.VotingPower.String())
+	s.Require().False(own.Inherited)
+
+	inherited := votes[1]
+	s.Require().EqualValues(2, inherited.ProposalId)
+	s.Require().EqualValues(1, inherited.AddressId)
+	s.Require().EqualValues(1, inherited.ValidatorId)
+	s.Require().EqualValues(types.VoteOptionAbstain, inherited.Option)
+	s.Require().EqualValues("10000", inherited.VotingPower.String())
+	s.Require().True(inherited.Inherited)
+	s.Require().NotNil(inherited.Address)
+	s.Require().EqualValues("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", inherited.Address.Address)
+
+	votes, err = s.storage.Votes.ComputeEffectiveVotes(ctx, 2, storage.EffectiveVoteFilters{
+		Limit:     10,
+		Inherited: testsuite.Ptr(true),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(1, votes[0].AddressId)
+}
+
+func (s *StorageTestSuite) TestEffectiveVotesByAddress() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Votes.EffectiveVotesByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 2)
+
+	s.Require().EqualValues(2, votes[0].ProposalId)
+	s.Require().EqualValues(types.VoteOptionAbstain, votes[0].Option)
+	s.Require().True(votes[0].Inherited)
+	s.Require().NotNil(votes[0].Proposal)
+	s.Require().EqualValues(types.ProposalStatusActive, votes[0].Proposal.Status)
+
+	s.Require().EqualValues(1, votes[1].ProposalId)
+	s.Require().EqualValues(types.VoteOptionYes, votes[1].Option)
+	s.Require().False(votes[1].Inherited)
+	s.Require().EqualValues("10000", votes[1].VotingPower.String())
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Webhook -
+type Webhook struct {
+	*postgres.Table[*storage.Webhook]
+}
+
+// NewWebhook -
+func NewWebhook(db *database.Bun) *Webhook {
+	return &Webhook{
+		Table: postgres.NewTable[*storage.Webhook](db),
+	}
+}
+
+func (w *Webhook) ByApiKey(ctx context.Context, key string, limit, offset int) (webhooks []storage.Webhook, err error) {
+	query := w.DB().NewSelect().
+		Model(&webhooks).
+		Where("api_key = ?", key).
+		Order("id desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+func (w *Webhook) Active(ctx context.Context) (webhooks []storage.Webhook, err error) {
+	err = w.DB().NewSelect().
+		Model(&webhooks).
+		Where("active = true").
+		Scan(ctx)
+	return
+}
+
+func (w *Webhook) Delete(ctx context.Context, id uint64) error {
+	_, err := w.DB().NewDelete().
+		Model((*storage.Webhook)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+// WebhookDelivery -
+type WebhookDelivery struct {
+	*postgres.Table[*storage.WebhookDelivery]
+}
+
+// NewWebhookDelivery -
+func NewWebhookDelivery(db *database.Bun) *WebhookDelivery {
+	return &WebhookDelivery{
+		Table: postgres.NewTable[*storage.WebhookDelivery](db),
+	}
+}
+
+func (wd *WebhookDelivery) ByWebhookId(ctx context.Context, webhookId uint64, limit, offset int) (deliveries []storage.WebhookDelivery, err error) {
+	query := wd.DB().NewSelect().
+		Model(&deliveries).
+		Where("webhook_id = ?", webhookId).
+		Order("time desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+// WebhookTask -
+type WebhookTask struct {
+	*postgres.Table[*storage.WebhookTask]
+}
+
+// NewWebhookTask -
+func NewWebhookTask(db *database.Bun) *WebhookTask {
+	return &WebhookTask{
+		Table: postgres.NewTable[*storage.WebhookTask](db),
+	}
+}
+
+// Enqueue - saves tasks skipping ones which were already enqueued by another API replica
+func (wt *WebhookTask) Enqueue(ctx context.Context, tasks ...storage.WebhookTask) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	_, err := wt.DB().NewInsert().
+		Model(&tasks).
+		On("CONFLICT (delivery_id) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+// Claim - returns tasks which attempts are due and postpones their next attempt by the lease. Rows locked by another replica are skipped,
+// so every task is sent by one replica. If the replica stops before the attempt is finished, the task is claimed again after the lease.
+func (wt *WebhookTask) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) (tasks []storage.WebhookTask, err error) {
+	due := wt.DB().NewSelect().
+		Model((*storage.WebhookTask)(nil)).
+		Column("id").
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at asc").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	_, err = wt.DB().NewUpdate().
+		Model((*storage.WebhookTask)(nil)).
+		Set("next_attempt_at = ?", now.Add(lease)).
+		Where("id IN (?)", due).
+		Returning("*").
+		Exec(ctx, &tasks)
+	return
+}
+
+func (wt *WebhookTask) Retry(ctx context.Context, id uint64, attempt int, nextAttemptAt time.Time) error {
+	_, err := wt.DB().NewUpdate().
+		Model((*storage.WebhookTask)(nil)).
+		Set("attempt = ?", attempt).
+		Set("next_attempt_at = ?", nextAttemptAt).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (wt *WebhookTask) Delete(ctx context.Context, id uint64) error {
+	_, err := wt.DB().NewDelete().
+		Model((*storage.WebhookTask)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+)
+
+func (s *StorageTestSuite) TestWebhookByApiKey() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	webhooks, err := s.storage.Webhooks.ByApiKey(ctx, "test_key", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(webhooks, 2)
+	s.Require().EqualValues(2, webhooks[0].Id)
+	s.Require().Equal(types.WebhookEventJail, webhooks[0].Event)
+	s.Require().EqualValues(1, webhooks[0].ValidatorId)
+	s.Require().False(webhooks[0].Active)
+
+	webhooks, err = s.storage.Webhooks.ByApiKey(ctx, "unknown", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(webhooks, 0)
+}
+
+func (s *StorageTestSuite) TestWebhookActive() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	webhooks, err := s.storage.Webhooks.Active(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(webhooks, 1)
+	s.Require().EqualValues(1, webhooks[0].Id)
+	s.Require().Equal(types.WebhookEventBlob, webhooks[0].Event)
+	s.Require().EqualValues(1, webhooks[0].NamespaceId)
+	s.Require().Equal("https://example.com/hooks/blob", webhooks[0].Url)
+}
+
+func (s *StorageTestSuite) TestWebhookDeliveryByWebhookId() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	deliveries, err := s.storage.WebhookDelivery.ByWebhookId(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 2)
+	s.Require().EqualValues(2, deliveries[0].Id)
+	s.Require().True(deliveries[0].Success)
+	s.Require().EqualValues(200, deliveries[0].StatusCode)
+	s.Require().EqualValues(2, deliveries[0].Attempt)
+
+	s.Require().EqualValues(1, deliveries[1].Id)
+	s.Require().False(deliveries[1].Success)
+	s.Require().Equal("unexpected status code: 500", deliveries[1].Error)
+}
+
+func (s *TransactionTestSuite) TestWebhookTaskEnqueue() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	now := time.Date(2023, 7, 4, 3, 12, 0, 0, time.UTC)
+	err := s.storage.WebhookTasks.Enqueue(ctx,
+		storage.WebhookTask{
+			DeliveryId:    "5b0f1c3a9e2d4f6a8b7c6d5e4f3a2b1c",
+			WebhookId:     1,
+			Event:         types.WebhookEventBlob,
+			Payload:       `{"event":"blob","duplicate":true}`,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		},
+		storage.WebhookTask{
+			DeliveryId:    "0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a",
+			WebhookId:     1,
+			Event:         types.WebhookEventBlob,
+			Payload:       `{"event":"blob"}`,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		},
+	)
+	s.Require().NoError(err)
+
+	var tasks []storage.WebhookTask
+	err = s.storage.Connection().DB().NewSelect().Model(&tasks).Order("created_at asc").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(tasks, 3)
+
+	// task enqueued by another replica is kept
+	s.Require().Equal("5b0f1c3a9e2d4f6a8b7c6d5e4f3a2b1c", tasks[1].DeliveryId)
+	s.Require().Equal(`{"event":"blob"}`, tasks[1].Payload)
+	s.Require().Equal("0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a", tasks[2].DeliveryId)
+}
+
+func (s *TransactionTestSuite) TestWebhookTaskClaim() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	now := time.Date(2023, 7, 4, 4, 0, 0, 0, time.UTC)
+	tasks, err := s.storage.WebhookTasks.Claim(ctx, now, time.Minute, 10)
+	s.Require().NoError(err)
+	s.Require().Len(tasks, 1)
+	s.Require().EqualValues(1, tasks[0].Id)
+	s.Require().True(tasks[0].NextAttemptAt.Equal(now.Add(time.Minute)))
+
+	// claimed task is leased
+	tasks, err = s.storage.WebhookTasks.Claim(ctx, now, time.Minute, 10)
+	s.Require().NoError(err)
+	s.Require().Len(tasks, 0)
+
+	tasks, err = s.storage.WebhookTasks.Claim(ctx, now.Add(2*time.Hour), time.Minute, 10)
+	s.Require().NoError(err)
+	s.Require().Len(tasks, 2)
+}
+
+func (s *TransactionTestSuite) TestWebhookTaskRetryAndDelete() {
+	ctx, ctxCancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer ctxCancel()
+
+	next := time.Date(2023, 7, 4, 5, 0, 0, 0, time.UTC)
+	s.Require().NoError(s.storage.WebhookTasks.Retry(ctx, 1, 1, next))
+
+	task, err := s.storage.WebhookTasks.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(1, task.Attempt)
+	s.Require().True(task.NextAttemptAt.Equal(next))
+
+	s.Require().NoError(s.storage.WebhookTasks.Delete(ctx, 1))
+	_, err = s.storage.WebhookTasks.GetByID(ctx, 1)
+	s.Require().Error(err)
+	s.Require().True(s.storage.WebhookTasks.IsNoRows(err))
+}
@@ -17,6 +17,8 @@ import (
 type IProposal interface {
 	ListWithFilters(ctx context.Context, filters ListProposalFilters) (proposals []Proposal, err error)
 	ById(ctx context.Context, id uint64) (Proposal, error)
+	ByIds(ctx context.Context, ids ...uint64) ([]Proposal, error)
+	Timeline(ctx context.Context, id uint64) ([]ProposalTally, error)
 }
 
 type Proposal struct {
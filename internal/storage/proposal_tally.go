@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/uptrace/bun"
+)
+
+// ProposalTally - snapshot of the proposal tally. It's recorded in blocks with votes for the proposal,
+// once an hour during voting period to reflect validator power changes and when the proposal is finished.
+type ProposalTally struct {
+	bun.BaseModel `bun:"proposal_tally" comment:"Table with snapshots of proposal tally"`
+
+	Id         uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height     pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time       time.Time      `bun:"time,pk,notnull"             comment:"The time of block"`
+	ProposalId uint64         `bun:"proposal_id,notnull"         comment:"Proposal id"`
+
+	VotesCount int64 `bun:"votes_count"  comment:"Total votes count"`
+	Yes        int64 `bun:"yes"          comment:"Count of yes votes"`
+	No         int64 `bun:"no"           comment:"Count of no votes"`
+	NoWithVeto int64 `bun:"no_with_veto" comment:"Count of no votes with veto"`
+	Abstain    int64 `bun:"abstain"      comment:"Count of abstain votes"`
+
+	VotingPower           types.Numeric `bun:"voting_power,type:numeric"              comment:"Summary voting power of all votes"`
+	YesVotingPower        types.Numeric `bun:"yes_voting_power,type:numeric"          comment:"Yes voting power"`
+	NoVotingPower         types.Numeric `bun:"no_voting_power,type:numeric"           comment:"No voting power"`
+	NoWithVetoVotingPower types.Numeric `bun:"no_with_veto_voting_power,type:numeric" comment:"No with veto voting power"`
+	AbstainVotingPower    types.Numeric `bun:"abstain_voting_power,type:numeric"      comment:"Abstain voting power"`
+	TotalVotingPower      types.Numeric `bun:"total_voting_power,type:numeric"        comment:"Total voting power in the network"`
+}
+
+// TableName -
+func (ProposalTally) TableName() string {
+	return "proposal_tally"
+}
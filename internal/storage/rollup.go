@@ -35,11 +35,15 @@ type IRollup interface {
 	sdk.Table[*Rollup]
 
 	Leaderboard(ctx context.Context, fltrs LeaderboardFilters) ([]RollupWithStats, error)
+	LeaderboardAfter(ctx context.Context, cursor uint64, limit int) ([]RollupWithStats, error)
 	LeaderboardDay(ctx context.Context, fltrs LeaderboardFilters) ([]RollupWithDayStats, error)
 	Namespaces(ctx context.Context, rollupId uint64, limit, offset int) (namespaceIds []uint64, err error)
+	NamespacesAfter(ctx context.Context, rollupId, cursor uint64, limit int) (namespaceIds []uint64, err error)
 	Providers(ctx context.Context, rollupId uint64) (providers []RollupProvider, err error)
 	RollupsByNamespace(ctx context.Context, namespaceId uint64, limit, offset int) (rollups []Rollup, err error)
+	RollupsByNamespaceAfter(ctx context.Context, namespaceId, cursor uint64, limit int) (rollups []Rollup, err error)
 	ById(ctx context.Context, rollupId uint64) (RollupWithStats, error)
+	GetByIds(ctx context.Context, ids ...uint64) ([]Rollup, error)
 	Series(ctx context.Context, rollupId uint64, timeframe Timeframe, column string, req SeriesRequest) (items []HistogramItem, err error)
 	AllSeries(ctx context.Context, timeframe Timeframe) ([]RollupHistogramItem, error)
 	Count(ctx context.Context) (int64, error)
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type RollupAuditFilters struct {
+	RollupId uint64
+	ApiKey   string
+	Limit    int
+	Offset   int
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IRollupAudit interface {
+	storage.Table[*RollupAudit]
+
+	List(ctx context.Context, fltrs RollupAuditFilters) ([]RollupAudit, error)
+}
+
+// RollupFieldChange - previous and new values of changed rollup field
+type RollupFieldChange struct {
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
+}
+
+// RollupAudit - change of rollup which was made through private API
+type RollupAudit struct {
+	bun.BaseModel `bun:"rollup_audit" comment:"Table with audit log of rollup changes"`
+
+	Id       uint64                       `bun:"id,pk,notnull,autoincrement"     comment:"Unique internal identity"`
+	Time     time.Time                    `bun:"time,notnull"                    comment:"Time of the change"`
+	RollupId uint64                       `bun:"rollup_id,notnull"               comment:"Rollup internal id"`
+	ApiKey   string                       `bun:"api_key,notnull"                 comment:"Api key which made the change"`
+	Action   types.RollupAuditAction      `bun:"action,type:rollup_audit_action" comment:"Kind of the change"`
+	Changes  map[string]RollupFieldChange `bun:"changes,type:jsonb,nullzero"     comment:"Changed fields with previous and new values"`
+}
+
+// TableName -
+func (RollupAudit) TableName() string {
+	return "rollup_audit"
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IRollupCandidate interface {
+	storage.Table[*RollupCandidate]
+
+	ByRollupIds(ctx context.Context, rollupIds ...uint64) ([]RollupCandidate, error)
+	Activity(ctx context.Context, since time.Time, minBlobs int) ([]NamespaceActivity, error)
+}
+
+// RollupCandidate - evidence collected by rollup discovery for the unverified rollup proposed by it.
+// Row is kept after the rollup is rejected, so namespaces of the candidate are not proposed again.
+type RollupCandidate struct {
+	bun.BaseModel `bun:"rollup_candidate" comment:"Table with evidences of automatically discovered rollups"`
+
+	Id           uint64    `bun:"id,pk,notnull,autoincrement"                 comment:"Unique internal identity"`
+	RollupId     uint64    `bun:"rollup_id,notnull,unique:rollup_candidate_idx" comment:"Proposed rollup internal id"`
+	NamespaceIds []uint64  `bun:"namespace_ids,array"                         comment:"Clustered namespaces"`
+	SignerIds    []uint64  `bun:"signer_ids,array"                            comment:"Signers of blobs in clustered namespaces"`
+	Stack        string    `bun:"stack"                                       comment:"Stack guessed from blob headers"`
+	BlobsCount   int64     `bun:"blobs_count"                                 comment:"Count of blobs in analyzed window"`
+	AvgSize      float64   `bun:"avg_size"                                    comment:"Average blob size"`
+	SizeStddev   float64   `bun:"size_stddev"                                 comment:"Standard deviation of blob size"`
+	Cadence      float64   `bun:"cadence"                                     comment:"Average interval between blocks with blobs in seconds"`
+	Score        float64   `bun:"score"                                       comment:"Confidence of the guess from 0 to 1"`
+	Evidence     []string  `bun:"evidence,array"                              comment:"Human-readable reasons of the proposal"`
+	CreatedAt    time.Time `bun:"created_at,notnull"                          comment:"Creation time"`
+}
+
+// TableName -
+func (RollupCandidate) TableName() string {
+	return "rollup_candidate"
+}
+
+// NamespaceActivity - blob activity profile of the namespace which is not linked to any rollup
+type NamespaceActivity struct {
+	NamespaceId uint64         `bun:"id"`
+	Version     byte           `bun:"version"`
+	NamespaceID []byte         `bun:"namespace_id"`
+	BlobsCount  int64          `bun:"blobs_count"`
+	AvgSize     float64        `bun:"avg_size"`
+	SizeStddev  float64        `bun:"size_stddev"`
+	Cadence     float64        `bun:"cadence"`
+	SignerIds   []uint64       `bun:"signer_ids,array"`
+	LastHeight  pkgTypes.Level `bun:"last_height"`
+	LastTime    time.Time      `bun:"last_time"`
+}
+
+// Namespace - returns namespace entity of the activity
+func (na NamespaceActivity) Namespace() Namespace {
+	return Namespace{
+		Id:          na.NamespaceId,
+		Version:     na.Version,
+		NamespaceID: na.NamespaceID,
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type ShareRangeFilters struct {
+	Limit     int
+	Offset    int
+	Namespace []byte
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IShareRange interface {
+	storage.Table[*ShareRange]
+
+	ByHeight(ctx context.Context, height pkgTypes.Level, fltrs ShareRangeFilters) ([]ShareRange, error)
+}
+
+// ShareRange - contiguous range of shares in the original data square of the block. Every blob has its own range.
+type ShareRange struct {
+	bun.BaseModel `bun:"share_range" comment:"Table with layout of original data squares."`
+
+	Id        uint64               `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	Height    pkgTypes.Level       `bun:"height,notnull"               comment:"The number (height) of this block"`
+	Time      time.Time            `bun:"time,pk,notnull"              comment:"The time of block"`
+	Kind      types.ShareRangeKind `bun:"kind,type:share_range_kind"   comment:"Kind of shares in the range"`
+	Namespace []byte               `bun:"namespace,type:bytea,notnull" comment:"Namespace of shares: version and namespace id"`
+	Start     int64                `bun:"start"                        comment:"Index of the first share of the range in row-major order"`
+	End       int64                `bun:"end"                          comment:"Index of the share next to the last share of the range"`
+	TxId      uint64               `bun:"tx_id"                        comment:"Pay for blob transaction id. Set for blob ranges only"`
+	BlobIndex int                  `bun:"blob_index"                   comment:"Index of the blob in pay for blob transaction. Set for blob ranges only"`
+
+	Tx *Tx `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (ShareRange) TableName() string {
+	return "share_range"
+}
+
+// Size - count of shares in the range
+func (sr ShareRange) Size() int64 {
+	return sr.End - sr.Start
+}
@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"context"
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
@@ -17,6 +18,9 @@ var _ storage.Copiable = (*StakingLog)(nil)
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IStakingLog interface {
 	storage.Table[*StakingLog]
+
+	RewardsLedger(ctx context.Context, fltrs RewardsLedgerFilters) ([]RewardsLedgerItem, error)
+	RewardsSeries(ctx context.Context, fltrs RewardsSeriesFilters) ([]RewardsSeriesItem, error)
 }
 
 // Delegation -
@@ -30,6 +34,7 @@ type StakingLog struct {
 	ValidatorId uint64               `bun:"validator_id"                comment:"Internal validator id"`
 	Change      types.Numeric        `bun:"change,type:numeric"         comment:"Change amount"`
 	Type        types.StakingLogType `bun:"type,type:staking_log_type"  comment:"Staking log type"`
+	EventId     *uint64              `bun:"event_id"                    comment:"Internal event id. It's set to rewards withdrawals restored from events by migration"`
 
 	Address   *Address   `bun:"rel:belongs-to,join:address_id=id"`
 	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
@@ -60,3 +65,47 @@ func (StakingLog) Columns() []string {
 		"time", "height", "address_id", "validator_id", "change", "type",
 	}
 }
+
+type RewardsLedgerFilters struct {
+	AddressId   *uint64
+	ValidatorId *uint64
+	From        time.Time
+	To          time.Time
+	Limit       int
+	Offset      int
+	Sort        storage.SortOrder
+}
+
+// RewardsLedgerItem - entry of the rewards ledger of the (address, validator) pair built from staking log:
+// rewards withdrawals (explicit or automatic on delegation change) and delegation changes.
+// Delegated and Withdrawn are running totals of the pair after the entry.
+type RewardsLedgerItem struct {
+	Time        time.Time            `bun:"time"`
+	Height      pkgTypes.Level       `bun:"height"`
+	AddressId   uint64               `bun:"address_id"`
+	ValidatorId uint64               `bun:"validator_id"`
+	Type        types.StakingLogType `bun:"type"`
+	Change      types.Numeric        `bun:"change"`
+	Delegated   types.Numeric        `bun:"delegated"`
+	Withdrawn   types.Numeric        `bun:"withdrawn"`
+
+	Address   *Address   `bun:"rel:belongs-to,join:address_id=id"`
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+}
+
+type RewardsSeriesFilters struct {
+	AddressId   *uint64
+	ValidatorId *uint64
+	Timeframe   Timeframe
+	SeriesRequest
+}
+
+// RewardsSeriesItem - rewards and commissions of the time bucket. Rewards are distributed to validators every block.
+// Delegator's accrued rewards are estimated by the share of the delegator in the validator's stake, commissions are always zero for delegators.
+type RewardsSeriesItem struct {
+	Bucket               time.Time     `bun:"bucket"`
+	Rewards              types.Numeric `bun:"rewards"`
+	Withdrawn            types.Numeric `bun:"withdrawn"`
+	Commissions          types.Numeric `bun:"commissions"`
+	CommissionsWithdrawn types.Numeric `bun:"commissions_withdrawn"`
+}
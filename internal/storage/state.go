@@ -25,22 +25,23 @@ type IState interface {
 type State struct {
 	bun.BaseModel `bun:"state" comment:"Current indexer state"`
 
-	Id              uint64         `bun:",pk,autoincrement"         comment:"Unique internal identity"`
-	Name            string         `bun:",unique:state_name"        comment:"Indexer name"`
-	LastHeight      pkgTypes.Level `bun:"last_height"               comment:"Last block height"`
-	LastHash        []byte         `bun:"last_hash"                 comment:"Last block hash"`
-	LastTime        time.Time      `bun:"last_time"                 comment:"Time of last block"`
-	ChainId         string         `bun:"chain_id"                  comment:"Celestia chain id"`
-	TotalTx         int64          `bun:"total_tx"                  comment:"Transactions count in celestia"`
-	TotalAccounts   int64          `bun:"total_accounts"            comment:"Accounts count in celestia"`
-	TotalNamespaces int64          `bun:"total_namespaces"          comment:"Namespaces count in celestia"`
-	TotalBlobsSize  int64          `bun:"total_blobs_size"          comment:"Total blobs size"`
-	TotalProposals  int64          `bun:"total_proposals"           comment:"Total proposals count in celestia"`
-	TotalIbcClients int64          `bun:"total_ibc_clients"         comment:"Total count of IBC clients"`
-	TotalValidators int            `bun:"total_validators"          comment:"Total validator's count"`
-	TotalSupply     types.Numeric  `bun:"total_supply,type:numeric" comment:"Total supply in celestia"`
-	TotalFee        types.Numeric  `bun:"total_fee,type:numeric"    comment:"Total paid fee"`
-	Version         uint64         `bun:"version"                   comment:"Version"`
+	Id                   uint64         `bun:",pk,autoincrement"                comment:"Unique internal identity"`
+	Name                 string         `bun:",unique:state_name"               comment:"Indexer name"`
+	LastHeight           pkgTypes.Level `bun:"last_height"                      comment:"Last block height"`
+	LastHash             []byte         `bun:"last_hash"                        comment:"Last block hash"`
+	LastTime             time.Time      `bun:"last_time"                        comment:"Time of last block"`
+	ChainId              string         `bun:"chain_id"                         comment:"Celestia chain id"`
+	TotalTx              int64          `bun:"total_tx"                         comment:"Transactions count in celestia"`
+	TotalAccounts        int64          `bun:"total_accounts"                   comment:"Accounts count in celestia"`
+	TotalNamespaces      int64          `bun:"total_namespaces"                 comment:"Namespaces count in celestia"`
+	TotalBlobsSize       int64          `bun:"total_blobs_size"                 comment:"Total blobs size"`
+	TotalProposals       int64          `bun:"total_proposals"                  comment:"Total proposals count in celestia"`
+	TotalIbcClients      int64          `bun:"total_ibc_clients"                comment:"Total count of IBC clients"`
+	TotalValidators      int            `bun:"total_validators"                 comment:"Total validator's count"`
+	TotalSupply          types.Numeric  `bun:"total_supply,type:numeric"        comment:"Total supply in celestia"`
+	TotalFee             types.Numeric  `bun:"total_fee,type:numeric"           comment:"Total paid fee"`
+	Version              uint64         `bun:"version"                          comment:"Version"`
+	BalanceHistoryHeight pkgTypes.Level `bun:"balance_history_height,default:0" comment:"Height since which history of balances is complete"`
 
 	TotalVotingPower types.Numeric `bun:"-"`
 }
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type ISupply interface {
+	storage.Table[*Supply]
+
+	Last(ctx context.Context) (Supply, error)
+	Series(ctx context.Context, timeframe Timeframe, req SeriesRequest) ([]Supply, error)
+}
+
+// Supply - supply breakdown at the end of the block. Staked and unbonding amounts are computed from indexed validators and undelegations.
+// Community pool is received from the node asynchronously and set to breakdowns since the requested height. Amount locked in vesting
+// accounts is recomputed once an hour. Circulating supply is computed by CirculatingSupply.
+type Supply struct {
+	bun.BaseModel `bun:"supply" comment:"Table with supply breakdown per block"`
+
+	Id     uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time   time.Time      `bun:"time,pk,notnull"             comment:"The time of block"`
+
+	Total         types.Numeric `bun:"total,type:numeric"          comment:"Total supply"`
+	Circulating   types.Numeric `bun:"circulating,type:numeric"    comment:"Circulating supply"`
+	Staked        types.Numeric `bun:"staked,type:numeric"         comment:"Bonded tokens"`
+	Unbonding     types.Numeric `bun:"unbonding,type:numeric"      comment:"Unbonding delegations which are not completed"`
+	VestingLocked types.Numeric `bun:"vesting_locked,type:numeric" comment:"Tokens locked in vesting accounts"`
+	CommunityPool types.Numeric `bun:"community_pool,type:numeric" comment:"Community pool"`
+}
+
+// TableName -
+func (Supply) TableName() string {
+	return "supply"
+}
+
+// CirculatingSupply - returns the total supply without tokens locked in vesting accounts. The community pool is not subtracted:
+// it isn't known for projected supply, so the same definition is used for indexed breakdowns and forecasts.
+func CirculatingSupply(total, locked types.Numeric) types.Numeric {
+	circulating := total.Sub(locked)
+	if circulating.IsNegative() {
+		return types.NumericZero()
+	}
+	return circulating
+}
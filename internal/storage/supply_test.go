@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCirculatingSupply(t *testing.T) {
+	tests := []struct {
+		name   string
+		total  int64
+		locked int64
+		want   string
+	}{
+		{name: "without locked", total: 1000, locked: 0, want: "1000"},
+		{name: "locked", total: 1000, locked: 300, want: "700"},
+		{name: "locked exceeds total", total: 1000, locked: 1300, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CirculatingSupply(types.NumericFromInt64(tt.total), types.NumericFromInt64(tt.locked))
+			require.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -22,6 +22,7 @@ type ITx interface {
 	IdAndTimeByHash(ctx context.Context, hash []byte) (uint64, time.Time, error)
 	Filter(ctx context.Context, fltrs TxFilter) ([]Tx, error)
 	ByIdWithRelations(ctx context.Context, id uint64) (Tx, error)
+	GetByIds(ctx context.Context, ids ...uint64) ([]Tx, error)
 	ByAddress(ctx context.Context, addressId uint64, fltrs TxFilter) ([]Tx, error)
 	Genesis(ctx context.Context, limit, offset int, sortOrder storage.SortOrder) ([]Tx, error)
 	Gas(ctx context.Context, height pkgTypes.Level, ts time.Time) ([]Gas, error)
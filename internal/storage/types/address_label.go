@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum AddressLabelCategory
+/*
+	ENUM(
+		exchange,
+		bridge,
+		validator,
+		rollup_sequencer,
+		team
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type AddressLabelCategory string
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum AlertRuleKind
+/*
+	ENUM(
+		no_blob,
+		hourly_fee,
+		hourly_size,
+		blob_size_p99
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type AlertRuleKind string
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ApiKeyTier
+/*
+	ENUM(
+		free,
+		basic,
+		pro,
+		enterprise
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type ApiKeyTier string
+
+// swagger:enum ApiKeyScope
+/*
+	ENUM(
+		standard,
+		rollup_auth,
+		read_only,
+		admin
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type ApiKeyScope string
@@ -22,3 +22,13 @@ type HLTokenType string
 */
 //go:generate go-enum --marshal --sql --values --names
 type HLTransferType string
+
+// swagger:enum HLDeliveryStatus
+/*
+	ENUM(
+		pending,
+		delivered
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type HLDeliveryStatus string
@@ -249,3 +249,120 @@ func (x *HLTransferType) Scan(value interface{}) (err error) {
 func (x HLTransferType) Value() (driver.Value, error) {
 	return x.String(), nil
 }
+
+const (
+	// HLDeliveryStatusPending is a HLDeliveryStatus of type pending.
+	HLDeliveryStatusPending HLDeliveryStatus = "pending"
+	// HLDeliveryStatusDelivered is a HLDeliveryStatus of type delivered.
+	HLDeliveryStatusDelivered HLDeliveryStatus = "delivered"
+)
+
+var ErrInvalidHLDeliveryStatus = fmt.Errorf("not a valid HLDeliveryStatus, try [%s]", strings.Join(_HLDeliveryStatusNames, ", "))
+
+var _HLDeliveryStatusNames = []string{
+	string(HLDeliveryStatusPending),
+	string(HLDeliveryStatusDelivered),
+}
+
+// HLDeliveryStatusNames returns a list of possible string values of HLDeliveryStatus.
+func HLDeliveryStatusNames() []string {
+	tmp := make([]string, len(_HLDeliveryStatusNames))
+	copy(tmp, _HLDeliveryStatusNames)
+	return tmp
+}
+
+// HLDeliveryStatusValues returns a list of the values for HLDeliveryStatus
+func HLDeliveryStatusValues() []HLDeliveryStatus {
+	return []HLDeliveryStatus{
+		HLDeliveryStatusPending,
+		HLDeliveryStatusDelivered,
+	}
+}
+
+// String implements the Stringer interface.
+func (x HLDeliveryStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x HLDeliveryStatus) IsValid() bool {
+	_, err := ParseHLDeliveryStatus(string(x))
+	return err == nil
+}
+
+var _HLDeliveryStatusValue = map[string]HLDeliveryStatus{
+	"pending":   HLDeliveryStatusPending,
+	"delivered": HLDeliveryStatusDelivered,
+}
+
+// ParseHLDeliveryStatus attempts to convert a string to a HLDeliveryStatus.
+func ParseHLDeliveryStatus(name string) (HLDeliveryStatus, error) {
+	if x, ok := _HLDeliveryStatusValue[name]; ok {
+		return x, nil
+	}
+	return HLDeliveryStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidHLDeliveryStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x HLDeliveryStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *HLDeliveryStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseHLDeliveryStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *HLDeliveryStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errHLDeliveryStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *HLDeliveryStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = HLDeliveryStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseHLDeliveryStatus(v)
+	case []byte:
+		*x, err = ParseHLDeliveryStatus(string(v))
+	case HLDeliveryStatus:
+		*x = v
+	case *HLDeliveryStatus:
+		if v == nil {
+			return errHLDeliveryStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errHLDeliveryStatusNilPtr
+		}
+		*x, err = ParseHLDeliveryStatus(*v)
+	default:
+		return errors.New("invalid type for HLDeliveryStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x HLDeliveryStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -13,3 +13,17 @@ package types
 */
 //go:generate go-enum --marshal --sql --values --names
 type IbcChannelStatus string
+
+// swagger:enum IbcTransferStatus
+/*
+	ENUM(
+		pending,
+		received,
+		failed,
+		acknowledged,
+		refunded,
+		timed_out
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type IbcTransferStatus string
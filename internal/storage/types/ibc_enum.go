@@ -137,3 +137,140 @@ func (x *IbcChannelStatus) Scan(value interface{}) (err error) {
 func (x IbcChannelStatus) Value() (driver.Value, error) {
 	return x.String(), nil
 }
+
+const (
+	// IbcTransferStatusPending is a IbcTransferStatus of type pending.
+	IbcTransferStatusPending IbcTransferStatus = "pending"
+	// IbcTransferStatusReceived is a IbcTransferStatus of type received.
+	IbcTransferStatusReceived IbcTransferStatus = "received"
+	// IbcTransferStatusFailed is a IbcTransferStatus of type failed.
+	IbcTransferStatusFailed IbcTransferStatus = "failed"
+	// IbcTransferStatusAcknowledged is a IbcTransferStatus of type acknowledged.
+	IbcTransferStatusAcknowledged IbcTransferStatus = "acknowledged"
+	// IbcTransferStatusRefunded is a IbcTransferStatus of type refunded.
+	IbcTransferStatusRefunded IbcTransferStatus = "refunded"
+	// IbcTransferStatusTimedOut is a IbcTransferStatus of type timed_out.
+	IbcTransferStatusTimedOut IbcTransferStatus = "timed_out"
+)
+
+var ErrInvalidIbcTransferStatus = fmt.Errorf("not a valid IbcTransferStatus, try [%s]", strings.Join(_IbcTransferStatusNames, ", "))
+
+var _IbcTransferStatusNames = []string{
+	string(IbcTransferStatusPending),
+	string(IbcTransferStatusReceived),
+	string(IbcTransferStatusFailed),
+	string(IbcTransferStatusAcknowledged),
+	string(IbcTransferStatusRefunded),
+	string(IbcTransferStatusTimedOut),
+}
+
+// IbcTransferStatusNames returns a list of possible string values of IbcTransferStatus.
+func IbcTransferStatusNames() []string {
+	tmp := make([]string, len(_IbcTransferStatusNames))
+	copy(tmp, _IbcTransferStatusNames)
+	return tmp
+}
+
+// IbcTransferStatusValues returns a list of the values for IbcTransferStatus
+func IbcTransferStatusValues() []IbcTransferStatus {
+	return []IbcTransferStatus{
+		IbcTransferStatusPending,
+		IbcTransferStatusReceived,
+		IbcTransferStatusFailed,
+		IbcTransferStatusAcknowledged,
+		IbcTransferStatusRefunded,
+		IbcTransferStatusTimedOut,
+	}
+}
+
+// String implements the Stringer interface.
+func (x IbcTransferStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x IbcTransferStatus) IsValid() bool {
+	_, err := ParseIbcTransferStatus(string(x))
+	return err == nil
+}
+
+var _IbcTransferStatusValue = map[string]IbcTransferStatus{
+	"pending":      IbcTransferStatusPending,
+	"received":     IbcTransferStatusReceived,
+	"failed":       IbcTransferStatusFailed,
+	"acknowledged": IbcTransferStatusAcknowledged,
+	"refunded":     IbcTransferStatusRefunded,
+	"timed_out":    IbcTransferStatusTimedOut,
+}
+
+// ParseIbcTransferStatus attempts to convert a string to a IbcTransferStatus.
+func ParseIbcTransferStatus(name string) (IbcTransferStatus, error) {
+	if x, ok := _IbcTransferStatusValue[name]; ok {
+		return x, nil
+	}
+	return IbcTransferStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidIbcTransferStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x IbcTransferStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *IbcTransferStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseIbcTransferStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *IbcTransferStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errIbcTransferStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *IbcTransferStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = IbcTransferStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseIbcTransferStatus(v)
+	case []byte:
+		*x, err = ParseIbcTransferStatus(string(v))
+	case IbcTransferStatus:
+		*x = v
+	case *IbcTransferStatus:
+		if v == nil {
+			return errIbcTransferStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errIbcTransferStatusNilPtr
+		}
+		*x, err = ParseIbcTransferStatus(*v)
+	default:
+		return errors.New("invalid type for IbcTransferStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x IbcTransferStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
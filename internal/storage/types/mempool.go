@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum MempoolTxStatus
+/*
+	ENUM(
+		pending,
+		included,
+		dropped
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type MempoolTxStatus string
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum RollupAuditAction
+/*
+	ENUM(
+		create,
+		update,
+		delete,
+		verify
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type RollupAuditAction string
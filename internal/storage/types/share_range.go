@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ShareRangeKind
+/*
+	ENUM(
+		tx,
+		pay_for_blob,
+		primary_reserved_padding,
+		blob,
+		namespace_padding,
+		tail_padding
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type ShareRangeKind string
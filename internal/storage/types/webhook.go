@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum WebhookEvent
+/*
+	ENUM(
+		blob,
+		transfer,
+		jail,
+		proposal_voting
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type WebhookEvent string
@@ -39,12 +39,15 @@ type IValidator interface {
 	storage.Table[*Validator]
 
 	ByAddress(ctx context.Context, address string) (Validator, error)
+	GetByIds(ctx context.Context, ids ...uint64) ([]Validator, error)
 	TotalVotingPower(ctx context.Context, maxVals int) (types.Numeric, error)
 	ListByPower(ctx context.Context, fltrs ValidatorFilters) ([]Validator, error)
+	ListByPowerAfter(ctx context.Context, cursor uint64, limit int) ([]Validator, error)
 	JailedCount(ctx context.Context) (int, error)
 	Messages(ctx context.Context, id uint64, fltrs ValidatorMessagesFilters) ([]MsgValidator, error)
 	Metrics(ctx context.Context, id uint64) (ValidatorMetrics, error)
 	TopNMetrics(ctx context.Context, n int) (ValidatorMetrics, error)
+	History(ctx context.Context, id uint64, fltrs ValidatorHistoryFilters) ([]ValidatorHistoryItem, error)
 }
 
 type Validator struct {
@@ -65,6 +68,7 @@ type Validator struct {
 	MaxRate           types.Numeric `bun:"max_rate,type:numeric"            comment:"Maximum commission rate which validator can ever charge, as a fraction" json:"-"`
 	MaxChangeRate     types.Numeric `bun:"max_change_rate,type:numeric"     comment:"Maximum daily increase of the validator commission, as a fraction"      json:"-"`
 	MinSelfDelegation types.Numeric `bun:"min_self_delegation,type:numeric" comment:""                                                                       json:"-"`
+	RateUnknown       bool          `bun:"rate_unknown,scanonly"            comment:"True if commission rate at the requested height is unknown"             json:"-"`
 
 	Stake       types.Numeric  `bun:"stake,type:numeric"       comment:"Validator's stake"                 json:"-"`
 	Rewards     types.Numeric  `bun:"rewards,type:numeric"     comment:"Validator's rewards"               json:"-"`
@@ -111,6 +115,8 @@ type ValidatorFilters struct {
 	Offset  int
 	Jailed  *bool
 	Version *int
+	// Height - if set, stake and commission rate are reconstructed at the height. Jailed and Version filters are not applied.
+	Height pkgTypes.Level
 }
 
 type ValidatorMessagesFilters struct {
@@ -120,3 +126,22 @@ type ValidatorMessagesFilters struct {
 	From   *time.Time
 	To     *time.Time
 }
+
+type ValidatorHistoryFilters struct {
+	Limit  int
+	Offset int
+	Sort   storage.SortOrder
+}
+
+// ValidatorHistoryItem - validator's stake and commission rate after the block which changed them
+type ValidatorHistoryItem struct {
+	Height      pkgTypes.Level `bun:"height"`
+	Time        time.Time      `bun:"time"`
+	Stake       types.Numeric  `bun:"stake"`
+	Rate        types.Numeric  `bun:"rate"`
+	RateUnknown bool           `bun:"rate_unknown"`
+}
+
+func (item ValidatorHistoryItem) VotingPower() types.Numeric {
+	return math.SharesNumeric(item.Stake)
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/uptrace/bun"
+)
+
+// ValidatorRate - commission rate of the validator which was set by create or edit message
+type ValidatorRate struct {
+	bun.BaseModel `bun:"validator_rate" comment:"Table with history of validators commission rates."`
+
+	Id          uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height      pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time        time.Time      `bun:"time,pk,notnull"             comment:"The time of block"`
+	ValidatorId uint64         `bun:"validator_id,notnull"        comment:"Internal validator id"`
+	Rate        types.Numeric  `bun:"rate,type:numeric"           comment:"Commission rate charged to delegators, as a fraction"`
+}
+
+// TableName -
+func (ValidatorRate) TableName() string {
+	return "validator_rate"
+}
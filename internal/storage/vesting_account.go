@@ -18,6 +18,8 @@ type IVestingAccount interface {
 	storage.Table[*VestingAccount]
 
 	ByAddress(ctx context.Context, addressId uint64, limit, offset int, showEnded bool) ([]VestingAccount, error)
+	UnlockSeries(ctx context.Context, timeframe Timeframe, req SeriesRequest) ([]VestingUnlockItem, error)
+	Locked(ctx context.Context, t time.Time) (types.Numeric, error)
 }
 
 type VestingAccount struct {
@@ -42,3 +44,9 @@ type VestingAccount struct {
 func (VestingAccount) TableName() string {
 	return "vesting_account"
 }
+
+// VestingUnlockItem - amount of tokens unlocked by all vesting accounts during the time bucket
+type VestingUnlockItem struct {
+	Bucket time.Time     `bun:"bucket"`
+	Amount types.Numeric `bun:"amount"`
+}
@@ -20,6 +20,9 @@ type IVote interface {
 	ByProposalId(ctx context.Context, proposalId uint64, fltrs VoteFilters) ([]Vote, error)
 	ByVoterId(ctx context.Context, voterId uint64, fltrs VoteFilters) ([]Vote, error)
 	ByValidatorId(ctx context.Context, validatorId uint64, fltrs VoteFilters) ([]Vote, error)
+	EffectiveVotes(ctx context.Context, proposalId uint64, fltrs EffectiveVoteFilters) ([]EffectiveVote, error)
+	ComputeEffectiveVotes(ctx context.Context, proposalId uint64, fltrs EffectiveVoteFilters) ([]EffectiveVote, error)
+	EffectiveVotesByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]EffectiveVote, error)
 }
 
 type Vote struct {
@@ -68,6 +71,9 @@ type VotesCount struct {
 	NoAddress         int64
 	NoWithVetoAddress int64
 	AbstainAddress    int64
+
+	// Removed - previous votes of the voters which were replaced by the new ones
+	Removed []Vote
 }
 
 func (vc *VotesCount) Update(count int64, vote Vote) {
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IWebhook interface {
+	storage.Table[*Webhook]
+
+	ByApiKey(ctx context.Context, key string, limit, offset int) ([]Webhook, error)
+	Active(ctx context.Context) ([]Webhook, error)
+	Delete(ctx context.Context, id uint64) error
+}
+
+// Webhook - HTTP callback registered by API key holder
+type Webhook struct {
+	bun.BaseModel `bun:"webhook" comment:"Table with registered webhooks"`
+
+	Id          uint64             `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	ApiKey      string             `bun:"api_key,notnull"              comment:"Owner api key"`
+	Url         string             `bun:"url,notnull"                  comment:"Callback url"`
+	Secret      string             `bun:"secret,notnull"               comment:"Secret for payload signing"`
+	Event       types.WebhookEvent `bun:"event,type:webhook_event"     comment:"Event type"`
+	NamespaceId uint64             `bun:"namespace_id"                 comment:"Namespace internal id filter. Used by blob event"`
+	Address     string             `bun:"address"                      comment:"Address filter. Used by transfer event"`
+	ValidatorId uint64             `bun:"validator_id"                 comment:"Validator internal id filter. Used by jail event"`
+	Active      bool               `bun:"active"                       comment:"Is webhook active"`
+	CreatedAt   time.Time          `bun:"created_at,notnull"           comment:"Creation time"`
+	UpdatedAt   time.Time          `bun:"updated_at,notnull"           comment:"Time of last update"`
+}
+
+// TableName -
+func (Webhook) TableName() string {
+	return "webhook"
+}
+
+type IWebhookDelivery interface {
+	storage.Table[*WebhookDelivery]
+
+	ByWebhookId(ctx context.Context, webhookId uint64, limit, offset int) ([]WebhookDelivery, error)
+}
+
+// WebhookDelivery - attempt of webhook delivery
+type WebhookDelivery struct {
+	bun.BaseModel `bun:"webhook_delivery" comment:"Table with webhook delivery attempts"`
+
+	Id         uint64             `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	WebhookId  uint64             `bun:"webhook_id,notnull"          comment:"Webhook internal identity"`
+	DeliveryId string             `bun:"delivery_id"                 comment:"Delivery identity. It's the same for all attempts of the delivery"`
+	Time       time.Time          `bun:"time,notnull"                comment:"Time of delivery attempt"`
+	Event      types.WebhookEvent `bun:"event,type:webhook_event"    comment:"Event type"`
+	Attempt    int                `bun:"attempt"                     comment:"Attempt number starting from 1"`
+	StatusCode int                `bun:"status_code"                 comment:"HTTP status code of response"`
+	Success    bool               `bun:"success"                     comment:"Is delivery successful"`
+	Error      string             `bun:"error"                       comment:"Error message"`
+	Payload    string             `bun:"payload,type:text"           comment:"Sent payload"`
+}
+
+// TableName -
+func (WebhookDelivery) TableName() string {
+	return "webhook_delivery"
+}
+
+type IWebhookTask interface {
+	storage.Table[*WebhookTask]
+
+	Enqueue(ctx context.Context, tasks ...WebhookTask) error
+	Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]WebhookTask, error)
+	Retry(ctx context.Context, id uint64, attempt int, nextAttemptAt time.Time) error
+	Delete(ctx context.Context, id uint64) error
+}
+
+// WebhookTask - pending webhook delivery. Every API replica enqueues received events, so tasks are deduplicated by delivery identity.
+// Task is removed after successful delivery or the last attempt.
+type WebhookTask struct {
+	bun.BaseModel `bun:"webhook_task" comment:"Table with pending webhook deliveries"`
+
+	Id            uint64             `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	DeliveryId    string             `bun:"delivery_id,notnull"         comment:"Unique delivery identity derived from webhook and event"`
+	WebhookId     uint64             `bun:"webhook_id,notnull"          comment:"Webhook internal identity"`
+	Event         types.WebhookEvent `bun:"event,type:webhook_event"    comment:"Event type"`
+	Payload       string             `bun:"payload,type:text"           comment:"Payload to send"`
+	Attempt       int                `bun:"attempt"                     comment:"Count of made attempts"`
+	NextAttemptAt time.Time          `bun:"next_attempt_at,notnull"     comment:"Time when the next attempt is allowed"`
+	CreatedAt     time.Time          `bun:"created_at,notnull"          comment:"Time of enqueueing"`
+}
+
+// TableName -
+func (WebhookTask) TableName() string {
+	return "webhook_task"
+}
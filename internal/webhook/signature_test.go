@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event":"blob"}`)
+	signature := Sign("secret", "1700000000", body)
+	require.Equal(t, "sha256=", signature[:7])
+	require.True(t, Verify("secret", "1700000000", body, signature))
+	require.False(t, Verify("other", "1700000000", body, signature))
+	require.False(t, Verify("secret", "1700000001", body, signature))
+	require.False(t, Verify("secret", "1700000000", []byte(`{}`), signature))
+}
+
+func TestNewSecret(t *testing.T) {
+	first, err := NewSecret()
+	require.NoError(t, err)
+	require.Len(t, first, 64)
+
+	second, err := NewSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	maxRedirects  = 3
+	dialerTimeout = 5 * time.Second
+)
+
+var (
+	ErrInvalidScheme    = errors.New("webhook url must use http or https scheme")
+	ErrEmptyHost        = errors.New("webhook url must contain host")
+	ErrForbiddenAddress = errors.New("webhook url points to private, loopback or link-local address")
+	errTooManyRedirects = errors.New("too many redirects")
+)
+
+// sharedAddressSpace - carrier-grade NAT range (RFC 6598) which is not covered by netip.Addr.IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// ValidateUrl - checks that webhook url uses http(s) scheme and its host is not an internal address.
+// Host names are resolved only at delivery time, see NewClient.
+func ValidateUrl(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrInvalidScheme
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return ErrEmptyHost
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return ErrForbiddenAddress
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// IsPublicAddr - returns false for loopback, private, link-local, multicast, unspecified and shared (CGNAT) addresses
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	switch {
+	case !addr.IsValid(),
+		addr.IsLoopback(),
+		addr.IsPrivate(),
+		addr.IsUnspecified(),
+		addr.IsLinkLocalUnicast(),
+		addr.IsLinkLocalMulticast(),
+		addr.IsInterfaceLocalMulticast(),
+		addr.IsMulticast(),
+		sharedAddressSpace.Contains(addr):
+		return false
+	default:
+		return true
+	}
+}
+
+// NewClient - returns http client for webhook delivery. Every connection is checked after DNS resolution,
+// so host names and redirects pointing to internal addresses are rejected too. Proxy from environment is not used.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: dialerTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !IsPublicAddr(addr) {
+				return errors.Wrap(ErrForbiddenAddress, host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   dialerTimeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConnsPerHost:   2,
+			IdleConnTimeout:       90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errTooManyRedirects
+			}
+			return ValidateUrl(req.URL.String())
+		},
+	}
+}
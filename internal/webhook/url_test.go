@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUrl(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr error
+	}{
+		{url: "https://example.com/hook"},
+		{url: "http://8.8.8.8:8080/hook"},
+		{url: "https://[2001:4860:4860::8888]/hook"},
+		{url: "ftp://example.com/hook", wantErr: ErrInvalidScheme},
+		{url: "file:///etc/passwd", wantErr: ErrInvalidScheme},
+		{url: "gopher://example.com", wantErr: ErrInvalidScheme},
+		{url: "http:///hook", wantErr: ErrEmptyHost},
+		{url: "http://localhost:8080/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://api.localhost/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://127.0.0.1/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://10.0.0.5/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://192.168.1.1/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://172.16.0.1/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://169.254.169.254/latest/meta-data", wantErr: ErrForbiddenAddress},
+		{url: "http://100.64.0.1/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://0.0.0.0/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://[::1]/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://[fe80::1]/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://[fd00::1]/hook", wantErr: ErrForbiddenAddress},
+		{url: "http://[::ffff:127.0.0.1]/hook", wantErr: ErrForbiddenAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := ValidateUrl(tt.url)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClientRejectsInternalAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = NewClient(time.Second).Do(req) //nolint:bodyclose
+	require.ErrorIs(t, err, ErrForbiddenAddress)
+}
@@ -24,6 +24,27 @@ type Indexer struct {
 	RequestBulkSize  int    `validate:"omitempty,min=1" yaml:"request_bulk_size"`
 	FetchConcurrency int    `validate:"omitempty,min=1" yaml:"fetch_concurrency"`
 	DisableGzip      bool   `yaml:"disable_gzip"`
+	// Archive - directory with recorded blocks. If it's set blocks are read from archive instead of node RPC.
+	Archive           string            `validate:"omitempty,dir" yaml:"archive"`
+	Mempool           Mempool           `validate:"omitempty"     yaml:"mempool"`
+	HyperlaneDelivery HyperlaneDelivery `validate:"omitempty"     yaml:"hyperlane_delivery"`
+}
+
+// Mempool - settings of mempool watcher. Periods are in seconds.
+type Mempool struct {
+	Enabled     bool  `yaml:"enabled"`
+	Interval    int64 `validate:"omitempty,min=1" yaml:"interval"`
+	Limit       int   `validate:"omitempty,min=1" yaml:"limit"`
+	DropTimeout int64 `validate:"omitempty,min=1" yaml:"drop_timeout"`
+}
+
+// HyperlaneDelivery - settings of hyperlane message delivery tracker. Periods are in seconds.
+type HyperlaneDelivery struct {
+	Enabled     bool   `yaml:"enabled"`
+	ExplorerUrl string `validate:"omitempty,url"   yaml:"explorer_url"`
+	Interval    int64  `validate:"omitempty,min=1" yaml:"interval"`
+	Window      int64  `validate:"omitempty,min=1" yaml:"window"`
+	MaxAttempts int    `validate:"omitempty,min=1" yaml:"max_attempts"`
 }
 
 // Substitute -
@@ -53,6 +53,7 @@ type Context struct {
 	IbcTransfers    []*storage.IbcTransfer
 	BlobLogs        []*storage.BlobLog
 	Signals         []*storage.SignalVersion
+	ShareRanges     []storage.ShareRange
 
 	Block         *storage.Block
 	TryUpgrade    *storage.Upgrade
@@ -420,6 +420,20 @@ func NewRecvPacket(m map[string]string) (rp RecvPacket, err error) {
 	return
 }
 
+// SendPacket - send_packet event has the same attributes as recv_packet
+type SendPacket = RecvPacket
+
+func NewSendPacket(m map[string]string) (SendPacket, error) {
+	return NewRecvPacket(m)
+}
+
+// TimeoutPacket - timeout_packet event has the same attributes as acknowledge_packet
+type TimeoutPacket = AcknowledgementPacket
+
+func NewTimeoutPacket(m map[string]string) (TimeoutPacket, error) {
+	return NewAcknowledgementPacket(m)
+}
+
 func parseUnquoteOptional(s string) (string, error) {
 	if strings.HasPrefix(s, "\"") && strings.HasSuffix(s, "\"") {
 		return strconv.Unquote(s)
@@ -154,6 +154,7 @@ func MsgRecvPacket(ctx *context.Context, status storageTypes.Status, codec codec
 			Height:    ctx.Block.Height,
 			Time:      ctx.Block.Time,
 			TxId:      txId,
+			Status:    storageTypes.IbcTransferStatusReceived,
 		}
 
 		partsDenom := strings.Split(packet.Denom, "/")
@@ -231,21 +232,52 @@ func MsgRecvPacket(ctx *context.Context, status storageTypes.Status, codec codec
 }
 
 // MsgTimeout receives a timed-out packet
-func MsgTimeout(ctx *context.Context, msgId uint64, m *coreChannel.MsgTimeout) (storageTypes.MsgType, error) {
+func MsgTimeout(ctx *context.Context, status storageTypes.Status, data storageTypes.PackedBytes, txId, msgId uint64, m *coreChannel.MsgTimeout) (storageTypes.MsgType, error) {
 	msgType := storageTypes.MsgTimeout
 	err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeSigner, address: m.Signer},
 	}, ctx.Block.Height, msgId)
-	return msgType, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, err
+	}
+	return msgType, timeoutPacket(ctx, data, txId, msgId, m.Packet)
 }
 
 // MsgTimeoutOnClose timed-out packet upon counterparty channel closure
-func MsgTimeoutOnClose(ctx *context.Context, msgId uint64, m *coreChannel.MsgTimeoutOnClose) (storageTypes.MsgType, error) {
+func MsgTimeoutOnClose(ctx *context.Context, status storageTypes.Status, data storageTypes.PackedBytes, txId, msgId uint64, m *coreChannel.MsgTimeoutOnClose) (storageTypes.MsgType, error) {
 	msgType := storageTypes.MsgTimeoutOnClose
 	err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeSigner, address: m.Signer},
 	}, ctx.Block.Height, msgId)
-	return msgType, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, err
+	}
+	return msgType, timeoutPacket(ctx, data, txId, msgId, m.Packet)
+}
+
+// timeoutPacket - tokens of timed-out transfer are refunded to the sender, so channel stats are not changed
+func timeoutPacket(ctx *context.Context, data storageTypes.PackedBytes, txId, msgId uint64, packet coreChannel.Packet) error {
+	if packet.SourcePort != "transfer" {
+		return nil
+	}
+
+	packetMap, ok := data["Packet"].(map[string]any)
+	if !ok {
+		return errors.Errorf("Packet is not map: %T", data["Packet"])
+	}
+
+	var packetData transferTypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.Data, &packetData); err != nil {
+		return errors.Wrap(err, "FungibleTokenPacketData")
+	}
+	packetMap["Data"] = packetData
+
+	transfer, err := resolvedIbcTransfer(ctx, txId, msgId, packet, packetData, storageTypes.IbcTransferStatusTimedOut)
+	if err != nil || transfer == nil {
+		return err
+	}
+	ctx.AddIbcTransfer(transfer)
+	return nil
 }
 
 // MsgAcknowledgement receives incoming IBC acknowledgement
@@ -303,33 +335,9 @@ func MsgAcknowledgement(ctx *context.Context, status storageTypes.Status, codec
 		}
 		packetMap["Data"] = packet
 
-		amount, err := storageTypes.NumericFromString(packet.Amount)
-		if err != nil {
-			return msgType, errors.Wrap(err, "parse transfer amount")
-		}
-		transfer := &storage.IbcTransfer{
-			Amount:    amount,
-			Memo:      packet.Memo,
-			ChannelId: m.Packet.SourceChannel,
-			Port:      m.Packet.SourcePort,
-			Sequence:  m.Packet.Sequence,
-			Denom:     packet.Denom,
-			Height:    ctx.Block.Height,
-			Time:      ctx.Block.Time,
-			TxId:      txId,
-		}
-
-		partsDenom := strings.Split(packet.Denom, "/")
-		if len(partsDenom) == 3 {
-			transfer.Denom = partsDenom[2]
-		}
-
-		if m.Packet.TimeoutHeight.RevisionHeight > 0 {
-			transfer.HeightTimeout = m.Packet.TimeoutHeight.RevisionHeight
-		}
-		if m.Packet.TimeoutTimestamp > 0 {
-			ts := math.TimeFromNano(m.Packet.TimeoutTimestamp)
-			transfer.Timeout = &ts
+		transfer, err := resolvedIbcTransfer(ctx, txId, msgId, m.Packet, packet, storageTypes.IbcTransferStatusAcknowledged)
+		if err != nil || transfer == nil {
+			return msgType, err
 		}
 
 		channel := &storage.IbcChannel{
@@ -337,53 +345,11 @@ func MsgAcknowledgement(ctx *context.Context, status storageTypes.Status, codec
 			TransfersCount: 1,
 			Status:         storageTypes.IbcChannelStatusInitialization,
 		}
-		prefix, hash, err := pkgTypes.Address(packet.Receiver).Decode()
-		if err != nil {
-			return msgType, nil
-		}
-		if prefix == pkgTypes.AddressPrefixCelestia {
-			transfer.Receiver = &storage.Address{
-				Address:    packet.Receiver,
-				Balances:   []storage.Balance{storage.EmptyBalance()},
-				Height:     ctx.Block.Height,
-				LastHeight: ctx.Block.Height,
-				Hash:       hash,
-			}
-			if err := ctx.AddAddress(transfer.Receiver); err != nil {
-				return msgType, errors.Wrap(err, "AddAddress receiver")
-			}
-			ctx.AddAddressMessage(&storage.MsgAddress{
-				MsgId:   msgId,
-				Type:    storageTypes.MsgAddressTypeReceiver,
-				Address: transfer.Receiver,
-			})
+		if transfer.Receiver != nil {
 			channel.Received = channel.Received.Add(transfer.Amount)
-		} else {
-			transfer.ReceiverAddress = &packet.Receiver
 		}
-		prefix, hash, err = pkgTypes.Address(packet.Sender).Decode()
-		if err != nil {
-			return msgType, nil
-		}
-		if prefix == pkgTypes.AddressPrefixCelestia {
-			transfer.Sender = &storage.Address{
-				Address:    packet.Sender,
-				Balances:   []storage.Balance{storage.EmptyBalance()},
-				Height:     ctx.Block.Height,
-				LastHeight: ctx.Block.Height,
-				Hash:       hash,
-			}
-			if err := ctx.AddAddress(transfer.Sender); err != nil {
-				return msgType, errors.Wrap(err, "AddAddress sender")
-			}
-			ctx.AddAddressMessage(&storage.MsgAddress{
-				MsgId:   msgId,
-				Type:    storageTypes.MsgAddressTypeSender,
-				Address: transfer.Sender,
-			})
+		if transfer.Sender != nil {
 			channel.Sent = channel.Sent.Add(transfer.Amount)
-		} else {
-			transfer.SenderAddress = &packet.Sender
 		}
 
 		ctx.AddIbcChannel(channel)
@@ -394,6 +360,90 @@ func MsgAcknowledgement(ctx *context.Context, status storageTypes.Status, codec
 	}
 }
 
+// resolvedIbcTransfer - creates transfer sent from Celestia which packet was acknowledged or timed out in the transaction.
+// It returns nil if the sender or receiver address can't be decoded.
+func resolvedIbcTransfer(ctx *context.Context, txId, msgId uint64, packet coreChannel.Packet, data transferTypes.FungibleTokenPacketData, status storageTypes.IbcTransferStatus) (*storage.IbcTransfer, error) {
+	amount, err := storageTypes.NumericFromString(data.Amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse transfer amount")
+	}
+	transfer := &storage.IbcTransfer{
+		Amount:        amount,
+		Memo:          data.Memo,
+		ChannelId:     packet.SourceChannel,
+		Port:          packet.SourcePort,
+		Sequence:      packet.Sequence,
+		Denom:         data.Denom,
+		Height:        ctx.Block.Height,
+		Time:          ctx.Block.Time,
+		TxId:          txId,
+		Status:        status,
+		ResolveTxId:   &txId,
+		ResolveHeight: ctx.Block.Height,
+	}
+
+	partsDenom := strings.Split(data.Denom, "/")
+	if len(partsDenom) == 3 {
+		transfer.Denom = partsDenom[2]
+	}
+
+	if packet.TimeoutHeight.RevisionHeight > 0 {
+		transfer.HeightTimeout = packet.TimeoutHeight.RevisionHeight
+	}
+	if packet.TimeoutTimestamp > 0 {
+		ts := math.TimeFromNano(packet.TimeoutTimestamp)
+		transfer.Timeout = &ts
+	}
+
+	prefix, hash, err := pkgTypes.Address(data.Receiver).Decode()
+	if err != nil {
+		return nil, nil
+	}
+	if prefix == pkgTypes.AddressPrefixCelestia {
+		transfer.Receiver = &storage.Address{
+			Address:    data.Receiver,
+			Balances:   []storage.Balance{storage.EmptyBalance()},
+			Height:     ctx.Block.Height,
+			LastHeight: ctx.Block.Height,
+			Hash:       hash,
+		}
+		if err := ctx.AddAddress(transfer.Receiver); err != nil {
+			return nil, errors.Wrap(err, "AddAddress receiver")
+		}
+		ctx.AddAddressMessage(&storage.MsgAddress{
+			MsgId:   msgId,
+			Type:    storageTypes.MsgAddressTypeReceiver,
+			Address: transfer.Receiver,
+		})
+	} else {
+		transfer.ReceiverAddress = &data.Receiver
+	}
+	prefix, hash, err = pkgTypes.Address(data.Sender).Decode()
+	if err != nil {
+		return nil, nil
+	}
+	if prefix == pkgTypes.AddressPrefixCelestia {
+		transfer.Sender = &storage.Address{
+			Address:    data.Sender,
+			Balances:   []storage.Balance{storage.EmptyBalance()},
+			Height:     ctx.Block.Height,
+			LastHeight: ctx.Block.Height,
+			Hash:       hash,
+		}
+		if err := ctx.AddAddress(transfer.Sender); err != nil {
+			return nil, errors.Wrap(err, "AddAddress sender")
+		}
+		ctx.AddAddressMessage(&storage.MsgAddress{
+			MsgId:   msgId,
+			Type:    storageTypes.MsgAddressTypeSender,
+			Address: transfer.Sender,
+		})
+	} else {
+		transfer.SenderAddress = &data.Sender
+	}
+	return transfer, nil
+}
+
 func MsgUpdateParamsChannel(ctx *context.Context, msgId uint64, m *coreChannel.MsgUpdateParams) (storageTypes.MsgType, error) {
 	msgType := storageTypes.MsgUpdateParams
 	err := createAddresses(ctx, addressesData{
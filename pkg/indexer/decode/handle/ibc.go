@@ -4,20 +4,87 @@
 package handle
 
 import (
+	"github.com/celenium-io/celestia-indexer/internal/math"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	ibcTypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/pkg/errors"
 )
 
 // IBCTransfer defines a msg to transfer fungible tokens (i.e., Coins) between
 // ICS20 enabled chains. See ICS Spec here:
 // https://github.com/cosmos/ibc/tree/master/spec/app/ics-020-fungible-token-transfer#data-structures
-func IBCTransfer(ctx *context.Context, msgId uint64, m *ibcTypes.MsgTransfer) (storageTypes.MsgType, error) {
+func IBCTransfer(ctx *context.Context, status storageTypes.Status, txId, msgId uint64, m *ibcTypes.MsgTransfer) (storageTypes.MsgType, error) {
 	msgType := storageTypes.IBCTransfer
 	err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeSender, address: m.Sender},
 		// {t: storageTypes.MsgAddressTypeReceiver,
 		// address: m.Receiver}, // TODO: is it data to do IBC Transfer on cosmos network?
 	}, ctx.Block.Height, msgId)
-	return msgType, err
+	if err != nil || status == storageTypes.StatusFailed || m.SourcePort != "transfer" {
+		return msgType, err
+	}
+	return msgType, sentIbcTransfer(ctx, txId, m)
+}
+
+// sentIbcTransfer - creates pending transfer. Packet sequence and connection are set by send_packet event.
+func sentIbcTransfer(ctx *context.Context, txId uint64, m *ibcTypes.MsgTransfer) error {
+	amount, err := storageTypes.NumericFromString(m.Token.Amount.String())
+	if err != nil {
+		return errors.Wrap(err, "parse transfer amount")
+	}
+
+	transfer := &storage.IbcTransfer{
+		Amount:    amount,
+		Denom:     m.Token.Denom,
+		Memo:      m.Memo,
+		ChannelId: m.SourceChannel,
+		Port:      m.SourcePort,
+		Height:    ctx.Block.Height,
+		Time:      ctx.Block.Time,
+		TxId:      txId,
+		Status:    storageTypes.IbcTransferStatusPending,
+	}
+	if m.TimeoutHeight.RevisionHeight > 0 {
+		transfer.HeightTimeout = m.TimeoutHeight.RevisionHeight
+	}
+	if m.TimeoutTimestamp > 0 {
+		ts := math.TimeFromNano(m.TimeoutTimestamp)
+		transfer.Timeout = &ts
+	}
+
+	_, hash, err := pkgTypes.Address(m.Sender).Decode()
+	if err != nil {
+		return errors.Wrap(err, "decode sender")
+	}
+	transfer.Sender = &storage.Address{
+		Address:    m.Sender,
+		Balances:   []storage.Balance{storage.EmptyBalance()},
+		Height:     ctx.Block.Height,
+		LastHeight: ctx.Block.Height,
+		Hash:       hash,
+	}
+	if err := ctx.AddAddress(transfer.Sender); err != nil {
+		return errors.Wrap(err, "AddAddress sender")
+	}
+
+	if prefix, hash, err := pkgTypes.Address(m.Receiver).Decode(); err == nil && prefix == pkgTypes.AddressPrefixCelestia {
+		transfer.Receiver = &storage.Address{
+			Address:    m.Receiver,
+			Balances:   []storage.Balance{storage.EmptyBalance()},
+			Height:     ctx.Block.Height,
+			LastHeight: ctx.Block.Height,
+			Hash:       hash,
+		}
+		if err := ctx.AddAddress(transfer.Receiver); err != nil {
+			return errors.Wrap(err, "AddAddress receiver")
+		}
+	} else {
+		transfer.ReceiverAddress = &m.Receiver
+	}
+
+	ctx.AddIbcTransfer(transfer)
+	return nil
 }
@@ -226,7 +226,7 @@ func Message(
 
 	// ibc module
 	case *ibcTypes.MsgTransfer:
-		d.Msg.Type, err = handle.IBCTransfer(ctx, d.Msg.Id, typedMsg)
+		d.Msg.Type, err = handle.IBCTransfer(ctx, status, txId, d.Msg.Id, typedMsg)
 
 	// crisis module
 	case *crisisTypes.MsgVerifyInvariant:
@@ -342,9 +342,9 @@ func Message(
 	case *coreChannel.MsgRecvPacket:
 		d.Msg.Type, err = handle.MsgRecvPacket(ctx, status, cfg.Codec, d.Msg.Data, txId, d.Msg.Id, typedMsg)
 	case *coreChannel.MsgTimeout:
-		d.Msg.Type, err = handle.MsgTimeout(ctx, d.Msg.Id, typedMsg)
+		d.Msg.Type, err = handle.MsgTimeout(ctx, status, d.Msg.Data, txId, d.Msg.Id, typedMsg)
 	case *coreChannel.MsgTimeoutOnClose:
-		d.Msg.Type, err = handle.MsgTimeoutOnClose(ctx, d.Msg.Id, typedMsg)
+		d.Msg.Type, err = handle.MsgTimeoutOnClose(ctx, status, d.Msg.Data, txId, d.Msg.Id, typedMsg)
 	case *coreChannel.MsgAcknowledgement:
 		d.Msg.Type, err = handle.MsgAcknowledgement(ctx, status, cfg.Codec, d.Msg.Data, txId, d.Msg.Id, typedMsg)
 	case *coreChannel.MsgUpdateParams:
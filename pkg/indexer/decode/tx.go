@@ -26,6 +26,7 @@ type DecodedTx struct {
 	Signers       map[types.Address][]byte
 	Blobs         []*blobTypes.Blob
 	Hash          []byte
+	GasLimit      uint64
 }
 
 func NewDecodedTx() DecodedTx {
@@ -39,7 +40,11 @@ var (
 )
 
 func Tx(b *types.BlockData, index int) (d DecodedTx, err error) {
-	raw := b.Block.Txs[index]
+	return RawTx(b.Block.Txs[index])
+}
+
+// RawTx - decodes transaction bytes which are not bound to a block, e.g. received from mempool
+func RawTx(raw tmTypes.Tx) (d DecodedTx, err error) {
 	if bTx, isBlob := UnmarshalBlobTxShallow(raw); isBlob {
 		raw = bTx.Tx
 		d.Blobs = bTx.Blobs
@@ -72,6 +77,7 @@ func decodeCosmosTx(decoder cosmosTypes.TxDecoder, raw tmTypes.Tx, d *DecodedTx)
 		if err != nil {
 			return errors.Wrap(err, "decode fee")
 		}
+		d.GasLimit = t.GetGas()
 	}
 	if t, ok := txDecoded.(signing.Tx); ok {
 		signers, err := t.GetSigners()
@@ -5,6 +5,7 @@ package decode
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"testing"
 
@@ -71,6 +72,27 @@ func TestDecodeTx_TxV050Signer(t *testing.T) {
 	}
 }
 
+func TestDecodeRawTx(t *testing.T) {
+	txData, err := base64.StdEncoding.DecodeString("CrsBCqIBCiMvY29zbW9zLnN0YWtpbmcudjFiZXRhMS5Nc2dEZWxlZ2F0ZRJ7Ci9jZWxlc3RpYTFtZW1jZjZzcWQwMGg2eXpmMGU4ZThlNzA3dGptc3pwZDNsemM2ZRI2Y2VsZXN0aWF2YWxvcGVyMXQ0Z2hmOTg0ejJ5Mnl2bjR4YWp4Y201anoyZzVzZHpxZG05eGVwGhAKBHV0aWESCDI3MDAwMDAwEhRTZW50IHZpYSBDZWxlbml1bS5pbxJkCk4KRgofL2Nvc21vcy5jcnlwdG8uc2VjcDI1NmsxLlB1YktleRIjCiEDZihoRCE/LwSjSlI5YpX/zHSqUy/TiAt/V+HupnPyr18SBAoCCAESEgoMCgR1dGlhEgQ0MjgyEOu5ChpAhlo8GUvT+uSwJc880k916MeM+Yl2cBpG6Nv9lmsq2Bp5npoUdSVQ1t8o/evc1EAafhljZQbF/e5geBpapwqZdA==")
+	require.NoError(t, err)
+
+	dTx, err := RawTx(txData)
+	require.NoError(t, err)
+
+	require.Len(t, dTx.Messages, 1)
+	require.Len(t, dTx.Signers, 1)
+	require.Empty(t, dTx.Blobs)
+	require.Equal(t, "Sent via Celenium.io", dTx.Memo)
+	require.EqualValues(t, "4282", dTx.Fee.String())
+	require.EqualValues(t, 171243, dTx.GasLimit)
+	require.Equal(t, "dcc589f8a7ae33beab72635548d4e7a298cc93db7ea640f3979b0fad664de3cd", hex.EncodeToString(dTx.Hash))
+}
+
+func TestDecodeRawTx_Invalid(t *testing.T) {
+	_, err := RawTx([]byte("not a transaction"))
+	require.Error(t, err)
+}
+
 func TestDecodeTx_TxV050Signer2(t *testing.T) {
 	deliverTx := nodeTypes.ResponseDeliverTx{
 		Code:      0,
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package delivery
+
+import "time"
+
+type TrackerOption func(*Tracker)
+
+// WithInterval - sets period of delivery info requests
+func WithInterval(interval time.Duration) TrackerOption {
+	return func(t *Tracker) {
+		if interval > 0 {
+			t.interval = interval
+		}
+	}
+}
+
+// WithWindow - sets period after dispatch during which delivery of the message is tracked
+func WithWindow(window time.Duration) TrackerOption {
+	return func(t *Tracker) {
+		if window > 0 {
+			t.window = window
+		}
+	}
+}
+
+// WithMaxAttempts - sets maximum count of delivery info requests of a single message
+func WithMaxAttempts(attempts int) TrackerOption {
+	return func(t *Tracker) {
+		if attempts > 0 {
+			t.maxAttempts = attempts
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package delivery
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/node/hyperlane"
+	"github.com/dipdup-io/workerpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const pageSize = 100
+
+// Tracker - periodically requests delivery info of hyperlane messages from the hyperlane explorer
+// and saves delivery status, dispatch and delivery time of the messages to the storage.
+// Only messages dispatched within the window are tracked and every message is requested limited count of times.
+type Tracker struct {
+	transfers storage.IHLTransfer
+	api       hyperlane.IApi
+
+	interval    time.Duration
+	window      time.Duration
+	maxAttempts int
+	now         func() time.Time
+
+	g   workerpool.Group
+	log zerolog.Logger
+}
+
+func NewTracker(transfers storage.IHLTransfer, explorerUrl string, opts ...TrackerOption) *Tracker {
+	api := hyperlane.NewApi(
+		"",
+		hyperlane.WithExplorerUrl(explorerUrl),
+		hyperlane.WithRateLimit(1),
+		hyperlane.WithTimeout(time.Second*time.Duration(30)))
+
+	t := &Tracker{
+		transfers:   transfers,
+		api:         api,
+		interval:    time.Minute,
+		window:      time.Hour * 24,
+		maxAttempts: 60,
+		now:         time.Now,
+		g:           workerpool.NewGroup(),
+		log:         log.With().Str("module", "hyperlane_delivery_tracker").Logger(),
+	}
+
+	for i := range opts {
+		opts[i](t)
+	}
+
+	return t
+}
+
+func (t *Tracker) Start(ctx context.Context) {
+	t.g.GoCtx(ctx, t.sync)
+}
+
+func (t *Tracker) sync(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.log.Info().Msg("context canceled, stopping delivery tracker")
+			return
+		case <-ticker.C:
+			if err := t.track(ctx); err != nil {
+				t.log.Error().Err(err).Msg("track hyperlane messages delivery")
+			}
+		}
+	}
+}
+
+func (t *Tracker) track(ctx context.Context) error {
+	var (
+		fromId  uint64
+		updated int
+		since   = t.now().UTC().Add(-t.window)
+	)
+
+	for {
+		transfers, err := t.transfers.Undelivered(ctx, since, t.maxAttempts, fromId, pageSize)
+		if err != nil {
+			return err
+		}
+		if len(transfers) == 0 {
+			break
+		}
+		fromId = transfers[len(transfers)-1].Id
+
+		changed, err := t.resolve(ctx, transfers)
+		if err != nil {
+			return err
+		}
+		if err := t.transfers.UpdateDelivery(ctx, changed...); err != nil {
+			return err
+		}
+		if err := t.transfers.IncDeliveryAttempts(ctx, transfers...); err != nil {
+			return err
+		}
+		updated += len(changed)
+
+		if len(transfers) < pageSize {
+			break
+		}
+	}
+
+	if updated > 0 {
+		t.log.Info().Int("count", updated).Msg("hyperlane messages delivery info updated")
+	}
+	return nil
+}
+
+func (t *Tracker) resolve(ctx context.Context, transfers []storage.HLTransfer) ([]storage.HLTransfer, error) {
+	ids := make([]string, len(transfers))
+	for i := range transfers {
+		ids[i] = hex.EncodeToString(transfers[i].MessageId)
+	}
+
+	messages, err := t.api.Messages(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make(map[string]hyperlane.MessageDelivery, len(messages))
+	for i := range messages {
+		deliveries[messages[i].MessageId] = messages[i]
+	}
+
+	changed := make([]storage.HLTransfer, 0)
+	for i := range transfers {
+		delivery, ok := deliveries[ids[i]]
+		if !ok {
+			continue
+		}
+		if applyDelivery(&transfers[i], delivery) {
+			changed = append(changed, transfers[i])
+		}
+	}
+	return changed, nil
+}
+
+func applyDelivery(transfer *storage.HLTransfer, delivery hyperlane.MessageDelivery) bool {
+	switch transfer.Type {
+	case types.HLTransferTypeSend:
+		if !delivery.IsDelivered || delivery.DeliveryOccurredAt == nil {
+			return false
+		}
+		transfer.DeliveryStatus = types.HLDeliveryStatusDelivered
+		transfer.DeliveredAt = delivery.DeliveryOccurredAt
+		transfer.CounterpartyTxHash = delivery.DestinationTxHash
+		return true
+	case types.HLTransferTypeReceive:
+		if delivery.SendOccurredAt == nil {
+			return false
+		}
+		transfer.DispatchedAt = delivery.SendOccurredAt
+		transfer.CounterpartyTxHash = delivery.OriginTxHash
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *Tracker) Close() error {
+	t.g.Wait()
+
+	return nil
+}
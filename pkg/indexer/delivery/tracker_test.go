@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package delivery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/node/hyperlane"
+	hlMock "github.com/celenium-io/celestia-indexer/pkg/node/hyperlane/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTrack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 7, 4, 3, 10, 57, 0, time.UTC)
+	deliveredAt := now.Add(-time.Minute)
+
+	transfers := mock.NewMockIHLTransfer(ctrl)
+	api := hlMock.NewMockIApi(ctrl)
+
+	tracker := NewTracker(transfers, "", WithWindow(time.Hour), WithMaxAttempts(5))
+	tracker.api = api
+	tracker.now = func() time.Time { return now }
+
+	pending := []storage.HLTransfer{
+		{
+			Id:             1,
+			Type:           types.HLTransferTypeSend,
+			MessageId:      []byte{0x01},
+			DeliveryStatus: types.HLDeliveryStatusPending,
+		}, {
+			Id:             2,
+			Type:           types.HLTransferTypeSend,
+			MessageId:      []byte{0x02},
+			DeliveryStatus: types.HLDeliveryStatusPending,
+		},
+	}
+
+	transfers.EXPECT().
+		Undelivered(gomock.Any(), now.Add(-time.Hour), 5, uint64(0), pageSize).
+		Return(pending, nil).
+		Times(1)
+
+	api.EXPECT().
+		Messages(gomock.Any(), []string{"01", "02"}).
+		Return([]hyperlane.MessageDelivery{
+			{
+				MessageId:          "01",
+				IsDelivered:        true,
+				DeliveryOccurredAt: &deliveredAt,
+			},
+		}, nil).
+		Times(1)
+
+	transfers.EXPECT().
+		UpdateDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, changed ...storage.HLTransfer) error {
+			require.Len(t, changed, 1)
+			require.EqualValues(t, 1, changed[0].Id)
+			require.Equal(t, types.HLDeliveryStatusDelivered, changed[0].DeliveryStatus)
+			return nil
+		}).
+		Times(1)
+
+	transfers.EXPECT().
+		IncDeliveryAttempts(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, requested ...storage.HLTransfer) error {
+			require.Len(t, requested, 2)
+			return nil
+		}).
+		Times(1)
+
+	require.NoError(t, tracker.track(t.Context()))
+}
+
+func TestApplyDelivery(t *testing.T) {
+	sentAt := time.Date(2025, 7, 4, 3, 10, 57, 0, time.UTC)
+	deliveredAt := sentAt.Add(time.Minute)
+
+	t.Run("delivered send", func(t *testing.T) {
+		transfer := storage.HLTransfer{
+			Type:           types.HLTransferTypeSend,
+			DeliveryStatus: types.HLDeliveryStatusPending,
+			DispatchedAt:   &sentAt,
+		}
+		ok := applyDelivery(&transfer, hyperlane.MessageDelivery{
+			IsDelivered:        true,
+			DestinationTxHash:  "destination",
+			OriginTxHash:       "origin",
+			SendOccurredAt:     &sentAt,
+			DeliveryOccurredAt: &deliveredAt,
+		})
+		require.True(t, ok)
+		require.Equal(t, types.HLDeliveryStatusDelivered, transfer.DeliveryStatus)
+		require.Equal(t, "destination", transfer.CounterpartyTxHash)
+		require.Equal(t, time.Minute, transfer.Latency())
+	})
+
+	t.Run("undelivered send", func(t *testing.T) {
+		transfer := storage.HLTransfer{
+			Type:           types.HLTransferTypeSend,
+			DeliveryStatus: types.HLDeliveryStatusPending,
+			DispatchedAt:   &sentAt,
+		}
+		ok := applyDelivery(&transfer, hyperlane.MessageDelivery{
+			OriginTxHash:   "origin",
+			SendOccurredAt: &sentAt,
+		})
+		require.False(t, ok)
+		require.Equal(t, types.HLDeliveryStatusPending, transfer.DeliveryStatus)
+		require.Nil(t, transfer.DeliveredAt)
+	})
+
+	t.Run("receive", func(t *testing.T) {
+		transfer := storage.HLTransfer{
+			Type:           types.HLTransferTypeReceive,
+			DeliveryStatus: types.HLDeliveryStatusDelivered,
+			DeliveredAt:    &deliveredAt,
+		}
+		ok := applyDelivery(&transfer, hyperlane.MessageDelivery{
+			IsDelivered:        true,
+			DestinationTxHash:  "destination",
+			OriginTxHash:       "origin",
+			SendOccurredAt:     &sentAt,
+			DeliveryOccurredAt: &deliveredAt,
+		})
+		require.True(t, ok)
+		require.Equal(t, types.HLDeliveryStatusDelivered, transfer.DeliveryStatus)
+		require.Equal(t, "origin", transfer.CounterpartyTxHash)
+		require.Equal(t, time.Minute, transfer.Latency())
+	})
+}
@@ -99,6 +99,21 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		if err := tx.SaveBalances(ctx, balances...); err != nil {
 			return tx.HandleError(ctx, err)
 		}
+
+		updates := make([]storage.BalanceUpdate, 0, len(entities))
+		for i := range entities {
+			for j := range entities[i].Balances {
+				update := storage.NewBalanceUpdate(data.block.Height, data.block.Time, entities[i].Balances[j])
+				if update.IsEmpty() {
+					continue
+				}
+				update.AddressId = entities[i].Id
+				updates = append(updates, update)
+			}
+		}
+		if err := tx.SaveBalanceUpdates(ctx, updates...); err != nil {
+			return tx.HandleError(ctx, err)
+		}
 	}
 
 	var totalNamespaces int64
@@ -119,6 +134,19 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		return tx.HandleError(ctx, err)
 	}
 
+	rates := make([]storage.ValidatorRate, 0, len(data.validators))
+	for i := range data.validators {
+		rates = append(rates, storage.ValidatorRate{
+			Height:      data.block.Height,
+			Time:        data.block.Time,
+			ValidatorId: data.validators[i].Id,
+			Rate:        data.validators[i].Rate,
+		})
+	}
+	if err := tx.SaveValidatorRates(ctx, rates...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	if err := tx.SaveMessages(ctx, messages...); err != nil {
 		return tx.HandleError(ctx, err)
 	}
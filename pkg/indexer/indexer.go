@@ -9,6 +9,7 @@ package indexer
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules/stopper"
@@ -16,12 +17,15 @@ import (
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 
 	internalStorage "github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/delivery"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/genesis"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/mempool"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/parser"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/rollback"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/storage"
 	"github.com/celenium-io/celestia-indexer/pkg/node"
 	"github.com/celenium-io/celestia-indexer/pkg/node/api"
+	"github.com/celenium-io/celestia-indexer/pkg/node/archive"
 	"github.com/celenium-io/celestia-indexer/pkg/node/rpc"
 	"github.com/pkg/errors"
 
@@ -40,6 +44,8 @@ type Indexer struct {
 	storage  *storage.Module
 	rollback *rollback.Module
 	genesis  *genesis.Module
+	mempool  *mempool.Watcher
+	delivery *delivery.Tracker
 	stopper  modules.Module
 	pg       postgres.Storage
 	wg       *sync.WaitGroup
@@ -61,7 +67,7 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating receiver module")
 	}
 
-	rb, err := createRollback(r, pg, &api, cfg.Indexer)
+	rb, err := createRollback(r, pg, api, cfg.Indexer)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating rollback module")
 	}
@@ -86,14 +92,19 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating stopper module")
 	}
 
+	mempoolWatcher := createMempool(pg, cfg)
+	deliveryTracker := createDeliveryTracker(pg, cfg)
+
 	return Indexer{
 		cfg:      cfg,
-		api:      &api,
+		api:      api,
 		receiver: r,
 		parser:   p,
 		storage:  s,
 		rollback: rb,
 		genesis:  genesisModule,
+		mempool:  mempoolWatcher,
+		delivery: deliveryTracker,
 		stopper:  stopperModule,
 		pg:       pg,
 		wg:       new(sync.WaitGroup),
@@ -109,6 +120,13 @@ func (i *Indexer) Start(ctx context.Context) {
 	i.parser.Start(ctx)
 	i.receiver.Start(ctx)
 	i.rollback.Start(ctx)
+
+	if i.mempool != nil {
+		i.mempool.Start(ctx)
+	}
+	if i.delivery != nil {
+		i.delivery.Start(ctx)
+	}
 }
 
 func (i *Indexer) Close() error {
@@ -130,6 +148,16 @@ func (i *Indexer) Close() error {
 	if err := i.rollback.Close(); err != nil {
 		log.Err(err).Msg("closing rollback")
 	}
+	if i.mempool != nil {
+		if err := i.mempool.Close(); err != nil {
+			log.Err(err).Msg("closing mempool watcher")
+		}
+	}
+	if i.delivery != nil {
+		if err := i.delivery.Close(); err != nil {
+			log.Err(err).Msg("closing hyperlane delivery tracker")
+		}
+	}
 	if err := i.pg.Close(); err != nil {
 		log.Err(err).Msg("closing postgres connection")
 	}
@@ -137,10 +165,23 @@ func (i *Indexer) Close() error {
 	return nil
 }
 
-func createReceiver(ctx context.Context, cfg config.Config, pg postgres.Storage) (rpc.API, *receiver.Module, error) {
+func createReceiver(ctx context.Context, cfg config.Config, pg postgres.Storage) (node.Api, *receiver.Module, error) {
 	state, err := loadState(pg, ctx, cfg.Indexer.Name)
 	if err != nil {
-		return rpc.API{}, nil, errors.Wrap(err, "while loading state")
+		return nil, nil, errors.Wrap(err, "while loading state")
+	}
+
+	nodeApi := api.NewAPI(cfg.DataSources["node_api"])
+
+	if cfg.Indexer.Archive != "" {
+		archiveApi, err := archive.NewAPI(cfg.Indexer.Archive)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "open blocks archive")
+		}
+		log.Info().Str("dir", cfg.Indexer.Archive).Msg("blocks are received from archive")
+
+		receiverModule := receiver.NewModule(cfg.Indexer, archiveApi, &nodeApi, nil, state)
+		return archiveApi, receiverModule, nil
 	}
 
 	rpcOpts := make([]rpc.ApiOption, 0)
@@ -148,22 +189,21 @@ func createReceiver(ctx context.Context, cfg config.Config, pg postgres.Storage)
 		rpcOpts = append(rpcOpts, rpc.WithDisableGzip())
 	}
 	nodeRpc := rpc.NewAPI(cfg.DataSources["node_rpc"], rpcOpts...)
-	nodeApi := api.NewAPI(cfg.DataSources["node_api"])
 
 	var ws *http.HTTP
 	if source, ok := cfg.DataSources["node_ws"]; ok && source.URL != "" {
 		ws, err = http.New(source.URL, "/websocket")
 		if err != nil {
-			return nodeRpc, nil, errors.Wrap(err, "create websocket")
+			return nil, nil, errors.Wrap(err, "create websocket")
 		}
 	}
 
 	receiverModule := receiver.NewModule(cfg.Indexer, &nodeRpc, &nodeApi, ws, state)
-	return nodeRpc, receiverModule, nil
+	return &nodeRpc, receiverModule, nil
 }
 
 func createRollback(receiverModule modules.Module, pg postgres.Storage, api node.Api, cfg config.Indexer) (*rollback.Module, error) {
-	rollbackModule := rollback.NewModule(pg.Transactable, pg.State, pg.Blocks, api, cfg)
+	rollbackModule := rollback.NewModule(pg.Transactable, pg.State, pg.Blocks, pg.Notificator, api, cfg)
 
 	// rollback <- listen signal -- receiver
 	if err := rollbackModule.AttachTo(receiverModule, receiver.RollbackOutput, rollback.InputName); err != nil {
@@ -189,7 +229,8 @@ func createParser(cfg config.Indexer, receiverModule modules.Module) (*parser.Mo
 }
 
 func createStorage(pg postgres.Storage, cfg config.Config, parserModule modules.Module) (*storage.Module, error) {
-	storageModule := storage.NewModule(pg.Transactable, pg.Constants, pg.Validator, pg.Notificator, cfg.Indexer)
+	cosmosApi := api.NewAPI(cfg.DataSources["node_api"])
+	storageModule := storage.NewModule(pg.Transactable, pg.Constants, pg.Validator, pg.Notificator, &cosmosApi, cfg.Indexer)
 
 	if err := storageModule.AttachTo(parserModule, parser.OutputName, storage.InputName); err != nil {
 		return nil, errors.Wrap(err, "while attaching storage to parser")
@@ -213,6 +254,49 @@ func createGenesis(pg postgres.Storage, cfg config.Config, receiverModule module
 	return genesisModulePtr, nil
 }
 
+// createMempool - creates mempool watcher if it's enabled. It always polls node RPC even if blocks are read from archive.
+func createMempool(pg postgres.Storage, cfg config.Config) *mempool.Watcher {
+	if !cfg.Indexer.Mempool.Enabled {
+		return nil
+	}
+
+	rpcOpts := make([]rpc.ApiOption, 0)
+	if cfg.Indexer.DisableGzip {
+		rpcOpts = append(rpcOpts, rpc.WithDisableGzip())
+	}
+	nodeRpc := rpc.NewAPI(cfg.DataSources["node_rpc"], rpcOpts...)
+
+	return mempool.NewWatcher(
+		&nodeRpc,
+		pg.MempoolTxs,
+		pg.Tx,
+		pg.Blocks,
+		pg.Notificator,
+		mempool.WithInterval(time.Duration(cfg.Indexer.Mempool.Interval)*time.Second),
+		mempool.WithLimit(cfg.Indexer.Mempool.Limit),
+		mempool.WithDropTimeout(time.Duration(cfg.Indexer.Mempool.DropTimeout)*time.Second),
+	)
+}
+
+// createDeliveryTracker - creates hyperlane message delivery tracker if it's enabled
+func createDeliveryTracker(pg postgres.Storage, cfg config.Config) *delivery.Tracker {
+	if !cfg.Indexer.HyperlaneDelivery.Enabled {
+		return nil
+	}
+	if cfg.Indexer.HyperlaneDelivery.ExplorerUrl == "" {
+		log.Warn().Msg("hyperlane explorer url is empty, delivery tracker is disabled")
+		return nil
+	}
+
+	return delivery.NewTracker(
+		pg.HLTransfer,
+		cfg.Indexer.HyperlaneDelivery.ExplorerUrl,
+		delivery.WithInterval(time.Duration(cfg.Indexer.HyperlaneDelivery.Interval)*time.Second),
+		delivery.WithWindow(time.Duration(cfg.Indexer.HyperlaneDelivery.Window)*time.Second),
+		delivery.WithMaxAttempts(cfg.Indexer.HyperlaneDelivery.MaxAttempts),
+	)
+}
+
 func attachStopper(
 	stopperModule modules.Module,
 	receiverModule modules.Module,
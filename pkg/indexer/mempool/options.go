@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package mempool
+
+import "time"
+
+type WatcherOption func(*Watcher)
+
+// WithInterval - sets period of mempool polling
+func WithInterval(interval time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		if interval > 0 {
+			w.interval = interval
+		}
+	}
+}
+
+// WithLimit - sets maximum count of transactions received from mempool by single request
+func WithLimit(limit int) WatcherOption {
+	return func(w *Watcher) {
+		if limit > 0 {
+			w.limit = limit
+		}
+	}
+}
+
+// WithDropTimeout - sets period after which transaction that left mempool and was not found in indexed blocks is considered as dropped
+func WithDropTimeout(timeout time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		if timeout > 0 {
+			w.dropTimeout = timeout
+		}
+	}
+}
+
+// WithRetention - sets period during which finished transactions are kept
+func WithRetention(retention time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		if retention > 0 {
+			w.retention = retention
+		}
+	}
+}
@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package mempool
+
+import (
+	"context"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/celenium-io/celestia-indexer/pkg/node"
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dipdup-io/workerpool"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/shopspring/decimal"
+)
+
+// Watcher - polls the node's mempool and tracks observed transactions until they are included in a block or dropped.
+// Time to inclusion is stored for every included transaction and every change is sent to `mempool` notification channel.
+type Watcher struct {
+	api         node.MempoolApi
+	mempool     storage.IMempoolTx
+	txs         storage.ITx
+	blocks      storage.IBlock
+	notificator storage.Notificator
+
+	interval    time.Duration
+	limit       int
+	dropTimeout time.Duration
+	retention   time.Duration
+	now         func() time.Time
+
+	// pending - tracked transactions by hash
+	pending   map[string]*pendingTx
+	lastPrune time.Time
+
+	log zerolog.Logger
+	g   workerpool.Group
+}
+
+type pendingTx struct {
+	tx storage.MempoolTx
+	// leftAt - time when transaction disappeared from mempool. It's zero while transaction is in mempool.
+	leftAt time.Time
+}
+
+func NewWatcher(
+	api node.MempoolApi,
+	mempool storage.IMempoolTx,
+	txs storage.ITx,
+	blocks storage.IBlock,
+	notificator storage.Notificator,
+	opts ...WatcherOption,
+) *Watcher {
+	w := &Watcher{
+		api:         api,
+		mempool:     mempool,
+		txs:         txs,
+		blocks:      blocks,
+		notificator: notificator,
+		interval:    time.Second * 2,
+		limit:       100,
+		dropTimeout: time.Minute * 10,
+		retention:   time.Hour * 24 * 7,
+		now:         time.Now,
+		pending:     make(map[string]*pendingTx),
+		log:         log.With().Str("module", "mempool").Logger(),
+		g:           workerpool.NewGroup(),
+	}
+
+	for i := range opts {
+		opts[i](w)
+	}
+
+	return w
+}
+
+func (w *Watcher) Start(ctx context.Context) {
+	w.g.GoCtx(ctx, w.run)
+}
+
+func (w *Watcher) Close() error {
+	w.g.Wait()
+	return nil
+}
+
+func (w *Watcher) run(ctx context.Context) {
+	if err := w.Init(ctx); err != nil {
+		w.log.Err(err).Msg("receiving pending transactions")
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Poll(ctx); err != nil {
+				w.log.Err(err).Msg("polling mempool")
+			}
+		}
+	}
+}
+
+// Init - restores transactions which were pending before restart
+func (w *Watcher) Init(ctx context.Context) error {
+	pending, err := w.mempool.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range pending {
+		w.pending[string(pending[i].Hash)] = &pendingTx{
+			tx: pending[i],
+		}
+	}
+	return nil
+}
+
+// Poll - receives mempool content once, saves new transactions and finishes the ones which left mempool
+func (w *Watcher) Poll(ctx context.Context) error {
+	result, err := w.api.UnconfirmedTxs(ctx, w.limit)
+	if err != nil {
+		return errors.Wrap(err, "receive unconfirmed txs")
+	}
+	now := w.now().UTC()
+	// node returns only `limit` transactions, so absence in incomplete snapshot doesn't mean that transaction left mempool
+	complete := int64(len(result.Txs)) >= result.Total
+
+	inMempool := make(map[string]struct{}, len(result.Txs))
+	newTxs := make([]*storage.MempoolTx, 0)
+	for i := range result.Txs {
+		decoded, err := decode.RawTx(result.Txs[i])
+		if err != nil {
+			w.log.Debug().Err(err).Msg("decoding mempool transaction")
+			continue
+		}
+		key := string(decoded.Hash)
+		inMempool[key] = struct{}{}
+
+		if ptx, ok := w.pending[key]; ok {
+			ptx.leftAt = time.Time{}
+			continue
+		}
+		tx := newMempoolTx(decoded, len(result.Txs[i]), now)
+		newTxs = append(newTxs, &tx)
+	}
+
+	if err := w.save(ctx, newTxs); err != nil {
+		return err
+	}
+
+	for key, ptx := range w.pending {
+		if _, ok := inMempool[key]; ok {
+			continue
+		}
+		if ptx.leftAt.IsZero() {
+			ptx.leftAt = now
+		}
+		finished, err := w.finish(ctx, ptx, now, complete)
+		if err != nil {
+			return err
+		}
+		if finished {
+			delete(w.pending, key)
+		}
+	}
+
+	return w.prune(ctx, now)
+}
+
+func (w *Watcher) save(ctx context.Context, txs []*storage.MempoolTx) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	if block, err := w.blocks.Last(ctx); err == nil {
+		for i := range txs {
+			txs[i].SeenHeight = block.Height
+		}
+	} else if !w.blocks.IsNoRows(err) {
+		return errors.Wrap(err, "receive last block")
+	}
+
+	if err := w.mempool.Insert(ctx, txs...); err != nil {
+		return errors.Wrap(err, "save mempool transactions")
+	}
+
+	for i := range txs {
+		w.pending[string(txs[i].Hash)] = &pendingTx{
+			tx: *txs[i],
+		}
+		w.notify(ctx, *txs[i])
+	}
+	return nil
+}
+
+// finish - marks transaction which left mempool as included if it's found in indexed blocks or as dropped if it's not found during drop timeout.
+// Indexer may be behind the node, so transaction is waited for a while after it left mempool. If mempool snapshot was incomplete,
+// transaction is dropped only when the node confirms that it isn't pending or committed.
+func (w *Watcher) finish(ctx context.Context, ptx *pendingTx, now time.Time, complete bool) (bool, error) {
+	tx, err := w.txs.ByHash(ctx, ptx.tx.Hash)
+	switch {
+	case err == nil:
+		finishedAt := tx.Time
+		ptx.tx.Status = types.MempoolTxStatusIncluded
+		ptx.tx.Height = tx.Height
+		ptx.tx.FinishedAt = &finishedAt
+		ptx.tx.InclusionTime = max(tx.Time.Sub(ptx.tx.FirstSeen).Milliseconds(), 0)
+	case w.txs.IsNoRows(err):
+		if now.Sub(ptx.leftAt) < w.dropTimeout {
+			return false, nil
+		}
+		if !complete {
+			absent, err := w.isAbsent(ctx, ptx.tx.Hash)
+			if err != nil {
+				return false, err
+			}
+			if !absent {
+				ptx.leftAt = now
+				return false, nil
+			}
+		}
+		ptx.tx.Status = types.MempoolTxStatusDropped
+		ptx.tx.FinishedAt = &now
+	default:
+		return false, errors.Wrap(err, "receive transaction by hash")
+	}
+
+	if err := w.mempool.Update(ctx, &ptx.tx); err != nil {
+		return false, errors.Wrap(err, "update mempool transaction")
+	}
+	w.notify(ctx, ptx.tx)
+	return true, nil
+}
+
+// isAbsent - checks in the node that transaction is neither in mempool nor committed
+func (w *Watcher) isAbsent(ctx context.Context, hash []byte) (bool, error) {
+	status, err := w.api.TxStatus(ctx, hash)
+	if err != nil {
+		return false, errors.Wrap(err, "receive transaction status")
+	}
+	return status.Status != nodeTypes.TxStatusPending && status.Status != nodeTypes.TxStatusCommitted, nil
+}
+
+// prune - removes finished transactions which are older than retention period. It's executed once an hour.
+func (w *Watcher) prune(ctx context.Context, now time.Time) error {
+	if now.Sub(w.lastPrune) < time.Hour {
+		return nil
+	}
+	count, err := w.mempool.DeleteFinished(ctx, now.Add(-w.retention))
+	if err != nil {
+		return errors.Wrap(err, "remove finished mempool transactions")
+	}
+	w.lastPrune = now
+	if count > 0 {
+		w.log.Info().Int("count", count).Msg("finished mempool transactions were removed")
+	}
+	return nil
+}
+
+func (w *Watcher) notify(ctx context.Context, tx storage.MempoolTx) {
+	payload, err := json.MarshalString([]storage.MempoolTx{tx})
+	if err != nil {
+		w.log.Err(err).Msg("marshal mempool transaction")
+		return
+	}
+	if len(payload) > storage.MaxNotificationPayloadSize {
+		w.log.Warn().Str("hash", hex.EncodeToString(tx.Hash)).Int("size", len(payload)).Msg("notification item is too large, skipping")
+		return
+	}
+	if err := w.notificator.Notify(ctx, storage.ChannelMempool, payload); err != nil {
+		w.log.Err(err).Msg("notify about mempool transaction")
+	}
+}
+
+func newMempoolTx(decoded decode.DecodedTx, size int, now time.Time) storage.MempoolTx {
+	tx := storage.MempoolTx{
+		Hash:         decoded.Hash,
+		Status:       types.MempoolTxStatusPending,
+		FirstSeen:    now,
+		GasWanted:    int64(decoded.GasLimit),
+		Fee:          types.NewNumeric(decoded.Fee),
+		GasPrice:     types.NumericZero(),
+		Size:         int64(size),
+		BlobsCount:   len(decoded.Blobs),
+		Signers:      make([]string, 0, len(decoded.Signers)),
+		MessageTypes: make([]string, len(decoded.Messages)),
+		Memo:         decoded.Memo,
+	}
+	if decoded.GasLimit > 0 {
+		tx.GasPrice = types.NewNumeric(decoded.Fee.Div(decimal.NewFromUint64(decoded.GasLimit)))
+	}
+	for i := range decoded.Blobs {
+		tx.BlobsSize += int64(len(decoded.Blobs[i].Data))
+	}
+	for address := range decoded.Signers {
+		tx.Signers = append(tx.Signers, address.String())
+	}
+	slices.Sort(tx.Signers)
+	for i := range decoded.Messages {
+		tx.MessageTypes[i] = messageType(decoded.Messages[i])
+	}
+	return tx
+}
+
+// messageType - returns message name without protobuf package, e.g. `MsgPayForBlobs`
+func messageType(msg cosmosTypes.Msg) string {
+	typeUrl := cosmosTypes.MsgTypeURL(msg)
+	if idx := strings.LastIndex(typeUrl, "."); idx >= 0 {
+		return typeUrl[idx+1:]
+	}
+	return typeUrl
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package mempool
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	nodeMock "github.com/celenium-io/celestia-indexer/pkg/node/mock"
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// MsgDelegate signed by celestia1memcf6sqd00h6yzf0e8e8e707tjmszpd3lzc6e
+const testTx = "CrsBCqIBCiMvY29zbW9zLnN0YWtpbmcudjFiZXRhMS5Nc2dEZWxlZ2F0ZRJ7Ci9jZWxlc3RpYTFtZW1jZjZzcWQwMGg2eXpmMGU4ZThlNzA3dGptc3pwZDNsemM2ZRI2Y2VsZXN0aWF2YWxvcGVyMXQ0Z2hmOTg0ejJ5Mnl2bjR4YWp4Y201anoyZzVzZHpxZG05eGVwGhAKBHV0aWESCDI3MDAwMDAwEhRTZW50IHZpYSBDZWxlbml1bS5pbxJkCk4KRgofL2Nvc21vcy5jcnlwdG8uc2VjcDI1NmsxLlB1YktleRIjCiEDZihoRCE/LwSjSlI5YpX/zHSqUy/TiAt/V+HupnPyr18SBAoCCAESEgoMCgR1dGlhEgQ0MjgyEOu5ChpAhlo8GUvT+uSwJc880k916MeM+Yl2cBpG6Nv9lmsq2Bp5npoUdSVQ1t8o/evc1EAafhljZQbF/e5geBpapwqZdA=="
+
+var testNow = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+type testWatcher struct {
+	*Watcher
+
+	api         *nodeMock.MockMempoolApi
+	mempool     *mock.MockIMempoolTx
+	txs         *mock.MockITx
+	blocks      *mock.MockIBlock
+	notificator *mock.MockNotificator
+}
+
+func newTestWatcher(t *testing.T) *testWatcher {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tw := &testWatcher{
+		api:         nodeMock.NewMockMempoolApi(ctrl),
+		mempool:     mock.NewMockIMempoolTx(ctrl),
+		txs:         mock.NewMockITx(ctrl),
+		blocks:      mock.NewMockIBlock(ctrl),
+		notificator: mock.NewMockNotificator(ctrl),
+	}
+	tw.Watcher = NewWatcher(tw.api, tw.mempool, tw.txs, tw.blocks, tw.notificator, WithLimit(10), WithDropTimeout(time.Minute))
+	tw.now = func() time.Time { return testNow }
+
+	tw.mempool.EXPECT().
+		DeleteFinished(gomock.Any(), gomock.Any()).
+		Return(0, nil).
+		AnyTimes()
+	return tw
+}
+
+func (tw *testWatcher) expectMempool(t *testing.T, txs ...string) {
+	tw.expectMempoolWithTotal(t, int64(len(txs)), txs...)
+}
+
+func (tw *testWatcher) expectMempoolWithTotal(t *testing.T, total int64, txs ...string) {
+	raw := make([][]byte, len(txs))
+	for i := range txs {
+		data, err := base64.StdEncoding.DecodeString(txs[i])
+		require.NoError(t, err)
+		raw[i] = data
+	}
+
+	tw.api.EXPECT().
+		UnconfirmedTxs(gomock.Any(), 10).
+		Return(nodeTypes.UnconfirmedTxs{
+			Count: int64(len(raw)),
+			Total: total,
+			Txs:   raw,
+		}, nil).
+		Times(1)
+}
+
+func (tw *testWatcher) observe(t *testing.T) storage.MempoolTx {
+	tw.expectMempool(t, testTx)
+
+	tw.blocks.EXPECT().
+		Last(gomock.Any()).
+		Return(storage.Block{Height: 100}, nil).
+		Times(1)
+
+	var saved storage.MempoolTx
+	tw.mempool.EXPECT().
+		Insert(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, txs ...*storage.MempoolTx) error {
+			require.Len(t, txs, 1)
+			saved = *txs[0]
+			return nil
+		}).
+		Times(1)
+
+	tw.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelMempool, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, tw.Poll(t.Context()))
+	return saved
+}
+
+func TestWatcherObservesNewTx(t *testing.T) {
+	tw := newTestWatcher(t)
+
+	tx := tw.observe(t)
+	require.Equal(t, types.MempoolTxStatusPending, tx.Status)
+	require.Equal(t, testNow, tx.FirstSeen)
+	require.EqualValues(t, 100, tx.SeenHeight)
+	require.EqualValues(t, 171243, tx.GasWanted)
+	require.Equal(t, "4282", tx.Fee.String())
+	require.True(t, tx.GasPrice.GreaterThan(types.NumericZero()))
+	require.Equal(t, []string{"celestia1memcf6sqd00h6yzf0e8e8e707tjmszpd3lzc6e"}, tx.Signers)
+	require.Equal(t, []string{"MsgDelegate"}, tx.MessageTypes)
+	require.Equal(t, "Sent via Celenium.io", tx.Memo)
+	require.Len(t, tw.pending, 1)
+
+	// transaction is still in mempool: nothing changes
+	tw.expectMempool(t, testTx)
+	require.NoError(t, tw.Poll(t.Context()))
+	require.Len(t, tw.pending, 1)
+}
+
+func TestWatcherIncludedTx(t *testing.T) {
+	tw := newTestWatcher(t)
+	observed := tw.observe(t)
+
+	tw.now = func() time.Time { return testNow.Add(10 * time.Second) }
+	tw.expectMempool(t)
+
+	tw.txs.EXPECT().
+		ByHash(gomock.Any(), observed.Hash).
+		Return(storage.Tx{
+			Height: 102,
+			Time:   testNow.Add(6 * time.Second),
+		}, nil).
+		Times(1)
+
+	tw.mempool.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tx *storage.MempoolTx) error {
+			require.Equal(t, types.MempoolTxStatusIncluded, tx.Status)
+			require.EqualValues(t, 102, tx.Height)
+			require.EqualValues(t, 6000, tx.InclusionTime)
+			require.EqualValues(t, 2, tx.InclusionBlocks())
+			require.NotNil(t, tx.FinishedAt)
+			require.Equal(t, testNow.Add(6*time.Second), *tx.FinishedAt)
+			return nil
+		}).
+		Times(1)
+
+	tw.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelMempool, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, tw.Poll(t.Context()))
+	require.Empty(t, tw.pending)
+}
+
+func TestWatcherDroppedTx(t *testing.T) {
+	tw := newTestWatcher(t)
+	observed := tw.observe(t)
+
+	// transaction left mempool, but it's not indexed yet
+	tw.now = func() time.Time { return testNow.Add(10 * time.Second) }
+	tw.expectMempool(t)
+	tw.txs.EXPECT().
+		ByHash(gomock.Any(), observed.Hash).
+		Return(storage.Tx{}, sql.ErrNoRows).
+		Times(2)
+	tw.txs.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(2)
+
+	require.NoError(t, tw.Poll(t.Context()))
+	require.Len(t, tw.pending, 1)
+
+	// drop timeout is over
+	tw.now = func() time.Time { return testNow.Add(2 * time.Minute) }
+	tw.expectMempool(t)
+
+	tw.mempool.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tx *storage.MempoolTx) error {
+			require.Equal(t, types.MempoolTxStatusDropped, tx.Status)
+			require.EqualValues(t, 0, tx.Height)
+			require.EqualValues(t, 0, tx.InclusionTime)
+			require.NotNil(t, tx.FinishedAt)
+			return nil
+		}).
+		Times(1)
+
+	tw.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelMempool, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, tw.Poll(t.Context()))
+	require.Empty(t, tw.pending)
+}
+
+func TestWatcherIncompleteSnapshot(t *testing.T) {
+	tw := newTestWatcher(t)
+	observed := tw.observe(t)
+
+	tw.txs.EXPECT().
+		ByHash(gomock.Any(), observed.Hash).
+		Return(storage.Tx{}, sql.ErrNoRows).
+		Times(3)
+	tw.txs.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(3)
+
+	// node returned only a part of mempool without the transaction
+	tw.now = func() time.Time { return testNow.Add(10 * time.Second) }
+	tw.expectMempoolWithTotal(t, 200)
+	require.NoError(t, tw.Poll(t.Context()))
+	require.Len(t, tw.pending, 1)
+
+	// drop timeout is over, but transaction is still pending in the node
+	tw.now = func() time.Time { return testNow.Add(2 * time.Minute) }
+	tw.expectMempoolWithTotal(t, 200)
+	tw.api.EXPECT().
+		TxStatus(gomock.Any(), observed.Hash).
+		Return(nodeTypes.TxStatus{Status: nodeTypes.TxStatusPending}, nil).
+		Times(1)
+	require.NoError(t, tw.Poll(t.Context()))
+	require.Len(t, tw.pending, 1)
+
+	// node confirms that transaction was evicted
+	tw.now = func() time.Time { return testNow.Add(4 * time.Minute) }
+	tw.expectMempoolWithTotal(t, 200)
+	tw.api.EXPECT().
+		TxStatus(gomock.Any(), observed.Hash).
+		Return(nodeTypes.TxStatus{Status: nodeTypes.TxStatusEvicted}, nil).
+		Times(1)
+	tw.mempool.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tx *storage.MempoolTx) error {
+			require.Equal(t, types.MempoolTxStatusDropped, tx.Status)
+			return nil
+		}).
+		Times(1)
+	tw.notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelMempool, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, tw.Poll(t.Context()))
+	require.Empty(t, tw.pending)
+}
+
+func TestWatcherInit(t *testing.T) {
+	tw := newTestWatcher(t)
+
+	tw.mempool.EXPECT().
+		Pending(gomock.Any()).
+		Return([]storage.MempoolTx{
+			{
+				Id:         1,
+				Hash:       []byte{0x01},
+				Status:     types.MempoolTxStatusPending,
+				SeenHeight: pkgTypes.Level(90),
+			},
+		}, nil).
+		Times(1)
+
+	require.NoError(t, tw.Init(t.Context()))
+	require.Len(t, tw.pending, 1)
+	require.Contains(t, tw.pending, string([]byte{0x01}))
+}
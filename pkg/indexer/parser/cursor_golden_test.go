@@ -96,8 +96,22 @@ func TestCursorGolden(t *testing.T) {
 
 	// Every in-flight transfer opened during processing was resolved
 	// (matched to a fungible-token-packet event or explicitly discarded) by
-	// the time parseTxs returns — none should be left dangling.
-	require.Empty(t, decodeCtx.IbcTransfers)
+	// the time parseTxs returns — none should be left dangling. The only
+	// transfer is the one sent by MsgTransfer: it stays pending until the
+	// packet is acknowledged or timed out.
+	require.Len(t, decodeCtx.IbcTransfers, 1)
+	sent := decodeCtx.IbcTransfers[0]
+	require.Equal(t, storageTypes.IbcTransferStatusPending, sent.Status)
+	require.Equal(t, "transfer", sent.Port)
+	require.Equal(t, "channel-7", sent.ChannelId)
+	require.Equal(t, "connection-6", sent.ConnectionId)
+	require.EqualValues(t, 51744, sent.Sequence)
+	require.Equal(t, "150495000", sent.Amount.String())
+	require.Equal(t, "utia", sent.Denom)
+	require.NotNil(t, sent.Sender)
+	require.Equal(t, "celestia1fe5h88wjlyay5qwj2kz230z5rwm5r9fllcklde", sent.Sender.Address)
+	require.NotNil(t, sent.ReceiverAddress)
+	require.Equal(t, "inj1fqyry4vfnmj5wm6c4cket2fm544n7y6xpwpy7x", *sent.ReceiverAddress)
 
 	require.Equal(t, 71, decodeCtx.Addresses.Len())
 	require.Len(t, decodeCtx.BlobLogs, 32)
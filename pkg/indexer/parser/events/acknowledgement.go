@@ -104,7 +104,8 @@ func processAcknowledgement(ctx *context.Context, c *Cursor, msg *storage.Messag
 				hasFtp = true
 				ftp := decode.NewFungibleTokenPacket(event.Data)
 				if ftp.Error != "" {
-					ctx.RemoveLastIbcTransfer()
+					// error acknowledgement: tokens are refunded to the sender
+					transfer.Status = storageTypes.IbcTransferStatusRefunded
 					ctx.DeleteIbcChannel(chanId)
 				}
 			}
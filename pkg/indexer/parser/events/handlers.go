@@ -39,6 +39,9 @@ var eventHandlers = map[storageTypes.MsgType]EventHandler{
 	storageTypes.MsgChannelCloseConfirm:            handleChannelClose,
 	storageTypes.MsgAcknowledgement:                handleAcknowledgement,
 	storageTypes.MsgRecvPacket:                     handleRecvPacket,
+	storageTypes.MsgTimeout:                        handleTimeout,
+	storageTypes.MsgTimeoutOnClose:                 handleTimeout,
+	storageTypes.IBCTransfer:                       handleIbcTransfer,
 	storageTypes.MsgCreateMailbox:                  handleCreateMailbox,
 	storageTypes.MsgSetMailbox:                     handleSetMailbox,
 	storageTypes.MsgProcessMessage:                 handleHyperlaneProcessMessage,
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
+	"github.com/pkg/errors"
+)
+
+func handleIbcTransfer(ctx *context.Context, c *Cursor, msg *storage.Message) error {
+	if c == nil {
+		return errors.New("nil event cursor")
+	}
+	if msg == nil {
+		return errors.New("nil message in events handler")
+	}
+	event, _ := c.Peek()
+	action := decoder.StringFromMap(event.Data, "action")
+	isValidMsg := action == "/ibc.applications.transfer.v1.MsgTransfer"
+	if !isValidMsg {
+		return errors.Errorf("unexpected event action %s for message type %s", action, msg.Type.String())
+	}
+	c.Next()
+	return processIbcTransfer(ctx, c, msg)
+}
+
+func processIbcTransfer(ctx *context.Context, c *Cursor, msg *storage.Message) error {
+	transfer := ctx.GetLastIbcTransfer()
+	if transfer == nil || transfer.Status != storageTypes.IbcTransferStatusPending || transfer.TxId != msg.TxId {
+		c.SkipToNext("action")
+		return nil
+	}
+
+	var sent bool
+	for event := range c.MsgEvents("action") {
+		if event.Type != storageTypes.EventTypeSendPacket || sent {
+			continue
+		}
+		sp, err := decode.NewSendPacket(event.Data)
+		if err != nil {
+			return errors.Wrap(err, "send packet")
+		}
+		transfer.Sequence = sp.Sequence
+		transfer.ConnectionId = sp.Connection
+		transfer.ChannelId = sp.SrcChannel
+		sent = true
+	}
+
+	if !sent {
+		ctx.RemoveLastIbcTransfer()
+	}
+	return nil
+}
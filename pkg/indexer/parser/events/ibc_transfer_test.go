@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_handleIbcTransfer(t *testing.T) {
+	sendEvents := []storage.Event{
+		{
+			Height: 100,
+			Type:   "message",
+			Data: map[string]string{
+				"action": "/ibc.applications.transfer.v1.MsgTransfer",
+			},
+		}, {
+			Height: 100,
+			Type:   "send_packet",
+			Data: map[string]string{
+				"packet_channel_ordering":  "ORDER_UNORDERED",
+				"packet_connection":        "connection-2",
+				"packet_data":              "{\"amount\":\"1000\",\"denom\":\"utia\",\"receiver\":\"osmo1345fue0f2zwmfef4d48qfe38k0wfvca657jkm0\",\"sender\":\"celestia1345fue0f2zwmfef4d48qfe38k0wfvca6d0skhs\"}",
+				"packet_dst_channel":       "channel-6994",
+				"packet_dst_port":          "transfer",
+				"packet_sequence":          "1004901",
+				"packet_src_channel":       "channel-2",
+				"packet_src_port":          "transfer",
+				"packet_timeout_height":    "0-0",
+				"packet_timeout_timestamp": "1726667321908511033",
+			},
+		}, {
+			Height: 100,
+			Type:   "ibc_transfer",
+			Data: map[string]string{
+				"sender":   "celestia1345fue0f2zwmfef4d48qfe38k0wfvca6d0skhs",
+				"receiver": "osmo1345fue0f2zwmfef4d48qfe38k0wfvca657jkm0",
+			},
+		}, {
+			Height: 100,
+			Type:   "message",
+			Data: map[string]string{
+				"module": "transfer",
+			},
+		},
+	}
+
+	t.Run("pending transfer", func(t *testing.T) {
+		ctx := context.NewContext()
+		ctx.AddIbcTransfer(&storage.IbcTransfer{
+			TxId:   1,
+			Port:   "transfer",
+			Status: types.IbcTransferStatusPending,
+		})
+
+		c := NewCursor(sendEvents)
+		err := handleIbcTransfer(ctx, c, &storage.Message{TxId: 1, Type: types.IBCTransfer})
+		require.NoError(t, err)
+		require.Len(t, ctx.IbcTransfers, 1)
+		require.EqualValues(t, 1004901, ctx.IbcTransfers[0].Sequence)
+		require.Equal(t, "connection-2", ctx.IbcTransfers[0].ConnectionId)
+		require.Equal(t, "channel-2", ctx.IbcTransfers[0].ChannelId)
+		require.Equal(t, types.IbcTransferStatusPending, ctx.IbcTransfers[0].Status)
+		require.Empty(t, c.Remaining())
+	})
+
+	t.Run("without send packet", func(t *testing.T) {
+		ctx := context.NewContext()
+		ctx.AddIbcTransfer(&storage.IbcTransfer{
+			TxId:   1,
+			Port:   "transfer",
+			Status: types.IbcTransferStatusPending,
+		})
+
+		c := NewCursor(sendEvents[:1])
+		err := handleIbcTransfer(ctx, c, &storage.Message{TxId: 1, Type: types.IBCTransfer})
+		require.NoError(t, err)
+		require.Empty(t, ctx.IbcTransfers)
+	})
+
+	t.Run("unexpected action", func(t *testing.T) {
+		c := NewCursor(sendEvents[1:])
+		err := handleIbcTransfer(context.NewContext(), c, &storage.Message{TxId: 1, Type: types.IBCTransfer})
+		require.Error(t, err)
+	})
+}
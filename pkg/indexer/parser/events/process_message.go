@@ -62,6 +62,14 @@ func processHyperlaneProcessMessage(ctx *context.Context, c *Cursor, msg *storag
 			transfer.Nonce = processEvent.Message.Nonce
 			transfer.Body = processEvent.Message.Body
 			transfer.Type = types.HLTransferTypeReceive
+			transfer.DeliveryStatus = types.HLDeliveryStatusDelivered
+			transfer.DeliveredAt = &transfer.Time
+
+			messageId, err := util.DecodeHexAddress(processEvent.MessageId)
+			if err != nil {
+				return errors.Wrap(err, "decode message id")
+			}
+			transfer.MessageId = messageId.Bytes()
 
 			if metadata := msg.Data.GetStringOrDefault("Metadata"); metadata != "" {
 				decodedMetadata, err := util.DecodeEthHex(metadata)
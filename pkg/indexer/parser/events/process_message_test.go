@@ -4,6 +4,7 @@
 package events
 
 import (
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -118,6 +119,10 @@ func Test_handleHyperlaneProcessMessage(t *testing.T) {
 
 				require.NotNil(t, tt.ctx.HlTransfers[0])
 				require.NotNil(t, tt.ctx.HlTransfers[0].Address)
+				require.Equal(t, "d3aa80e8a5f8082cba208900a4ad3aec15516f423a89ef7803a9da35e2e58369", hex.EncodeToString(tt.ctx.HlTransfers[0].MessageId))
+				require.Equal(t, types.HLDeliveryStatusDelivered, tt.ctx.HlTransfers[0].DeliveryStatus)
+				require.NotNil(t, tt.ctx.HlTransfers[0].DeliveredAt)
+				require.Nil(t, tt.ctx.HlTransfers[0].DispatchedAt)
 			}
 		})
 	}
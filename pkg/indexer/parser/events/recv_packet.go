@@ -128,7 +128,8 @@ func processRecvPacket(ctx *context.Context, c *Cursor, msg *storage.Message) er
 				hasFtp = true
 				ftp := decode.NewFungibleTokenPacket(next.Data)
 				if ftp.Error != "" {
-					ctx.RemoveLastIbcTransfer()
+					// error acknowledgement is written, the counterparty refunds the sender
+					transfer.Status = storageTypes.IbcTransferStatusFailed
 					ctx.DeleteIbcChannel(chanId)
 				}
 			}
@@ -53,6 +53,9 @@ func processHyperlaneRemoteTransfer(ctx *context.Context, c *Cursor, msg *storag
 			transfer.Nonce = dispatchEvent.Message.Nonce
 			transfer.Body = dispatchEvent.Message.Body
 			transfer.Type = types.HLTransferTypeSend
+			transfer.MessageId = dispatchEvent.Message.Id().Bytes()
+			transfer.DeliveryStatus = types.HLDeliveryStatusPending
+			transfer.DispatchedAt = &transfer.Time
 
 			transfer.Mailbox = &storage.HLMailbox{
 				Mailbox:      originMailboxId.Bytes(),
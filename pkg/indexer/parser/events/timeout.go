@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
+	"github.com/pkg/errors"
+)
+
+func handleTimeout(ctx *context.Context, c *Cursor, msg *storage.Message) error {
+	if c == nil {
+		return errors.New("nil event cursor")
+	}
+	if msg == nil {
+		return errors.New("nil message in events handler")
+	}
+	event, _ := c.Peek()
+	action := decoder.StringFromMap(event.Data, "action")
+	isValidMsg := action == "/ibc.core.channel.v1.MsgTimeout" || action == "/ibc.core.channel.v1.MsgTimeoutOnClose"
+	if !isValidMsg {
+		return errors.Errorf("unexpected event action %s for message type %s", action, msg.Type.String())
+	}
+	c.Next()
+	return processTimeout(ctx, c, msg)
+}
+
+func processTimeout(ctx *context.Context, c *Cursor, msg *storage.Message) error {
+	transfer := ctx.GetLastIbcTransfer()
+	if transfer == nil || transfer.Status != storageTypes.IbcTransferStatusTimedOut || transfer.TxId != msg.TxId {
+		c.SkipToNext("action")
+		return nil
+	}
+
+	var timedOut bool
+	for event := range c.MsgEvents("action") {
+		if event.Type != storageTypes.EventTypeTimeoutPacket {
+			continue
+		}
+		tp, err := decode.NewTimeoutPacket(event.Data)
+		if err != nil {
+			return errors.Wrap(err, "timeout packet")
+		}
+		transfer.ConnectionId = tp.PacketConnection
+		timedOut = true
+	}
+
+	// packet was already timed out by another relayer, message is no-op
+	if !timedOut {
+		ctx.RemoveLastIbcTransfer()
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_handleTimeout(t *testing.T) {
+	timeoutEvents := []storage.Event{
+		{
+			Height: 100,
+			Type:   "message",
+			Data: map[string]string{
+				"action": "/ibc.core.channel.v1.MsgTimeout",
+			},
+		}, {
+			Height: 100,
+			Type:   "timeout_packet",
+			Data: map[string]string{
+				"packet_channel_ordering":  "ORDER_UNORDERED",
+				"packet_connection":        "connection-2",
+				"packet_dst_channel":       "channel-6994",
+				"packet_dst_port":          "transfer",
+				"packet_sequence":          "1004901",
+				"packet_src_channel":       "channel-2",
+				"packet_src_port":          "transfer",
+				"packet_timeout_height":    "0-0",
+				"packet_timeout_timestamp": "1726667321908511033",
+			},
+		}, {
+			Height: 100,
+			Type:   "message",
+			Data: map[string]string{
+				"module": "ibc_channel",
+			},
+		}, {
+			Height: 100,
+			Type:   "timeout",
+			Data: map[string]string{
+				"refund_receiver": "celestia1345fue0f2zwmfef4d48qfe38k0wfvca6d0skhs",
+				"refund_denom":    "utia",
+				"refund_amount":   "1000",
+			},
+		},
+	}
+
+	t.Run("timed out transfer", func(t *testing.T) {
+		ctx := context.NewContext()
+		ctx.AddIbcTransfer(&storage.IbcTransfer{
+			TxId:   1,
+			Port:   "transfer",
+			Status: types.IbcTransferStatusTimedOut,
+		})
+
+		c := NewCursor(timeoutEvents)
+		err := handleTimeout(ctx, c, &storage.Message{TxId: 1, Type: types.MsgTimeout})
+		require.NoError(t, err)
+		require.Len(t, ctx.IbcTransfers, 1)
+		require.Equal(t, "connection-2", ctx.IbcTransfers[0].ConnectionId)
+		require.Empty(t, c.Remaining())
+	})
+
+	t.Run("redundant relay", func(t *testing.T) {
+		ctx := context.NewContext()
+		ctx.AddIbcTransfer(&storage.IbcTransfer{
+			TxId:   1,
+			Port:   "transfer",
+			Status: types.IbcTransferStatusTimedOut,
+		})
+
+		c := NewCursor(timeoutEvents[:1])
+		err := handleTimeout(ctx, c, &storage.Message{TxId: 1, Type: types.MsgTimeout})
+		require.NoError(t, err)
+		require.Empty(t, ctx.IbcTransfers)
+	})
+}
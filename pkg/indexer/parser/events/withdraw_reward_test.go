@@ -98,3 +98,33 @@ func Test_handleWithdrawRewards(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseWithdrawRewards(t *testing.T) {
+	ctx := context.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 848613,
+	}
+	msg := &storage.Message{
+		Type:   types.MsgWithdrawDelegatorReward,
+		Height: 848613,
+	}
+
+	err := parseWithdrawRewards(ctx, msg, map[string]string{
+		"amount":    "1000utia",
+		"validator": "celestiavaloper1u5pshtqpexjmuudrvq6q335qym2zggzhyp5ee8",
+		"delegator": "celestia1u5pshtqpexjmuudrvq6q335qym2zggzhp7kq0p",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, ctx.StakingLogs, 1)
+	log := ctx.StakingLogs[0]
+	require.Equal(t, types.StakingLogTypeRewards, log.Type)
+	require.Equal(t, "-1000", log.Change.String())
+	require.NotNil(t, log.Address)
+	require.Equal(t, "celestia1u5pshtqpexjmuudrvq6q335qym2zggzhp7kq0p", log.Address.Address)
+	require.NotNil(t, log.Validator)
+	require.Equal(t, "celestiavaloper1u5pshtqpexjmuudrvq6q335qym2zggzhyp5ee8", log.Validator.Address)
+
+	_, ok := ctx.Validators.Get("celestiavaloper1u5pshtqpexjmuudrvq6q335qym2zggzhyp5ee8")
+	require.False(t, ok, "validator's rewards are not changed by delegator withdrawal")
+}
@@ -18,10 +18,14 @@ func parseWithdrawRewards(ctx *context.Context, msg *storage.Message, data map[s
 		return err
 	}
 
-	if rewards.Validator == "" || rewards.Delegator != "" {
+	if rewards.Validator == "" {
 		return nil
 	}
 
+	if rewards.Delegator != "" {
+		return parseDelegatorWithdrawal(ctx, msg, rewards)
+	}
+
 	validator := storage.EmptyValidator()
 	validator.Address = rewards.Validator
 
@@ -57,6 +61,37 @@ func parseWithdrawRewards(ctx *context.Context, msg *storage.Message, data map[s
 	return nil
 }
 
+// parseDelegatorWithdrawal - records rewards withdrawal of the delegator to the rewards ledger. Validator's rewards are not changed.
+func parseDelegatorWithdrawal(ctx *context.Context, msg *storage.Message, rewards decode.WithdrawRewards) error {
+	if rewards.Amount == nil || rewards.Amount.IsZero() {
+		return nil
+	}
+	amount, err := storageTypes.NumericFromString(rewards.Amount.Amount.String())
+	if err != nil {
+		return errors.Wrap(err, "parse withdraw rewards amount")
+	}
+
+	validator := storage.EmptyValidator()
+	validator.Address = rewards.Validator
+
+	rewardReceiver := &storage.Address{
+		Address:    rewards.Delegator,
+		Height:     ctx.Block.Height,
+		LastHeight: ctx.Block.Height,
+		Balances:   []storage.Balance{storage.EmptyBalance()},
+	}
+	ctx.AddStakingLog(storage.StakingLog{
+		Height:    msg.Height,
+		Time:      msg.Time,
+		Validator: &validator,
+		Address:   rewardReceiver,
+		Change:    amount.Neg(),
+		Type:      storageTypes.StakingLogTypeRewards,
+	})
+
+	return ctx.AddAddress(rewardReceiver)
+}
+
 func handleWithdrawDelegatorRewards(ctx *context.Context, c *Cursor, msg *storage.Message) error {
 	if c == nil {
 		return errors.New("nil event cursor")
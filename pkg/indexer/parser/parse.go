@@ -22,6 +22,24 @@ func (p *Module) parse(b *types.BlockData) error {
 		Int64("height", b.Block.Height).
 		Msg("parsing block...")
 
+	decodeCtx, err := p.Decode(b)
+	if err != nil {
+		return err
+	}
+
+	p.Log.Info().
+		Uint64("height", uint64(decodeCtx.Block.Height)).
+		Int64("ms", time.Since(start).Milliseconds()).
+		Msg("block parsed")
+
+	output := p.MustOutput(OutputName)
+	output.Push(decodeCtx)
+
+	return nil
+}
+
+// Decode - decodes block data to context which can be saved by storage module
+func (p *Module) Decode(b *types.BlockData) (*dCtx.Context, error) {
 	decodeCtx := dCtx.NewContext()
 
 	decodeCtx.Block = &storage.Block{
@@ -65,10 +83,16 @@ func (p *Module) parse(b *types.BlockData) error {
 
 	txs, err := p.parseTxs(decodeCtx, b)
 	if err != nil {
-		return errors.Wrapf(err, "while parsing block on level=%d", b.Height)
+		return nil, errors.Wrapf(err, "while parsing block on level=%d", b.Height)
 	}
 	decodeCtx.Block.Txs = txs
 
+	shareRanges, err := parseShareRanges(b, txs)
+	if err != nil {
+		p.Log.Warn().Err(err).Int64("height", b.Block.Height).Msg("can't build square layout")
+	}
+	decodeCtx.ShareRanges = shareRanges
+
 	for i := range b.Block.Txs {
 		decodeCtx.Block.Stats.BytesInBlock += int64(len(b.Block.Txs[i]))
 	}
@@ -78,19 +102,11 @@ func (p *Module) parse(b *types.BlockData) error {
 
 	blockEvents, err := parseBlockEvents(decodeCtx, b, b.FinalizeBlockEvents, getFirstTxEvent(b.TxsResults))
 	if err != nil {
-		return errors.Wrap(err, "parsing begin end events")
+		return nil, errors.Wrap(err, "parsing begin end events")
 	}
 	decodeCtx.AddEvents(blockEvents...)
 
-	p.Log.Info().
-		Uint64("height", uint64(decodeCtx.Block.Height)).
-		Int64("ms", time.Since(start).Milliseconds()).
-		Msg("block parsed")
-
-	output := p.MustOutput(OutputName)
-	output.Push(decodeCtx)
-
-	return nil
+	return decodeCtx, nil
 }
 
 func (p *Module) parseBlockSignatures(commit *types.Commit) []storage.BlockSignature {
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"bytes"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/celestiaorg/celestia-app/v9/pkg/appconsts"
+	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/pkg/errors"
+)
+
+// parseShareRanges - builds original data square of the block and splits it into contiguous ranges of shares.
+// Every blob gets its own range linked to the pay for blob transaction, other shares are grouped by namespace.
+func parseShareRanges(b *types.BlockData, txs []storage.Tx) ([]storage.ShareRange, error) {
+	if len(b.Block.Txs) == 0 {
+		return nil, nil
+	}
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, b.Block.Txs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating square builder")
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, errors.Wrap(err, "building square")
+	}
+
+	blobs := make(map[int]storage.ShareRange, len(builder.Blobs))
+	for _, blob := range builder.Blobs {
+		txIndex := len(builder.Txs) + blob.PfbIndex
+		start, err := builder.FindBlobStartingIndex(txIndex, blob.BlobIndex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "blob %d of tx %d", blob.BlobIndex, txIndex)
+		}
+		if txIndex >= len(txs) {
+			return nil, errors.Errorf("unknown pay for blob transaction with index %d", txIndex)
+		}
+		blobs[start] = storage.ShareRange{
+			Height:    b.Height,
+			Time:      b.Block.Time,
+			Kind:      storageTypes.ShareRangeKindBlob,
+			Namespace: blob.Blob.Namespace().Bytes(),
+			Start:     int64(start),
+			End:       int64(start + blob.NumShares),
+			TxId:      txs[txIndex].Id,
+			BlobIndex: blob.BlobIndex,
+		}
+	}
+
+	ranges := make([]storage.ShareRange, 0)
+	for i := 0; i < len(dataSquare); {
+		if blob, ok := blobs[i]; ok {
+			ranges = append(ranges, blob)
+			i = int(blob.End)
+			continue
+		}
+
+		kind := shareRangeKind(&dataSquare[i])
+		namespace := dataSquare[i].Namespace().Bytes()
+
+		last := len(ranges) - 1
+		if last >= 0 && ranges[last].Kind == kind && kind != storageTypes.ShareRangeKindBlob && bytes.Equal(ranges[last].Namespace, namespace) {
+			ranges[last].End++
+		} else {
+			ranges = append(ranges, storage.ShareRange{
+				Height:    b.Height,
+				Time:      b.Block.Time,
+				Kind:      kind,
+				Namespace: namespace,
+				Start:     int64(i),
+				End:       int64(i + 1),
+			})
+		}
+		i++
+	}
+
+	return ranges, nil
+}
+
+func shareRangeKind(s *share.Share) storageTypes.ShareRangeKind {
+	ns := s.Namespace()
+	switch {
+	case ns.IsTx():
+		return storageTypes.ShareRangeKindTx
+	case ns.IsPayForBlob():
+		return storageTypes.ShareRangeKindPayForBlob
+	case ns.IsPrimaryReservedPadding():
+		return storageTypes.ShareRangeKindPrimaryReservedPadding
+	case ns.IsTailPadding():
+		return storageTypes.ShareRangeKindTailPadding
+	case s.IsPadding():
+		return storageTypes.ShareRangeKindNamespacePadding
+	default:
+		// shares of blobs are handled by builder, so it's unreachable for valid squares
+		return storageTypes.ShareRangeKindBlob
+	}
+}
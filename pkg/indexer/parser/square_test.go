@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	squareTx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseShareRanges(t *testing.T) {
+	t.Run("empty block", func(t *testing.T) {
+		ranges, err := parseShareRanges(&types.BlockData{
+			ResultBlock: types.ResultBlock{
+				Block: &types.Block{},
+			},
+		}, nil)
+		require.NoError(t, err)
+		require.Empty(t, ranges)
+	})
+
+	t.Run("tx and blob tx", func(t *testing.T) {
+		ns := share.MustNewV0Namespace(bytes.Repeat([]byte{0x01}, share.NamespaceVersionZeroIDSize))
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{0xff}, 1000))
+		require.NoError(t, err)
+
+		blobTx, err := squareTx.MarshalBlobTx(bytes.Repeat([]byte{0x02}, 100), blob)
+		require.NoError(t, err)
+
+		ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		block := &types.BlockData{
+			ResultBlock: types.ResultBlock{
+				Block: &types.Block{
+					Header: types.Header{Time: ts},
+					Data: types.Data{
+						Txs: [][]byte{
+							bytes.Repeat([]byte{0x03}, 100),
+							blobTx,
+						},
+					},
+				},
+			},
+			ResultBlockResults: types.ResultBlockResults{
+				Height: 100,
+			},
+		}
+		txs := []storage.Tx{{Id: 1}, {Id: 2}}
+
+		ranges, err := parseShareRanges(block, txs)
+		require.NoError(t, err)
+		require.NotEmpty(t, ranges)
+
+		require.EqualValues(t, 0, ranges[0].Start)
+		require.Equal(t, storageTypes.ShareRangeKindTx, ranges[0].Kind)
+		require.Equal(t, storageTypes.ShareRangeKindTailPadding, ranges[len(ranges)-1].Kind)
+
+		var blobs int
+		for i := range ranges {
+			require.EqualValues(t, 100, ranges[i].Height)
+			require.Equal(t, ts, ranges[i].Time)
+			require.Positive(t, ranges[i].Size())
+			if i > 0 {
+				require.Equal(t, ranges[i-1].End, ranges[i].Start, "ranges should be contiguous")
+			}
+
+			if ranges[i].Kind != storageTypes.ShareRangeKindBlob {
+				require.Zero(t, ranges[i].TxId)
+				continue
+			}
+			blobs++
+			require.Equal(t, ns.Bytes(), ranges[i].Namespace)
+			require.EqualValues(t, 2, ranges[i].TxId)
+			require.EqualValues(t, 0, ranges[i].BlobIndex)
+			require.EqualValues(t, share.SparseSharesNeeded(1000, false), ranges[i].Size())
+		}
+		require.Equal(t, 1, blobs)
+	})
+}
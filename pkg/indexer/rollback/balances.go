@@ -16,9 +16,14 @@ import (
 func (module *Module) rollbackBalances(
 	ctx context.Context,
 	tx storage.Transaction,
+	height pkgTypes.Level,
 	deletedEvents []storage.Event,
 	deletedAddresses []storage.Address,
 ) error {
+	if _, err := tx.RollbackBalanceUpdates(ctx, height); err != nil {
+		return err
+	}
+
 	var (
 		ids     = make([]uint64, len(deletedAddresses))
 		deleted = make(map[string]struct{}, len(deletedAddresses))
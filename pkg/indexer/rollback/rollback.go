@@ -12,6 +12,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 
+	json "github.com/bytedance/sonic"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
@@ -27,6 +28,7 @@ const (
 )
 
 // Module - executes rollback on signal from input and notify all subscribers about new state after rollback operation.
+// Removed heights are sent to `rollback` notification channel, so API can invalidate its caches.
 //
 //	                |----------------|
 //	                |                |
@@ -35,11 +37,12 @@ const (
 //	                |----------------|
 type Module struct {
 	modules.BaseModule
-	tx        sdk.Transactable
-	state     storage.IState
-	blocks    storage.IBlock
-	node      node.Api
-	indexName string
+	tx          sdk.Transactable
+	state       storage.IState
+	blocks      storage.IBlock
+	notificator storage.Notificator
+	node        node.Api
+	indexName   string
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -48,16 +51,18 @@ func NewModule(
 	tx sdk.Transactable,
 	state storage.IState,
 	blocks storage.IBlock,
+	notificator storage.Notificator,
 	node node.Api,
 	cfg config.Indexer,
 ) Module {
 	module := Module{
-		BaseModule: modules.New("rollback"),
-		tx:         tx,
-		state:      state,
-		blocks:     blocks,
-		node:       node,
-		indexName:  cfg.Name,
+		BaseModule:  modules.New("rollback"),
+		tx:          tx,
+		state:       state,
+		blocks:      blocks,
+		notificator: notificator,
+		node:        node,
+		indexName:   cfg.Name,
 	}
 
 	module.CreateInput(InputName)
@@ -102,6 +107,7 @@ func (module *Module) Close() error {
 }
 
 func (module *Module) rollback(ctx context.Context) error {
+	var fromHeight types.Level
 	for {
 		select {
 		case <-ctx.Done():
@@ -110,6 +116,9 @@ func (module *Module) rollback(ctx context.Context) error {
 			if err != nil {
 				return errors.Wrap(err, "receive last block from database")
 			}
+			if fromHeight == 0 {
+				fromHeight = lastBlock.Height
+			}
 
 			nodeBlock, err := module.node.Block(ctx, lastBlock.Height)
 			if err != nil {
@@ -123,7 +132,7 @@ func (module *Module) rollback(ctx context.Context) error {
 				Msg("comparing hash...")
 
 			if bytes.Equal(lastBlock.Hash, nodeBlock.BlockID.Hash) {
-				return module.finish(ctx)
+				return module.finish(ctx, fromHeight)
 			}
 
 			log.Warn().
@@ -139,7 +148,7 @@ func (module *Module) rollback(ctx context.Context) error {
 	}
 }
 
-func (module *Module) finish(ctx context.Context) error {
+func (module *Module) finish(ctx context.Context, fromHeight types.Level) error {
 	newState, err := module.state.ByName(ctx, module.indexName)
 	if err != nil {
 		return err
@@ -150,9 +159,29 @@ func (module *Module) finish(ctx context.Context) error {
 		Uint64("new_height", uint64(newState.LastHeight)).
 		Msg("roll backed to new height")
 
+	if newState.LastHeight < fromHeight {
+		module.notify(ctx, storage.Rollback{
+			FromHeight: fromHeight,
+			ToHeight:   newState.LastHeight,
+		})
+	}
 	return nil
 }
 
+func (module *Module) notify(ctx context.Context, rollback storage.Rollback) {
+	if module.notificator == nil {
+		return
+	}
+	payload, err := json.MarshalString(rollback)
+	if err != nil {
+		module.Log.Err(err).Msg("marshal rollback notification")
+		return
+	}
+	if err := module.notificator.Notify(ctx, storage.ChannelRollback, payload); err != nil {
+		module.Log.Err(err).Msg("notify about rollback")
+	}
+}
+
 func (module *Module) rollbackBlock(ctx context.Context, height types.Level) error {
 	tx, err := postgres.BeginTransaction(ctx, module.tx)
 	if err != nil {
@@ -186,7 +215,7 @@ func (module *Module) rollbackBlock(ctx context.Context, height types.Level) err
 		return tx.HandleError(ctx, err)
 	}
 
-	if err := module.rollbackBalances(ctx, tx, events, addresses); err != nil {
+	if err := module.rollbackBalances(ctx, tx, height, events, addresses); err != nil {
 		return tx.HandleError(ctx, err)
 	}
 
@@ -199,7 +228,10 @@ func (module *Module) rollbackBlock(ctx context.Context, height types.Level) err
 		return err
 	}
 
-	if err := tx.RollbackBlobLog(ctx, height); err != nil {
+	if _, err := tx.RollbackBlobLog(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackShareRanges(ctx, height); err != nil {
 		return tx.HandleError(ctx, err)
 	}
 	if err := tx.RollbackGrants(ctx, height); err != nil {
@@ -216,6 +248,15 @@ func (module *Module) rollbackBlock(ctx context.Context, height types.Level) err
 	if err := tx.RollbackVotes(ctx, height); err != nil {
 		return err
 	}
+	if err := tx.RollbackProposalTallies(ctx, height); err != nil {
+		return err
+	}
+	if err := tx.RollbackEffectiveVotes(ctx, height); err != nil {
+		return err
+	}
+	if err := tx.RollbackSupply(ctx, height); err != nil {
+		return err
+	}
 	if err := tx.RollbackProposals(ctx, height); err != nil {
 		return err
 	}
@@ -129,6 +129,7 @@ func (s *ModuleTestSuite) TestModule_SuccessOnRollbackTwoBlocks() {
 		s.storage.Transactable,
 		s.storage.State,
 		s.storage.Blocks,
+		s.storage.Notificator,
 		s.api,
 		indexerCfg.Indexer{Name: testIndexerName},
 	)
@@ -197,6 +198,7 @@ func (s *ModuleTestSuite) TestModule_OnClosedInput() {
 		s.storage.Transactable,
 		s.storage.State,
 		s.storage.Blocks,
+		s.storage.Notificator,
 		s.api,
 		indexerCfg.Indexer{Name: testIndexerName},
 	)
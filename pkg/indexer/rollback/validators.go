@@ -48,6 +48,9 @@ func rollbackValidators(
 	if err := tx.RollbackRedelegations(ctx, height); err != nil {
 		return result, err
 	}
+	if err := tx.RollbackValidatorRates(ctx, height); err != nil {
+		return result, err
+	}
 	jails, err := tx.RollbackJails(ctx, height)
 	if err != nil {
 		return result, err
@@ -169,7 +172,8 @@ func rollbackValidators(
 			}
 
 		case st.StakingLogTypeRewards:
-			if removed {
+			// withdrawals of delegators are recorded to the rewards ledger only and don't change validator's rewards
+			if removed || logs[i].AddressId != nil {
 				continue
 			}
 			if val, ok := updated[logs[i].ValidatorId]; ok {
@@ -5,8 +5,10 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/pkg/errors"
 )
 
@@ -40,6 +42,31 @@ func saveAddresses(
 	return addToId, totalAccounts, err
 }
 
+// saveBalanceUpdates - saves non-empty balance deltas of the block. Deltas of slashing are marked because they aren't emitted by block parsing.
+func saveBalanceUpdates(
+	ctx context.Context,
+	tx storage.Transaction,
+	height types.Level,
+	ts time.Time,
+	balances []storage.Balance,
+	slashing bool,
+) error {
+	if len(balances) == 0 {
+		return nil
+	}
+
+	updates := make([]storage.BalanceUpdate, 0, len(balances))
+	for i := range balances {
+		update := storage.NewBalanceUpdate(height, ts, balances[i])
+		if update.IsEmpty() {
+			continue
+		}
+		update.Slashing = slashing
+		updates = append(updates, update)
+	}
+	return tx.SaveBalanceUpdates(ctx, updates...)
+}
+
 func saveSigners(
 	ctx context.Context,
 	tx storage.Transaction,
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/pkg/errors"
 )
 
@@ -91,5 +92,63 @@ func saveIbcTransfers(
 		}
 	}
 
+	transfers, err := resolveIbcTransfers(ctx, tx, transfers)
+	if err != nil {
+		return errors.Wrap(err, "resolve ibc transfers")
+	}
 	return tx.SaveIbcTransfers(ctx, transfers...)
 }
+
+type packetKey struct {
+	port     string
+	channel  string
+	sequence uint64
+}
+
+// resolveIbcTransfers - correlates acknowledgements and timeouts with transfers sent from Celestia by port, channel and sequence.
+// Resolved pending transfers are updated in place, the rest of resolutions are saved as new transfers
+// because the packet was sent before the transfer lifecycle was tracked.
+func resolveIbcTransfers(ctx context.Context, tx storage.Transaction, transfers []*storage.IbcTransfer) ([]*storage.IbcTransfer, error) {
+	pending := make(map[packetKey]*storage.IbcTransfer)
+	result := make([]*storage.IbcTransfer, 0, len(transfers))
+
+	for i := range transfers {
+		key := packetKey{
+			port:     transfers[i].Port,
+			channel:  transfers[i].ChannelId,
+			sequence: transfers[i].Sequence,
+		}
+
+		if transfers[i].Status == types.IbcTransferStatusPending {
+			pending[key] = transfers[i]
+			result = append(result, transfers[i])
+			continue
+		}
+
+		if !transfers[i].IsResolution() {
+			result = append(result, transfers[i])
+			continue
+		}
+
+		if sent, ok := pending[key]; ok {
+			sent.Status = transfers[i].Status
+			sent.ResolveTxId = transfers[i].ResolveTxId
+			sent.ResolveHeight = transfers[i].ResolveHeight
+			if transfers[i].ConnectionId != "" {
+				sent.ConnectionId = transfers[i].ConnectionId
+			}
+			delete(pending, key)
+			continue
+		}
+
+		resolved, err := tx.ResolveIbcTransfer(ctx, transfers[i])
+		if err != nil {
+			return nil, err
+		}
+		if !resolved {
+			result = append(result, transfers[i])
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"bytes"
+	"context"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+)
+
+func (module *Module) notifyEntities(ctx context.Context, dCtx *decodeContext.Context) error {
+	if len(dCtx.Block.Txs) > 0 {
+		txs := make([]storage.Tx, len(dCtx.Block.Txs))
+		for i := range dCtx.Block.Txs {
+			txs[i] = txNotification(dCtx.Block.Txs[i])
+		}
+		if err := notifyBatch(ctx, module, storage.ChannelTx, txs); err != nil {
+			return err
+		}
+	}
+
+	if len(dCtx.Messages) > 0 {
+		msgs := make([]storage.Message, len(dCtx.Messages))
+		for i := range dCtx.Messages {
+			msgs[i] = messageNotification(*dCtx.Messages[i])
+		}
+		if err := notifyBatch(ctx, module, storage.ChannelMessage, msgs); err != nil {
+			return err
+		}
+	}
+
+	if len(dCtx.BlobLogs) > 0 {
+		blobs := make([]storage.BlobLog, len(dCtx.BlobLogs))
+		for i := range dCtx.BlobLogs {
+			blobs[i] = blobNotification(*dCtx.BlobLogs[i])
+		}
+		if err := notifyBatch(ctx, module, storage.ChannelBlob, blobs); err != nil {
+			return err
+		}
+	}
+
+	// addresses which balances were updated in the block
+	if dCtx.Addresses.Len() > 0 {
+		addresses := make([]storage.Address, 0, dCtx.Addresses.Len())
+		for address := range dCtx.Addresses.AllValues() {
+			addresses = append(addresses, addressNotification(*address))
+		}
+		if err := notifyBatch(ctx, module, storage.ChannelAddress, addresses); err != nil {
+			return err
+		}
+	}
+
+	if dCtx.Jails.Len() > 0 {
+		jails := make([]storage.Jail, 0, dCtx.Jails.Len())
+		for j := range dCtx.Jails.AllValues() {
+			jails = append(jails, jailNotification(*j))
+		}
+		if err := notifyBatch(ctx, module, storage.ChannelJail, jails); err != nil {
+			return err
+		}
+	}
+
+	if dCtx.Proposals.Len() > 0 {
+		proposals := make([]storage.Proposal, 0)
+		for proposal := range dCtx.Proposals.AllValues() {
+			// only proposals which entered voting period in the block
+			if proposal.Status != types.ProposalStatusActive || proposal.ActivationTime == nil {
+				continue
+			}
+			proposals = append(proposals, proposalNotification(*proposal))
+		}
+		if err := notifyBatch(ctx, module, storage.ChannelProposal, proposals); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyBatch - sends items as JSON arrays splitted by maximum notification payload size
+func notifyBatch[T any](ctx context.Context, module *Module, channel string, items []T) error {
+	var (
+		buf   = new(bytes.Buffer)
+		count int
+	)
+
+	flush := func() error {
+		if count == 0 {
+			return nil
+		}
+		buf.WriteByte(']')
+		err := module.notificator.Notify(ctx, channel, buf.String())
+		buf.Reset()
+		count = 0
+		return err
+	}
+
+	for i := range items {
+		raw, err := json.Marshal(items[i])
+		if err != nil {
+			return err
+		}
+		if len(raw)+2 > storage.MaxNotificationPayloadSize {
+			module.Log.Warn().Str("channel", channel).Int("size", len(raw)).Msg("notification item is too large, skipping")
+			continue
+		}
+		if buf.Len()+len(raw)+2 > storage.MaxNotificationPayloadSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		if count == 0 {
+			buf.WriteByte('[')
+		} else {
+			buf.WriteByte(',')
+		}
+		buf.Write(raw)
+		count++
+	}
+
+	return flush()
+}
+
+func txNotification(tx storage.Tx) storage.Tx {
+	signers := make([]storage.Address, len(tx.Signers))
+	for i := range tx.Signers {
+		signers[i] = storage.Address{
+			Address: tx.Signers[i].Address,
+		}
+	}
+	tx.Signers = signers
+	tx.Messages = nil
+	tx.Events = nil
+	return tx
+}
+
+func messageNotification(msg storage.Message) storage.Message {
+	addresses := make([]storage.AddressWithType, len(msg.Addresses))
+	for i := range msg.Addresses {
+		addresses[i] = storage.AddressWithType{
+			Address: storage.Address{
+				Address: msg.Addresses[i].Address.Address,
+			},
+			Type: msg.Addresses[i].Type,
+		}
+	}
+	msg.Addresses = addresses
+	if msg.Size > storage.MaxNotificationPayloadSize/2 {
+		msg.Data = nil
+	}
+	msg.Namespace = nil
+	msg.InternalMsgs = nil
+	msg.Proposal = nil
+	msg.Validators = nil
+	return msg
+}
+
+func blobNotification(blob storage.BlobLog) storage.BlobLog {
+	if blob.Signer != nil {
+		blob.Signer = &storage.Address{
+			Id:      blob.SignerId,
+			Address: blob.Signer.Address,
+		}
+	}
+	if blob.Namespace != nil {
+		blob.Namespace = &storage.Namespace{
+			Id:          blob.Namespace.Id,
+			Version:     blob.Namespace.Version,
+			NamespaceID: blob.Namespace.NamespaceID,
+		}
+	}
+	blob.Message = nil
+	blob.Tx = nil
+	blob.Rollup = nil
+	return blob
+}
+
+func addressNotification(address storage.Address) storage.Address {
+	return storage.Address{
+		Id:      address.Id,
+		Address: address.Address,
+	}
+}
+
+func jailNotification(jail storage.Jail) storage.Jail {
+	jail.Validator = nil
+	return jail
+}
+
+func proposalNotification(proposal storage.Proposal) storage.Proposal {
+	return storage.Proposal{
+		Id:             proposal.Id,
+		Height:         proposal.Height,
+		Status:         proposal.Status,
+		ActivationTime: proposal.ActivationTime,
+		EndTime:        proposal.EndTime,
+	}
+}
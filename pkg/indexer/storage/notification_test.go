@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type testNotificationItem struct {
+	Id   int    `json:"id"`
+	Data string `json:"data"`
+}
+
+func TestNotifyBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificator := mock.NewMockNotificator(ctrl)
+	module := &Module{notificator: notificator}
+
+	items := make([]testNotificationItem, 10)
+	for i := range items {
+		items[i] = testNotificationItem{
+			Id:   i,
+			Data: strings.Repeat("a", storage.MaxNotificationPayloadSize/4),
+		}
+	}
+	// too large item has to be skipped
+	items = append(items, testNotificationItem{
+		Id:   100,
+		Data: strings.Repeat("b", storage.MaxNotificationPayloadSize),
+	})
+
+	var received []testNotificationItem
+	notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelBlob, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, payload string) error {
+			require.LessOrEqual(t, len(payload), storage.MaxNotificationPayloadSize)
+
+			var batch []testNotificationItem
+			require.NoError(t, json.UnmarshalString(payload, &batch))
+			received = append(received, batch...)
+			return nil
+		}).
+		Times(4)
+
+	err := notifyBatch(t.Context(), module, storage.ChannelBlob, items)
+	require.NoError(t, err)
+	require.Len(t, received, 10)
+	for i := range received {
+		require.Equal(t, i, received[i].Id)
+	}
+}
+
+func TestNotifyBatchEmpty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificator := mock.NewMockNotificator(ctrl)
+	module := &Module{notificator: notificator}
+
+	err := notifyBatch[testNotificationItem](t.Context(), module, storage.ChannelTx, nil)
+	require.NoError(t, err)
+}
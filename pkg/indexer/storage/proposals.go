@@ -20,11 +20,15 @@ import (
 func (module *Module) saveProposals(
 	ctx context.Context,
 	tx storage.Transaction,
-	height pkgTypes.Level,
+	block *storage.Block,
 	proposals *sdkSync.Map[uint64, *storage.Proposal],
 	votes []*storage.Vote,
 	addrToId map[string]uint64,
 ) (int64, error) {
+	var (
+		votesCount     map[uint64]*storage.VotesCount
+		validatorVoted bool
+	)
 	if len(votes) > 0 {
 		for i := range votes {
 			if votes[i].Voter != nil {
@@ -36,13 +40,15 @@ func (module *Module) saveProposals(
 
 				if validatorId, ok := module.validatorsByDelegator[votes[i].Voter.Address]; ok {
 					votes[i].ValidatorId = &validatorId
+					validatorVoted = true
 				}
 			} else {
 				return 0, errors.Errorf("nil voter address")
 			}
 		}
 
-		votesCount, err := tx.SaveVotes(ctx, votes...)
+		var err error
+		votesCount, err = tx.SaveVotes(ctx, votes...)
 		if err != nil {
 			return 0, errors.Wrap(err, "save votes")
 		}
@@ -72,11 +78,34 @@ func (module *Module) saveProposals(
 		}
 	}
 
-	filled, err := module.fillProposalsVotingPower(ctx, tx, height, proposals)
+	filled, tallies, err := module.fillProposalsVotingPower(ctx, tx, block.Height, proposals, validatorVoted)
 	if err != nil {
 		return 0, errors.Wrap(err, "compute proposal shares")
 	}
 
+	if len(tallies) == 0 && len(votesCount) > 0 {
+		tallies, err = module.votesTallies(ctx, tx, block.Height, proposals, votes, votesCount)
+		if err != nil {
+			return 0, errors.Wrap(err, "compute tallies of voted proposals")
+		}
+	}
+
+	for i := range tallies {
+		tallies[i].Time = block.Time
+	}
+	if err := tx.SaveProposalTallies(ctx, tallies...); err != nil {
+		return 0, errors.Wrap(err, "save proposal tallies")
+	}
+
+	for i := range filled {
+		if !filled[i].Finished() {
+			continue
+		}
+		if err := tx.SaveEffectiveVotes(ctx, filled[i].Id, block.Height); err != nil {
+			return 0, errors.Wrapf(err, "save effective votes: proposal_id=%d", filled[i].Id)
+		}
+	}
+
 	for i := range filled {
 		if filled[i].Proposer != nil {
 			proposerId, ok := addrToId[filled[i].Proposer.Address]
@@ -119,12 +148,16 @@ func (module *Module) getConstantDuration(ctx context.Context, moduleName types.
 	return time.Duration(intValue), nil
 }
 
+// fillProposalsVotingPower - computes voting power of active and just completed proposals and returns tally snapshot for each of them.
+// Voting power is recomputed from all votes and delegations, so it's done only if any proposal is completed or any validator voted
+// in the block and once in an hour to reflect validator power changes. Other votes are applied incrementally by votesTallies.
 func (module *Module) fillProposalsVotingPower(
 	ctx context.Context,
 	tx storage.Transaction,
 	height pkgTypes.Level,
 	proposals *sdkSync.Map[uint64, *storage.Proposal],
-) ([]*storage.Proposal, error) {
+	validatorVoted bool,
+) ([]*storage.Proposal, []storage.ProposalTally, error) {
 	// 1. Receive all active or just completed proposals
 
 	// 1.1 Return if we don't have proposal updates and it's not certain block height (one block in hour)
@@ -137,35 +170,41 @@ func (module *Module) fillProposalsVotingPower(
 		}
 	}
 
-	if len(finished) == 0 && height%600 > 0 {
-		return proposals.Values(), nil
+	if len(finished) == 0 && !validatorVoted && height%600 > 0 {
+		return proposals.Values(), nil, nil
 	}
 
 	active, err := tx.ActiveProposals(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "get active proposals")
+		return nil, nil, errors.Wrap(err, "get active proposals")
 	}
 
 	for i := range active {
-		if _, ok := finished[active[i].Id]; !ok {
+		if _, ok := finished[active[i].Id]; ok {
+			continue
+		}
+		// keep changes of the proposal received in the block (e.g. votes count)
+		if updated, ok := proposals.Get(active[i].Id); ok {
+			finished[active[i].Id] = updated
+		} else {
 			finished[active[i].Id] = &active[i]
 		}
 	}
 
 	if len(finished) == 0 {
-		return proposals.Values(), nil
+		return proposals.Values(), nil, nil
 	}
 
 	// 2. Get all validators
 
 	maxVals, err := getMaxValidatorsCount(ctx, module.constants)
 	if err != nil {
-		return nil, errors.Wrapf(err, "receiving max validators count")
+		return nil, nil, errors.Wrapf(err, "receiving max validators count")
 	}
 
 	validators, err := tx.BondedValidators(ctx, maxVals)
 	if err != nil {
-		return nil, errors.Wrap(err, "get validators")
+		return nil, nil, errors.Wrap(err, "get validators")
 	}
 	validatorsPower := make(map[uint64]types.Numeric)
 	for i := range validators {
@@ -178,37 +217,43 @@ func (module *Module) fillProposalsVotingPower(
 
 	totalVotingPower, err := module.validators.TotalVotingPower(ctx, maxVals)
 	if err != nil {
-		return nil, errors.Wrap(err, "get total voting power")
+		return nil, nil, errors.Wrap(err, "get total voting power")
 	}
 
+	tallies := make([]storage.ProposalTally, 0, len(finished))
 	for _, proposal := range finished {
 		validatorMinus := make(map[uint64]types.Numeric)
 		votedValidators := make(map[uint64]types.VoteOption)
+		tally := storage.ProposalTally{
+			Height:           height,
+			ProposalId:       proposal.Id,
+			TotalVotingPower: totalVotingPower,
+		}
 
 		if proposal.Finished() {
 			proposal.TotalVotingPower = totalVotingPower
 
 			quorum, err := module.constants.Get(ctx, types.ModuleNameGov, "quorum")
 			if err != nil {
-				return nil, errors.Wrapf(err, "can't find quorum constant")
+				return nil, nil, errors.Wrapf(err, "can't find quorum constant")
 			}
 			proposal.Quorum = quorum.Value
 
 			minDeposit, err := module.constants.Get(ctx, types.ModuleNameGov, "min_deposit")
 			if err != nil {
-				return nil, errors.Wrapf(err, "can't find min_deposit constant")
+				return nil, nil, errors.Wrapf(err, "can't find min_deposit constant")
 			}
 			proposal.MinDeposit = minDeposit.Value
 
 			threshold, err := module.constants.Get(ctx, types.ModuleNameGov, "threshold")
 			if err != nil {
-				return nil, errors.Wrapf(err, "can't find threshold constant")
+				return nil, nil, errors.Wrapf(err, "can't find threshold constant")
 			}
 			proposal.Threshold = threshold.Value
 
 			veto, err := module.constants.Get(ctx, types.ModuleNameGov, "veto_threshold")
 			if err != nil {
-				return nil, errors.Wrapf(err, "can't find veto_threshold constant")
+				return nil, nil, errors.Wrapf(err, "can't find veto_threshold constant")
 			}
 			proposal.VetoQuorum = veto.Value
 		}
@@ -222,16 +267,27 @@ func (module *Module) fillProposalsVotingPower(
 
 		for vote, err := range paginate {
 			if err != nil {
-				return nil, errors.Wrapf(err, "get proposal votes: proposal_id=%d", proposal.Id)
+				return nil, nil, errors.Wrapf(err, "get proposal votes: proposal_id=%d", proposal.Id)
 			}
 
 			if vote.ValidatorId != nil {
 				votedValidators[*vote.ValidatorId] = vote.Option
 			}
+			tally.VotesCount++
+			switch vote.Option {
+			case types.VoteOptionAbstain:
+				tally.Abstain++
+			case types.VoteOptionNo:
+				tally.No++
+			case types.VoteOptionNoWithVeto:
+				tally.NoWithVeto++
+			case types.VoteOptionYes:
+				tally.Yes++
+			}
 
 			delegations, err := tx.AddressDelegations(ctx, vote.VoterId)
 			if err != nil {
-				return nil, errors.Wrapf(err, "can't receive address delegations: %d", vote.VoterId)
+				return nil, nil, errors.Wrapf(err, "can't receive address delegations: %d", vote.VoterId)
 			}
 
 			for j := range delegations {
@@ -281,7 +337,151 @@ func (module *Module) fillProposalsVotingPower(
 				}
 			}
 		}
+
+		tally.VotingPower = proposal.VotingPower
+		tally.YesVotingPower = proposal.YesVotingPower
+		tally.NoVotingPower = proposal.NoVotingPower
+		tally.NoWithVetoVotingPower = proposal.NoWithVetoVotingPower
+		tally.AbstainVotingPower = proposal.AbstainVotingPower
+		tallies = append(tallies, tally)
+	}
+
+	// proposals which were changed in the block but aren't active anymore (e.g. new or removed ones)
+	for value := range proposals.AllValues() {
+		if _, ok := finished[value.Id]; !ok {
+			finished[value.Id] = value
+		}
+	}
+
+	return slices.Collect(maps.Values(finished)), tallies, nil
+}
+
+// votesTallies - returns tally snapshots of proposals voted in the block without recomputing them from all votes.
+// Delegations of the block's voters are added to the last tally: they move from the option of the validator (if it voted) to the voter's option.
+// Replaced votes are reverted the same way. Validators' votes and validator power changes are reflected by fillProposalsVotingPower.
+func (module *Module) votesTallies(
+	ctx context.Context,
+	tx storage.Transaction,
+	height pkgTypes.Level,
+	proposals *sdkSync.Map[uint64, *storage.Proposal],
+	votes []*storage.Vote,
+	votesCount map[uint64]*storage.VotesCount,
+) ([]storage.ProposalTally, error) {
+	maxVals, err := getMaxValidatorsCount(ctx, module.constants)
+	if err != nil {
+		return nil, errors.Wrapf(err, "receiving max validators count")
+	}
+
+	validators, err := tx.BondedValidators(ctx, maxVals)
+	if err != nil {
+		return nil, errors.Wrap(err, "get validators")
+	}
+	bonded := make(map[uint64]struct{}, len(validators))
+	for i := range validators {
+		bonded[validators[i].Id] = struct{}{}
+	}
+
+	totalVotingPower, err := module.validators.TotalVotingPower(ctx, maxVals)
+	if err != nil {
+		return nil, errors.Wrap(err, "get total voting power")
+	}
+
+	tallies := make([]storage.ProposalTally, 0, len(votesCount))
+	for id, vc := range votesCount {
+		proposal, ok := proposals.Get(id)
+		if !ok {
+			return nil, errors.Errorf("unknown proposal id during tally computing: %d", id)
+		}
+
+		tally, err := tx.LastProposalTally(ctx, id)
+		if err != nil {
+			if !module.validators.IsNoRows(err) {
+				return nil, errors.Wrapf(err, "get last proposal tally: proposal_id=%d", id)
+			}
+			tally = storage.ProposalTally{
+				ProposalId: id,
+			}
+		}
+		tally.Id = 0
+		tally.Height = height
+		tally.TotalVotingPower = totalVotingPower
+
+		tally.VotesCount += vc.VotesCount
+		tally.Yes += vc.Yes
+		tally.No += vc.No
+		tally.NoWithVeto += vc.NoWithVeto
+		tally.Abstain += vc.Abstain
+
+		validatorVotes, err := tx.ProposalValidatorVotes(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get validator votes: proposal_id=%d", id)
+		}
+		validatorOptions := make(map[uint64]types.VoteOption, len(validatorVotes))
+		for i := range validatorVotes {
+			if _, ok := bonded[*validatorVotes[i].ValidatorId]; ok {
+				validatorOptions[*validatorVotes[i].ValidatorId] = validatorVotes[i].Option
+			}
+		}
+
+		applyVote := func(vote storage.Vote, sign types.Numeric) error {
+			if vote.ValidatorId != nil {
+				return nil
+			}
+			delegations, err := tx.AddressDelegations(ctx, vote.VoterId)
+			if err != nil {
+				return errors.Wrapf(err, "can't receive address delegations: %d", vote.VoterId)
+			}
+			for j := range delegations {
+				shares := delegations[j].Amount.Mul(sign)
+				addTallyVotingPower(&tally, vote.Option, shares)
+				if option, ok := validatorOptions[delegations[j].ValidatorId]; ok {
+					addTallyVotingPower(&tally, option, shares.Neg())
+				}
+			}
+			return nil
+		}
+
+		for i := range vc.Removed {
+			if err := applyVote(vc.Removed[i], minusOne); err != nil {
+				return nil, err
+			}
+		}
+		for i := range votes {
+			if votes[i].ProposalId != id {
+				continue
+			}
+			if err := applyVote(*votes[i], plusOne); err != nil {
+				return nil, err
+			}
+		}
+
+		proposal.VotingPower = tally.VotingPower
+		proposal.YesVotingPower = tally.YesVotingPower
+		proposal.NoVotingPower = tally.NoVotingPower
+		proposal.NoWithVetoVotingPower = tally.NoWithVetoVotingPower
+		proposal.AbstainVotingPower = tally.AbstainVotingPower
+		tallies = append(tallies, tally)
 	}
 
-	return slices.Collect(maps.Values(finished)), nil
+	return tallies, nil
+}
+
+var (
+	plusOne  = types.NumericFromInt64(1)
+	minusOne = types.NumericFromInt64(-1)
+)
+
+func addTallyVotingPower(tally *storage.ProposalTally, option types.VoteOption, power types.Numeric) {
+	tally.VotingPower = tally.VotingPower.Add(power)
+
+	switch option {
+	case types.VoteOptionAbstain:
+		tally.AbstainVotingPower = tally.AbstainVotingPower.Add(power)
+	case types.VoteOptionNo:
+		tally.NoVotingPower = tally.NoVotingPower.Add(power)
+	case types.VoteOptionNoWithVeto:
+		tally.NoWithVetoVotingPower = tally.NoWithVetoVotingPower.Add(power)
+	case types.VoteOptionYes:
+		tally.YesVotingPower = tally.YesVotingPower.Add(power)
+	}
 }
@@ -5,6 +5,7 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
@@ -24,7 +25,7 @@ func TestFillProposalVotingPower(t *testing.T) {
 	constants := mock.NewMockIConstant(ctrl)
 	validators := mock.NewMockIValidator(ctrl)
 
-	module := NewModule(nil, constants, validators, nil, config.Indexer{})
+	module := NewModule(nil, constants, validators, nil, nil, config.Indexer{})
 
 	t.Run("not fill", func(t *testing.T) {
 		tx := mock.NewMockTransaction(ctrl)
@@ -35,9 +36,10 @@ func TestFillProposalVotingPower(t *testing.T) {
 			Status: types.ProposalStatusActive,
 		})
 
-		filled, err := module.fillProposalsVotingPower(t.Context(), tx, 1, proposals)
+		filled, tallies, err := module.fillProposalsVotingPower(t.Context(), tx, 1, proposals, false)
 		require.NoError(t, err)
 		require.Len(t, filled, 1)
+		require.Empty(t, tallies)
 	})
 
 	t.Run("no active and finished", func(t *testing.T) {
@@ -59,9 +61,10 @@ func TestFillProposalVotingPower(t *testing.T) {
 			NoWithVeto: 1,
 		})
 
-		filled, err := module.fillProposalsVotingPower(t.Context(), tx, 600, proposals)
+		filled, tallies, err := module.fillProposalsVotingPower(t.Context(), tx, 600, proposals, false)
 		require.NoError(t, err)
 		require.Len(t, filled, 1)
+		require.Empty(t, tallies)
 	})
 
 	t.Run("active and no finished", func(t *testing.T) {
@@ -153,7 +156,7 @@ func TestFillProposalVotingPower(t *testing.T) {
 			NoWithVeto: 1,
 		})
 
-		filled, err := module.fillProposalsVotingPower(t.Context(), tx, 600, proposals)
+		filled, tallies, err := module.fillProposalsVotingPower(t.Context(), tx, 600, proposals, false)
 		require.NoError(t, err)
 		require.Len(t, filled, 1)
 
@@ -161,6 +164,248 @@ func TestFillProposalVotingPower(t *testing.T) {
 		require.Equal(t, "40000000", filled[0].AbstainVotingPower.String())
 		require.Equal(t, "50000000", filled[0].NoVotingPower.String())
 		require.Equal(t, "10000000", filled[0].YesVotingPower.String())
+		require.EqualValues(t, 100, filled[0].Yes, "votes count changes of the block are kept")
+
+		require.Len(t, tallies, 1)
+		require.EqualValues(t, 600, tallies[0].Height)
+		require.EqualValues(t, 1, tallies[0].ProposalId)
+		require.EqualValues(t, 3, tallies[0].VotesCount)
+		require.EqualValues(t, 1, tallies[0].Yes)
+		require.EqualValues(t, 1, tallies[0].No)
+		require.EqualValues(t, 1, tallies[0].Abstain)
+		require.EqualValues(t, 0, tallies[0].NoWithVeto)
+		require.Equal(t, "100000000", tallies[0].VotingPower.String())
+		require.Equal(t, "40000000", tallies[0].AbstainVotingPower.String())
+		require.Equal(t, "50000000", tallies[0].NoVotingPower.String())
+		require.Equal(t, "10000000", tallies[0].YesVotingPower.String())
+		require.Equal(t, "10000", tallies[0].TotalVotingPower.String())
+	})
+
+	t.Run("voted in the block", func(t *testing.T) {
+		tx := mock.NewMockTransaction(ctrl)
+
+		proposals := sdkSync.NewMap[uint64, *storage.Proposal]()
+		proposals.Set(2, &storage.Proposal{
+			Id:         2,
+			VotesCount: 1,
+			NoWithVeto: 1,
+		})
+
+		filled, tallies, err := module.fillProposalsVotingPower(t.Context(), tx, 601, proposals, false)
+		require.NoError(t, err)
+		require.Len(t, filled, 1)
+		require.EqualValues(t, 1, filled[0].VotesCount)
+		require.True(t, filled[0].VotingPower.IsZero(), "voting power isn't recomputed")
+		require.Empty(t, tallies)
+	})
+
+	t.Run("validator voted in the block", func(t *testing.T) {
+		tx := mock.NewMockTransaction(ctrl)
+
+		tx.EXPECT().
+			ActiveProposals(t.Context()).
+			Return([]storage.Proposal{}, nil).
+			Times(1)
+
+		proposals := sdkSync.NewMap[uint64, *storage.Proposal]()
+		proposals.Set(2, &storage.Proposal{
+			Id:         2,
+			VotesCount: 1,
+			Yes:        1,
+		})
+
+		filled, tallies, err := module.fillProposalsVotingPower(t.Context(), tx, 601, proposals, true)
+		require.NoError(t, err)
+		require.Len(t, filled, 1)
+		require.Empty(t, tallies)
+	})
+}
+
+func TestVotesTallies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	constants := mock.NewMockIConstant(ctrl)
+	validators := mock.NewMockIValidator(ctrl)
+
+	module := NewModule(nil, constants, validators, nil, nil, config.Indexer{})
+
+	expectValidators := func(tx *mock.MockTransaction) {
+		constants.EXPECT().
+			Get(gomock.Any(), types.ModuleNameStaking, "max_validators").
+			Return(storage.Constant{
+				Name:   "max_validators",
+				Module: types.ModuleNameStaking,
+				Value:  "100",
+			}, nil).
+			Times(1)
+
+		tx.EXPECT().
+			BondedValidators(t.Context(), 100).
+			Return([]storage.Validator{{
+				Id:    1,
+				Stake: types.NumericFromInt64(1000),
+			}, {
+				Id:    2,
+				Stake: types.NumericFromInt64(2000),
+			}}, nil).
+			Times(1)
+
+		validators.EXPECT().
+			TotalVotingPower(gomock.Any(), 100).
+			Return(types.NumericFromInt64(10000), nil).
+			Times(1)
+	}
+
+	t.Run("new and changed votes", func(t *testing.T) {
+		tx := mock.NewMockTransaction(ctrl)
+		expectValidators(tx)
+
+		tx.EXPECT().
+			LastProposalTally(t.Context(), uint64(1)).
+			Return(storage.ProposalTally{
+				Id:             10,
+				Height:         500,
+				ProposalId:     1,
+				VotesCount:     2,
+				Yes:            1,
+				No:             1,
+				VotingPower:    types.NumericFromInt64(300),
+				YesVotingPower: types.NumericFromInt64(100),
+				NoVotingPower:  types.NumericFromInt64(200),
+			}, nil).
+			Times(1)
+
+		tx.EXPECT().
+			ProposalValidatorVotes(t.Context(), uint64(1)).
+			Return([]storage.Vote{{
+				ValidatorId: testsuite.Ptr(uint64(1)),
+				VoterId:     3,
+				Option:      types.VoteOptionNo,
+			}}, nil).
+			Times(1)
+
+		tx.EXPECT().
+			AddressDelegations(t.Context(), uint64(5)).
+			Return([]storage.Delegation{{
+				ValidatorId: 1,
+				AddressId:   5,
+				Amount:      types.NumericFromInt64(50),
+			}}, nil).
+			Times(1)
+
+		tx.EXPECT().
+			AddressDelegations(t.Context(), uint64(6)).
+			Return([]storage.Delegation{{
+				ValidatorId: 2,
+				AddressId:   6,
+				Amount:      types.NumericFromInt64(20),
+			}}, nil).
+			Times(2)
+
+		proposals := sdkSync.NewMap[uint64, *storage.Proposal]()
+		proposals.Set(1, &storage.Proposal{
+			Id:         1,
+			VotesCount: 1,
+			Yes:        1,
+			No:         -1,
+			Abstain:    1,
+		})
+
+		votes := []*storage.Vote{
+			{ProposalId: 1, VoterId: 5, Option: types.VoteOptionYes},
+			{ProposalId: 1, VoterId: 6, Option: types.VoteOptionAbstain},
+		}
+		votesCount := map[uint64]*storage.VotesCount{
+			1: {
+				VotesCount: 1,
+				Yes:        1,
+				No:         -1,
+				Abstain:    1,
+				Removed: []storage.Vote{
+					{ProposalId: 1, VoterId: 6, Option: types.VoteOptionNo},
+				},
+			},
+		}
+
+		tallies, err := module.votesTallies(t.Context(), tx, 601, proposals, votes, votesCount)
+		require.NoError(t, err)
+		require.Len(t, tallies, 1)
+
+		require.EqualValues(t, 0, tallies[0].Id)
+		require.EqualValues(t, 601, tallies[0].Height)
+		require.EqualValues(t, 1, tallies[0].ProposalId)
+		require.EqualValues(t, 3, tallies[0].VotesCount)
+		require.EqualValues(t, 2, tallies[0].Yes)
+		require.EqualValues(t, 0, tallies[0].No)
+		require.EqualValues(t, 1, tallies[0].Abstain)
+		require.Equal(t, "300", tallies[0].VotingPower.String())
+		require.Equal(t, "150", tallies[0].YesVotingPower.String())
+		require.Equal(t, "130", tallies[0].NoVotingPower.String())
+		require.Equal(t, "20", tallies[0].AbstainVotingPower.String())
+		require.Equal(t, "10000", tallies[0].TotalVotingPower.String())
+
+		proposal, ok := proposals.Get(1)
+		require.True(t, ok)
+		require.Equal(t, "300", proposal.VotingPower.String())
+		require.Equal(t, "150", proposal.YesVotingPower.String())
+		require.EqualValues(t, 1, proposal.Yes, "votes count changes of the block are kept")
+	})
+
+	t.Run("first vote", func(t *testing.T) {
+		tx := mock.NewMockTransaction(ctrl)
+		expectValidators(tx)
+
+		tx.EXPECT().
+			LastProposalTally(t.Context(), uint64(2)).
+			Return(storage.ProposalTally{}, sql.ErrNoRows).
+			Times(1)
+
+		validators.EXPECT().
+			IsNoRows(sql.ErrNoRows).
+			Return(true).
+			Times(1)
+
+		tx.EXPECT().
+			ProposalValidatorVotes(t.Context(), uint64(2)).
+			Return([]storage.Vote{}, nil).
+			Times(1)
+
+		tx.EXPECT().
+			AddressDelegations(t.Context(), uint64(5)).
+			Return([]storage.Delegation{{
+				ValidatorId: 1,
+				AddressId:   5,
+				Amount:      types.NumericFromInt64(50),
+			}}, nil).
+			Times(1)
+
+		proposals := sdkSync.NewMap[uint64, *storage.Proposal]()
+		proposals.Set(2, &storage.Proposal{
+			Id:         2,
+			VotesCount: 1,
+			NoWithVeto: 1,
+		})
+
+		votes := []*storage.Vote{
+			{ProposalId: 2, VoterId: 5, Option: types.VoteOptionNoWithVeto},
+		}
+		votesCount := map[uint64]*storage.VotesCount{
+			2: {
+				VotesCount: 1,
+				NoWithVeto: 1,
+			},
+		}
+
+		tallies, err := module.votesTallies(t.Context(), tx, 601, proposals, votes, votesCount)
+		require.NoError(t, err)
+		require.Len(t, tallies, 1)
+
+		require.EqualValues(t, 2, tallies[0].ProposalId)
+		require.EqualValues(t, 1, tallies[0].VotesCount)
+		require.EqualValues(t, 1, tallies[0].NoWithVeto)
+		require.Equal(t, "50", tallies[0].VotingPower.String())
+		require.Equal(t, "50", tallies[0].NoWithVetoVotingPower.String())
 	})
 }
 
@@ -184,7 +429,7 @@ func TestModule_getConstantDuration(t *testing.T) {
 			}, nil).
 			Times(1)
 
-		module := NewModule(nil, constants, validators, nil, config.Indexer{})
+		module := NewModule(nil, constants, validators, nil, nil, config.Indexer{})
 		got, err := module.getConstantDuration(ctx, types.ModuleNameGov, "voting_period")
 		require.NoError(t, err)
 		require.EqualValues(t, "24h0m0s", got.String())
@@ -0,0 +1,507 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	json "github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Reindex - replaces rows derived from already indexed block with rows from the new decoding of the same block
+// and reconciles aggregated values: address balances, namespace statistics, supply breakdowns and indexer state.
+// Block, its stats, transactions, messages, events, blob logs and balance updates are rewritten. Transactions and messages keep
+// their ids, so rows of other entities still reference them. Balance updates of slashing
+// aren't emitted by block parsing, so they are kept. Entities with their own lifecycle (validators, delegations, votes, proposals,
+// IBC and Hyperlane entities) are kept untouched together with proposal tallies and effective votes derived from them.
+// Total and circulating supply of the block and all following blocks are shifted by the difference of supply.
+// Subscribers are notified by rollback message, so cached data since the block is invalidated.
+func (module *Module) Reindex(ctx context.Context, dCtx *decodeContext.Context) error {
+	if len(module.validatorsByAddress) == 0 {
+		if err := module.init(ctx); err != nil {
+			return err
+		}
+	}
+
+	start := time.Now()
+	tx, err := postgres.BeginTransaction(ctx, module.storage)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	lastHeight, err := module.reindexBlockInTransaction(ctx, tx, dCtx)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	module.notifyReindex(ctx, lastHeight, dCtx.Block.Height)
+
+	module.Log.Info().
+		Uint64("height", uint64(dCtx.Block.Height)).
+		Int64("ms", time.Since(start).Milliseconds()).
+		Msg("block reindexed")
+	return nil
+}
+
+// notifyReindex - sends the same message as rollback does: data since the reindexed block was changed
+func (module *Module) notifyReindex(ctx context.Context, lastHeight, height types.Level) {
+	if module.notificator == nil {
+		return
+	}
+	payload, err := json.MarshalString(storage.Rollback{
+		FromHeight: lastHeight,
+		ToHeight:   height - 1,
+	})
+	if err != nil {
+		module.Log.Err(err).Msg("marshal reindex notification")
+		return
+	}
+	if err := module.notificator.Notify(ctx, storage.ChannelRollback, payload); err != nil {
+		module.Log.Err(err).Msg("notify about reindex")
+	}
+}
+
+// reindexBlockInTransaction - rewrites the block and returns the last indexed height
+func (module *Module) reindexBlockInTransaction(ctx context.Context, tx storage.Transaction, dCtx *decodeContext.Context) (types.Level, error) {
+	block := dCtx.Block
+
+	state, err := tx.State(ctx, module.indexerName)
+	if err != nil {
+		return 0, err
+	}
+	if block.Height > state.LastHeight {
+		return 0, errors.Errorf("block %d is not indexed yet, last indexed block is %d", block.Height, state.LastHeight)
+	}
+
+	if id, ok := module.validatorsByConsAddress[block.ProposerAddress]; ok {
+		block.ProposerId = id
+	} else {
+		proposerId, err := tx.GetProposerId(ctx, block.ProposerAddress)
+		if err != nil {
+			return 0, errors.Wrap(err, "can't find block proposer")
+		}
+		block.ProposerId = proposerId
+	}
+
+	old, err := deleteBlockRows(ctx, tx, block.Height)
+	if err != nil {
+		return 0, err
+	}
+
+	// block time depends on previous block which isn't decoded
+	block.Stats.BlockTime = old.stats.BlockTime
+
+	if err := keepIds(dCtx, old.txs, old.msgs); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Add(ctx, block); err != nil {
+		return 0, err
+	}
+	if err := tx.Add(ctx, &block.Stats); err != nil {
+		return 0, err
+	}
+	if err := tx.SaveTransactions(ctx, block.Txs...); err != nil {
+		return 0, err
+	}
+	if err := tx.SaveEvents(ctx, dCtx.Events...); err != nil {
+		return 0, err
+	}
+
+	addresses := dCtx.Addresses.Values()
+	totalAccounts, err := tx.MergeAddresses(ctx, addresses...)
+	if err != nil {
+		return 0, errors.Wrap(err, "save addresses")
+	}
+	addrToId := make(map[string]uint64, len(addresses))
+	balances := make([]storage.Balance, 0, len(addresses))
+	for i := range addresses {
+		if addresses[i].Id == 0 {
+			return 0, errors.Errorf("id for address %s equals 0", addresses[i].Address)
+		}
+		addrToId[addresses[i].Address] = addresses[i].Id
+		for j := range addresses[i].Balances {
+			addresses[i].Balances[j].Id = addresses[i].Id
+		}
+		balances = append(balances, addresses[i].Balances...)
+	}
+
+	reemitted, slashing := splitSlashingUpdates(old.balanceUpdates)
+	if err := tx.SaveBalanceUpdates(ctx, slashing...); err != nil {
+		return 0, errors.Wrap(err, "restore slashing balance updates")
+	}
+	if err := reconcileBalances(ctx, tx, block.Height, reemitted, balances); err != nil {
+		return 0, errors.Wrap(err, "reconcile balances")
+	}
+	if err := saveBalanceUpdates(ctx, tx, block.Height, block.Time, balances, false); err != nil {
+		return 0, err
+	}
+
+	if err := saveSigners(ctx, tx, addrToId, block.Txs); err != nil {
+		return 0, err
+	}
+	if err := saveAddressMessage(ctx, tx, dCtx.AddressMessages.Values(), addrToId); err != nil {
+		return 0, err
+	}
+
+	namespaces := dCtx.Namespaces.Values()
+	totalNamespaces, err := tx.SaveNamespaces(ctx, namespaces...)
+	if err != nil {
+		return 0, errors.Wrap(err, "save namespaces")
+	}
+	if err := saveNamespaceMessages(ctx, tx, dCtx.NamespaceMessages.Values()); err != nil {
+		return 0, errors.Wrap(err, "save namespace messages")
+	}
+	if err := module.reconcileNamespaces(ctx, tx, namespaces, old.blobLogs, old.namespaceMessages); err != nil {
+		return 0, errors.Wrap(err, "reconcile namespaces")
+	}
+
+	if err := module.saveMessages(ctx, tx, dCtx.Messages); err != nil {
+		return 0, err
+	}
+	if err := saveBlobLogs(ctx, tx, dCtx.BlobLogs, addrToId); err != nil {
+		return 0, err
+	}
+	if err := tx.SaveShareRanges(ctx, dCtx.ShareRanges...); err != nil {
+		return 0, err
+	}
+
+	state.TotalTx += block.Stats.TxCount - old.stats.TxCount
+	state.TotalAccounts += totalAccounts
+	state.TotalNamespaces += totalNamespaces
+	state.TotalBlobsSize += block.Stats.BlobsSize - old.stats.BlobsSize
+	state.TotalFee = state.TotalFee.Add(block.Stats.Fee).Sub(old.stats.Fee)
+	supplyChange := block.Stats.SupplyChange.Sub(old.stats.SupplyChange)
+	state.TotalSupply = state.TotalSupply.Add(supplyChange)
+	if state.LastHeight == block.Height {
+		state.LastHash = block.Hash
+	}
+
+	if err := tx.ShiftSupply(ctx, block.Height, supplyChange); err != nil {
+		return 0, errors.Wrap(err, "shift supply")
+	}
+	return state.LastHeight, tx.Update(ctx, &state)
+}
+
+type deletedRows struct {
+	stats             storage.BlockStats
+	txs               []storage.Tx
+	msgs              []storage.Message
+	balanceUpdates    []storage.BalanceUpdate
+	blobLogs          []storage.BlobLog
+	namespaceMessages []storage.NamespaceMessage
+}
+
+// deleteBlockRows - removes rows which are rewritten by reindex and returns ones required for reconciliation
+func deleteBlockRows(ctx context.Context, tx storage.Transaction, height types.Level) (deletedRows, error) {
+	var (
+		result deletedRows
+		err    error
+	)
+
+	if err := tx.RollbackBlock(ctx, height); err != nil {
+		return result, err
+	}
+	result.stats, err = tx.RollbackBlockStats(ctx, height)
+	if err != nil {
+		return result, err
+	}
+
+	result.txs, err = tx.RollbackTxs(ctx, height)
+	if err != nil {
+		return result, err
+	}
+	if len(result.txs) > 0 {
+		ids := make([]uint64, len(result.txs))
+		for i := range result.txs {
+			ids[i] = result.txs[i].Id
+		}
+		if err := tx.RollbackSigners(ctx, ids); err != nil {
+			return result, err
+		}
+	}
+
+	result.msgs, err = tx.RollbackMessages(ctx, height)
+	if err != nil {
+		return result, err
+	}
+	if len(result.msgs) > 0 {
+		ids := make([]uint64, len(result.msgs))
+		for i := range result.msgs {
+			ids[i] = result.msgs[i].Id
+		}
+		if err := tx.RollbackMessageAddresses(ctx, ids); err != nil {
+			return result, err
+		}
+	}
+	if err := tx.RollbackMessageValidators(ctx, height); err != nil {
+		return result, err
+	}
+
+	result.namespaceMessages, err = tx.RollbackNamespaceMessages(ctx, height)
+	if err != nil {
+		return result, err
+	}
+	result.blobLogs, err = tx.RollbackBlobLog(ctx, height)
+	if err != nil {
+		return result, err
+	}
+	if err := tx.RollbackShareRanges(ctx, height); err != nil {
+		return result, err
+	}
+	if _, err := tx.RollbackEvents(ctx, height); err != nil {
+		return result, err
+	}
+	result.balanceUpdates, err = tx.RollbackBalanceUpdates(ctx, height)
+	return result, err
+}
+
+type msgKey struct {
+	txId     uint64
+	position int64
+}
+
+// keepIds - assigns ids of deleted transactions and messages to the decoded ones and updates references to them in the context.
+// Transactions are matched by hash and messages by transaction and position in it. Rows which aren't rewritten by reindex
+// (IBC and Hyperlane transfers, votes, forwardings, signals, etc.) reference transactions and messages by id, so ids must be kept.
+func keepIds(dCtx *decodeContext.Context, oldTxs []storage.Tx, oldMsgs []storage.Message) error {
+	txByHash := make(map[string]uint64, len(oldTxs))
+	for i := range oldTxs {
+		txByHash[string(oldTxs[i].Hash)] = oldTxs[i].Id
+	}
+	msgByKey := make(map[msgKey]uint64, len(oldMsgs))
+	for i := range oldMsgs {
+		msgByKey[msgKey{oldMsgs[i].TxId, oldMsgs[i].Position}] = oldMsgs[i].Id
+	}
+
+	txIds := make(map[uint64]uint64)
+	for i := range dCtx.Block.Txs {
+		if id, ok := txByHash[string(dCtx.Block.Txs[i].Hash)]; ok && id != dCtx.Block.Txs[i].Id {
+			txIds[dCtx.Block.Txs[i].Id] = id
+		}
+	}
+	mapTx := func(id uint64) uint64 {
+		if oldId, ok := txIds[id]; ok {
+			return oldId
+		}
+		return id
+	}
+
+	msgIds := make(map[uint64]uint64)
+	for i := range dCtx.Messages {
+		key := msgKey{mapTx(dCtx.Messages[i].TxId), dCtx.Messages[i].Position}
+		if id, ok := msgByKey[key]; ok && id != dCtx.Messages[i].Id {
+			msgIds[dCtx.Messages[i].Id] = id
+		}
+	}
+	mapMsg := func(id uint64) uint64 {
+		if oldId, ok := msgIds[id]; ok {
+			return oldId
+		}
+		return id
+	}
+
+	if len(txIds) == 0 && len(msgIds) == 0 {
+		return nil
+	}
+
+	usedTxIds := make(map[uint64]struct{}, len(dCtx.Block.Txs))
+	for i := range dCtx.Block.Txs {
+		dCtx.Block.Txs[i].Id = mapTx(dCtx.Block.Txs[i].Id)
+		if _, ok := usedTxIds[dCtx.Block.Txs[i].Id]; ok {
+			return errors.Errorf("duplicate transaction id %d after keeping ids of block %d", dCtx.Block.Txs[i].Id, dCtx.Block.Height)
+		}
+		usedTxIds[dCtx.Block.Txs[i].Id] = struct{}{}
+	}
+	usedMsgIds := make(map[uint64]struct{}, len(dCtx.Messages))
+	for i := range dCtx.Messages {
+		dCtx.Messages[i].Id = mapMsg(dCtx.Messages[i].Id)
+		dCtx.Messages[i].TxId = mapTx(dCtx.Messages[i].TxId)
+		if _, ok := usedMsgIds[dCtx.Messages[i].Id]; ok {
+			return errors.Errorf("duplicate message id %d after keeping ids of block %d", dCtx.Messages[i].Id, dCtx.Block.Height)
+		}
+		usedMsgIds[dCtx.Messages[i].Id] = struct{}{}
+	}
+
+	for i := range dCtx.Events {
+		if dCtx.Events[i].TxId != nil {
+			txId := mapTx(*dCtx.Events[i].TxId)
+			dCtx.Events[i].TxId = &txId
+		}
+	}
+	for i := range dCtx.BlobLogs {
+		dCtx.BlobLogs[i].TxId = mapTx(dCtx.BlobLogs[i].TxId)
+		dCtx.BlobLogs[i].MsgId = mapMsg(dCtx.BlobLogs[i].MsgId)
+	}
+	for i := range dCtx.ShareRanges {
+		dCtx.ShareRanges[i].TxId = mapTx(dCtx.ShareRanges[i].TxId)
+	}
+	for _, msg := range dCtx.NamespaceMessages.Values() {
+		msg.TxId = mapTx(msg.TxId)
+		msg.MsgId = mapMsg(msg.MsgId)
+	}
+	for _, msg := range dCtx.AddressMessages.Values() {
+		msg.MsgId = mapMsg(msg.MsgId)
+	}
+	return nil
+}
+
+// splitSlashingUpdates - separates balance updates emitted by block parsing from updates of slashing
+func splitSlashingUpdates(updates []storage.BalanceUpdate) (reemitted, slashing []storage.BalanceUpdate) {
+	for i := range updates {
+		if updates[i].Slashing {
+			slashing = append(slashing, updates[i])
+		} else {
+			reemitted = append(reemitted, updates[i])
+		}
+	}
+	return
+}
+
+// reconcileBalances - applies difference between new and old balance changes of the block to current balances.
+// Old updates should contain only changes emitted by block parsing.
+func reconcileBalances(
+	ctx context.Context,
+	tx storage.Transaction,
+	height types.Level,
+	oldUpdates []storage.BalanceUpdate,
+	balances []storage.Balance,
+) error {
+	if len(oldUpdates) == 0 {
+		for i := range balances {
+			if !balances[i].Spendable.IsZero() || !balances[i].Delegated.IsZero() || !balances[i].Unbonding.IsZero() {
+				return errors.Errorf("balance updates of block %d are not found, balances can't be reconciled", height)
+			}
+		}
+	}
+
+	return tx.SaveBalances(ctx, balanceDiff(oldUpdates, balances)...)
+}
+
+type balanceKey struct {
+	id       uint64
+	currency string
+}
+
+// balanceDiff - returns balance deltas which turn old balance changes into new ones
+func balanceDiff(oldUpdates []storage.BalanceUpdate, balances []storage.Balance) []storage.Balance {
+	var (
+		diff  = make(map[balanceKey]*storage.Balance)
+		order = make([]balanceKey, 0, len(balances)+len(oldUpdates))
+	)
+	get := func(id uint64, currency string) *storage.Balance {
+		key := balanceKey{id, currency}
+		if b, ok := diff[key]; ok {
+			return b
+		}
+		b := storage.EmptyBalance()
+		b.Id = id
+		b.Currency = currency
+		diff[key] = &b
+		order = append(order, key)
+		return &b
+	}
+
+	for i := range balances {
+		b := get(balances[i].Id, balances[i].Currency)
+		b.Spendable = b.Spendable.Add(balances[i].Spendable)
+		b.Delegated = b.Delegated.Add(balances[i].Delegated)
+		b.Unbonding = b.Unbonding.Add(balances[i].Unbonding)
+	}
+	for i := range oldUpdates {
+		b := get(oldUpdates[i].AddressId, oldUpdates[i].Currency)
+		b.Spendable = b.Spendable.Sub(oldUpdates[i].Spendable)
+		b.Delegated = b.Delegated.Sub(oldUpdates[i].Delegated)
+		b.Unbonding = b.Unbonding.Sub(oldUpdates[i].Unbonding)
+	}
+
+	result := make([]storage.Balance, 0, len(order))
+	for _, key := range order {
+		b := diff[key]
+		if b.Spendable.IsZero() && b.Delegated.IsZero() && b.Unbonding.IsZero() {
+			continue
+		}
+		result = append(result, *b)
+	}
+	return result
+}
+
+// reconcileNamespaces - removes contribution of old blobs from namespace statistics. Contribution of new blobs
+// is already added by saving of decoded namespaces. Last message of namespace is restored from stored messages.
+func (module *Module) reconcileNamespaces(
+	ctx context.Context,
+	tx storage.Transaction,
+	namespaces []*storage.Namespace,
+	oldLogs []storage.BlobLog,
+	oldMsgs []storage.NamespaceMessage,
+) error {
+	diffs := namespaceDiff(oldLogs, oldMsgs)
+	for i := range namespaces {
+		if _, ok := diffs[namespaces[i].Id]; !ok {
+			diffs[namespaces[i].Id] = &storage.Namespace{}
+		}
+	}
+
+	updates := make([]*storage.Namespace, 0, len(diffs))
+	for id, diff := range diffs {
+		ns, err := tx.Namespace(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "receiving namespace %d", id)
+		}
+		diff.Id = ns.Id
+		diff.Version = ns.Version
+		diff.NamespaceID = ns.NamespaceID
+		diff.FirstHeight = ns.FirstHeight
+		diff.LastHeight = ns.LastHeight
+		diff.LastMessageTime = ns.LastMessageTime
+
+		last, err := tx.LastNamespaceMessage(ctx, id)
+		switch {
+		case err == nil:
+			diff.LastHeight = last.Height
+			diff.LastMessageTime = last.Time
+		case module.validators.IsNoRows(err):
+			module.Log.Warn().Uint64("namespace_id", id).Msg("namespace doesn't have messages after reindex")
+		default:
+			return errors.Wrap(err, "receiving last namespace message")
+		}
+		updates = append(updates, diff)
+	}
+
+	_, err := tx.SaveNamespaces(ctx, updates...)
+	return err
+}
+
+// namespaceDiff - returns negative contribution of old blobs to namespace statistics by namespace id
+func namespaceDiff(oldLogs []storage.BlobLog, oldMsgs []storage.NamespaceMessage) map[uint64]*storage.Namespace {
+	diffs := make(map[uint64]*storage.Namespace)
+	get := func(id uint64) *storage.Namespace {
+		if diff, ok := diffs[id]; ok {
+			return diff
+		}
+		diff := &storage.Namespace{Id: id}
+		diffs[id] = diff
+		return diff
+	}
+
+	for i := range oldLogs {
+		diff := get(oldLogs[i].NamespaceId)
+		diff.Size -= oldLogs[i].Size
+		diff.BlobsCount -= 1
+	}
+	for i := range oldMsgs {
+		get(oldMsgs[i].NamespaceId).PfbCount -= 1
+	}
+	return diffs
+}
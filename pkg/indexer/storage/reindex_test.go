@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceDiff(t *testing.T) {
+	oldUpdates := []storage.BalanceUpdate{
+		{
+			AddressId: 1,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(-100),
+			Delegated: types.NumericZero(),
+			Unbonding: types.NumericZero(),
+		}, {
+			AddressId: 2,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(100),
+			Delegated: types.NumericZero(),
+			Unbonding: types.NumericZero(),
+		}, {
+			AddressId: 3,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(5),
+			Delegated: types.NumericZero(),
+			Unbonding: types.NumericZero(),
+		},
+	}
+	balances := []storage.Balance{
+		{
+			Id:        1,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(-100),
+			Delegated: types.NumericZero(),
+			Unbonding: types.NumericZero(),
+		}, {
+			Id:        2,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(70),
+			Delegated: types.NumericZero(),
+			Unbonding: types.NumericZero(),
+		}, {
+			Id:        4,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(30),
+			Delegated: types.NumericFromInt64(10),
+			Unbonding: types.NumericZero(),
+		},
+	}
+
+	diff := balanceDiff(oldUpdates, balances)
+	require.Len(t, diff, 3)
+
+	require.EqualValues(t, 2, diff[0].Id)
+	require.Equal(t, "-30", diff[0].Spendable.String())
+
+	require.EqualValues(t, 4, diff[1].Id)
+	require.Equal(t, "30", diff[1].Spendable.String())
+	require.Equal(t, "10", diff[1].Delegated.String())
+
+	require.EqualValues(t, 3, diff[2].Id)
+	require.Equal(t, "-5", diff[2].Spendable.String())
+	require.Equal(t, "0", diff[2].Delegated.String())
+}
+
+func TestBalanceDiffOfSlashedBlock(t *testing.T) {
+	oldUpdates := []storage.BalanceUpdate{
+		{
+			AddressId: 1,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(-100),
+			Delegated: types.NumericFromInt64(100),
+			Unbonding: types.NumericZero(),
+		}, {
+			AddressId: 1,
+			Currency:  "utia",
+			Spendable: types.NumericZero(),
+			Delegated: types.NumericFromInt64(-5),
+			Unbonding: types.NumericZero(),
+			Slashing:  true,
+		}, {
+			AddressId: 2,
+			Currency:  "utia",
+			Spendable: types.NumericZero(),
+			Delegated: types.NumericFromInt64(-10),
+			Unbonding: types.NumericZero(),
+			Slashing:  true,
+		},
+	}
+	balances := []storage.Balance{
+		{
+			Id:        1,
+			Currency:  "utia",
+			Spendable: types.NumericFromInt64(-100),
+			Delegated: types.NumericFromInt64(100),
+			Unbonding: types.NumericZero(),
+		},
+	}
+
+	reemitted, slashing := splitSlashingUpdates(oldUpdates)
+	require.Len(t, reemitted, 1)
+	require.Len(t, slashing, 2)
+	require.EqualValues(t, 1, slashing[0].AddressId)
+	require.EqualValues(t, 2, slashing[1].AddressId)
+
+	// parsing of the block doesn't emit slashing, so balances are unchanged
+	require.Empty(t, balanceDiff(reemitted, balances))
+}
+
+func TestNamespaceDiff(t *testing.T) {
+	diffs := namespaceDiff(
+		[]storage.BlobLog{
+			{NamespaceId: 1, Size: 100},
+			{NamespaceId: 1, Size: 50},
+			{NamespaceId: 2, Size: 10},
+		},
+		[]storage.NamespaceMessage{
+			{NamespaceId: 1, MsgId: 10},
+			{NamespaceId: 2, MsgId: 10},
+		},
+	)
+	require.Len(t, diffs, 2)
+
+	require.EqualValues(t, -150, diffs[1].Size)
+	require.EqualValues(t, -2, diffs[1].BlobsCount)
+	require.EqualValues(t, -1, diffs[1].PfbCount)
+
+	require.EqualValues(t, -10, diffs[2].Size)
+	require.EqualValues(t, -1, diffs[2].BlobsCount)
+	require.EqualValues(t, -1, diffs[2].PfbCount)
+}
+
+func TestKeepIds(t *testing.T) {
+	dCtx := decodeContext.NewContext()
+	dCtx.Block = &storage.Block{
+		Height: 100,
+		Txs: []storage.Tx{
+			{Id: 100<<24 | 0, Hash: []byte{0x01}},
+			{Id: 100<<24 | 1, Hash: []byte{0x02}},
+		},
+	}
+	dCtx.Messages = []*storage.Message{
+		{Id: 100<<24 | 0, TxId: 100<<24 | 0, Position: 0},
+		{Id: 100<<24 | 1, TxId: 100<<24 | 1, Position: 0},
+	}
+	txId := uint64(100<<24 | 1)
+	dCtx.Events = []storage.Event{{TxId: &txId}, {}}
+	dCtx.BlobLogs = []*storage.BlobLog{{TxId: 100<<24 | 1, MsgId: 100<<24 | 1}}
+	dCtx.AddNamespaceMessage(&storage.NamespaceMessage{
+		TxId:      100<<24 | 1,
+		MsgId:     100<<24 | 1,
+		Namespace: &storage.Namespace{},
+	})
+
+	err := keepIds(dCtx,
+		[]storage.Tx{
+			{Id: 10, Hash: []byte{0x01}},
+			{Id: 11, Hash: []byte{0x02}},
+		},
+		[]storage.Message{
+			{Id: 20, TxId: 10, Position: 0},
+			{Id: 21, TxId: 11, Position: 0},
+		},
+	)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 10, dCtx.Block.Txs[0].Id)
+	require.EqualValues(t, 11, dCtx.Block.Txs[1].Id)
+	require.EqualValues(t, 20, dCtx.Messages[0].Id)
+	require.EqualValues(t, 10, dCtx.Messages[0].TxId)
+	require.EqualValues(t, 21, dCtx.Messages[1].Id)
+	require.EqualValues(t, 11, dCtx.Messages[1].TxId)
+	require.EqualValues(t, 11, *dCtx.Events[0].TxId)
+	require.Nil(t, dCtx.Events[1].TxId)
+	require.EqualValues(t, 11, dCtx.BlobLogs[0].TxId)
+	require.EqualValues(t, 21, dCtx.BlobLogs[0].MsgId)
+	for _, msg := range dCtx.NamespaceMessages.Values() {
+		require.EqualValues(t, 11, msg.TxId)
+		require.EqualValues(t, 21, msg.MsgId)
+	}
+}
+
+func TestKeepIdsDuplicate(t *testing.T) {
+	dCtx := decodeContext.NewContext()
+	dCtx.Block = &storage.Block{
+		Height: 100,
+		Txs: []storage.Tx{
+			{Id: 1, Hash: []byte{0x01}},
+			{Id: 2, Hash: []byte{0x02}},
+		},
+	}
+
+	// the first transaction takes id of the second one which isn't matched
+	err := keepIds(dCtx, []storage.Tx{{Id: 2, Hash: []byte{0x01}}}, nil)
+	require.Error(t, err)
+}
@@ -32,7 +32,7 @@ func makeModule(ctrl *gomock.Controller, constants *mock.MockIConstant) (Module,
 		Return(storage.Constant{Value: "100"}, nil).
 		AnyTimes()
 
-	m := NewModule(nil, constants, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
+	m := NewModule(nil, constants, nil, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 	return m, tx
 }
 
@@ -253,7 +253,7 @@ func TestSaveSignals_Empty(t *testing.T) {
 	defer ctrl.Finish()
 
 	constants := mock.NewMockIConstant(ctrl)
-	module := NewModule(nil, constants, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(nil, constants, nil, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 
 	err := module.saveSignals(context.Background(), nil, nil,
 		sdkSync.NewMap[uint64, *storage.Upgrade](), storage.State{})
@@ -294,7 +294,7 @@ func TestSaveSignals_WithQuorum(t *testing.T) {
 			return nil
 		})
 
-	module := NewModule(nil, constants, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(nil, constants, nil, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 	module.validatorsByAddress["val1address"] = 1
 
 	signals := []*storage.SignalVersion{
@@ -325,7 +325,7 @@ func TestSaveSignals_UnknownValidator(t *testing.T) {
 	tx := mock.NewMockTransaction(ctrl)
 	tx.EXPECT().BondedValidators(gomock.Any(), 100).Return([]storage.Validator{}, nil)
 
-	module := NewModule(nil, constants, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(nil, constants, nil, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 	// validatorsByAddress intentionally empty
 
 	signals := []*storage.SignalVersion{
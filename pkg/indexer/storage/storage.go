@@ -10,6 +10,7 @@ import (
 	json "github.com/bytedance/sonic"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
 	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/node"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 
@@ -39,6 +40,7 @@ type Module struct {
 	constants               storage.IConstant
 	validators              storage.IValidator
 	notificator             storage.Notificator
+	cosmosApi               node.CosmosApi
 	validatorsByConsAddress map[string]uint64
 	validatorsByAddress     map[string]uint64
 	validatorsByDelegator   map[string]uint64
@@ -48,6 +50,10 @@ type Module struct {
 	maxAgeNumBlocks       string
 	maxAgeDuration        string
 	indexerName           string
+
+	vestingLocked     types.Numeric
+	vestingLockedHour time.Time
+	poolRequests      chan poolRequest
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -58,6 +64,7 @@ func NewModule(
 	constants storage.IConstant,
 	validators storage.IValidator,
 	notificator storage.Notificator,
+	cosmosApi node.CosmosApi,
 	cfg config.Indexer,
 ) Module {
 	m := Module{
@@ -66,6 +73,7 @@ func NewModule(
 		constants:               constants,
 		validators:              validators,
 		notificator:             notificator,
+		cosmosApi:               cosmosApi,
 		validatorsByConsAddress: make(map[string]uint64),
 		validatorsByAddress:     make(map[string]uint64),
 		validatorsByDelegator:   make(map[string]uint64),
@@ -74,6 +82,8 @@ func NewModule(
 		maxAgeNumBlocks:         "",
 		maxAgeDuration:          "",
 		indexerName:             cfg.Name,
+		vestingLocked:           types.NumericZero(),
+		poolRequests:            make(chan poolRequest, 1),
 	}
 
 	m.CreateInputWithCapacity(InputName, 128)
@@ -88,6 +98,9 @@ func (module *Module) Start(ctx context.Context) {
 		panic(err)
 	}
 	module.G.GoCtx(ctx, module.listen)
+	if module.cosmosApi != nil {
+		module.G.GoCtx(ctx, module.receiveCommunityPool)
+	}
 }
 
 func (module *Module) init(ctx context.Context) error {
@@ -192,8 +205,9 @@ func (module *Module) listen(ctx context.Context) {
 				module.MustOutput(StopOutput).Push(struct{}{})
 				continue
 			}
+			module.requestCommunityPool(decodedContext.Block)
 
-			if err := module.notify(ctx, state, *decodedContext.Block); err != nil {
+			if err := module.notify(ctx, state, decodedContext); err != nil {
 				module.Log.Err(err).Msg("block notification error")
 			}
 		}
@@ -294,11 +308,20 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return state, err
 	}
 
-	addrToId, totalAccounts, err := saveAddresses(ctx, tx, dCtx.Addresses.Values())
+	addresses := dCtx.Addresses.Values()
+	addrToId, totalAccounts, err := saveAddresses(ctx, tx, addresses)
 	if err != nil {
 		return state, err
 	}
 
+	balances := make([]storage.Balance, 0, len(addresses))
+	for i := range addresses {
+		balances = append(balances, addresses[i].Balances...)
+	}
+	if err := saveBalanceUpdates(ctx, tx, block.Height, block.Time, balances, false); err != nil {
+		return state, err
+	}
+
 	if err := saveSigners(ctx, tx, addrToId, block.Txs); err != nil {
 		return state, err
 	}
@@ -316,7 +339,7 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return state, errors.Wrap(err, "save namespace messages")
 	}
 
-	totalValidators, err := module.saveValidators(ctx, tx, dCtx.Validators.Values(), dCtx.Jails)
+	totalValidators, err := module.saveValidators(ctx, tx, block, dCtx.Validators.Values(), dCtx.Jails)
 	if err != nil {
 		return state, err
 	}
@@ -336,8 +359,11 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 	if err := saveBlobLogs(ctx, tx, dCtx.BlobLogs, addrToId); err != nil {
 		return state, err
 	}
+	if err := tx.SaveShareRanges(ctx, dCtx.ShareRanges...); err != nil {
+		return state, err
+	}
 
-	totalProposals, err := module.saveProposals(ctx, tx, dCtx.Block.Height, dCtx.Proposals, dCtx.Votes, addrToId)
+	totalProposals, err := module.saveProposals(ctx, tx, dCtx.Block, dCtx.Proposals, dCtx.Votes, addrToId)
 	if err != nil {
 		return state, err
 	}
@@ -406,11 +432,16 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 
 	updateState(block, totalAccounts, totalNamespaces, totalProposals, ibcClientsCount, totalValidators, dCtx.Block.VersionApp, &state)
 
+	if err := module.saveSupply(ctx, tx, block, state); err != nil {
+		return state, err
+	}
+
 	err = tx.Update(ctx, &state)
 	return state, err
 }
 
-func (module *Module) notify(ctx context.Context, state storage.State, block storage.Block) error {
+func (module *Module) notify(ctx context.Context, state storage.State, dCtx *decodeContext.Context) error {
+	block := *dCtx.Block
 	if time.Since(block.Time) > time.Hour {
 		// do not notify all about events if initial indexing is in progress
 		return nil
@@ -432,7 +463,7 @@ func (module *Module) notify(ctx context.Context, state storage.State, block sto
 		return err
 	}
 
-	return nil
+	return module.notifyEntities(ctx, dCtx)
 }
 
 func (module *Module) setUpgradeApplied(ctx context.Context, tx storage.Transaction, currentVersion uint64, block *storage.Block) error {
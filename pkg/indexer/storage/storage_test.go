@@ -14,10 +14,14 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	indexerCfg "github.com/celenium-io/celestia-indexer/pkg/indexer/config"
 	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	nodeMock "github.com/celenium-io/celestia-indexer/pkg/node/mock"
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-io/go-lib/config"
 	"github.com/dipdup-io/go-lib/testhelpers"
 	"github.com/go-testfixtures/testfixtures/v3"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
 )
 
 const testIndexerName = "test_indexer"
@@ -77,7 +81,7 @@ func (s *ModuleTestSuite) TestBlockLast() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer ctxCancel()
 
-	module := NewModule(s.storage.Transactable, s.storage.Constants, s.storage.Validator, s.storage.Notificator, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(s.storage.Transactable, s.storage.Constants, s.storage.Validator, s.storage.Notificator, nil, indexerCfg.Indexer{Name: testIndexerName})
 	module.Start(ctx)
 
 	hash, err := hex.DecodeString("F44BC94BF7D064ADF82618F2691D2353161DE232ECB3091B7E5C89B453C79456")
@@ -109,9 +113,103 @@ func (s *ModuleTestSuite) TestBlockLast() {
 	s.Require().Equal(testIndexerName, state.Name)
 	s.Require().EqualValues(1001, state.LastHeight)
 
+	supply, err := s.storage.Supply.Last(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1001, supply.Height)
+	s.Require().Equal(state.TotalSupply.String(), supply.Total.String())
+	// stake of validators and undelegations which are not completed
+	s.Require().Equal("2000200", supply.Staked.String())
+	s.Require().Equal("1000", supply.Unbonding.String())
+	// community pool of the previous breakdown is kept
+	s.Require().Equal("10050", supply.CommunityPool.String())
+
 	s.Require().NoError(module.Close())
 }
 
+func (s *ModuleTestSuite) TestUpdateCommunityPool() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	cosmosApi := nodeMock.NewMockCosmosApi(ctrl)
+	cosmosApi.EXPECT().
+		CommunityPool(gomock.Any(), pkgTypes.Level(999)).
+		Return([]nodeTypes.Coins{
+			{Denom: "utia", Amount: "12345.500000000000000000"},
+		}, nil).
+		Times(1)
+
+	module := NewModule(s.storage.Transactable, s.storage.Constants, s.storage.Validator, s.storage.Notificator, cosmosApi, indexerCfg.Indexer{Name: testIndexerName})
+	err := module.updateCommunityPool(ctx, poolRequest{
+		height: 999,
+		time:   time.Date(2023, 7, 3, 10, 0, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+
+	items, err := s.storage.Supply.Series(ctx, storage.TimeframeHour, storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(items)
+
+	for i := range items {
+		expected := "12345"
+		if items[i].Height < 999 {
+			expected = "10000"
+		}
+		s.Require().Equal(expected, items[i].CommunityPool.String(), items[i].Height)
+	}
+}
+
+func (s *ModuleTestSuite) TestReindexKeepsTxIds() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	module := NewModule(s.storage.Transactable, s.storage.Constants, s.storage.Validator, s.storage.Notificator, nil, indexerCfg.Indexer{Name: testIndexerName})
+
+	blockHash, err := hex.DecodeString("6A30C94091DA7C436D64E62111D6890D772E351823C41496B4E52F28F5B000BF")
+	s.Require().NoError(err)
+	txHash, err := hex.DecodeString("652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF")
+	s.Require().NoError(err)
+
+	blockTime := time.Date(2023, 7, 4, 3, 10, 57, 0, time.UTC)
+	tx := storage.Tx{
+		Height:       1000,
+		Time:         blockTime,
+		Position:     0,
+		Hash:         txHash,
+		Status:       types.StatusSuccess,
+		MessageTypes: types.NewMsgTypeBitMask(),
+	}
+	// id of the decoded transaction differs from the id of the indexed one
+	s.Require().NoError(tx.SetId())
+	s.Require().NotEqualValues(1, tx.Id)
+
+	dCtx := decodeContext.NewContext()
+	dCtx.Block = &storage.Block{
+		Height:          1000,
+		Hash:            blockHash,
+		VersionBlock:    11,
+		VersionApp:      1,
+		ProposerAddress: "81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8",
+		Time:            blockTime,
+		MessageTypes:    types.NewMsgTypeBitMask(),
+		Txs:             []storage.Tx{tx},
+		Stats: storage.BlockStats{
+			Height:  1000,
+			Time:    blockTime,
+			TxCount: 1,
+		},
+	}
+	s.Require().NoError(module.Reindex(ctx, dCtx))
+
+	transfer, err := s.storage.IbcTransfers.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, transfer.TxId)
+	s.Require().NotNil(transfer.Tx)
+	s.Require().Equal(txHash, transfer.Tx.Hash)
+}
+
 func TestSuiteModule_Run(t *testing.T) {
 	suite.Run(t, new(ModuleTestSuite))
 }
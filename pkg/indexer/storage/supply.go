@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/currency"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// saveSupply - saves supply breakdown at the end of the block. It should be called after the state, validators and undelegations are updated with the block.
+// Staked and unbonding amounts are computed from indexed validators and undelegations. Community pool is received from the node
+// asynchronously, so the amount of the previous breakdown is kept. Amount locked in vesting accounts is recomputed once an hour.
+func (module *Module) saveSupply(ctx context.Context, tx storage.Transaction, block *storage.Block, state storage.State) error {
+	staked, unbonding, err := tx.StakingTotals(ctx, block.Time)
+	if err != nil {
+		return errors.Wrap(err, "staking totals")
+	}
+
+	communityPool, err := tx.LastCommunityPool(ctx)
+	if err != nil {
+		return errors.Wrap(err, "last community pool")
+	}
+
+	if hour := block.Time.Truncate(time.Hour); !hour.Equal(module.vestingLockedHour) {
+		locked, err := tx.LockedVesting(ctx, block.Time)
+		if err != nil {
+			return errors.Wrap(err, "locked vesting")
+		}
+		module.vestingLocked = locked
+		module.vestingLockedHour = hour
+	}
+
+	supply := storage.Supply{
+		Height:        block.Height,
+		Time:          block.Time,
+		Total:         state.TotalSupply,
+		Staked:        staked,
+		Unbonding:     unbonding,
+		VestingLocked: module.vestingLocked,
+		CommunityPool: communityPool,
+	}
+	supply.Circulating = storage.CirculatingSupply(supply.Total, supply.VestingLocked)
+
+	return tx.Add(ctx, &supply)
+}
+
+type poolRequest struct {
+	height pkgTypes.Level
+	time   time.Time
+}
+
+// requestCommunityPool - asks the worker to receive community pool at the saved block. Request is skipped if the worker is busy.
+func (module *Module) requestCommunityPool(block *storage.Block) {
+	if module.cosmosApi == nil {
+		return
+	}
+	select {
+	case module.poolRequests <- poolRequest{height: block.Height, time: block.Time}:
+	default:
+	}
+}
+
+// receiveCommunityPool - receives community pool from the node outside of block saving. Failures are logged and skipped:
+// the amount of the last successful request is kept in following supply breakdowns.
+func (module *Module) receiveCommunityPool(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-module.poolRequests:
+			if err := module.updateCommunityPool(ctx, req); err != nil {
+				module.Log.Warn().Err(err).Uint64("height", uint64(req.height)).Msg("update community pool")
+			}
+		}
+	}
+}
+
+func (module *Module) updateCommunityPool(ctx context.Context, req poolRequest) error {
+	coins, err := module.cosmosApi.CommunityPool(ctx, req.height)
+	if err != nil {
+		return errors.Wrap(err, "receive community pool")
+	}
+	communityPool := types.NumericZero()
+	for i := range coins {
+		if coins[i].Denom != currency.DefaultCurrency {
+			continue
+		}
+		amount, err := types.NumericFromString(coins[i].Amount)
+		if err != nil {
+			return errors.Wrap(err, "community pool")
+		}
+		communityPool = amount.Floor()
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, module.storage)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	if err := tx.UpdateCommunityPool(ctx, req.height, req.time, communityPool); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return nil
+}
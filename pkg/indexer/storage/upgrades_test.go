@@ -43,7 +43,7 @@ func TestUpgradeV7(t *testing.T) {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer ctxCancel()
 
-	module := NewModule(nil, nil, validators, nil, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(nil, nil, validators, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 	dCtx := decodeContext.NewContext()
 
 	err := module.upgradeV7(ctx, dCtx, 7)
@@ -82,7 +82,7 @@ func TestUpgrade_V8WithoutPriorV7(t *testing.T) {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer ctxCancel()
 
-	module := NewModule(nil, nil, validators, nil, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(nil, nil, validators, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 	dCtx := decodeContext.NewContext()
 
 	err := module.upgrade(ctx, dCtx, 6, 8)
@@ -126,7 +126,7 @@ func TestUpgrade_V8WithPriorV7(t *testing.T) {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer ctxCancel()
 
-	module := NewModule(nil, nil, validators, nil, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(nil, nil, validators, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 	dCtx := decodeContext.NewContext()
 
 	err := module.upgrade(ctx, dCtx, 7, 8)
@@ -146,7 +146,7 @@ func TestUpgrade_NoOpWhenCurrentGTE(t *testing.T) {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer ctxCancel()
 
-	module := NewModule(nil, nil, validators, nil, indexerCfg.Indexer{Name: testIndexerName})
+	module := NewModule(nil, nil, validators, nil, nil, indexerCfg.Indexer{Name: testIndexerName})
 	dCtx := decodeContext.NewContext()
 
 	err := module.upgrade(ctx, dCtx, 8, 8)
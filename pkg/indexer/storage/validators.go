@@ -14,6 +14,7 @@ import (
 func (module *Module) saveValidators(
 	ctx context.Context,
 	tx storage.Transaction,
+	block *storage.Block,
 	validators []*storage.Validator,
 	jails *sdkSync.Map[string, *storage.Jail],
 ) (int, error) {
@@ -30,12 +31,12 @@ func (module *Module) saveValidators(
 				return 0, errors.Errorf("unknown jailed validator: %s", address)
 			}
 
+			jailsArr = append(jailsArr, *j)
+
 			if j.Burned.IsZero() {
 				continue
 			}
 
-			jailsArr = append(jailsArr, *j)
-
 			balanceUpdates, err := tx.UpdateSlashedDelegations(ctx, j.ValidatorId, j.Burned)
 			if err != nil {
 				return 0, err
@@ -43,6 +44,9 @@ func (module *Module) saveValidators(
 			if err := tx.SaveBalances(ctx, balanceUpdates...); err != nil {
 				return 0, err
 			}
+			if err := saveBalanceUpdates(ctx, tx, j.Height, j.Time, balanceUpdates, true); err != nil {
+				return 0, err
+			}
 		}
 
 		if err := tx.Jail(ctx, jailedVals...); err != nil {
@@ -63,6 +67,22 @@ func (module *Module) saveValidators(
 		return 0, errors.Wrap(err, "saving validators")
 	}
 
+	rates := make([]storage.ValidatorRate, 0)
+	for i := range validators {
+		if !validators[i].Rate.IsPositive() {
+			continue
+		}
+		rates = append(rates, storage.ValidatorRate{
+			Height:      block.Height,
+			Time:        block.Time,
+			ValidatorId: validators[i].Id,
+			Rate:        validators[i].Rate,
+		})
+	}
+	if err := tx.SaveValidatorRates(ctx, rates...); err != nil {
+		return 0, errors.Wrap(err, "saving validator rates")
+	}
+
 	if count == 0 {
 		return 0, nil
 	}
@@ -32,4 +32,11 @@ type DalApi interface {
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type CosmosApi interface {
 	ModuleAccounts(ctx context.Context) ([]types.Account, error)
+	CommunityPool(ctx context.Context, height pkgTypes.Level) ([]types.Coins, error)
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type MempoolApi interface {
+	UnconfirmedTxs(ctx context.Context, limit int) (types.UnconfirmedTxs, error)
+	TxStatus(ctx context.Context, hash []byte) (types.TxStatus, error)
 }
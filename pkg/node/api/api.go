@@ -8,9 +8,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/bytedance/sonic"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-io/go-lib/config"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -65,7 +67,10 @@ func NewAPI(cfg config.DataSource) API {
 	}
 }
 
-func (api *API) get(ctx context.Context, path string, args map[string]string, output any) error {
+const heightHeader = "x-cosmos-block-height"
+
+// get - requests cosmos REST API. If height is not zero, the state at the height is requested.
+func (api *API) get(ctx context.Context, path string, height pkgTypes.Level, args map[string]string, output any) error {
 	u, err := url.Parse(api.cfg.URL)
 	if err != nil {
 		return err
@@ -94,6 +99,9 @@ func (api *API) get(ctx context.Context, path string, args map[string]string, ou
 		return err
 	}
 	req.Header.Set("User-Agent", celeniumUserAgent)
+	if height > 0 {
+		req.Header.Set(heightHeader, strconv.FormatInt(int64(height), 10))
+	}
 
 	response, err := api.client.Do(req) //nolint:gosec,bodyclose
 	if err != nil {
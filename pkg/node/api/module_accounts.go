@@ -12,7 +12,7 @@ import (
 
 func (api *API) ModuleAccounts(ctx context.Context) ([]types.Account, error) {
 	var response types.Auth
-	if err := api.get(ctx, "cosmos/auth/v1beta1/module_accounts", nil, &response); err != nil {
+	if err := api.get(ctx, "cosmos/auth/v1beta1/module_accounts", 0, nil, &response); err != nil {
 		return nil, errors.Wrap(err, "get")
 	}
 	return response.Accounts, nil
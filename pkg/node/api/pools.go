@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package api
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// CommunityPool - returns coins of the community pool at the height
+func (api *API) CommunityPool(ctx context.Context, height pkgTypes.Level) ([]types.Coins, error) {
+	var response types.CommunityPoolResponse
+	if err := api.get(ctx, "cosmos/distribution/v1beta1/community_pool", height, nil, &response); err != nil {
+		return nil, errors.Wrap(err, "get")
+	}
+	return response.Pool, nil
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package archive
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/pkg/node"
+	"github.com/celenium-io/celestia-indexer/pkg/node/rpc"
+	"github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	kgzip "github.com/klauspost/compress/gzip"
+	"github.com/pkg/errors"
+)
+
+// API - node.Api implementation which reads blocks from local archive recorded by Recorder.
+// Head of the archive is the highest recorded level.
+type API struct {
+	dir string
+}
+
+var _ node.Api = (*API)(nil)
+
+func NewAPI(dir string) (*API, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "archive directory")
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf("archive path is not a directory: %s", dir)
+	}
+	return &API{dir: dir}, nil
+}
+
+func (api *API) CurrentHead(_ context.Context) (pkgTypes.Level, error) {
+	level, err := lastLevel(api.dir)
+	return level, errors.Wrap(err, "CurrentHead")
+}
+
+func (api *API) Head(ctx context.Context) (pkgTypes.ResultBlock, error) {
+	return api.Block(ctx, 0)
+}
+
+func (api *API) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlock, error) {
+	data, err := api.BlockDataGet(ctx, level)
+	if err != nil {
+		return pkgTypes.ResultBlock{}, errors.Wrap(err, "Block")
+	}
+	return data.ResultBlock, nil
+}
+
+func (api *API) BlockResults(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlockResults, error) {
+	data, err := api.BlockDataGet(ctx, level)
+	if err != nil {
+		return pkgTypes.ResultBlockResults{}, errors.Wrap(err, "BlockResults")
+	}
+	return data.ResultBlockResults, nil
+}
+
+func (api *API) BlockDataGet(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
+	if level == 0 {
+		head, err := api.CurrentHead(ctx)
+		if err != nil {
+			return pkgTypes.BlockData{}, err
+		}
+		level = head
+	}
+
+	var (
+		result pkgTypes.BlockData
+		found  bool
+	)
+	err := readFile(blockPath(api.dir, level), func(r io.Reader) error {
+		return rpc.DecodeBlockData(r, func(data pkgTypes.BlockData) error {
+			result = data
+			found = true
+			return nil
+		})
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, errors.Wrapf(types.ErrRequest, "block %d is not found in archive", level)
+		}
+		return result, errors.Wrapf(err, "read block %d", level)
+	}
+	if !found {
+		return result, errors.Errorf("archive file of block %d is empty", level)
+	}
+	return result, nil
+}
+
+func (api *API) BlockBulkData(ctx context.Context, levels ...pkgTypes.Level) ([]pkgTypes.BlockData, error) {
+	result := make([]pkgTypes.BlockData, 0, len(levels))
+	err := api.BlockBulkDataStream(ctx, func(data pkgTypes.BlockData) error {
+		result = append(result, data)
+		return nil
+	}, levels...)
+	return result, err
+}
+
+func (api *API) BlockBulkDataStream(ctx context.Context, fn func(pkgTypes.BlockData) error, levels ...pkgTypes.Level) error {
+	for _, level := range levels {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := api.BlockDataGet(ctx, level)
+		if err != nil {
+			return err
+		}
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (api *API) Genesis(_ context.Context) (types.Genesis, error) {
+	var genesis types.Genesis
+	err := readFile(genesisPath(api.dir), func(r io.Reader) error {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return sonic.ConfigFastest.Unmarshal(data, &genesis)
+	})
+	return genesis, errors.Wrap(err, "Genesis")
+}
+
+func readFile(path string, fn func(io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := kgzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	return fn(gz)
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testSource struct {
+	failOn pkgTypes.Level
+}
+
+func (s testSource) RawBlockData(_ context.Context, level pkgTypes.Level, w io.Writer) error {
+	if level == s.failOn {
+		_, err := io.WriteString(w, `[{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"height is not available"}}]`)
+		return err
+	}
+	_, err := fmt.Fprintf(w,
+		`[{"jsonrpc":"2.0","id":-1,"result":{"block_id":{"hash":"DEADBEEF"},"block":{"header":{"chain_id":"celestia","height":"%d","time":"2024-01-01T00:00:00Z"},"data":{"txs":[],"square_size":"1"},"last_commit":{"height":"%d","signatures":[]}}}},`+
+			`{"jsonrpc":"2.0","id":-1,"result":{"height":"%d","txs_results":null,"finalize_block_events":[]}}]`,
+		level, level-1, level,
+	)
+	return err
+}
+
+func (s testSource) Genesis(_ context.Context) (types.Genesis, error) {
+	return types.Genesis{
+		ChainID:       "celestia",
+		InitialHeight: 1,
+	}, nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	ctx := t.Context()
+
+	recorder := NewRecorder(testSource{}, dir, WithWorkers(3))
+	require.NoError(t, recorder.RecordGenesis(ctx))
+	require.NoError(t, recorder.Record(ctx, 9_998, 10_002))
+
+	api, err := NewAPI(dir)
+	require.NoError(t, err)
+
+	head, err := api.CurrentHead(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 10_002, head)
+
+	block, err := api.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 10_002, block.Block.Height)
+
+	data, err := api.BlockBulkData(ctx, 9_998, 9_999, 10_000)
+	require.NoError(t, err)
+	require.Len(t, data, 3)
+	for i := range data {
+		require.EqualValues(t, 9_998+i, data[i].Height)
+		require.EqualValues(t, 9_998+i, data[i].Block.Height)
+	}
+
+	genesis, err := api.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "celestia", genesis.ChainID)
+	require.EqualValues(t, 1, genesis.InitialHeight)
+
+	_, err = api.BlockDataGet(ctx, 10_003)
+	require.ErrorIs(t, err, types.ErrRequest)
+}
+
+func TestRecordSkipsErrorResponse(t *testing.T) {
+	dir := t.TempDir()
+	ctx := t.Context()
+
+	recorder := NewRecorder(testSource{failOn: 3}, dir)
+	err := recorder.Record(ctx, 1, 5)
+	require.ErrorIs(t, err, types.ErrRequest)
+
+	api, err := NewAPI(dir)
+	require.NoError(t, err)
+
+	head, err := api.CurrentHead(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, head)
+}
+
+func TestEmptyArchive(t *testing.T) {
+	api, err := NewAPI(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = api.CurrentHead(t.Context())
+	require.ErrorIs(t, err, ErrEmptyArchive)
+
+	err = NewRecorder(testSource{}, t.TempDir()).Record(t.Context(), 5, 1)
+	require.Error(t, err)
+}
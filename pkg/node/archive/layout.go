@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package archive
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Archive layout:
+//
+//	<dir>/genesis.json.gz
+//	<dir>/blocks/<height / bucketSize>/<height>.json.gz
+//
+// Every block file contains gzipped JSON-RPC batch response of `block` and `block_results` requests.
+const (
+	genesisFile = "genesis.json.gz"
+	blocksDir   = "blocks"
+	blockExt    = ".json.gz"
+	bucketSize  = 10_000
+)
+
+var ErrEmptyArchive = errors.New("archive doesn't contain blocks")
+
+func bucketName(level pkgTypes.Level) string {
+	return fmt.Sprintf("%08d", uint64(level)/bucketSize)
+}
+
+func blockPath(dir string, level pkgTypes.Level) string {
+	return filepath.Join(dir, blocksDir, bucketName(level), fmt.Sprintf("%012d%s", uint64(level), blockExt))
+}
+
+func genesisPath(dir string) string {
+	return filepath.Join(dir, genesisFile)
+}
+
+// lastLevel - returns the highest recorded level. Names are zero-padded, so lexical order is numeric.
+func lastLevel(dir string) (pkgTypes.Level, error) {
+	buckets, err := os.ReadDir(filepath.Join(dir, blocksDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrEmptyArchive
+		}
+		return 0, err
+	}
+
+	for _, bucket := range slices.Backward(buckets) {
+		if !bucket.IsDir() {
+			continue
+		}
+		files, err := os.ReadDir(filepath.Join(dir, blocksDir, bucket.Name()))
+		if err != nil {
+			return 0, err
+		}
+		for _, file := range slices.Backward(files) {
+			name, ok := strings.CutSuffix(file.Name(), blockExt)
+			if file.IsDir() || !ok {
+				continue
+			}
+			level, err := strconv.ParseInt(name, 10, 64)
+			if err != nil {
+				continue
+			}
+			return pkgTypes.Level(level), nil
+		}
+	}
+	return 0, ErrEmptyArchive
+}
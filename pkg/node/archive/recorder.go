@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/celenium-io/celestia-indexer/pkg/node/rpc"
+	"github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	kgzip "github.com/klauspost/compress/gzip"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Source - node which blocks are recorded from. It's implemented by rpc.API.
+type Source interface {
+	RawBlockData(ctx context.Context, level pkgTypes.Level, w io.Writer) error
+	Genesis(ctx context.Context) (types.Genesis, error)
+}
+
+// Recorder - writes node responses to local archive which can be replayed by API
+type Recorder struct {
+	source  Source
+	dir     string
+	workers int
+	log     zerolog.Logger
+}
+
+type RecorderOption func(*Recorder)
+
+func WithWorkers(workers int) RecorderOption {
+	return func(r *Recorder) {
+		if workers > 0 {
+			r.workers = workers
+		}
+	}
+}
+
+func NewRecorder(source Source, dir string, opts ...RecorderOption) *Recorder {
+	r := &Recorder{
+		source:  source,
+		dir:     dir,
+		workers: 1,
+		log:     log.With().Str("module", "archive recorder").Logger(),
+	}
+	for i := range opts {
+		opts[i](r)
+	}
+	return r
+}
+
+// Record - records blocks from `from` to `to` inclusive. Already recorded blocks are skipped,
+// so interrupted recording can be continued with the same arguments.
+func (r *Recorder) Record(ctx context.Context, from, to pkgTypes.Level) error {
+	if from < 1 || from > to {
+		return errors.Errorf("invalid range of levels: %d - %d", from, to)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	levels := make(chan pkgTypes.Level)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for range r.workers {
+		wg.Go(func() {
+			for level := range levels {
+				if err := r.recordBlock(ctx, level); err != nil {
+					once.Do(func() {
+						firstErr = errors.Wrapf(err, "record block %d", level)
+						cancel()
+					})
+					return
+				}
+			}
+		})
+	}
+
+send:
+	for level := from; level <= to; level++ {
+		select {
+		case <-ctx.Done():
+			break send
+		case levels <- level:
+		}
+	}
+	close(levels)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+func (r *Recorder) recordBlock(ctx context.Context, level pkgTypes.Level) error {
+	path := blockPath(r.dir, level)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := r.source.RawBlockData(ctx, level, &buf); err != nil {
+		return err
+	}
+	// node can respond with error inside of successful response, such responses shouldn't be recorded
+	var height pkgTypes.Level
+	if err := rpc.DecodeBlockData(bytes.NewReader(buf.Bytes()), func(data pkgTypes.BlockData) error {
+		height = data.Height
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode response")
+	}
+	if height != level {
+		return errors.Errorf("unexpected block height in response: %d", height)
+	}
+
+	if err := writeFile(path, func(w io.Writer) error {
+		_, err := w.Write(buf.Bytes())
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if level%1000 == 0 {
+		r.log.Info().Uint64("height", uint64(level)).Msg("block was recorded")
+	}
+	return nil
+}
+
+// RecordGenesis - records genesis which is required to replay archive from the first block
+func (r *Recorder) RecordGenesis(ctx context.Context) error {
+	genesis, err := r.source.Genesis(ctx)
+	if err != nil {
+		return errors.Wrap(err, "receive genesis")
+	}
+	data, err := sonic.Marshal(genesis)
+	if err != nil {
+		return errors.Wrap(err, "marshal genesis")
+	}
+	return writeFile(genesisPath(r.dir), func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// writeFile - writes gzipped file atomically, so partially written file never appears in archive
+func writeFile(path string, fn func(io.Writer) error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	gz := kgzip.NewWriter(tmp)
+	if err := fn(gz); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
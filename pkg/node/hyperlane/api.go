@@ -18,6 +18,7 @@ import (
 
 type Api struct {
 	client    fastshot.ClientHttpMethods
+	explorer  fastshot.ClientHttpMethods
 	rateLimit *rate.Limiter
 	timeout   time.Duration
 }
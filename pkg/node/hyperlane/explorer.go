@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package hyperlane
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const messagesQuery = `query ($ids: [bytea!]) {
+	message_view(where: {msg_id: {_in: $ids}}) {
+		msg_id
+		is_delivered
+		origin_tx_hash
+		destination_tx_hash
+		send_occurred_at
+		delivery_occurred_at
+	}
+}`
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+type messagesResponse struct {
+	Data struct {
+		Messages []explorerMessage `json:"message_view"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+type explorerMessage struct {
+	MsgId              string `json:"msg_id"`
+	IsDelivered        bool   `json:"is_delivered"`
+	OriginTxHash       string `json:"origin_tx_hash"`
+	DestinationTxHash  string `json:"destination_tx_hash"`
+	SendOccurredAt     string `json:"send_occurred_at"`
+	DeliveryOccurredAt string `json:"delivery_occurred_at"`
+}
+
+// Messages - receives delivery info of messages by their identities (hex without 0x prefix) from the hyperlane explorer
+func (api Api) Messages(ctx context.Context, ids []string) ([]MessageDelivery, error) {
+	if api.explorer == nil {
+		return nil, errors.New("hyperlane explorer url is not set")
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if api.rateLimit != nil {
+		if err := api.rateLimit.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, api.timeout)
+	defer cancel()
+
+	explorerIds := make([]string, len(ids))
+	for i := range ids {
+		explorerIds[i] = `\x` + strings.TrimPrefix(strings.ToLower(ids[i]), "0x")
+	}
+
+	resp, err := api.explorer.POST("").
+		Body().AsJSON(graphQLRequest{
+		Query: messagesQuery,
+		Variables: map[string]any{
+			"ids": explorerIds,
+		},
+	}).
+		Context().Set(requestCtx).
+		Send()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Status().IsError() {
+		return nil, errors.Errorf("invalid status: %d", resp.Status().Code())
+	}
+
+	var response messagesResponse
+	if err := resp.Body().AsJSON(&response); err != nil {
+		return nil, err
+	}
+	if len(response.Errors) > 0 {
+		return nil, errors.Errorf("hyperlane explorer error: %s", response.Errors[0].Message)
+	}
+
+	result := make([]MessageDelivery, len(response.Data.Messages))
+	for i, msg := range response.Data.Messages {
+		result[i] = MessageDelivery{
+			MessageId:          trimBytea(msg.MsgId),
+			IsDelivered:        msg.IsDelivered,
+			OriginTxHash:       trimBytea(msg.OriginTxHash),
+			DestinationTxHash:  trimBytea(msg.DestinationTxHash),
+			SendOccurredAt:     parseExplorerTime(msg.SendOccurredAt),
+			DeliveryOccurredAt: parseExplorerTime(msg.DeliveryOccurredAt),
+		}
+	}
+	return result, nil
+}
+
+func trimBytea(value string) string {
+	return strings.TrimPrefix(value, `\x`)
+}
+
+// parseExplorerTime - explorer returns timestamps in UTC without time zone
+func parseExplorerTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			t = t.UTC()
+			return &t
+		}
+	}
+	return nil
+}
@@ -3,7 +3,10 @@
 
 package hyperlane
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type ChainMetadata struct {
 	DomainId       uint64          `yaml:"domainId"`
@@ -25,7 +28,18 @@ type NativeToken struct {
 	Symbol   string `yaml:"symbol"`
 }
 
+// MessageDelivery - delivery info of the hyperlane message received from the hyperlane explorer
+type MessageDelivery struct {
+	MessageId          string
+	IsDelivered        bool
+	OriginTxHash       string
+	DestinationTxHash  string
+	SendOccurredAt     *time.Time
+	DeliveryOccurredAt *time.Time
+}
+
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IApi interface {
 	ChainMetadata(ctx context.Context) (map[uint64]ChainMetadata, error)
+	Messages(ctx context.Context, ids []string) ([]MessageDelivery, error)
 }
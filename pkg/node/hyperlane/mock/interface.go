@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock/interface.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	hyperlane "github.com/celenium-io/celestia-indexer/pkg/node/hyperlane"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApi is a mock of IApi interface.
+type MockIApi struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiMockRecorder
+	isgomock struct{}
+}
+
+// MockIApiMockRecorder is the mock recorder for MockIApi.
+type MockIApiMockRecorder struct {
+	mock *MockIApi
+}
+
+// NewMockIApi creates a new mock instance.
+func NewMockIApi(ctrl *gomock.Controller) *MockIApi {
+	mock := &MockIApi{ctrl: ctrl}
+	mock.recorder = &MockIApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApi) EXPECT() *MockIApiMockRecorder {
+	return m.recorder
+}
+
+// ChainMetadata mocks base method.
+func (m *MockIApi) ChainMetadata(ctx context.Context) (map[uint64]hyperlane.ChainMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainMetadata", ctx)
+	ret0, _ := ret[0].(map[uint64]hyperlane.ChainMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainMetadata indicates an expected call of ChainMetadata.
+func (mr *MockIApiMockRecorder) ChainMetadata(ctx any) *MockIApiChainMetadataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainMetadata", reflect.TypeOf((*MockIApi)(nil).ChainMetadata), ctx)
+	return &MockIApiChainMetadataCall{Call: call}
+}
+
+// MockIApiChainMetadataCall wrap *gomock.Call
+type MockIApiChainMetadataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiChainMetadataCall) Return(arg0 map[uint64]hyperlane.ChainMetadata, arg1 error) *MockIApiChainMetadataCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiChainMetadataCall) Do(f func(context.Context) (map[uint64]hyperlane.ChainMetadata, error)) *MockIApiChainMetadataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiChainMetadataCall) DoAndReturn(f func(context.Context) (map[uint64]hyperlane.ChainMetadata, error)) *MockIApiChainMetadataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Messages mocks base method.
+func (m *MockIApi) Messages(ctx context.Context, ids []string) ([]hyperlane.MessageDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Messages", ctx, ids)
+	ret0, _ := ret[0].([]hyperlane.MessageDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Messages indicates an expected call of Messages.
+func (mr *MockIApiMockRecorder) Messages(ctx, ids any) *MockIApiMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Messages", reflect.TypeOf((*MockIApi)(nil).Messages), ctx, ids)
+	return &MockIApiMessagesCall{Call: call}
+}
+
+// MockIApiMessagesCall wrap *gomock.Call
+type MockIApiMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiMessagesCall) Return(arg0 []hyperlane.MessageDelivery, arg1 error) *MockIApiMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiMessagesCall) Do(f func(context.Context, []string) ([]hyperlane.MessageDelivery, error)) *MockIApiMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiMessagesCall) DoAndReturn(f func(context.Context, []string) ([]hyperlane.MessageDelivery, error)) *MockIApiMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -6,6 +6,7 @@ package hyperlane
 import (
 	"time"
 
+	fastshot "github.com/opus-domini/fast-shot"
 	"golang.org/x/time/rate"
 )
 
@@ -22,3 +23,11 @@ func WithTimeout(timeout time.Duration) ApiOption {
 		api.timeout = timeout
 	}
 }
+
+func WithExplorerUrl(url string) ApiOption {
+	return func(api *Api) {
+		if url != "" {
+			api.explorer = fastshot.NewClient(url).Build()
+		}
+	}
+}
@@ -497,6 +497,45 @@ func (m *MockCosmosApi) EXPECT() *MockCosmosApiMockRecorder {
 	return m.recorder
 }
 
+// CommunityPool mocks base method.
+func (m *MockCosmosApi) CommunityPool(ctx context.Context, height types0.Level) ([]types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommunityPool", ctx, height)
+	ret0, _ := ret[0].([]types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommunityPool indicates an expected call of CommunityPool.
+func (mr *MockCosmosApiMockRecorder) CommunityPool(ctx, height any) *MockCosmosApiCommunityPoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommunityPool", reflect.TypeOf((*MockCosmosApi)(nil).CommunityPool), ctx, height)
+	return &MockCosmosApiCommunityPoolCall{Call: call}
+}
+
+// MockCosmosApiCommunityPoolCall wrap *gomock.Call
+type MockCosmosApiCommunityPoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCosmosApiCommunityPoolCall) Return(arg0 []types.Coins, arg1 error) *MockCosmosApiCommunityPoolCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCosmosApiCommunityPoolCall) Do(f func(context.Context, types0.Level) ([]types.Coins, error)) *MockCosmosApiCommunityPoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCosmosApiCommunityPoolCall) DoAndReturn(f func(context.Context, types0.Level) ([]types.Coins, error)) *MockCosmosApiCommunityPoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ModuleAccounts mocks base method.
 func (m *MockCosmosApi) ModuleAccounts(ctx context.Context) ([]types.Account, error) {
 	m.ctrl.T.Helper()
@@ -535,3 +574,105 @@ func (c *MockCosmosApiModuleAccountsCall) DoAndReturn(f func(context.Context) ([
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockMempoolApi is a mock of MempoolApi interface.
+type MockMempoolApi struct {
+	ctrl     *gomock.Controller
+	recorder *MockMempoolApiMockRecorder
+	isgomock struct{}
+}
+
+// MockMempoolApiMockRecorder is the mock recorder for MockMempoolApi.
+type MockMempoolApiMockRecorder struct {
+	mock *MockMempoolApi
+}
+
+// NewMockMempoolApi creates a new mock instance.
+func NewMockMempoolApi(ctrl *gomock.Controller) *MockMempoolApi {
+	mock := &MockMempoolApi{ctrl: ctrl}
+	mock.recorder = &MockMempoolApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMempoolApi) EXPECT() *MockMempoolApiMockRecorder {
+	return m.recorder
+}
+
+// TxStatus mocks base method.
+func (m *MockMempoolApi) TxStatus(ctx context.Context, hash []byte) (types.TxStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxStatus", ctx, hash)
+	ret0, _ := ret[0].(types.TxStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxStatus indicates an expected call of TxStatus.
+func (mr *MockMempoolApiMockRecorder) TxStatus(ctx, hash any) *MockMempoolApiTxStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxStatus", reflect.TypeOf((*MockMempoolApi)(nil).TxStatus), ctx, hash)
+	return &MockMempoolApiTxStatusCall{Call: call}
+}
+
+// MockMempoolApiTxStatusCall wrap *gomock.Call
+type MockMempoolApiTxStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMempoolApiTxStatusCall) Return(arg0 types.TxStatus, arg1 error) *MockMempoolApiTxStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMempoolApiTxStatusCall) Do(f func(context.Context, []byte) (types.TxStatus, error)) *MockMempoolApiTxStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMempoolApiTxStatusCall) DoAndReturn(f func(context.Context, []byte) (types.TxStatus, error)) *MockMempoolApiTxStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnconfirmedTxs mocks base method.
+func (m *MockMempoolApi) UnconfirmedTxs(ctx context.Context, limit int) (types.UnconfirmedTxs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnconfirmedTxs", ctx, limit)
+	ret0, _ := ret[0].(types.UnconfirmedTxs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnconfirmedTxs indicates an expected call of UnconfirmedTxs.
+func (mr *MockMempoolApiMockRecorder) UnconfirmedTxs(ctx, limit any) *MockMempoolApiUnconfirmedTxsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnconfirmedTxs", reflect.TypeOf((*MockMempoolApi)(nil).UnconfirmedTxs), ctx, limit)
+	return &MockMempoolApiUnconfirmedTxsCall{Call: call}
+}
+
+// MockMempoolApiUnconfirmedTxsCall wrap *gomock.Call
+type MockMempoolApiUnconfirmedTxsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMempoolApiUnconfirmedTxsCall) Return(arg0 types.UnconfirmedTxs, arg1 error) *MockMempoolApiUnconfirmedTxsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMempoolApiUnconfirmedTxsCall) Do(f func(context.Context, int) (types.UnconfirmedTxs, error)) *MockMempoolApiUnconfirmedTxsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMempoolApiUnconfirmedTxsCall) DoAndReturn(f func(context.Context, int) (types.UnconfirmedTxs, error)) *MockMempoolApiUnconfirmedTxsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -230,6 +230,17 @@ func (api *API) getStream(ctx context.Context, path string, args map[string]stri
 }
 
 func (api *API) postStream(ctx context.Context, requests []types.Request, fn func(*jxpkg.Decoder) error) error {
+	return api.post(ctx, requests, func(body io.Reader) error {
+		d := decoderPool.Get()
+		d.Reset(body)
+		defer decoderPool.Put(d)
+
+		return fn(d)
+	})
+}
+
+// post - sends batch request and passes decompressed response body to fn
+func (api *API) post(ctx context.Context, requests []types.Request, fn func(io.Reader) error) error {
 	u, err := url.Parse(api.cfg.URL)
 	if err != nil {
 		return err
@@ -281,11 +292,7 @@ func (api *API) postStream(ctx context.Context, requests []types.Request, fn fun
 		Str("url", u.String()).
 		Msg("post request")
 
-	d := decoderPool.Get()
-	d.Reset(streamBody)
-	defer decoderPool.Put(d)
-
-	return fn(d)
+	return fn(streamBody)
 }
 
 func closeWithLogError(stream io.ReadCloser, log zerolog.Logger) {
@@ -817,6 +817,56 @@ func jxGenesisChunk(d *jxpkg.Decoder, gc *GenesisChunk) error {
 	})
 }
 
+// jxUnconfirmedTxs decodes the unconfirmed_txs response.
+// Transactions are base64-decoded from the JSON string values.
+func jxUnconfirmedTxs(d *jxpkg.Decoder, result *nodeTypes.UnconfirmedTxs) error {
+	return d.ObjBytes(func(d *jxpkg.Decoder, key []byte) error {
+		var err error
+		switch string(key) {
+		case "n_txs":
+			result.Count, err = jxInt64(d)
+		case "total":
+			result.Total, err = jxInt64(d)
+		case "total_bytes":
+			result.TotalBytes, err = jxInt64(d)
+		case "txs":
+			if d.Next() == jxpkg.Null {
+				return d.Null()
+			}
+			return d.Arr(func(d *jxpkg.Decoder) error {
+				raw, err := d.StrBytes()
+				if err != nil {
+					return err
+				}
+				tx, err := base64.StdEncoding.AppendDecode(nil, raw)
+				if err != nil {
+					return errors.Wrap(err, "unconfirmed tx base64 decode")
+				}
+				result.Txs = append(result.Txs, tx)
+				return nil
+			})
+		default:
+			return d.Skip()
+		}
+		return err
+	})
+}
+
+func jxTxStatus(d *jxpkg.Decoder, result *nodeTypes.TxStatus) error {
+	return d.ObjBytes(func(d *jxpkg.Decoder, key []byte) error {
+		var err error
+		switch string(key) {
+		case "height":
+			result.Height, err = jxInt64(d)
+		case "status":
+			result.Status, err = d.Str()
+		default:
+			return d.Skip()
+		}
+		return err
+	})
+}
+
 // jxBatchResponse decodes a JSON-RPC batch response array, calling fn for each
 // pair of block + block_results responses. Handles JSON-RPC error objects.
 func jxBatchResponse(d *jxpkg.Decoder, fn func(pkgTypes.BlockData) error) error {
@@ -1161,3 +1161,57 @@ func TestJxGenesisChunk_EmptyData(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, gc.Data)
 }
+
+// ── jxUnconfirmedTxs ─────────────────────────────────────────────────────────
+
+func TestJxUnconfirmedTxs_AllFields(t *testing.T) {
+	tx1 := []byte("first transaction")
+	tx2 := []byte("second transaction")
+	input := `{"n_txs":"2","total":"10","total_bytes":"4096","txs":["` +
+		base64.StdEncoding.EncodeToString(tx1) + `","` +
+		base64.StdEncoding.EncodeToString(tx2) + `"]}`
+
+	d := jdec(input)
+	defer jxpkg.PutDecoder(d)
+
+	var result nodeTypes.UnconfirmedTxs
+	err := jxUnconfirmedTxs(d, &result)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), result.Count)
+	require.Equal(t, int64(10), result.Total)
+	require.Equal(t, int64(4096), result.TotalBytes)
+	require.Equal(t, [][]byte{tx1, tx2}, result.Txs)
+}
+
+func TestJxUnconfirmedTxs_NullTxs(t *testing.T) {
+	d := jdec(`{"n_txs":"0","total":"0","total_bytes":"0","txs":null}`)
+	defer jxpkg.PutDecoder(d)
+
+	var result nodeTypes.UnconfirmedTxs
+	err := jxUnconfirmedTxs(d, &result)
+	require.NoError(t, err)
+	require.Empty(t, result.Txs)
+}
+
+func TestJxUnconfirmedTxs_InvalidBase64(t *testing.T) {
+	d := jdec(`{"n_txs":"1","total":"1","total_bytes":"10","txs":["!!!not-valid-base64!!!"]}`)
+	defer jxpkg.PutDecoder(d)
+
+	var result nodeTypes.UnconfirmedTxs
+	err := jxUnconfirmedTxs(d, &result)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unconfirmed tx base64 decode")
+}
+
+// ── jxTxStatus ───────────────────────────────────────────────────────────────
+
+func TestJxTxStatus(t *testing.T) {
+	d := jdec(`{"height":"0","index":0,"execution_code":0,"error":"","status":"PENDING"}`)
+	defer jxpkg.PutDecoder(d)
+
+	var result nodeTypes.TxStatus
+	err := jxTxStatus(d, &result)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, result.Height)
+	require.Equal(t, nodeTypes.TxStatusPending, result.Status)
+}
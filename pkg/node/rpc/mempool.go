@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/celenium-io/celestia-indexer/pkg/node/types"
+	jxpkg "github.com/go-faster/jx"
+	"github.com/pkg/errors"
+)
+
+const (
+	pathUnconfirmedTxs = "unconfirmed_txs"
+	pathTxStatus       = "tx_status"
+)
+
+// UnconfirmedTxs - returns raw transactions from the node's mempool. Node limits the count of returned transactions with 100 by default.
+func (api *API) UnconfirmedTxs(ctx context.Context, limit int) (types.UnconfirmedTxs, error) {
+	var args map[string]string
+	if limit > 0 {
+		args = map[string]string{
+			"limit": strconv.Itoa(limit),
+		}
+	}
+
+	var result types.UnconfirmedTxs
+	err := api.getStream(ctx, pathUnconfirmedTxs, args, func(d *jxpkg.Decoder) error {
+		return jxResponse(d, func(d *jxpkg.Decoder) error {
+			return jxUnconfirmedTxs(d, &result)
+		})
+	})
+	return result, errors.Wrap(err, "UnconfirmedTxs")
+}
+
+// TxStatus - returns status of transaction in the node: whether it's pending in mempool, committed, evicted, rejected or unknown.
+func (api *API) TxStatus(ctx context.Context, hash []byte) (types.TxStatus, error) {
+	args := map[string]string{
+		"hash": "0x" + hex.EncodeToString(hash),
+	}
+
+	var result types.TxStatus
+	err := api.getStream(ctx, pathTxStatus, args, func(d *jxpkg.Decoder) error {
+		return jxResponse(d, func(d *jxpkg.Decoder) error {
+			return jxTxStatus(d, &result)
+		})
+	})
+	return result, errors.Wrap(err, "TxStatus")
+}
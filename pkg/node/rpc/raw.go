@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// RawBlockData - writes raw JSON-RPC batch response with block and block results of the level.
+// Written response can be decoded with DecodeBlockData exactly as live response.
+func (api *API) RawBlockData(ctx context.Context, level pkgTypes.Level, w io.Writer) error {
+	levelString := level.String()
+	requests := []types.Request{
+		{Method: pathBlock, JsonRpc: "2.0", Id: -1, Params: []any{levelString}},
+		{Method: pathBlockResults, JsonRpc: "2.0", Id: -1, Params: []any{levelString}},
+	}
+
+	err := api.post(ctx, requests, func(body io.Reader) error {
+		_, err := io.Copy(w, body)
+		return err
+	})
+	return errors.Wrap(err, "RawBlockData")
+}
+
+// DecodeBlockData - decodes JSON-RPC batch response of block and block results requests
+func DecodeBlockData(r io.Reader, fn func(pkgTypes.BlockData) error) error {
+	d := decoderPool.Get()
+	d.Reset(r)
+	defer decoderPool.Put(d)
+
+	return jxBatchResponse(d, fn)
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// UnconfirmedTxs - result of `unconfirmed_txs` request
+type UnconfirmedTxs struct {
+	Count      int64
+	Total      int64
+	TotalBytes int64
+	Txs        [][]byte
+}
+
+// Statuses of transaction returned by `tx_status` request
+const (
+	TxStatusPending   = "PENDING"
+	TxStatusCommitted = "COMMITTED"
+	TxStatusEvicted   = "EVICTED"
+	TxStatusRejected  = "REJECTED"
+	TxStatusUnknown   = "UNKNOWN"
+)
+
+// TxStatus - result of `tx_status` request
+type TxStatus struct {
+	Height int64
+	Status string
+}
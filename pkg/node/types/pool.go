@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: 2025 Bb Strategy Pte. Ltd. <celenium@baking-bad.org>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// CommunityPoolResponse - amounts of the community pool are decimal coins, e.g. `1234.567890000000000000`
+type CommunityPoolResponse struct {
+	Pool []Coins `json:"pool"`
+}